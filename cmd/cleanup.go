@@ -0,0 +1,280 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/spf13/pflag"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/generator"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/lb"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/config"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/metric"
+)
+
+// cleanupCommand is the subcommand name used to delete all AWS resources of a cluster.
+const cleanupCommand = "cleanup"
+
+// cleanupOptions defines the commandline interface of the cleanup subcommand
+type cleanupOptions struct {
+	// DryRun prints the resources which would be deleted, without deleting them
+	DryRun bool
+
+	cloudConfig      aws.CloudConfig
+	ingressCTLConfig config.Configuration
+}
+
+func (options *cleanupOptions) BindFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&options.DryRun, "dry-run", false,
+		`Only print the AWS resources of the cluster which would be deleted.`)
+	options.cloudConfig.BindFlags(fs)
+	options.ingressCTLConfig.BindFlags(fs)
+}
+
+func runCleanup(args []string) error {
+	options := &cleanupOptions{
+		ingressCTLConfig: config.NewConfiguration(),
+	}
+	fs := pflag.NewFlagSet(cleanupCommand, pflag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s %s [flags]\n", os.Args[0], cleanupCommand)
+		fs.PrintDefaults()
+	}
+	options.BindFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := options.ingressCTLConfig.Validate(); err != nil {
+		return err
+	}
+
+	cloud, err := aws.New(options.cloudConfig, options.ingressCTLConfig.ClusterName, metric.DummyCollector{}, false, nil)
+	if err != nil {
+		return err
+	}
+	return cleanupClusterResources(context.Background(), cloud, &options.ingressCTLConfig, options.DryRun, os.Stdout)
+}
+
+// clusterCleaner deletes the AWS resources carrying the ownership tags of a cluster.
+type clusterCleaner struct {
+	cloud  aws.CloudAPI
+	cfg    *config.Configuration
+	dryRun bool
+	out    io.Writer
+
+	errs []error
+}
+
+// cleanupClusterResources deletes the LoadBalancers, targetGroups, securityGroups and Route 53 health checks created for the cluster of cfg,
+// in an order which releases their dependencies first. Failures don't stop the cleanup, they're returned together at the end.
+// LoadBalancers with deletion protection enabled are kept and reported as failures.
+func cleanupClusterResources(ctx context.Context, cloud aws.CloudAPI, cfg *config.Configuration, dryRun bool, out io.Writer) error {
+	c := &clusterCleaner{cloud: cloud, cfg: cfg, dryRun: dryRun, out: out}
+	ownedFilter := map[string][]string{"kubernetes.io/cluster/" + cfg.ClusterName: {"owned"}}
+
+	// frontend NLBs and their targetGroups go first, as ALBs can't be deleted while they are the target of one.
+	frontendLBArns, err := cloud.GetResourcesByFilters(withResourceID(ownedFilter, generator.V2ResourceIDFrontendNLB), aws.ResourceTypeEnumELBLoadBalancer)
+	if err != nil {
+		return fmt.Errorf("failed to find frontend NLBs of cluster %v due to %v", cfg.ClusterName, err)
+	}
+	frontendTGArns, err := cloud.GetResourcesByFilters(withResourceID(ownedFilter, generator.V2ResourceIDFrontendTargetGroup), aws.ResourceTypeEnumELBTargetGroup)
+	if err != nil {
+		return fmt.Errorf("failed to find frontend targetGroups of cluster %v due to %v", cfg.ClusterName, err)
+	}
+	frontendArns := sets.NewString(frontendLBArns...)
+	frontendArns.Insert(frontendTGArns...)
+	sort.Strings(frontendLBArns)
+	for _, lbArn := range frontendLBArns {
+		c.deleteLoadBalancer(ctx, lbArn)
+	}
+	sort.Strings(frontendTGArns)
+	for _, tgArn := range frontendTGArns {
+		c.delete("targetGroup", tgArn, func() error { return cloud.DeleteTargetGroupByArn(ctx, tgArn) })
+	}
+
+	lbArns, err := cloud.GetResourcesByFilters(ownedFilter, aws.ResourceTypeEnumELBLoadBalancer)
+	if err != nil {
+		return fmt.Errorf("failed to find LoadBalancers of cluster %v due to %v", cfg.ClusterName, err)
+	}
+	sort.Strings(lbArns)
+	for _, lbArn := range lbArns {
+		if !frontendArns.Has(lbArn) {
+			c.deleteLoadBalancer(ctx, lbArn)
+		}
+	}
+
+	tgArns, err := cloud.GetResourcesByFilters(ownedFilter, aws.ResourceTypeEnumELBTargetGroup)
+	if err != nil {
+		return fmt.Errorf("failed to find targetGroups of cluster %v due to %v", cfg.ClusterName, err)
+	}
+	sort.Strings(tgArns)
+	for _, tgArn := range tgArns {
+		if !frontendArns.Has(tgArn) {
+			c.delete("targetGroup", tgArn, func() error { return cloud.DeleteTargetGroupByArn(ctx, tgArn) })
+		}
+	}
+
+	if cfg.FeatureGate.Enabled(config.Route53) {
+		healthChecks, err := cloud.GetHealthChecksByTags(ctx, map[string]string{
+			generator.V2TagKeyClusterID:  cfg.ClusterName,
+			generator.V2TagKeyResourceID: generator.V2ResourceIDRoute53HealthCheck,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to find Route 53 health checks of cluster %v due to %v", cfg.ClusterName, err)
+		}
+		for _, healthCheck := range healthChecks {
+			healthCheckID := aws.StringValue(healthCheck.Id)
+			c.delete("Route 53 health check", healthCheckID, func() error { return cloud.DeleteHealthCheck(ctx, healthCheckID) })
+		}
+	}
+
+	// securityGroups aren't tagged with the cluster ownership tag, see generator.TagGenerator.
+	sgArns, err := cloud.GetResourcesByFilters(map[string][]string{generator.TagKeyClusterName: {cfg.ClusterName}}, aws.ResourceTypeEnumEC2SecurityGroup)
+	if err != nil {
+		return fmt.Errorf("failed to find securityGroups of cluster %v due to %v", cfg.ClusterName, err)
+	}
+	var groupIDs []string
+	for _, sgArn := range sgArns {
+		groupID, err := securityGroupIDFromArn(sgArn)
+		if err != nil {
+			c.errs = append(c.errs, err)
+			continue
+		}
+		groupIDs = append(groupIDs, groupID)
+	}
+	sort.Strings(groupIDs)
+	// instance securityGroups are attached to node ENIs, and LoadBalancer securityGroups are referenced by the rules of instance securityGroups.
+	for _, groupID := range groupIDs {
+		c.releaseSecurityGroup(ctx, groupID)
+	}
+	for _, groupID := range groupIDs {
+		c.delete("securityGroup", groupID, func() error { return cloud.DeleteSecurityGroupByID(ctx, groupID) })
+	}
+	return utilerrors.NewAggregate(c.errs)
+}
+
+// withResourceID returns a copy of tagFilters matching the resources tagged with resourceID.
+func withResourceID(tagFilters map[string][]string, resourceID string) map[string][]string {
+	result := map[string][]string{generator.V2TagKeyResourceID: {resourceID}}
+	for k, v := range tagFilters {
+		result[k] = v
+	}
+	return result
+}
+
+func (c *clusterCleaner) deleteLoadBalancer(ctx context.Context, lbArn string) {
+	attrs, err := c.cloud.DescribeLoadBalancerAttributesWithContext(ctx, &elbv2.DescribeLoadBalancerAttributesInput{LoadBalancerArn: aws.String(lbArn)})
+	if err != nil {
+		c.errs = append(c.errs, fmt.Errorf("failed to describe attributes of LoadBalancer %v due to %v", lbArn, err))
+		return
+	}
+	for _, attr := range attrs.Attributes {
+		if aws.StringValue(attr.Key) == lb.DeletionProtectionEnabledKey && aws.StringValue(attr.Value) == "true" {
+			c.errs = append(c.errs, fmt.Errorf("LoadBalancer %v is kept as it has deletion protection enabled, disable it to delete the LoadBalancer", lbArn))
+			return
+		}
+	}
+	c.delete("LoadBalancer", lbArn, func() error { return c.cloud.DeleteLoadBalancerByArn(ctx, lbArn) })
+}
+
+// releaseSecurityGroup detaches the securityGroup from ENIs and revokes the rules of other securityGroups referencing it, so it can be deleted.
+// ENIs of LoadBalancers are managed by AWS; they're released along with their LoadBalancer.
+func (c *clusterCleaner) releaseSecurityGroup(ctx context.Context, groupID string) {
+	enis, err := c.cloud.DescribeNetworkInterfaces(ctx, &ec2.DescribeNetworkInterfacesInput{
+		Filters: []*ec2.Filter{{Name: aws.String("group-id"), Values: aws.StringSlice([]string{groupID})}},
+	})
+	if err != nil {
+		c.errs = append(c.errs, fmt.Errorf("failed to find ENIs attached with securityGroup %v due to %v", groupID, err))
+		return
+	}
+	for _, eni := range enis {
+		if aws.BoolValue(eni.RequesterManaged) {
+			continue
+		}
+		eniID := aws.StringValue(eni.NetworkInterfaceId)
+		var desiredGroups []string
+		for _, group := range eni.Groups {
+			if aws.StringValue(group.GroupId) != groupID {
+				desiredGroups = append(desiredGroups, aws.StringValue(group.GroupId))
+			}
+		}
+		c.run(fmt.Sprintf("detaching securityGroup %v from ENI %v", groupID, eniID), func() error {
+			_, err := c.cloud.ModifyNetworkInterfaceAttributeWithContext(ctx, &ec2.ModifyNetworkInterfaceAttributeInput{
+				NetworkInterfaceId: aws.String(eniID),
+				Groups:             aws.StringSlice(desiredGroups),
+			})
+			return err
+		})
+	}
+
+	referencingSGs, err := c.cloud.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
+		Filters: []*ec2.Filter{{Name: aws.String("ip-permission.group-id"), Values: aws.StringSlice([]string{groupID})}},
+	})
+	if err != nil {
+		c.errs = append(c.errs, fmt.Errorf("failed to find securityGroups referencing securityGroup %v due to %v", groupID, err))
+		return
+	}
+	for _, sg := range referencingSGs {
+		var permissions []*ec2.IpPermission
+		for _, permission := range sg.IpPermissions {
+			for _, pair := range permission.UserIdGroupPairs {
+				if aws.StringValue(pair.GroupId) == groupID {
+					permissions = append(permissions, &ec2.IpPermission{
+						IpProtocol:       permission.IpProtocol,
+						FromPort:         permission.FromPort,
+						ToPort:           permission.ToPort,
+						UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String(groupID)}},
+					})
+				}
+			}
+		}
+		if len(permissions) == 0 {
+			continue
+		}
+		c.run(fmt.Sprintf("revoking inbound permissions from securityGroup %v referencing securityGroup %v", aws.StringValue(sg.GroupId), groupID), func() error {
+			_, err := c.cloud.RevokeSecurityGroupIngressWithContext(ctx, &ec2.RevokeSecurityGroupIngressInput{
+				GroupId:       sg.GroupId,
+				IpPermissions: permissions,
+			})
+			return err
+		})
+	}
+}
+
+func (c *clusterCleaner) delete(kind string, id string, deleteFn func() error) {
+	c.run(fmt.Sprintf("deleting %v %v", kind, id), deleteFn)
+}
+
+// run prints the action and performs it unless in dry-run mode, recording its failure.
+func (c *clusterCleaner) run(action string, fn func() error) {
+	if c.dryRun {
+		fmt.Fprintf(c.out, "%v (dry run)\n", action)
+		return
+	}
+	fmt.Fprintln(c.out, action)
+	if err := fn(); err != nil {
+		c.errs = append(c.errs, fmt.Errorf("failed %v due to %v", action, err))
+	}
+}
+
+// securityGroupIDFromArn returns the ID of the securityGroup identified by sgArn, e.g. arn:aws:ec2:us-west-2:123456789012:security-group/sg-1.
+func securityGroupIDFromArn(sgArn string) (string, error) {
+	parsed, err := arn.Parse(sgArn)
+	if err != nil {
+		return "", fmt.Errorf("invalid securityGroup ARN %v due to %v", sgArn, err)
+	}
+	if !strings.HasPrefix(parsed.Resource, "security-group/") {
+		return "", fmt.Errorf("invalid securityGroup ARN %v", sgArn)
+	}
+	return strings.TrimPrefix(parsed.Resource, "security-group/"), nil
+}
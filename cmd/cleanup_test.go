@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/generator"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/lb"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/tags"
+	albtesting "github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/testing"
+)
+
+func Test_cleanupClusterResources(t *testing.T) {
+	cfg := newStatusTestConfig(t)
+	ctx := context.Background()
+	cloud := albtesting.NewCloud("vpc-1", "cluster")
+	tagGen := &generator.TagGenerator{ClusterName: "cluster"}
+	otherTagGen := &generator.TagGenerator{ClusterName: "other"}
+
+	createSG := func(name string, sgTags map[string]string) string {
+		output, err := cloud.CreateSecurityGroupWithContext(ctx, &ec2.CreateSecurityGroupInput{GroupName: aws.String(name), Description: aws.String(name), VpcId: aws.String("vpc-1")})
+		assert.NoError(t, err)
+		_, err = cloud.CreateEC2TagsWithContext(ctx, &ec2.CreateTagsInput{Resources: []*string{output.GroupId}, Tags: tags.ConvertToEC2(sgTags)})
+		assert.NoError(t, err)
+		return aws.StringValue(output.GroupId)
+	}
+	lbSG := createSG("lb", tagGen.TagLBSG("default", "ingress"))
+	instanceSG := createSG("instance", tagGen.TagInstanceSG("default", "ingress"))
+	nodeSG := createSG("node", nil)
+	_, err := cloud.AuthorizeSecurityGroupIngressWithContext(ctx, &ec2.AuthorizeSecurityGroupIngressInput{
+		GroupId: aws.String(nodeSG),
+		IpPermissions: []*ec2.IpPermission{{
+			IpProtocol:       aws.String("tcp"),
+			FromPort:         aws.Int64(0),
+			ToPort:           aws.Int64(65535),
+			UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String(lbSG)}},
+		}},
+	})
+	assert.NoError(t, err)
+	cloud.AddNetworkInterface(&ec2.NetworkInterface{
+		NetworkInterfaceId: aws.String("eni-1"),
+		VpcId:              aws.String("vpc-1"),
+		Groups:             []*ec2.GroupIdentifier{{GroupId: aws.String(nodeSG)}, {GroupId: aws.String(instanceSG)}},
+	})
+
+	createLB := func(name string, lbTags map[string]string, sgs ...string) string {
+		output, err := cloud.CreateLoadBalancerWithContext(ctx, &elbv2.CreateLoadBalancerInput{Name: aws.String(name), SecurityGroups: aws.StringSlice(sgs)})
+		assert.NoError(t, err)
+		lbArn := output.LoadBalancers[0].LoadBalancerArn
+		_, err = cloud.AddELBV2TagsWithContext(ctx, &elbv2.AddTagsInput{ResourceArns: []*string{lbArn}, Tags: tags.ConvertToELBV2(lbTags)})
+		assert.NoError(t, err)
+		return aws.StringValue(lbArn)
+	}
+	lbArn := createLB("lb", tagGen.TagLB("default", "ingress"), lbSG)
+	protectedLBArn := createLB("protected", tagGen.TagLB("default", "protected"))
+	_, err = cloud.ModifyLoadBalancerAttributesWithContext(ctx, &elbv2.ModifyLoadBalancerAttributesInput{
+		LoadBalancerArn: aws.String(protectedLBArn),
+		Attributes:      []*elbv2.LoadBalancerAttribute{{Key: aws.String(lb.DeletionProtectionEnabledKey), Value: aws.String("true")}},
+	})
+	assert.NoError(t, err)
+	otherLBArn := createLB("other", otherTagGen.TagLB("default", "ingress"))
+
+	tgOutput, err := cloud.CreateTargetGroupWithContext(ctx, &elbv2.CreateTargetGroupInput{Name: aws.String("tg"), Port: aws.Int64(80), Protocol: aws.String("HTTP")})
+	assert.NoError(t, err)
+	tgArn := tgOutput.TargetGroups[0].TargetGroupArn
+	_, err = cloud.AddELBV2TagsWithContext(ctx, &elbv2.AddTagsInput{ResourceArns: []*string{tgArn}, Tags: tags.ConvertToELBV2(tagGen.TagTGGroup("default", "ingress"))})
+	assert.NoError(t, err)
+	_, err = cloud.CreateListenerWithContext(ctx, &elbv2.CreateListenerInput{
+		LoadBalancerArn: aws.String(lbArn),
+		Port:            aws.Int64(80),
+		Protocol:        aws.String("HTTP"),
+		DefaultActions:  []*elbv2.Action{{Type: aws.String(elbv2.ActionTypeEnumForward), TargetGroupArn: tgArn}},
+	})
+	assert.NoError(t, err)
+
+	nlbOutput, err := cloud.CreateLoadBalancerWithContext(ctx, &elbv2.CreateLoadBalancerInput{
+		Name: aws.String("lb-nlb"),
+		Type: aws.String(elbv2.LoadBalancerTypeEnumNetwork),
+		Tags: tags.ConvertToELBV2(tagGen.TagFrontendNLB("default", "ingress")),
+	})
+	assert.NoError(t, err)
+	nlbArn := aws.StringValue(nlbOutput.LoadBalancers[0].LoadBalancerArn)
+	frontendTGOutput, err := cloud.CreateTargetGroupWithContext(ctx, &elbv2.CreateTargetGroupInput{Name: aws.String("frontend"), Port: aws.Int64(80), Protocol: aws.String("TCP"), TargetType: aws.String("alb")})
+	assert.NoError(t, err)
+	frontendTGArn := frontendTGOutput.TargetGroups[0].TargetGroupArn
+	_, err = cloud.AddELBV2TagsWithContext(ctx, &elbv2.AddTagsInput{ResourceArns: []*string{frontendTGArn}, Tags: tags.ConvertToELBV2(tagGen.TagFrontendTG("default", "ingress"))})
+	assert.NoError(t, err)
+	_, err = cloud.RegisterTargetsWithContext(ctx, &elbv2.RegisterTargetsInput{TargetGroupArn: frontendTGArn, Targets: []*elbv2.TargetDescription{{Id: aws.String(lbArn)}}})
+	assert.NoError(t, err)
+	_, err = cloud.CreateListenerWithContext(ctx, &elbv2.CreateListenerInput{
+		LoadBalancerArn: aws.String(nlbArn),
+		Port:            aws.Int64(80),
+		Protocol:        aws.String("TCP"),
+		DefaultActions:  []*elbv2.Action{{Type: aws.String(elbv2.ActionTypeEnumForward), TargetGroupArn: frontendTGArn}},
+	})
+	assert.NoError(t, err)
+
+	protectedErr := "LoadBalancer " + protectedLBArn + " is kept as it has deletion protection enabled, disable it to delete the LoadBalancer"
+	out := &bytes.Buffer{}
+	assert.EqualError(t, cleanupClusterResources(ctx, cloud, cfg, true, out), protectedErr)
+	remaining, _ := cloud.GetResourcesByFilters(nil)
+	assert.Len(t, remaining, 9, "dry run should not delete anything")
+
+	out.Reset()
+	err = cleanupClusterResources(ctx, cloud, cfg, false, out)
+	assert.EqualError(t, err, protectedErr)
+	assert.Equal(t, "deleting LoadBalancer "+nlbArn+"\n"+
+		"deleting targetGroup "+aws.StringValue(frontendTGArn)+"\n"+
+		"deleting LoadBalancer "+lbArn+"\n"+
+		"deleting targetGroup "+aws.StringValue(tgArn)+"\n"+
+		"revoking inbound permissions from securityGroup "+nodeSG+" referencing securityGroup "+lbSG+"\n"+
+		"detaching securityGroup "+instanceSG+" from ENI eni-1\n"+
+		"deleting securityGroup "+lbSG+"\n"+
+		"deleting securityGroup "+instanceSG+"\n", out.String())
+
+	lbArns, _ := cloud.GetResourcesByFilters(nil, "elasticloadbalancing:loadbalancer")
+	assert.ElementsMatch(t, []string{protectedLBArn, otherLBArn}, lbArns)
+	node, err := cloud.GetSecurityGroupByID(nodeSG)
+	assert.NoError(t, err)
+	assert.Empty(t, node.IpPermissions)
+	enis, _ := cloud.DescribeNetworkInterfaces(ctx, &ec2.DescribeNetworkInterfacesInput{})
+	assert.Equal(t, []*ec2.GroupIdentifier{{GroupId: aws.String(nodeSG), GroupName: aws.String("node")}}, enis[0].Groups)
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/config"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/util/log"
+	"github.com/spf13/pflag"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/yaml"
+)
+
+// configFileReloadPeriod is the period of checking the config file for changes.
+const configFileReloadPeriod = 10 * time.Second
+
+// reloadableSettings apply the settings of the config file changed while the controller runs, by flag name.
+// Settings removed from the file are reset to the defaults of their flags.
+var reloadableSettings = map[string]func(options *Options) error{
+	"log-levels": func(options *Options) error {
+		return log.SetLevels(options.LogLevels)
+	},
+}
+
+// loadConfigFile sets flags in fs from the YAML file at path.
+// Keys of the file are flag names, e.g. "cluster-name" or "default-tags". Lists and maps are converted into the
+// comma-separated form accepted by the command line, e.g. "key1=value1,key2=value2".
+// Flags explicitly set on the command line take precedence over the file. The settings of the file are returned by flag name.
+func loadConfigFile(fs *pflag.FlagSet, path string) (map[string]interface{}, error) {
+	settings, err := readConfigFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := setFlags(fs, path, settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// readConfigFile returns the settings of the YAML file at path by flag name.
+func readConfigFile(path string) (map[string]interface{}, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file %v due to %v", path, err)
+	}
+	var settings map[string]interface{}
+	if err := yaml.Unmarshal(content, &settings); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %v due to %v", path, err)
+	}
+	return settings, nil
+}
+
+// setFlags sets the flags of fs not explicitly set yet from the settings of the config file at path.
+func setFlags(fs *pflag.FlagSet, path string, settings map[string]interface{}) error {
+	names := make([]string, 0, len(settings))
+	for name := range settings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		flag := fs.Lookup(name)
+		if flag == nil {
+			return fmt.Errorf("unknown setting %v in config file %v", name, path)
+		}
+		if name == "config" {
+			return fmt.Errorf("setting config is not allowed in config file %v", path)
+		}
+		if flag.Changed {
+			continue
+		}
+		value, err := flagValue(settings[name])
+		if err != nil {
+			return fmt.Errorf("invalid value for setting %v in config file %v due to %v", name, path, err)
+		}
+		if err := fs.Set(name, value); err != nil {
+			return fmt.Errorf("invalid value for setting %v in config file %v due to %v", name, path, err)
+		}
+	}
+	return nil
+}
+
+// flagValue converts a YAML value into its command line representation.
+func flagValue(v interface{}) (string, error) {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		var pairs []string
+		for k, item := range value {
+			s, err := flagValue(item)
+			if err != nil {
+				return "", err
+			}
+			pairs = append(pairs, fmt.Sprintf("%v=%v", k, s))
+		}
+		sort.Strings(pairs)
+		return strings.Join(pairs, ","), nil
+	case []interface{}:
+		var items []string
+		for _, item := range value {
+			s, err := flagValue(item)
+			if err != nil {
+				return "", err
+			}
+			items = append(items, s)
+		}
+		return strings.Join(items, ","), nil
+	case nil:
+		return "", fmt.Errorf("value must not be empty")
+	default:
+		return fmt.Sprintf("%v", value), nil
+	}
+}
+
+// configFileWatcher applies the changes of the reloadable settings of the config file, checking the file periodically.
+// Polling follows the files of ConfigMap volumes, which are replaced through symlinks rather than written.
+type configFileWatcher struct {
+	path string
+	// overridden are the flags set on the command line, whose settings in the file are ignored.
+	overridden map[string]bool
+	// settings are the settings last read from the file.
+	settings map[string]interface{}
+	// reloadable apply the reloadable settings by flag name.
+	reloadable map[string]func(options *Options) error
+}
+
+// newConfigFileWatcher sets flags in fs from the YAML file at path like loadConfigFile, and returns a configFileWatcher of the file.
+func newConfigFileWatcher(fs *pflag.FlagSet, path string) (*configFileWatcher, error) {
+	w := &configFileWatcher{
+		path:       path,
+		overridden: make(map[string]bool),
+		reloadable: reloadableSettings,
+	}
+	fs.Visit(func(flag *pflag.Flag) {
+		w.overridden[flag.Name] = true
+	})
+	settings, err := loadConfigFile(fs, path)
+	if err != nil {
+		return nil, err
+	}
+	w.settings = settings
+	return w, nil
+}
+
+// Start checks the file for changes every period until stop is closed.
+func (w *configFileWatcher) Start(period time.Duration, stop <-chan struct{}) {
+	wait.Until(func() {
+		if err := w.reload(); err != nil {
+			glog.Errorf("Failed to reload config file %v: %v", w.path, err)
+		}
+	}, period, stop)
+}
+
+// reload applies the reloadable settings changed since the file was last read.
+// Changes of other settings are only reported, they take effect once the controller is restarted.
+func (w *configFileWatcher) reload() error {
+	settings, err := readConfigFile(w.path)
+	if err != nil {
+		return err
+	}
+	previous := w.settings
+	w.settings = settings
+
+	names := make([]string, 0, len(settings))
+	for name := range settings {
+		names = append(names, name)
+	}
+	for name := range previous {
+		if _, ok := settings[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		value, ok := settings[name]
+		if reflect.DeepEqual(value, previous[name]) {
+			continue
+		}
+		apply, reloadable := w.reloadable[name]
+		if !reloadable {
+			glog.Warningf("Setting %v changed in config file %v, restart the controller for the change to take effect", name, w.path)
+			continue
+		}
+		if w.overridden[name] {
+			glog.Warningf("Setting %v changed in config file %v, but the command line takes precedence", name, w.path)
+			continue
+		}
+		if err := w.apply(name, value, ok, apply); err != nil {
+			errs = append(errs, fmt.Errorf("invalid value for setting %v in config file %v due to %v", name, w.path, err))
+			continue
+		}
+		glog.Infof("Reloaded setting %v from config file %v", name, w.path)
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// apply parses the value of the setting name, or its default if the file doesn't set it, and applies it.
+func (w *configFileWatcher) apply(name string, value interface{}, set bool, apply func(options *Options) error) error {
+	options := &Options{
+		ingressCTLConfig: config.NewConfiguration(),
+	}
+	fs := pflag.NewFlagSet("", pflag.ContinueOnError)
+	options.BindFlags(fs)
+	if set {
+		s, err := flagValue(value)
+		if err != nil {
+			return err
+		}
+		if err := fs.Set(name, s); err != nil {
+			return err
+		}
+	}
+	return apply(options)
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/config"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_loadConfigFile(t *testing.T) {
+	for _, tc := range []struct {
+		Name             string
+		Args             []string
+		Content          string
+		ExpectedTags     map[string]string
+		ExpectedClass    string
+		ExpectedSync     time.Duration
+		ExpectedSubnets  []string
+		ExpectedErrorMsg error
+	}{
+		{
+			Name: "settings from file",
+			Content: `
+ingress-class: alb
+sync-period: 10m
+default-tags:
+  team: web
+  env: prod
+subnets: [subnet-1, subnet-2]
+`,
+			ExpectedTags:    map[string]string{"team": "web", "env": "prod"},
+			ExpectedClass:   "alb",
+			ExpectedSync:    10 * time.Minute,
+			ExpectedSubnets: []string{"subnet-1", "subnet-2"},
+		},
+		{
+			Name:            "command line takes precedence",
+			Args:            []string{"--ingress-class=nginx"},
+			Content:         "ingress-class: alb\n",
+			ExpectedTags:    map[string]string{},
+			ExpectedClass:   "nginx",
+			ExpectedSync:    time.Hour,
+			ExpectedSubnets: []string{},
+		},
+		{
+			Name:             "unknown setting",
+			Content:          "ingress-klass: alb\n",
+			ExpectedErrorMsg: errors.New("unknown setting ingress-klass in config file "),
+		},
+		{
+			Name:             "invalid value",
+			Content:          "sync-period: soon\n",
+			ExpectedErrorMsg: errors.New("invalid value for setting sync-period in config file "),
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "config")
+			assert.NoError(t, err)
+			defer os.RemoveAll(dir)
+			path := filepath.Join(dir, "config.yaml")
+			assert.NoError(t, ioutil.WriteFile(path, []byte(tc.Content), 0600))
+
+			fs := pflag.NewFlagSet("", pflag.ContinueOnError)
+			var class string
+			var syncPeriod time.Duration
+			var tags map[string]string
+			var subnets []string
+			fs.StringVar(&class, "ingress-class", "", "")
+			fs.DurationVar(&syncPeriod, "sync-period", time.Hour, "")
+			fs.StringToStringVar(&tags, "default-tags", map[string]string{}, "")
+			fs.StringSliceVar(&subnets, "subnets", []string{}, "")
+			assert.NoError(t, fs.Parse(tc.Args))
+
+			_, err = loadConfigFile(fs, path)
+			if tc.ExpectedErrorMsg != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.ExpectedErrorMsg.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedClass, class)
+			assert.Equal(t, tc.ExpectedSync, syncPeriod)
+			assert.Equal(t, tc.ExpectedTags, tags)
+			assert.Equal(t, tc.ExpectedSubnets, subnets)
+		})
+	}
+}
+
+func Test_configFileWatcher_reload(t *testing.T) {
+	for _, tc := range []struct {
+		Name             string
+		Args             []string
+		Content          string
+		ReloadedContent  string
+		ExpectedLevels   []map[string]string
+		ExpectedErrorMsg string
+	}{
+		{
+			Name:            "reloadable setting changed",
+			Content:         "log-levels:\n  tg: warn\n",
+			ReloadedContent: "log-levels:\n  tg: debug\n",
+			ExpectedLevels:  []map[string]string{{"tg": "debug"}},
+		},
+		{
+			Name:            "reloadable setting removed",
+			Content:         "log-levels:\n  tg: warn\n",
+			ReloadedContent: "ingress-class: alb\n",
+			ExpectedLevels:  []map[string]string{{}},
+		},
+		{
+			Name:            "other settings changed",
+			Content:         "ingress-class: alb\nlog-levels:\n  tg: warn\n",
+			ReloadedContent: "ingress-class: nginx\nlog-levels:\n  tg: warn\n",
+		},
+		{
+			Name:            "reloadable setting set on the command line",
+			Args:            []string{"--log-levels=tg=info"},
+			Content:         "log-levels:\n  tg: warn\n",
+			ReloadedContent: "log-levels:\n  tg: debug\n",
+		},
+		{
+			Name:             "invalid value",
+			Content:          "log-levels:\n  tg: warn\n",
+			ReloadedContent:  "log-levels: [tg]\n",
+			ExpectedErrorMsg: "invalid value for setting log-levels in config file ",
+		},
+		{
+			Name:             "unparsable file",
+			Content:          "log-levels:\n  tg: warn\n",
+			ReloadedContent:  "log-levels: {\n",
+			ExpectedErrorMsg: "failed to parse config file ",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "config")
+			assert.NoError(t, err)
+			defer os.RemoveAll(dir)
+			path := filepath.Join(dir, "config.yaml")
+			assert.NoError(t, ioutil.WriteFile(path, []byte(tc.Content), 0600))
+
+			options := &Options{ingressCTLConfig: config.NewConfiguration()}
+			fs := pflag.NewFlagSet("", pflag.ContinueOnError)
+			options.BindFlags(fs)
+			assert.NoError(t, fs.Parse(tc.Args))
+			w, err := newConfigFileWatcher(fs, path)
+			assert.NoError(t, err)
+			var levels []map[string]string
+			w.reloadable = map[string]func(options *Options) error{
+				"log-levels": func(options *Options) error {
+					levels = append(levels, options.LogLevels)
+					return nil
+				},
+			}
+
+			assert.NoError(t, w.reload(), "unchanged file")
+			assert.NoError(t, ioutil.WriteFile(path, []byte(tc.ReloadedContent), 0600))
+			err = w.reload()
+			if tc.ExpectedErrorMsg != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.ExpectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.ExpectedLevels, levels)
+		})
+	}
+}
@@ -17,7 +17,9 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"expvar"
 	"fmt"
 	"math/rand"
 	"net/http"
@@ -31,9 +33,14 @@ import (
 	"github.com/ticketmaster/aws-sdk-go-cache/cache"
 
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/backend"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/store"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/ingressapi"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/metric"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/metric/collectors"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/tracing"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/util/log"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"k8s.io/apiserver/pkg/server/healthz"
@@ -41,9 +48,11 @@ import (
 	"github.com/go-logr/glogr"
 	"github.com/golang/glog"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/version"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
 	"sigs.k8s.io/controller-runtime/pkg/runtime/signals"
 )
@@ -60,11 +69,29 @@ const (
 func main() {
 	logf.SetLogger(glogr.New())
 	rand.Seed(time.Now().UnixNano())
+	if len(os.Args) > 1 {
+		subcommands := map[string]func([]string) error{
+			statusCommand:  runStatus,
+			migrateCommand: runMigrate,
+			cleanupCommand: runCleanup,
+			renderCommand:  runRender,
+		}
+		if run, ok := subcommands[os.Args[1]]; ok {
+			if err := run(os.Args[2:]); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
 	fmt.Println(version.String())
 	options, err := getOptions()
 	if err != nil {
 		glog.Fatal(err)
 	}
+	if err := log.Configure(options.LogFormat, options.LogLevels); err != nil {
+		glog.Fatal(err)
+	}
 	if options.ShowVersion {
 		os.Exit(0)
 	}
@@ -73,13 +100,47 @@ func main() {
 	if err != nil {
 		glog.Fatal(err)
 	}
-	mgr, err := manager.New(restCfg, manager.Options{
+	mgrOptions := manager.Options{
 		Namespace:               options.WatchNamespace,
 		SyncPeriod:              &options.SyncPeriod,
 		LeaderElection:          options.LeaderElection,
 		LeaderElectionID:        options.LeaderElectionID,
 		LeaderElectionNamespace: options.LeaderElectionNamespace,
-	})
+	}
+	ingressV1, err := ingressapi.Configure(restCfg, &mgrOptions)
+	if err != nil {
+		glog.Fatalf("failed to discover the Ingress API due to %v", err)
+	}
+	if ingressV1 {
+		glog.Info("Ingresses are served from the networking.k8s.io/v1 API")
+	}
+	if options.ingressCTLConfig.EndpointSlices {
+		served, err := store.ServesEndpointSlices(restCfg)
+		if err != nil {
+			glog.Fatalf("failed to discover the EndpointSlice API due to %v", err)
+		}
+		if !served {
+			glog.Info("EndpointSlices aren't served, ip targets are resolved from Endpoints")
+			options.ingressCTLConfig.EndpointSlices = false
+		}
+	}
+	clientSet, err := kubernetes.NewForConfig(restCfg)
+	if err != nil {
+		glog.Fatal(err)
+	}
+	autoTargetType, err := backend.DetectAutoTargetType(clientSet)
+	if err != nil {
+		glog.Warningf("failed to detect the Amazon VPC CNI plugin due to %v, the auto target type is %v", err, autoTargetType)
+	} else {
+		glog.Infof("The auto target type is %v", autoTargetType)
+	}
+	options.ingressCTLConfig.AutoTargetType = autoTargetType
+	mgr, err := manager.New(restCfg, mgrOptions)
+	if err != nil {
+		glog.Fatal(err)
+	}
+
+	shutdownTracing, err := tracing.Init(options.tracingConfig)
 	if err != nil {
 		glog.Fatal(err)
 	}
@@ -97,28 +158,62 @@ func main() {
 	if err != nil {
 		glog.Fatal(err)
 	}
-	mc.Start()
 
+	options.cloudConfig.ReadOnly = options.ingressCTLConfig.ObserveOnly
 	cloud, err := aws.New(options.cloudConfig, options.ingressCTLConfig.ClusterName, mc, options.EnableSdkCache, cc)
 	if err != nil {
-		glog.Fatal(err)
+		glog.Fatal(invalidConfigurationError(err))
+	}
+	if err := verifyCloud(context.Background(), cloud); err != nil {
+		glog.Fatal(invalidConfigurationError(err))
+	}
+	if len(options.cloudWatchMetricsConfig.Namespace) != 0 {
+		mc = metric.NewCloudWatchCollector(options.cloudWatchMetricsConfig, mc, cloud, options.ingressCTLConfig.ClusterName)
 	}
-	if err := controller.Initialize(&options.ingressCTLConfig, mgr, mc, cloud); err != nil {
+	mc.Start()
+	drainer, err := controller.Initialize(&options.ingressCTLConfig, mgr, mc, cloud)
+	if err != nil {
 		glog.Fatal(err)
 	}
 
+	stop := signals.SetupSignalHandler()
+	if options.configFileWatcher != nil {
+		go options.configFileWatcher.Start(configFileReloadPeriod, stop)
+	}
+	awsChecker := aws.NewHealthChecker(cloud, options.HealthCheckPeriod)
 	mux := http.NewServeMux()
 	if options.ProfilingEnabled {
 		registerProfiler(mux)
 	}
-	registerHealthz(mux, aws.NewHealthChecker(cloud))
+	registerHealthz(mux, awsChecker)
+	apiClient, err := ingressapi.NewAPIClient(restCfg)
+	if err != nil {
+		glog.Fatal(err)
+	}
+	registerReadyz(mux, awsChecker, controller.NewCacheSyncChecker(mgr.GetCache(), stop),
+		controller.NewIngressListChecker(apiClient, options.WatchNamespace, options.HealthCheckPeriod))
 	registerMetrics(mux, reg)
 	registerHandlers(mux)
-	go startHTTPServer(options.HealthzPort, mux)
+	go startHTTPServer(fmt.Sprintf(":%v", options.HealthzPort), mux)
+	if options.EnableProfiling {
+		profilingMux := http.NewServeMux()
+		registerProfiler(profilingMux)
+		profilingMux.Handle("/debug/vars", expvar.Handler())
+		go startHTTPServer(fmt.Sprintf("127.0.0.1:%v", options.ProfilingPort), profilingMux)
+	}
 
-	if err := mgr.Start(signals.SetupSignalHandler()); err != nil {
+	if err := mgr.Start(stop); err != nil {
 		glog.Fatal(err)
 	}
+	glog.Infof("Shutting down, waiting up to %v for in-flight reconciles", options.ShutdownGracePeriod)
+	if err := drainer.Drain(options.ShutdownGracePeriod); err != nil {
+		glog.Warning(err)
+	}
+	mc.Stop()
+	if err := shutdownTracing(context.Background()); err != nil {
+		glog.Warningf("failed to flush traces due to %v", err)
+	}
+	glog.Flush()
 }
 
 // buildRestConfig creates a new Kubernetes REST configuration. apiserverHost is
@@ -158,12 +253,19 @@ func registerHealthz(mux *http.ServeMux, awsChecker *aws.HealthChecker) {
 	healthz.InstallHandler(mux, healthz.PingHealthz, awsChecker)
 }
 
+// registerReadyz registers the readiness endpoint, which additionally requires the informer caches to be synced,
+// and ingresses to be listable from the API server.
+func registerReadyz(mux *http.ServeMux, awsChecker *aws.HealthChecker, cacheChecker *controller.CacheSyncChecker, ingressChecker *controller.IngressListChecker) {
+	healthz.InstallPathHandler(mux, "/readyz", healthz.PingHealthz, awsChecker, cacheChecker, ingressChecker)
+}
+
+// registerMetrics serves metrics from reg, along with controller-runtime metrics such as the work queue depth.
 func registerMetrics(mux *http.ServeMux, reg *prometheus.Registry) {
 	mux.Handle(
 		"/metrics",
 		promhttp.InstrumentMetricHandler(
 			reg,
-			promhttp.HandlerFor(reg, promhttp.HandlerOpts{}),
+			promhttp.HandlerFor(prometheus.Gatherers{reg, ctrlmetrics.Registry}, promhttp.HandlerOpts{}),
 		),
 	)
 }
@@ -181,9 +283,9 @@ func registerProfiler(mux *http.ServeMux) {
 	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
 }
 
-func startHTTPServer(port int, mux *http.ServeMux) {
+func startHTTPServer(addr string, mux *http.ServeMux) {
 	server := &http.Server{
-		Addr:              fmt.Sprintf(":%v", port),
+		Addr:              addr,
 		Handler:           mux,
 		ReadTimeout:       10 * time.Second,
 		ReadHeaderTimeout: 10 * time.Second,
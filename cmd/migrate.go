@@ -0,0 +1,610 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/spf13/pflag"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/generator"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/lb"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/tags"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/action"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/conditions"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/parser"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/config"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/ingressapi"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/metric"
+	corev1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+)
+
+// migrateCommand is the subcommand name used to hand an existing ALB over to the controller.
+const migrateCommand = "migrate"
+
+const (
+	ingressClassAnnotation    = "kubernetes.io/ingress.class"
+	migratedDefaultActionName = "default-action"
+	defaultSSLPolicy          = "ELBSecurityPolicy-2016-08"
+)
+
+// defaultLBAttributes are the attribute values the controller reconciles LoadBalancers to when not annotated.
+var defaultLBAttributes = map[string]string{
+	lb.DeletionProtectionEnabledKey:      strconv.FormatBool(lb.DeletionProtectionEnabled),
+	lb.AccessLogsS3EnabledKey:            strconv.FormatBool(lb.AccessLogsS3Enabled),
+	lb.AccessLogsS3BucketKey:             lb.AccessLogsS3Bucket,
+	lb.AccessLogsS3PrefixKey:             lb.AccessLogsS3Prefix,
+	lb.IdleTimeoutTimeoutSecondsKey:      strconv.Itoa(lb.IdleTimeoutTimeoutSeconds),
+	lb.RoutingHTTP2EnabledKey:            strconv.FormatBool(lb.RoutingHTTP2Enabled),
+	lb.DropInvalidHeaderFieldsEnabledKey: strconv.FormatBool(lb.DropInvalidHeaderFieldsEnabled),
+}
+
+// migrateOptions defines the commandline interface of the migrate subcommand
+type migrateOptions struct {
+	APIServerHost  string
+	KubeConfigFile string
+	Namespace      string
+
+	LoadBalancerArn  string
+	LoadBalancerName string
+
+	// Apply tags the LoadBalancer and creates the ingress, instead of only printing the ingress
+	Apply bool
+
+	cloudConfig      aws.CloudConfig
+	ingressCTLConfig config.Configuration
+}
+
+func (options *migrateOptions) BindFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&options.APIServerHost, "apiserver-host", "",
+		`Address of the Kubernetes API server.`)
+	fs.StringVar(&options.KubeConfigFile, "kubeconfig", "",
+		`Path to a kubeconfig file containing authorization and API server information.`)
+	fs.StringVarP(&options.Namespace, "namespace", "n", corev1.NamespaceDefault,
+		`Namespace of the ingress to generate.`)
+	fs.StringVar(&options.LoadBalancerArn, "load-balancer-arn", "",
+		`ARN of the existing ALB to migrate. Either this or --load-balancer-name must be specified.`)
+	fs.StringVar(&options.LoadBalancerName, "load-balancer-name", "",
+		`Name of the existing ALB to migrate. Either this or --load-balancer-arn must be specified.`)
+	fs.BoolVar(&options.Apply, "apply", false,
+		`Apply the controller's ownership tags to the ALB and create the ingress. Otherwise the ingress is only printed.`)
+	options.cloudConfig.BindFlags(fs)
+	options.ingressCTLConfig.BindFlags(fs)
+}
+
+func (options *migrateOptions) Validate() error {
+	if (len(options.LoadBalancerArn) == 0) == (len(options.LoadBalancerName) == 0) {
+		return fmt.Errorf("precisely one of --load-balancer-arn and --load-balancer-name must be specified")
+	}
+	if len(options.LoadBalancerArn) != 0 {
+		lbArn, err := arn.Parse(options.LoadBalancerArn)
+		if err != nil {
+			return fmt.Errorf("invalid --load-balancer-arn %v due to %v", options.LoadBalancerArn, err)
+		}
+		// the LoadBalancer's region and partition apply unless specified otherwise.
+		if len(options.cloudConfig.Region) == 0 {
+			options.cloudConfig.Region = lbArn.Region
+		}
+		if len(options.cloudConfig.Partition) == 0 {
+			options.cloudConfig.Partition = lbArn.Partition
+		}
+	}
+	return options.ingressCTLConfig.Validate()
+}
+
+func runMigrate(args []string) error {
+	options := &migrateOptions{
+		ingressCTLConfig: config.NewConfiguration(),
+	}
+	fs := pflag.NewFlagSet(migrateCommand, pflag.ExitOnError)
+	options.BindFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return fmt.Errorf("exactly one ingress name must be specified")
+	}
+	if err := options.Validate(); err != nil {
+		return err
+	}
+	ingressName := fs.Arg(0)
+
+	ctx := context.Background()
+	cloud, err := aws.New(options.cloudConfig, options.ingressCTLConfig.ClusterName, metric.DummyCollector{}, false, nil)
+	if err != nil {
+		return err
+	}
+	var instance *elbv2.LoadBalancer
+	if len(options.LoadBalancerArn) != 0 {
+		instance, err = cloud.GetLoadBalancerByArn(ctx, options.LoadBalancerArn)
+	} else {
+		instance, err = cloud.GetLoadBalancerByName(ctx, options.LoadBalancerName)
+	}
+	if err != nil {
+		return err
+	}
+	if instance == nil {
+		return fmt.Errorf("LoadBalancer %v%v not found", options.LoadBalancerArn, options.LoadBalancerName)
+	}
+
+	ingress, warnings, err := buildMigratedIngress(ctx, cloud, instance, options.Namespace, ingressName, options.ingressCTLConfig.IngressClass)
+	if err != nil {
+		return err
+	}
+	for _, warning := range warnings {
+		fmt.Fprintf(os.Stderr, "WARNING: %v\n", warning)
+	}
+	manifest, err := yaml.Marshal(ingress)
+	if err != nil {
+		return err
+	}
+	fmt.Print(string(manifest))
+	if !options.Apply {
+		return nil
+	}
+
+	restCfg, err := clientcmd.BuildConfigFromFlags(options.APIServerHost, options.KubeConfigFile)
+	if err != nil {
+		return err
+	}
+	ingressClient, err := ingressapi.NewAPIClient(restCfg)
+	if err != nil {
+		return err
+	}
+	if err := ingressClient.Get(ctx, types.NamespacedName{Namespace: options.Namespace, Name: ingressName}, &extensions.Ingress{}); err == nil {
+		return fmt.Errorf("ingress %v/%v already exists", options.Namespace, ingressName)
+	} else if !apierrs.IsNotFound(err) {
+		return err
+	}
+
+	// the LoadBalancer must be tagged before the ingress exists, otherwise the controller creates a new one.
+	ownershipTags := generator.NewNameTagGenerator(options.ingressCTLConfig).TagLB(options.Namespace, ingressName)
+	if _, err := cloud.AddELBV2TagsWithContext(ctx, &elbv2.AddTagsInput{
+		ResourceArns: []*string{instance.LoadBalancerArn},
+		Tags:         tags.ConvertToELBV2(ownershipTags),
+	}); err != nil {
+		return fmt.Errorf("failed to tag LoadBalancer %v due to %v", aws.StringValue(instance.LoadBalancerArn), err)
+	}
+	if err := ingressClient.Create(ctx, ingress); err != nil {
+		return fmt.Errorf("failed to create ingress %v/%v due to %v", options.Namespace, ingressName, err)
+	}
+	fmt.Fprintf(os.Stderr, "LoadBalancer %v is now managed by ingress %v/%v\n", aws.StringValue(instance.LoadBalancerArn), options.Namespace, ingressName)
+	return nil
+}
+
+// buildMigratedIngress generates an ingress whose annotations and rules describe the existing LoadBalancer instance,
+// so that the controller reconciles it in place. Targets are kept by forwarding to the existing targetGroups by ARN.
+// Settings which couldn't be expressed are returned as warnings.
+func buildMigratedIngress(ctx context.Context, cloud aws.CloudAPI, instance *elbv2.LoadBalancer,
+	namespace string, name string, ingressClass string) (*extensions.Ingress, []string, error) {
+	lbArn := aws.StringValue(instance.LoadBalancerArn)
+	if aws.StringValue(instance.Type) != elbv2.LoadBalancerTypeEnumApplication {
+		return nil, nil, fmt.Errorf("LoadBalancer %v is of type %v, only application LoadBalancers can be migrated", lbArn, aws.StringValue(instance.Type))
+	}
+
+	lbTags, err := describeLBTags(ctx, cloud, lbArn)
+	if err != nil {
+		return nil, nil, err
+	}
+	if owner, ok := lbTags[generator.TagKeyIngressName]; ok {
+		return nil, nil, fmt.Errorf("LoadBalancer %v is already managed by ingress %v/%v", lbArn, lbTags[generator.TagKeyNamespace], owner)
+	}
+
+	var warnings []string
+	annos := map[string]string{
+		parser.GetAnnotationWithPrefix(lb.AnnotationMigrated): "true",
+		parser.GetAnnotationWithPrefix("scheme"):              aws.StringValue(instance.Scheme),
+		parser.GetAnnotationWithPrefix("ip-address-type"):     aws.StringValue(instance.IpAddressType),
+	}
+	if len(ingressClass) != 0 {
+		annos[ingressClassAnnotation] = ingressClass
+	}
+	var subnets []string
+	for _, az := range instance.AvailabilityZones {
+		subnets = append(subnets, aws.StringValue(az.SubnetId))
+	}
+	sort.Strings(subnets)
+	annos[parser.GetAnnotationWithPrefix("subnets")] = strings.Join(subnets, ",")
+	if len(instance.SecurityGroups) != 0 {
+		annos[parser.GetAnnotationWithPrefix("security-groups")] = strings.Join(aws.StringValueSlice(instance.SecurityGroups), ",")
+	}
+	if userTags := formatStringMap(lbTags); len(userTags) != 0 {
+		annos[parser.GetAnnotationWithPrefix("tags")] = userTags
+	}
+
+	if cloud.WAFRegionalAvailable() {
+		webACLSummary, err := cloud.GetWebACLSummary(ctx, instance.LoadBalancerArn)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get web acl of %v due to %v", lbArn, err)
+		}
+		if webACLSummary != nil {
+			annos[parser.GetAnnotationWithPrefix("waf-acl-id")] = aws.StringValue(webACLSummary.WebACLId)
+		}
+	}
+	webACL, err := cloud.GetWAFV2WebACLSummary(ctx, instance.LoadBalancerArn)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get WAFv2 webACL of %v due to %v", lbArn, err)
+	}
+	if webACL != nil {
+		annos[parser.GetAnnotationWithPrefix("wafv2-acl-arn")] = aws.StringValue(webACL.ARN)
+	}
+
+	attrs, err := cloud.DescribeLoadBalancerAttributesWithContext(ctx, &elbv2.DescribeLoadBalancerAttributesInput{LoadBalancerArn: instance.LoadBalancerArn})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to describe attributes of %v due to %v", lbArn, err)
+	}
+	changedAttrs := make(map[string]string)
+	for _, attr := range attrs.Attributes {
+		if defaultValue, ok := defaultLBAttributes[aws.StringValue(attr.Key)]; ok && aws.StringValue(attr.Value) != defaultValue {
+			changedAttrs[aws.StringValue(attr.Key)] = aws.StringValue(attr.Value)
+		}
+	}
+	if len(changedAttrs) != 0 {
+		annos[parser.GetAnnotationWithPrefix("load-balancer-attributes")] = formatStringMap(changedAttrs)
+	}
+
+	listeners, err := cloud.ListListenersByLoadBalancer(ctx, lbArn)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list listeners of %v due to %v", lbArn, err)
+	}
+	if len(listeners) == 0 {
+		return nil, nil, fmt.Errorf("LoadBalancer %v has no listeners", lbArn)
+	}
+	sort.Slice(listeners, func(i, j int) bool {
+		return aws.Int64Value(listeners[i].Port) < aws.Int64Value(listeners[j].Port)
+	})
+
+	var listenPorts []map[string]int64
+	var certARNs []string
+	sslPolicy := ""
+	var portSSLPolicies []string
+	for _, listener := range listeners {
+		listenPorts = append(listenPorts, map[string]int64{aws.StringValue(listener.Protocol): aws.Int64Value(listener.Port)})
+		if aws.StringValue(listener.Protocol) != elbv2.ProtocolEnumHttps {
+			continue
+		}
+		if len(sslPolicy) != 0 && sslPolicy != aws.StringValue(listener.SslPolicy) {
+			portSSLPolicies = append(portSSLPolicies, fmt.Sprintf("%v=%v", aws.Int64Value(listener.Port), aws.StringValue(listener.SslPolicy)))
+		} else {
+			sslPolicy = aws.StringValue(listener.SslPolicy)
+		}
+		certs, err := cloud.DescribeListenerCertificates(ctx, aws.StringValue(listener.ListenerArn))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to describe certificates of listener %v due to %v", aws.StringValue(listener.ListenerArn), err)
+		}
+		sort.SliceStable(certs, func(i, j int) bool { return aws.BoolValue(certs[i].IsDefault) && !aws.BoolValue(certs[j].IsDefault) })
+		for _, cert := range certs {
+			if !containsString(certARNs, aws.StringValue(cert.CertificateArn)) {
+				certARNs = append(certARNs, aws.StringValue(cert.CertificateArn))
+			}
+		}
+	}
+	rawListenPorts, err := json.Marshal(listenPorts)
+	if err != nil {
+		return nil, nil, err
+	}
+	annos[parser.GetAnnotationWithPrefix("listen-ports")] = string(rawListenPorts)
+	if len(certARNs) != 0 {
+		annos[parser.GetAnnotationWithPrefix("certificate-arn")] = strings.Join(certARNs, ",")
+	}
+	if len(portSSLPolicies) != 0 {
+		annos[parser.GetAnnotationWithPrefix("ssl-policy")] = strings.Join(append([]string{sslPolicy}, portSSLPolicies...), ",")
+	} else if len(sslPolicy) != 0 && sslPolicy != defaultSSLPolicy {
+		annos[parser.GetAnnotationWithPrefix("ssl-policy")] = sslPolicy
+	}
+
+	// The controller applies the same rules to every listener, so rules are taken from the first one.
+	// The ingress carries the finalizer right away, as the LoadBalancer is only found through it once the ingress is deleted.
+	ingress := &extensions.Ingress{
+		TypeMeta: metav1.TypeMeta{APIVersion: "extensions/v1beta1", Kind: "Ingress"},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   namespace,
+			Name:        name,
+			Annotations: annos,
+			Finalizers:  []string{controller.IngressFinalizer},
+		},
+	}
+	if len(listeners) > 1 {
+		warnings = append(warnings, fmt.Sprintf("rules of listener on port %v are applied to all listeners", aws.Int64Value(listeners[0].Port)))
+	}
+	if defaultAction, ok, warning := migrateActions(listeners[0].DefaultActions); ok {
+		if !isDefault404Action(defaultAction) {
+			if err := setActionAnnotation(annos, migratedDefaultActionName, defaultAction); err != nil {
+				return nil, nil, err
+			}
+			ingress.Spec.Backend = &extensions.IngressBackend{
+				ServiceName: migratedDefaultActionName,
+				ServicePort: intstr.FromString(action.UseActionAnnotation),
+			}
+		}
+	} else {
+		warnings = append(warnings, fmt.Sprintf("default action of listener on port %v: %v", aws.Int64Value(listeners[0].Port), warning))
+	}
+
+	rules, err := cloud.GetRules(ctx, aws.StringValue(listeners[0].ListenerArn))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get rules of listener %v due to %v", aws.StringValue(listeners[0].ListenerArn), err)
+	}
+	sort.Slice(rules, func(i, j int) bool { return rulePriority(rules[i]) < rulePriority(rules[j]) })
+	for _, rule := range rules {
+		if aws.BoolValue(rule.IsDefault) {
+			continue
+		}
+		ruleName := fmt.Sprintf("rule-%v", aws.StringValue(rule.Priority))
+		ruleAction, ok, warning := migrateActions(rule.Actions)
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("rule %v is skipped: %v", aws.StringValue(rule.Priority), warning))
+			continue
+		}
+		if len(warning) != 0 {
+			warnings = append(warnings, fmt.Sprintf("rule %v: %v", aws.StringValue(rule.Priority), warning))
+		}
+		if err := setActionAnnotation(annos, ruleName, ruleAction); err != nil {
+			return nil, nil, err
+		}
+
+		host, path, ruleConditions := migrateConditions(rule.Conditions)
+		if len(ruleConditions) != 0 {
+			raw, err := marshalCompact(ruleConditions)
+			if err != nil {
+				return nil, nil, err
+			}
+			annos[parser.GetAnnotationWithPrefix("conditions."+ruleName)] = raw
+		}
+		ingress.Spec.Rules = append(ingress.Spec.Rules, extensions.IngressRule{
+			Host: host,
+			IngressRuleValue: extensions.IngressRuleValue{
+				HTTP: &extensions.HTTPIngressRuleValue{
+					Paths: []extensions.HTTPIngressPath{
+						{
+							Path: path,
+							Backend: extensions.IngressBackend{
+								ServiceName: ruleName,
+								ServicePort: intstr.FromString(action.UseActionAnnotation),
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+	return ingress, warnings, nil
+}
+
+func describeLBTags(ctx context.Context, cloud aws.CloudAPI, lbArn string) (map[string]string, error) {
+	resp, err := cloud.DescribeELBV2TagsWithContext(ctx, &elbv2.DescribeTagsInput{ResourceArns: []*string{aws.String(lbArn)}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe tags of %v due to %v", lbArn, err)
+	}
+	lbTags := make(map[string]string)
+	for _, desc := range resp.TagDescriptions {
+		for _, tag := range desc.Tags {
+			if strings.HasPrefix(aws.StringValue(tag.Key), "aws:") {
+				continue
+			}
+			lbTags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+	}
+	return lbTags, nil
+}
+
+// migrateActions converts the terminal action of elbActions into an action annotation.
+// ok is false when it cannot be expressed, in which case warning tells why. Otherwise warning may note actions which were dropped.
+func migrateActions(elbActions []*elbv2.Action) (result action.Action, ok bool, warning string) {
+	if len(elbActions) == 0 {
+		return action.Action{}, false, "no actions"
+	}
+	sorted := append([]*elbv2.Action{}, elbActions...)
+	sort.SliceStable(sorted, func(i, j int) bool { return aws.Int64Value(sorted[i].Order) < aws.Int64Value(sorted[j].Order) })
+	if len(sorted) > 1 {
+		warning = "authenticate actions are not migrated, configure them with the auth-* annotations"
+	}
+
+	elbAction := sorted[len(sorted)-1]
+	result.Type = elbAction.Type
+	switch aws.StringValue(elbAction.Type) {
+	case elbv2.ActionTypeEnumForward:
+		var tgARNs []string
+		if elbAction.ForwardConfig != nil {
+			for _, t := range elbAction.ForwardConfig.TargetGroups {
+				tgARNs = append(tgARNs, aws.StringValue(t.TargetGroupArn))
+			}
+		}
+		if len(tgARNs) <= 1 {
+			result.TargetGroupArn = elbAction.TargetGroupArn
+			if result.TargetGroupArn == nil && len(tgARNs) == 1 {
+				result.TargetGroupArn = aws.String(tgARNs[0])
+			}
+			return result, true, warning
+		}
+		result.ForwardConfig = &action.ForwardActionConfig{}
+		if stickiness := elbAction.ForwardConfig.TargetGroupStickinessConfig; stickiness != nil {
+			result.ForwardConfig.TargetGroupStickinessConfig = &action.TargetGroupStickinessConfig{
+				DurationSeconds: stickiness.DurationSeconds,
+				Enabled:         stickiness.Enabled,
+			}
+		}
+		for _, t := range elbAction.ForwardConfig.TargetGroups {
+			result.ForwardConfig.TargetGroups = append(result.ForwardConfig.TargetGroups, &action.TargetGroupTuple{
+				TargetGroupArn: t.TargetGroupArn,
+				Weight:         t.Weight,
+			})
+		}
+	case elbv2.ActionTypeEnumRedirect:
+		cfg := elbAction.RedirectConfig
+		result.RedirectConfig = &action.RedirectActionConfig{
+			Host:       cfg.Host,
+			Path:       cfg.Path,
+			Port:       cfg.Port,
+			Protocol:   cfg.Protocol,
+			Query:      cfg.Query,
+			StatusCode: cfg.StatusCode,
+		}
+	case elbv2.ActionTypeEnumFixedResponse:
+		cfg := elbAction.FixedResponseConfig
+		result.FixedResponseConfig = &action.FixedResponseActionConfig{
+			ContentType: cfg.ContentType,
+			MessageBody: cfg.MessageBody,
+			StatusCode:  cfg.StatusCode,
+		}
+	default:
+		return action.Action{}, false, fmt.Sprintf("unsupported action type %v", aws.StringValue(elbAction.Type))
+	}
+	return result, true, warning
+}
+
+// migrateConditions splits elbConditions into a single host and path expressible in the ingress spec, plus the remaining conditions for the conditions annotation.
+func migrateConditions(elbConditions []*elbv2.RuleCondition) (host string, path string, result []conditions.RuleCondition) {
+	for _, c := range elbConditions {
+		switch aws.StringValue(c.Field) {
+		case conditions.FieldHostHeader:
+			values := aws.StringValueSlice(c.Values)
+			if c.HostHeaderConfig != nil {
+				values = aws.StringValueSlice(c.HostHeaderConfig.Values)
+			}
+			if len(values) == 1 && len(host) == 0 {
+				host = values[0]
+				continue
+			}
+			result = append(result, conditions.RuleCondition{
+				Field:            c.Field,
+				HostHeaderConfig: &conditions.HostHeaderConditionConfig{Values: aws.StringSlice(values)},
+			})
+		case conditions.FieldPathPattern:
+			values := aws.StringValueSlice(c.Values)
+			if c.PathPatternConfig != nil {
+				values = aws.StringValueSlice(c.PathPatternConfig.Values)
+			}
+			if len(values) == 1 && len(path) == 0 {
+				path = values[0]
+				continue
+			}
+			result = append(result, conditions.RuleCondition{
+				Field:             c.Field,
+				PathPatternConfig: &conditions.PathPatternConditionConfig{Values: aws.StringSlice(values)},
+			})
+		case conditions.FieldHTTPHeader:
+			result = append(result, conditions.RuleCondition{
+				Field: c.Field,
+				HttpHeaderConfig: &conditions.HttpHeaderConditionConfig{
+					HttpHeaderName: c.HttpHeaderConfig.HttpHeaderName,
+					Values:         c.HttpHeaderConfig.Values,
+				},
+			})
+		case conditions.FieldHTTPRequestMethod:
+			result = append(result, conditions.RuleCondition{
+				Field:                   c.Field,
+				HttpRequestMethodConfig: &conditions.HttpRequestMethodConditionConfig{Values: c.HttpRequestMethodConfig.Values},
+			})
+		case conditions.FieldQueryString:
+			queryStringConfig := &conditions.QueryStringConditionConfig{}
+			for _, kv := range c.QueryStringConfig.Values {
+				queryStringConfig.Values = append(queryStringConfig.Values, &conditions.QueryStringKeyValuePair{Key: kv.Key, Value: kv.Value})
+			}
+			result = append(result, conditions.RuleCondition{
+				Field:             c.Field,
+				QueryStringConfig: queryStringConfig,
+			})
+		case conditions.FieldSourceIP:
+			result = append(result, conditions.RuleCondition{
+				Field:          c.Field,
+				SourceIpConfig: &conditions.SourceIpConditionConfig{Values: c.SourceIpConfig.Values},
+			})
+		}
+	}
+	return host, path, result
+}
+
+func setActionAnnotation(annos map[string]string, name string, a action.Action) error {
+	raw, err := marshalCompact(a)
+	if err != nil {
+		return err
+	}
+	annos[parser.GetAnnotationWithPrefix("actions."+name)] = raw
+	return nil
+}
+
+// marshalCompact encodes v as JSON without its null fields, as the annotation types don't omit them.
+func marshalCompact(v interface{}) (string, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return "", err
+	}
+	raw, err = json.Marshal(dropNulls(decoded))
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+func dropNulls(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, field := range value {
+			if field == nil {
+				delete(value, k)
+				continue
+			}
+			value[k] = dropNulls(field)
+		}
+	case []interface{}:
+		for i := range value {
+			value[i] = dropNulls(value[i])
+		}
+	}
+	return v
+}
+
+// isDefault404Action returns whether a is the default action the controller uses when an ingress has no backend.
+func isDefault404Action(a action.Action) bool {
+	return aws.StringValue(a.Type) == elbv2.ActionTypeEnumFixedResponse &&
+		aws.StringValue(a.FixedResponseConfig.StatusCode) == "404" &&
+		aws.StringValue(a.FixedResponseConfig.ContentType) == "text/plain" &&
+		aws.StringValue(a.FixedResponseConfig.MessageBody) == ""
+}
+
+func rulePriority(rule *elbv2.Rule) int64 {
+	priority, err := strconv.ParseInt(aws.StringValue(rule.Priority), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return priority
+}
+
+func formatStringMap(m map[string]string) string {
+	var pairs []string
+	for k, v := range m {
+		pairs = append(pairs, fmt.Sprintf("%v=%v", k, v))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/stretchr/testify/assert"
+	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/mocks"
+)
+
+func Test_buildMigratedIngress(t *testing.T) {
+	newStatusTestConfig(t)
+	ctx := context.Background()
+	instance := &elbv2.LoadBalancer{
+		LoadBalancerArn: aws.String("lbArn"),
+		Type:            aws.String(elbv2.LoadBalancerTypeEnumApplication),
+		Scheme:          aws.String(elbv2.LoadBalancerSchemeEnumInternal),
+		IpAddressType:   aws.String(elbv2.IpAddressTypeIpv4),
+		SecurityGroups:  aws.StringSlice([]string{"sg-1"}),
+		AvailabilityZones: []*elbv2.AvailabilityZone{
+			{SubnetId: aws.String("subnet-2")},
+			{SubnetId: aws.String("subnet-1")},
+		},
+	}
+	listeners := []*elbv2.Listener{
+		{
+			ListenerArn: aws.String("lsArn443"),
+			Port:        aws.Int64(443),
+			Protocol:    aws.String(elbv2.ProtocolEnumHttps),
+			SslPolicy:   aws.String("ELBSecurityPolicy-TLS-1-2-2017-01"),
+		},
+		{
+			ListenerArn: aws.String("lsArn80"),
+			Port:        aws.Int64(80),
+			Protocol:    aws.String(elbv2.ProtocolEnumHttp),
+			DefaultActions: []*elbv2.Action{
+				{
+					Type:                aws.String(elbv2.ActionTypeEnumFixedResponse),
+					FixedResponseConfig: &elbv2.FixedResponseActionConfig{ContentType: aws.String("text/plain"), StatusCode: aws.String("404")},
+				},
+			},
+		},
+	}
+	rules := []*elbv2.Rule{
+		{
+			Priority:  aws.String("default"),
+			IsDefault: aws.Bool(true),
+		},
+		{
+			Priority: aws.String("10"),
+			Conditions: []*elbv2.RuleCondition{
+				{Field: aws.String("path-pattern"), Values: aws.StringSlice([]string{"/api/*", "/v1/*"})},
+			},
+			Actions: []*elbv2.Action{
+				{
+					Type: aws.String(elbv2.ActionTypeEnumForward),
+					ForwardConfig: &elbv2.ForwardActionConfig{
+						TargetGroups: []*elbv2.TargetGroupTuple{
+							{TargetGroupArn: aws.String("tgArn1"), Weight: aws.Int64(80)},
+							{TargetGroupArn: aws.String("tgArn2"), Weight: aws.Int64(20)},
+						},
+					},
+				},
+			},
+		},
+		{
+			Priority: aws.String("2"),
+			Conditions: []*elbv2.RuleCondition{
+				{Field: aws.String("host-header"), Values: aws.StringSlice([]string{"example.com"})},
+				{Field: aws.String("path-pattern"), Values: aws.StringSlice([]string{"/*"})},
+			},
+			Actions: []*elbv2.Action{
+				{Type: aws.String(elbv2.ActionTypeEnumForward), TargetGroupArn: aws.String("tgArn1")},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		Name                string
+		Tags                []*elbv2.Tag
+		ExpectedAnnotations map[string]string
+		ExpectedRules       []extensions.IngressRule
+		ExpectedWarnings    []string
+		ExpectedErr         string
+	}{
+		{
+			Name: "unmanaged LoadBalancer",
+			Tags: []*elbv2.Tag{
+				{Key: aws.String("team"), Value: aws.String("web")},
+				{Key: aws.String("aws:cloudformation:stack-name"), Value: aws.String("stack")},
+			},
+			ExpectedAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/migrated":                 "true",
+				"kubernetes.io/ingress.class":                        "alb",
+				"alb.ingress.kubernetes.io/scheme":                   "internal",
+				"alb.ingress.kubernetes.io/ip-address-type":          "ipv4",
+				"alb.ingress.kubernetes.io/subnets":                  "subnet-1,subnet-2",
+				"alb.ingress.kubernetes.io/security-groups":          "sg-1",
+				"alb.ingress.kubernetes.io/tags":                     "team=web",
+				"alb.ingress.kubernetes.io/wafv2-acl-arn":            "webACLArn",
+				"alb.ingress.kubernetes.io/load-balancer-attributes": "idle_timeout.timeout_seconds=120",
+				"alb.ingress.kubernetes.io/listen-ports":             `[{"HTTP":80},{"HTTPS":443}]`,
+				"alb.ingress.kubernetes.io/certificate-arn":          "certArn1,certArn2",
+				"alb.ingress.kubernetes.io/ssl-policy":               "ELBSecurityPolicy-TLS-1-2-2017-01",
+				"alb.ingress.kubernetes.io/actions.rule-2":           `{"TargetGroupArn":"tgArn1","Type":"forward"}`,
+				"alb.ingress.kubernetes.io/actions.rule-10":          `{"ForwardConfig":{"TargetGroups":[{"TargetGroupArn":"tgArn1","Weight":80},{"TargetGroupArn":"tgArn2","Weight":20}]},"Type":"forward"}`,
+				"alb.ingress.kubernetes.io/conditions.rule-10":       `[{"Field":"path-pattern","PathPatternConfig":{"Values":["/api/*","/v1/*"]}}]`,
+			},
+			ExpectedRules: []extensions.IngressRule{
+				migratedIngressRule("example.com", "/*", "rule-2"),
+				migratedIngressRule("", "", "rule-10"),
+			},
+			ExpectedWarnings: []string{"rules of listener on port 80 are applied to all listeners"},
+		},
+		{
+			Name: "LoadBalancer owned by another ingress",
+			Tags: []*elbv2.Tag{
+				{Key: aws.String("kubernetes.io/namespace"), Value: aws.String("other")},
+				{Key: aws.String("kubernetes.io/ingress-name"), Value: aws.String("ingress")},
+			},
+			ExpectedErr: "LoadBalancer lbArn is already managed by ingress other/ingress",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			cloud := &mocks.CloudAPI{}
+			cloud.On("DescribeELBV2TagsWithContext", ctx, &elbv2.DescribeTagsInput{ResourceArns: aws.StringSlice([]string{"lbArn"})}).
+				Return(&elbv2.DescribeTagsOutput{TagDescriptions: []*elbv2.TagDescription{{Tags: tc.Tags}}}, nil)
+			cloud.On("WAFRegionalAvailable").Return(false)
+			cloud.On("GetWAFV2WebACLSummary", ctx, aws.String("lbArn")).Return(&wafv2.WebACL{ARN: aws.String("webACLArn")}, nil)
+			cloud.On("DescribeLoadBalancerAttributesWithContext", ctx, &elbv2.DescribeLoadBalancerAttributesInput{LoadBalancerArn: aws.String("lbArn")}).
+				Return(&elbv2.DescribeLoadBalancerAttributesOutput{Attributes: []*elbv2.LoadBalancerAttribute{
+					{Key: aws.String("idle_timeout.timeout_seconds"), Value: aws.String("120")},
+					{Key: aws.String("routing.http2.enabled"), Value: aws.String("true")},
+				}}, nil)
+			cloud.On("ListListenersByLoadBalancer", ctx, "lbArn").Return(append([]*elbv2.Listener{}, listeners...), nil)
+			cloud.On("DescribeListenerCertificates", ctx, "lsArn443").Return([]*elbv2.Certificate{
+				{CertificateArn: aws.String("certArn2"), IsDefault: aws.Bool(false)},
+				{CertificateArn: aws.String("certArn1"), IsDefault: aws.Bool(true)},
+			}, nil)
+			cloud.On("GetRules", ctx, "lsArn80").Return(append([]*elbv2.Rule{}, rules...), nil)
+
+			ingress, warnings, err := buildMigratedIngress(ctx, cloud, instance, "namespace", "ingress", "alb")
+			if len(tc.ExpectedErr) != 0 {
+				assert.EqualError(t, err, tc.ExpectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedAnnotations, ingress.Annotations)
+			assert.Equal(t, []string{"ingress.k8s.aws/resources"}, ingress.Finalizers)
+			assert.Equal(t, tc.ExpectedRules, ingress.Spec.Rules)
+			assert.Nil(t, ingress.Spec.Backend)
+			assert.Equal(t, tc.ExpectedWarnings, warnings)
+		})
+	}
+}
+
+func Test_migrateActions(t *testing.T) {
+	for _, tc := range []struct {
+		Name            string
+		Actions         []*elbv2.Action
+		ExpectedOK      bool
+		ExpectedType    string
+		ExpectedWarning string
+	}{
+		{
+			Name: "redirect",
+			Actions: []*elbv2.Action{
+				{Type: aws.String(elbv2.ActionTypeEnumRedirect), RedirectConfig: &elbv2.RedirectActionConfig{Protocol: aws.String("HTTPS"), StatusCode: aws.String("HTTP_301")}},
+			},
+			ExpectedOK:   true,
+			ExpectedType: elbv2.ActionTypeEnumRedirect,
+		},
+		{
+			Name: "authenticate before forward",
+			Actions: []*elbv2.Action{
+				{Type: aws.String(elbv2.ActionTypeEnumForward), TargetGroupArn: aws.String("tgArn"), Order: aws.Int64(2)},
+				{Type: aws.String(elbv2.ActionTypeEnumAuthenticateOidc), Order: aws.Int64(1)},
+			},
+			ExpectedOK:      true,
+			ExpectedType:    elbv2.ActionTypeEnumForward,
+			ExpectedWarning: "authenticate actions are not migrated, configure them with the auth-* annotations",
+		},
+		{
+			Name:            "no actions",
+			ExpectedWarning: "no actions",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			result, ok, warning := migrateActions(tc.Actions)
+			assert.Equal(t, tc.ExpectedOK, ok)
+			assert.Equal(t, tc.ExpectedWarning, warning)
+			assert.Equal(t, tc.ExpectedType, aws.StringValue(result.Type))
+		})
+	}
+}
+
+func migratedIngressRule(host string, path string, ruleName string) extensions.IngressRule {
+	return extensions.IngressRule{
+		Host: host,
+		IngressRuleValue: extensions.IngressRuleValue{
+			HTTP: &extensions.HTTPIngressRuleValue{
+				Paths: []extensions.HTTPIngressPath{
+					{
+						Path:    path,
+						Backend: extensions.IngressBackend{ServiceName: ruleName, ServicePort: intstr.FromString("use-annotation")},
+					},
+				},
+			},
+		},
+	}
+}
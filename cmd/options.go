@@ -17,9 +17,12 @@ limitations under the License.
 package main
 
 import (
+	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"k8s.io/klog"
@@ -29,8 +32,12 @@ import (
 	"github.com/spf13/pflag"
 
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/config"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/metric"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/net"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/tracing"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/util/log"
 	apiv1 "k8s.io/api/core/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 )
 
 const (
@@ -42,14 +49,23 @@ const (
 	defaultHealthCheckPeriod       = 1 * time.Minute
 	defaultHealthzPort             = 10254
 	defaultProfilingEnabled        = true
+	defaultEnableProfiling         = false
+	defaultProfilingPort           = 10255
 	defaultEnableSdkCache          = false
 	defaultSdkCacheDuration        = 5 * time.Minute
+	defaultShutdownGracePeriod     = 20 * time.Second
+	defaultLogFormat               = log.FormatText
 )
 
 // Options defines the commandline interface of this binary
 type Options struct {
 	ShowVersion bool
 
+	// ConfigFile is the path of a YAML file containing flag values
+	ConfigFile string
+	// configFileWatcher reloads the ConfigFile, nil if there is none
+	configFileWatcher *configFileWatcher
+
 	APIServerHost  string
 	KubeConfigFile string
 
@@ -63,6 +79,23 @@ type Options struct {
 	HealthzPort       int
 	ProfilingEnabled  bool
 
+	// EnableProfiling enables pprof and expvar endpoints on a localhost-bound ProfilingPort
+	EnableProfiling bool
+	ProfilingPort   int
+
+	// ShutdownGracePeriod is the maximum time to wait for in-flight reconciles when the controller is stopping
+	ShutdownGracePeriod time.Duration
+
+	// LogFormat and LogLevels configures the output of controller logs
+	LogFormat string
+	LogLevels map[string]string
+
+	// tracing specific configuration
+	tracingConfig tracing.Config
+
+	// cloudwatch metrics publishing configuration
+	cloudWatchMetricsConfig metric.CloudWatchConfig
+
 	// aws cloud specific configuration
 	cloudConfig aws.CloudConfig
 
@@ -77,6 +110,8 @@ type Options struct {
 func (options *Options) BindFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&options.ShowVersion, "version", false,
 		`Show release information about the AWS ALB Ingress controller and exit.`)
+	fs.StringVar(&options.ConfigFile, "config", "",
+		`Path to a YAML file with flag names as keys, e.g. "cluster-name: my-cluster". Flags on the command line take precedence.`)
 	fs.StringVar(&options.APIServerHost, "apiserver-host", "",
 		`Address of the Kubernetes API server.
 		Takes the form "protocol://address:port". If not specified, it is assumed the
@@ -94,18 +129,31 @@ func (options *Options) BindFlags(fs *pflag.FlagSet) {
 		This includes Ingresses, Services and all configuration resources. All
 		namespaces are watched if this parameter is left empty.`)
 	fs.DurationVar(&options.SyncPeriod, "sync-period", defaultSyncPeriod,
-		`Period at which the controller forces the repopulation of its local object stores.`)
+		`Period at which the controller forces the repopulation of its local object stores, and reconciles every ingress to revert changes made to its AWS resources out of band. 0 disables periodic reconciles`)
 	fs.DurationVar(&options.HealthCheckPeriod, "health-check-period", defaultHealthCheckPeriod,
 		`Period at which the controller executes AWS health checks for its healthz endpoint.`)
 	fs.IntVar(&options.HealthzPort, "healthz-port", defaultHealthzPort,
 		`Port to use for the healthz endpoint.`)
 	fs.BoolVar(&options.ProfilingEnabled, "profiling", defaultProfilingEnabled,
 		`Enable profiling via web interface host:port/debug/pprof/`)
+	fs.BoolVar(&options.EnableProfiling, "enable-profiling", defaultEnableProfiling,
+		`Enable pprof and expvar endpoints via web interface 127.0.0.1:profiling-port/debug/`)
+	fs.IntVar(&options.ProfilingPort, "profiling-port", defaultProfilingPort,
+		`Port to use for the profiling endpoints, only bound to localhost. Only respected when enable-profiling is true.`)
+	fs.DurationVar(&options.ShutdownGracePeriod, "shutdown-grace-period", defaultShutdownGracePeriod,
+		`Maximum time to wait for in-flight reconciles to finish on shutdown. Should be less than the pod's terminationGracePeriodSeconds.`)
+	fs.StringVar(&options.LogFormat, "log-format", defaultLogFormat,
+		`Format of controller logs, must be "text" or "json"`)
+	fs.StringToStringVar(&options.LogLevels, "log-levels", map[string]string{},
+		`Minimum log level per module, e.g. tg=warn,aws=debug. Modules are lb, ls, tg, sg, aws, etc., levels are debug, info, warn, error`)
 	fs.BoolVar(&options.EnableSdkCache, "aws-cache-enable", defaultEnableSdkCache, "Enables AWS SDK Caching")
 	fs.DurationVar(&options.SdkCacheDuration, "aws-cache-duration", defaultSdkCacheDuration, "Duration of AWS SDK Cache entries, default 5m")
+	options.tracingConfig.BindFlags(fs)
+	options.cloudWatchMetricsConfig.BindFlags(fs)
 	options.cloudConfig.BindFlags(fs)
 	options.ingressCTLConfig.BindFlags(fs)
 
+	_ = fs.MarkDeprecated("profiling", `Exposes profiling on the healthz port, switch to the --enable-profiling flag`)
 	_ = fs.MarkDeprecated("aws-sync-period", `No longer used, will be removed in next release`)
 	_ = fs.MarkDeprecated("default-backend-service", `No longer used, will be removed in next release`)
 }
@@ -120,16 +168,70 @@ func (options *Options) BindEnv() error {
 	return nil
 }
 
+// Validate checks the options of all components, all the errors found are returned together.
 func (options *Options) Validate() error {
+	var errs []error
 	if !net.IsPortAvailable(options.HealthzPort) {
-		return fmt.Errorf("port %v is already in use. Please check the flag --healthz-port", options.HealthzPort)
+		errs = append(errs, fmt.Errorf("port %v is already in use. Please check the flag --healthz-port", options.HealthzPort))
 	}
-	if err := options.ingressCTLConfig.Validate(); err != nil {
-		return err
+	if options.SyncPeriod < 0 {
+		errs = append(errs, fmt.Errorf("--sync-period must not be negative, got %v", options.SyncPeriod))
+	}
+	if err := log.ValidateConfig(options.LogFormat, options.LogLevels); err != nil {
+		errs = append(errs, err)
+	}
+	if options.EnableProfiling {
+		if options.ProfilingPort == options.HealthzPort {
+			errs = append(errs, fmt.Errorf("--profiling-port must be different from --healthz-port"))
+		} else if !net.IsPortAvailable(options.ProfilingPort) {
+			errs = append(errs, fmt.Errorf("port %v is already in use. Please check the flag --profiling-port", options.ProfilingPort))
+		}
+	}
+	// the ConfigMap of internet-facing ingresses is read through the cache of the manager, which only holds the watched namespace.
+	if options.ingressCTLConfig.RestrictScheme && options.WatchNamespace != apiv1.NamespaceAll &&
+		options.ingressCTLConfig.RestrictSchemeNamespace != options.WatchNamespace {
+		errs = append(errs, fmt.Errorf("--restrict-scheme-namespace %v must be the --watch-namespace %v, the ConfigMap of allowed ingresses isn't watched otherwise",
+			options.ingressCTLConfig.RestrictSchemeNamespace, options.WatchNamespace))
+	}
+	if options.ingressCTLConfig.ObserveOnly && options.LeaderElection && options.LeaderElectionID == defaultLeaderElectionID {
+		errs = append(errs, fmt.Errorf("--observe-only requires an --election-id other than %v, it would wait for the active controller to give up leadership otherwise", defaultLeaderElectionID))
+	}
+	for _, validate := range []func() error{
+		options.tracingConfig.Validate,
+		options.cloudWatchMetricsConfig.Validate,
+		options.cloudConfig.Validate,
+		options.ingressCTLConfig.Validate,
+	} {
+		if err := validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.Flatten(utilerrors.NewAggregate(errs))
+}
+
+// verifyCloud checks the AWS resources the configuration refers to exist, once the region and VPC are resolved.
+func verifyCloud(ctx context.Context, cloud aws.CloudAPI) error {
+	if _, err := cloud.GetVpcWithContext(ctx); err != nil {
+		return fmt.Errorf("failed to find VPC %v due to %v, check the --aws-vpc-id and --aws-region flags", cloud.GetVpcID(), err)
 	}
 	return nil
 }
 
+// invalidConfigurationError reports the errors of a failed validation one per line.
+func invalidConfigurationError(err error) error {
+	agg, ok := err.(utilerrors.Aggregate)
+	if !ok {
+		agg = utilerrors.NewAggregate([]error{err})
+	}
+	var report strings.Builder
+	report.WriteString("invalid configuration:")
+	for _, e := range utilerrors.Flatten(agg).Errors() {
+		report.WriteString("\n  - ")
+		report.WriteString(e.Error())
+	}
+	return errors.New(report.String())
+}
+
 func getOptions() (*Options, error) {
 	options := &Options{
 		ingressCTLConfig: config.NewConfiguration(),
@@ -147,11 +249,18 @@ func getOptions() (*Options, error) {
 	fs.AddGoFlagSet(klogFs)
 
 	_ = fs.Parse(os.Args)
+	if len(options.ConfigFile) != 0 {
+		watcher, err := newConfigFileWatcher(fs, options.ConfigFile)
+		if err != nil {
+			return nil, err
+		}
+		options.configFileWatcher = watcher
+	}
 	if err := options.BindEnv(); err != nil {
 		return nil, err
 	}
 	if err := options.Validate(); err != nil {
-		return nil, err
+		return nil, invalidConfigurationError(err)
 	}
 
 	return options, nil
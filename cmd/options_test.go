@@ -0,0 +1,86 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/config"
+)
+
+func TestOptions_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		args          []string
+		expectedError string
+	}{
+		{
+			name: "valid options",
+			args: []string{"--cluster-name=cluster"},
+		},
+		{
+			name: "restrict scheme namespace not watched",
+			args: []string{"--cluster-name=cluster", "--watch-namespace=ingresses", "--restrict-scheme"},
+			expectedError: "--restrict-scheme-namespace default must be the --watch-namespace ingresses, " +
+				"the ConfigMap of allowed ingresses isn't watched otherwise",
+		},
+		{
+			name: "restrict scheme namespace watched",
+			args: []string{"--cluster-name=cluster", "--watch-namespace=ingresses", "--restrict-scheme", "--restrict-scheme-namespace=ingresses"},
+		},
+		{
+			name:          "observe only with the election id of the active controller",
+			args:          []string{"--cluster-name=cluster", "--observe-only"},
+			expectedError: "--observe-only requires an --election-id other than ingress-controller-leader-alb, it would wait for the active controller to give up leadership otherwise",
+		},
+		{
+			name: "observe only",
+			args: []string{"--cluster-name=cluster", "--observe-only", "--election-id=ingress-controller-observer-alb"},
+		},
+		{
+			name:          "negative sync period",
+			args:          []string{"--cluster-name=cluster", "--sync-period=-1m"},
+			expectedError: "--sync-period must not be negative, got -1m0s",
+		},
+		{
+			name: "sync period disabled",
+			args: []string{"--cluster-name=cluster", "--sync-period=0"},
+		},
+		{
+			name: "errors of all components",
+			args: []string{"--aws-region=us-west-2a", "--tracing-sample-ratio=2", "--max-concurrent-reconciles=0"},
+			expectedError: "[tracing sample ratio must be between 0 and 1, got 2, " +
+				"--aws-region us-west-2a is not a region, e.g. us-west-2, " +
+				"clusterName must be specified, " +
+				"maxConcurrentReconciles must be at least 1, got 0]",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			options := &Options{ingressCTLConfig: config.NewConfiguration()}
+			fs := pflag.NewFlagSet("", pflag.ContinueOnError)
+			options.BindFlags(fs)
+			assert.NoError(t, fs.Parse(append([]string{"--healthz-port=0"}, tc.args...)))
+
+			err := options.Validate()
+			if tc.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func Test_invalidConfigurationError(t *testing.T) {
+	err := utilerrors.NewAggregate([]error{
+		errors.New("clusterName must be specified"),
+		utilerrors.NewAggregate([]error{errors.New("--aws-region us-west-2a is not a region, e.g. us-west-2")}),
+	})
+	assert.EqualError(t, invalidConfigurationError(err), "invalid configuration:\n"+
+		"  - clusterName must be specified\n"+
+		"  - --aws-region us-west-2a is not a region, e.g. us-west-2")
+	assert.EqualError(t, invalidConfigurationError(errors.New("failed to introspect region")), "invalid configuration:\n  - failed to introspect region")
+}
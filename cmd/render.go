@@ -0,0 +1,342 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/spf13/pflag"
+	corev1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/generator"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/ls"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/tg"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/auth"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/config"
+)
+
+// renderCommand is the subcommand name used to print the desired AWS model of an ingress.
+const renderCommand = "render"
+
+// renderOptions defines the commandline interface of the render subcommand
+type renderOptions struct {
+	APIServerHost  string
+	KubeConfigFile string
+	Namespace      string
+
+	ingressCTLConfig config.Configuration
+}
+
+func (options *renderOptions) BindFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&options.APIServerHost, "apiserver-host", "",
+		`Address of the Kubernetes API server.`)
+	fs.StringVar(&options.KubeConfigFile, "kubeconfig", "",
+		`Path to a kubeconfig file containing authorization and API server information.`)
+	fs.StringVarP(&options.Namespace, "namespace", "n", corev1.NamespaceDefault,
+		`Namespace of the ingress.`)
+	options.ingressCTLConfig.BindFlags(fs)
+}
+
+// renderedModel is the AWS model resolved for an ingress.
+// TargetGroups are referred to by name in actions, their ARNs are only known once created.
+type renderedModel struct {
+	Ingress              string                 `json:"ingress"`
+	LoadBalancer         renderedLoadBalancer   `json:"loadBalancer"`
+	Listeners            []renderedListener     `json:"listeners"`
+	TargetGroups         []renderedTargetGroup  `json:"targetGroups"`
+	ManagedSecurityGroup *renderedSecurityGroup `json:"managedSecurityGroup,omitempty"`
+}
+
+type renderedLoadBalancer struct {
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	Scheme        string `json:"scheme"`
+	IPAddressType string `json:"ipAddressType"`
+	// Subnets are discovered when unspecified.
+	Subnets []string `json:"subnets,omitempty"`
+	// SecurityGroups are managed by the controller when unspecified.
+	SecurityGroups []string          `json:"securityGroups,omitempty"`
+	WebACLId       string            `json:"webACLId,omitempty"`
+	ShieldAdvanced *bool             `json:"shieldAdvanced,omitempty"`
+	Attributes     map[string]string `json:"attributes,omitempty"`
+	Tags           map[string]string `json:"tags"`
+}
+
+type renderedListener struct {
+	Port      int64  `json:"port"`
+	Protocol  string `json:"protocol"`
+	SSLPolicy string `json:"sslPolicy,omitempty"`
+	// CertificateARNs are discovered from ACM when unspecified.
+	CertificateARNs      []string                              `json:"certificateARNs,omitempty"`
+	MutualAuthentication *elbv2.MutualAuthenticationAttributes `json:"mutualAuthentication,omitempty"`
+	DefaultActions       []*elbv2.Action                       `json:"defaultActions"`
+	Rules                []elbv2.Rule                          `json:"rules"`
+}
+
+type renderedHealthCheck struct {
+	Path            string `json:"path"`
+	Port            string `json:"port"`
+	Protocol        string `json:"protocol"`
+	IntervalSeconds int64  `json:"intervalSeconds"`
+	TimeoutSeconds  int64  `json:"timeoutSeconds"`
+}
+
+type renderedTargetGroup struct {
+	Name                    string              `json:"name"`
+	Backend                 string              `json:"backend"`
+	TargetType              string              `json:"targetType"`
+	Protocol                string              `json:"protocol"`
+	HealthCheck             renderedHealthCheck `json:"healthCheck"`
+	SuccessCodes            string              `json:"successCodes"`
+	HealthyThresholdCount   int64               `json:"healthyThresholdCount"`
+	UnhealthyThresholdCount int64               `json:"unhealthyThresholdCount"`
+	Attributes              map[string]string   `json:"attributes,omitempty"`
+	Tags                    map[string]string   `json:"tags"`
+}
+
+type renderedInboundRule struct {
+	Port       int64  `json:"port"`
+	CIDR       string `json:"cidr,omitempty"`
+	PrefixList string `json:"prefixList,omitempty"`
+}
+
+type renderedSecurityGroup struct {
+	Name         string                `json:"name"`
+	InboundRules []renderedInboundRule `json:"inboundRules"`
+	Tags         map[string]string     `json:"tags"`
+}
+
+func runRender(args []string) error {
+	options := &renderOptions{
+		ingressCTLConfig: config.NewConfiguration(),
+	}
+	fs := pflag.NewFlagSet(renderCommand, pflag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s %s [flags] INGRESS\n", os.Args[0], renderCommand)
+		fs.PrintDefaults()
+	}
+	options.BindFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return fmt.Errorf("exactly one ingress name must be specified")
+	}
+	if err := options.ingressCTLConfig.Validate(); err != nil {
+		return err
+	}
+
+	restCfg, err := clientcmd.BuildConfigFromFlags(options.APIServerHost, options.KubeConfigFile)
+	if err != nil {
+		return err
+	}
+	reader, err := client.New(restCfg, client.Options{})
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	ingress := &extensions.Ingress{}
+	if err := reader.Get(ctx, types.NamespacedName{Namespace: options.Namespace, Name: fs.Arg(0)}, ingress); err != nil {
+		return err
+	}
+	model, err := buildRenderedModel(ctx, reader, ingress, &options.ingressCTLConfig)
+	if err != nil {
+		return err
+	}
+	return printRenderedModel(os.Stdout, model)
+}
+
+// buildRenderedModel resolves the AWS model of ingress the same way the controller does, without calling AWS.
+// The services and secrets ingress references are read from reader.
+func buildRenderedModel(ctx context.Context, reader client.Reader, ingress *extensions.Ingress, cfg *config.Configuration) (*renderedModel, error) {
+	r := staticResolver{cfg}
+	ingressAnnos := annotations.NewIngressAnnotationExtractor(r).ExtractIngress(ingress)
+	if ingressAnnos.Error != nil {
+		return nil, fmt.Errorf("failed to parse ingress annotations due to %v", ingressAnnos.Error)
+	}
+	nameGen := generator.NewNameTagGenerator(*cfg)
+
+	model := &renderedModel{
+		Ingress: fmt.Sprintf("%v/%v", ingress.Namespace, ingress.Name),
+		LoadBalancer: renderedLoadBalancer{
+			Name:           nameGen.NameLB(ingress.Namespace, ingress.Name),
+			Type:           aws.StringValue(ingressAnnos.LoadBalancer.Type),
+			Scheme:         aws.StringValue(ingressAnnos.LoadBalancer.Scheme),
+			IPAddressType:  aws.StringValue(ingressAnnos.LoadBalancer.IPAddressType),
+			Subnets:        ingressAnnos.LoadBalancer.Subnets,
+			SecurityGroups: ingressAnnos.LoadBalancer.SecurityGroups,
+			WebACLId:       aws.StringValue(ingressAnnos.LoadBalancer.WebACLId),
+			ShieldAdvanced: ingressAnnos.LoadBalancer.ShieldAdvanced,
+			Attributes:     make(map[string]string),
+			Tags:           withTags(nameGen.TagLB(ingress.Namespace, ingress.Name), ingressAnnos.Tags.LoadBalancer),
+		},
+	}
+	for _, attr := range ingressAnnos.LoadBalancer.Attributes {
+		model.LoadBalancer.Attributes[aws.StringValue(attr.Key)] = aws.StringValue(attr.Value)
+	}
+
+	backends, _, err := tg.ExtractTargetGroupBackends(ingress)
+	if err != nil {
+		return nil, err
+	}
+	tgGroup := tg.TargetGroupGroup{TGByBackend: make(map[extensions.IngressBackend]tg.TargetGroup)}
+	svcExtractor := annotations.NewServiceAnnotationExtractor(r)
+	for _, backend := range backends {
+		svc := &corev1.Service{}
+		if err := reader.Get(ctx, types.NamespacedName{Namespace: ingress.Namespace, Name: backend.ServiceName}, svc); err != nil {
+			return nil, fmt.Errorf("failed to get service %v/%v due to %v", ingress.Namespace, backend.ServiceName, err)
+		}
+		serviceAnnos := svcExtractor.ExtractService(svc)
+		if serviceAnnos.Error != nil {
+			return nil, fmt.Errorf("failed to parse annotations of service %v due to %v", backend.ServiceName, serviceAnnos.Error)
+		}
+		serviceAnnos = serviceAnnos.Merge(ingressAnnos, cfg)
+
+		targetType := aws.StringValue(serviceAnnos.TargetGroup.TargetType)
+		protocol := tg.BackendProtocol(ingressAnnos, serviceAnnos)
+		tgName := nameGen.NameTG(ingress.Namespace, ingress.Name, backend.ServiceName, backend.ServicePort.String(), targetType, protocol)
+		tgGroup.TGByBackend[backend] = tg.TargetGroup{Arn: tgName, TargetType: targetType}
+
+		renderedTG := renderedTargetGroup{
+			Name:       tgName,
+			Backend:    fmt.Sprintf("%v:%v", backend.ServiceName, backend.ServicePort.String()),
+			TargetType: targetType,
+			Protocol:   protocol,
+			HealthCheck: renderedHealthCheck{
+				Path:            aws.StringValue(serviceAnnos.HealthCheck.Path),
+				Port:            aws.StringValue(serviceAnnos.HealthCheck.Port),
+				Protocol:        aws.StringValue(serviceAnnos.HealthCheck.Protocol),
+				IntervalSeconds: aws.Int64Value(serviceAnnos.HealthCheck.IntervalSeconds),
+				TimeoutSeconds:  aws.Int64Value(serviceAnnos.HealthCheck.TimeoutSeconds),
+			},
+			SuccessCodes:            aws.StringValue(serviceAnnos.TargetGroup.SuccessCodes),
+			HealthyThresholdCount:   aws.Int64Value(serviceAnnos.TargetGroup.HealthyThresholdCount),
+			UnhealthyThresholdCount: aws.Int64Value(serviceAnnos.TargetGroup.UnhealthyThresholdCount),
+			Attributes:              make(map[string]string),
+			Tags: withTags(nameGen.TagTGGroup(ingress.Namespace, ingress.Name),
+				nameGen.TagTG(ingress.Namespace, ingress.Name, backend.ServiceName, backend.ServicePort.String()),
+				ingressAnnos.Tags.LoadBalancer),
+		}
+		for _, attr := range serviceAnnos.TargetGroup.Attributes {
+			renderedTG.Attributes[aws.StringValue(attr.Key)] = aws.StringValue(attr.Value)
+		}
+		model.TargetGroups = append(model.TargetGroups, renderedTG)
+	}
+	sort.Slice(model.TargetGroups, func(i, j int) bool { return model.TargetGroups[i].Name < model.TargetGroups[j].Name })
+
+	authModule := auth.NewReaderModule(reader)
+	for _, port := range ingressAnnos.LoadBalancer.Ports {
+		listener := renderedListener{Port: port.Port, Protocol: port.Scheme}
+		if port.Scheme == elbv2.ProtocolEnumHttps || port.Scheme == elbv2.ProtocolEnumTls {
+			listener.SSLPolicy, err = ls.BuildSSLPolicy(ingress.Annotations, port.Port)
+			if err != nil {
+				return nil, err
+			}
+			_ = annotations.LoadStringSliceAnnotation(ls.AnnotationCertificateARN, &listener.CertificateARNs, ingress.Annotations)
+		}
+		options := ls.ReconcileOptions{
+			Ingress:      ingress,
+			IngressAnnos: ingressAnnos,
+			Port:         port,
+			TGGroup:      tgGroup,
+		}
+		if port.Scheme == elbv2.ProtocolEnumHttps {
+			listener.MutualAuthentication, err = ls.BuildMutualAuthentication(options)
+			if err != nil {
+				return nil, fmt.Errorf("failed to build mutual authentication of listener %v due to %v", port.Port, err)
+			}
+		}
+		listener.DefaultActions, err = ls.BuildDefaultActions(ctx, authModule, options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build default actions of listener %v due to %v", port.Port, err)
+		}
+		// listeners of network LoadBalancers have no rules.
+		if port.Scheme != elbv2.ProtocolEnumTcp && port.Scheme != elbv2.ProtocolEnumTls {
+			elbListener := &elbv2.Listener{Port: aws.Int64(port.Port), Protocol: aws.String(port.Scheme)}
+			listener.Rules, err = ls.BuildRules(ctx, authModule, elbListener, ingress, ingressAnnos, tgGroup)
+			if err != nil {
+				return nil, fmt.Errorf("failed to build rules of listener %v due to %v", port.Port, err)
+			}
+		}
+		model.Listeners = append(model.Listeners, listener)
+	}
+	sort.Slice(model.Listeners, func(i, j int) bool { return model.Listeners[i].Port < model.Listeners[j].Port })
+
+	if len(ingressAnnos.LoadBalancer.SecurityGroups) == 0 {
+		sg := &renderedSecurityGroup{
+			Name: nameGen.NameLBSG(ingress.Namespace, ingress.Name),
+			Tags: withTags(nameGen.TagLBSG(ingress.Namespace, ingress.Name), ingressAnnos.Tags.LoadBalancer),
+		}
+		for _, listener := range model.Listeners {
+			for _, cidrs := range [][]string{ingressAnnos.LoadBalancer.InboundCidrs, ingressAnnos.LoadBalancer.InboundV6CIDRs} {
+				for _, cidr := range cidrs {
+					sg.InboundRules = append(sg.InboundRules, renderedInboundRule{Port: listener.Port, CIDR: cidr})
+				}
+			}
+			for _, prefixList := range ingressAnnos.LoadBalancer.InboundPrefixLists {
+				sg.InboundRules = append(sg.InboundRules, renderedInboundRule{Port: listener.Port, PrefixList: prefixList})
+			}
+		}
+		model.ManagedSecurityGroup = sg
+	}
+	return model, nil
+}
+
+// withTags returns the union of tagSets, the latter ones take precedence.
+func withTags(tagSets ...map[string]string) map[string]string {
+	tags := make(map[string]string)
+	for _, tagSet := range tagSets {
+		for k, v := range tagSet {
+			tags[k] = v
+		}
+	}
+	return tags
+}
+
+// printRenderedModel prints model as indented JSON with sorted keys, so renders of an ingress can be diffed.
+func printRenderedModel(w io.Writer, model *renderedModel) error {
+	raw, err := json.Marshal(model)
+	if err != nil {
+		return err
+	}
+	var doc interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return err
+	}
+	out, err := json.MarshalIndent(pruneNulls(doc), "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(out))
+	return err
+}
+
+// pruneNulls drops the null members of the objects in doc, the AWS SDK types don't omit their unset fields.
+func pruneNulls(doc interface{}) interface{} {
+	switch doc := doc.(type) {
+	case map[string]interface{}:
+		for k, v := range doc {
+			if v == nil {
+				delete(doc, k)
+				continue
+			}
+			doc[k] = pruneNulls(v)
+		}
+	case []interface{}:
+		for i, v := range doc {
+			doc[i] = pruneNulls(v)
+		}
+	}
+	return doc
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/ls"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_buildRenderedModel(t *testing.T) {
+	cfg := newStatusTestConfig(t)
+	ingress := &extensions.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "namespace",
+			Name:      "ingress",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/scheme":                "internet-facing",
+				"alb.ingress.kubernetes.io/listen-ports":          `[{"HTTP": 80}, {"HTTPS": 443}]`,
+				"alb.ingress.kubernetes.io/certificate-arn":       "arn:aws:acm:us-west-2:123456789012:certificate/cert",
+				"alb.ingress.kubernetes.io/inbound-cidrs":         "10.0.0.0/8",
+				"alb.ingress.kubernetes.io/tags":                  "team=web",
+				"alb.ingress.kubernetes.io/auth-type":             "oidc",
+				"alb.ingress.kubernetes.io/auth-idp-oidc":         `{"Issuer":"https://idp","AuthorizationEndpoint":"https://idp/auth","TokenEndpoint":"https://idp/token","UserInfoEndpoint":"https://idp/userinfo","SecretName":"oidc"}`,
+				"alb.ingress.kubernetes.io/mutual-authentication": `[{"port": 443, "mode": "passthrough"}]`,
+			},
+		},
+		Spec: extensions.IngressSpec{
+			Rules: []extensions.IngressRule{
+				{
+					Host: "example.com",
+					IngressRuleValue: extensions.IngressRuleValue{
+						HTTP: &extensions.HTTPIngressRuleValue{
+							Paths: []extensions.HTTPIngressPath{
+								{
+									Path:    "/*",
+									Backend: extensions.IngressBackend{ServiceName: "web", ServicePort: intstr.FromInt(80)},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "namespace",
+			Name:        "web",
+			Annotations: map[string]string{"alb.ingress.kubernetes.io/healthcheck-path": "/healthz"},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "oidc"},
+		Data:       map[string][]byte{"clientId": []byte("id"), "clientSecret": []byte("secret")},
+	}
+	reader := fake.NewFakeClient(service, secret)
+
+	model, err := buildRenderedModel(context.Background(), reader, ingress, cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, "namespace/ingress", model.Ingress)
+	assert.Equal(t, elbv2.LoadBalancerSchemeEnumInternetFacing, model.LoadBalancer.Scheme)
+	assert.Equal(t, "web", model.LoadBalancer.Tags["team"])
+
+	assert.Len(t, model.TargetGroups, 1)
+	renderedTG := model.TargetGroups[0]
+	assert.Equal(t, "web:80", renderedTG.Backend)
+	assert.Equal(t, "/healthz", renderedTG.HealthCheck.Path)
+	assert.Equal(t, "web", renderedTG.Tags["kubernetes.io/service-name"])
+
+	assert.Len(t, model.Listeners, 2)
+	http, https := model.Listeners[0], model.Listeners[1]
+	assert.Equal(t, int64(80), http.Port)
+	assert.Empty(t, http.SSLPolicy)
+	assert.Nil(t, http.MutualAuthentication)
+	assert.Len(t, http.Rules, 1)
+	assert.Len(t, http.Rules[0].Actions, 1)
+	assert.Equal(t, renderedTG.Name, aws.StringValue(http.Rules[0].Actions[0].ForwardConfig.TargetGroups[0].TargetGroupArn))
+
+	assert.Equal(t, int64(443), https.Port)
+	assert.Equal(t, "ELBSecurityPolicy-2016-08", https.SSLPolicy)
+	assert.Equal(t, []string{"arn:aws:acm:us-west-2:123456789012:certificate/cert"}, https.CertificateARNs)
+	assert.Equal(t, ls.MutualAuthenticationModePassthrough, aws.StringValue(https.MutualAuthentication.Mode))
+	assert.Len(t, https.Rules, 1)
+	assert.Len(t, https.Rules[0].Actions, 2)
+	oidc := https.Rules[0].Actions[0].AuthenticateOidcConfig
+	assert.Equal(t, "https://idp", aws.StringValue(oidc.Issuer))
+	assert.Equal(t, "<redacted>", aws.StringValue(oidc.ClientSecret))
+
+	assert.Equal(t, []renderedInboundRule{{Port: 80, CIDR: "10.0.0.0/8"}, {Port: 443, CIDR: "10.0.0.0/8"}}, model.ManagedSecurityGroup.InboundRules)
+
+	_, err = buildRenderedModel(context.Background(), fake.NewFakeClient(), ingress, cfg)
+	assert.Error(t, err)
+}
+
+func Test_printRenderedModel(t *testing.T) {
+	model := &renderedModel{
+		Ingress:      "namespace/ingress",
+		LoadBalancer: renderedLoadBalancer{Name: "lb", Tags: map[string]string{"b": "2", "a": "1"}},
+		Listeners: []renderedListener{
+			{
+				Port:           80,
+				Protocol:       elbv2.ProtocolEnumHttp,
+				DefaultActions: []*elbv2.Action{{Type: aws.String(elbv2.ActionTypeEnumFixedResponse), Order: aws.Int64(1)}},
+			},
+		},
+	}
+	buf := &bytes.Buffer{}
+	assert.NoError(t, printRenderedModel(buf, model))
+	assert.Equal(t, `{
+  "ingress": "namespace/ingress",
+  "listeners": [
+    {
+      "defaultActions": [
+        {
+          "Order": 1,
+          "Type": "fixed-response"
+        }
+      ],
+      "port": 80,
+      "protocol": "HTTP"
+    }
+  ],
+  "loadBalancer": {
+    "ipAddressType": "",
+    "name": "lb",
+    "scheme": "",
+    "tags": {
+      "a": "1",
+      "b": "2"
+    },
+    "type": ""
+  }
+}
+`, buf.String())
+}
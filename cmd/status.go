@@ -0,0 +1,597 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/generator"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/ls"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/tg"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/auth"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/config"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/ingressapi"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/metric"
+	corev1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+)
+
+// statusCommand is the subcommand name used to inspect a single ingress.
+const statusCommand = "status"
+
+// statusOptions defines the commandline interface of the status subcommand
+type statusOptions struct {
+	APIServerHost  string
+	KubeConfigFile string
+	Namespace      string
+
+	cloudConfig      aws.CloudConfig
+	ingressCTLConfig config.Configuration
+}
+
+func (options *statusOptions) BindFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&options.APIServerHost, "apiserver-host", "",
+		`Address of the Kubernetes API server.`)
+	fs.StringVar(&options.KubeConfigFile, "kubeconfig", "",
+		`Path to a kubeconfig file containing authorization and API server information.`)
+	fs.StringVarP(&options.Namespace, "namespace", "n", corev1.NamespaceDefault,
+		`Namespace of the ingress.`)
+	options.cloudConfig.BindFlags(fs)
+	options.ingressCTLConfig.BindFlags(fs)
+}
+
+// staticResolver resolves controller configuration for annotation parsers outside of the controller.
+type staticResolver struct {
+	cfg *config.Configuration
+}
+
+func (r staticResolver) GetConfig() *config.Configuration {
+	return r.cfg
+}
+
+func (r staticResolver) GetInstanceIDFromPodIP(ip string) (string, error) {
+	return "", fmt.Errorf("resolving instance for pod IP %v is not supported", ip)
+}
+
+// desiredState is the AWS state derived from an ingress and its annotations.
+type desiredState struct {
+	LBName string
+	Scheme string
+	// Listener protocols keyed by port
+	Listeners map[int64]string
+	// Listener rules keyed by port, their actions refer to targetGroups by name rather than by ARN
+	Rules map[int64][]elbv2.Rule
+	// Backend service:port keyed by targetGroup name
+	TargetGroups map[string]string
+}
+
+// liveListener is a listener found in AWS, along with its rules.
+type liveListener struct {
+	Listener *elbv2.Listener
+	Rules    []*elbv2.Rule
+}
+
+// liveTargetGroup is a targetGroup found in AWS, along with the health of its targets.
+type liveTargetGroup struct {
+	TargetGroup *elbv2.TargetGroup
+	Health      []*elbv2.TargetHealthDescription
+}
+
+// liveState is the AWS state currently serving an ingress.
+type liveState struct {
+	LoadBalancer *elbv2.LoadBalancer
+	Listeners    []liveListener
+	// targetGroups keyed by ARN
+	TargetGroups map[string]liveTargetGroup
+}
+
+func runStatus(args []string) error {
+	options := &statusOptions{
+		ingressCTLConfig: config.NewConfiguration(),
+	}
+	fs := pflag.NewFlagSet(statusCommand, pflag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s %s [flags] INGRESS\n", os.Args[0], statusCommand)
+		fs.PrintDefaults()
+	}
+	options.BindFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return fmt.Errorf("exactly one ingress name must be specified")
+	}
+	if err := options.ingressCTLConfig.Validate(); err != nil {
+		return err
+	}
+
+	restCfg, err := clientcmd.BuildConfigFromFlags(options.APIServerHost, options.KubeConfigFile)
+	if err != nil {
+		return err
+	}
+	reader, err := client.New(restCfg, client.Options{})
+	if err != nil {
+		return err
+	}
+	ingressClient, err := ingressapi.NewAPIClient(restCfg)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	ingress := &extensions.Ingress{}
+	if err := ingressClient.Get(ctx, types.NamespacedName{Namespace: options.Namespace, Name: fs.Arg(0)}, ingress); err != nil {
+		return err
+	}
+	desired, err := buildDesiredState(ctx, reader, ingress, &options.ingressCTLConfig)
+	if err != nil {
+		return err
+	}
+
+	cloud, err := aws.New(options.cloudConfig, options.ingressCTLConfig.ClusterName, metric.DummyCollector{}, false, nil)
+	if err != nil {
+		return err
+	}
+	live, err := loadLiveState(ctx, cloud, ingress, desired.LBName)
+	if err != nil {
+		return err
+	}
+
+	if drifts := printStatus(os.Stdout, desired, live); len(drifts) != 0 {
+		return fmt.Errorf("found %d drift(s) between ingress %v/%v and AWS", len(drifts), ingress.Namespace, ingress.Name)
+	}
+	return nil
+}
+
+// buildDesiredState derives the expected AWS state from ingress the same way the controller does, without calling AWS.
+// The services and secrets ingress references are read from reader.
+func buildDesiredState(ctx context.Context, reader client.Reader, ingress *extensions.Ingress, cfg *config.Configuration) (*desiredState, error) {
+	r := staticResolver{cfg}
+	ingressAnnos := annotations.NewIngressAnnotationExtractor(r).ExtractIngress(ingress)
+	if ingressAnnos.Error != nil {
+		return nil, fmt.Errorf("failed to parse ingress annotations due to %v", ingressAnnos.Error)
+	}
+	nameGen := generator.NewNameTagGenerator(*cfg)
+
+	desired := &desiredState{
+		LBName:       nameGen.NameLB(ingress.Namespace, ingress.Name),
+		Scheme:       aws.StringValue(ingressAnnos.LoadBalancer.Scheme),
+		Listeners:    make(map[int64]string),
+		Rules:        make(map[int64][]elbv2.Rule),
+		TargetGroups: make(map[string]string),
+	}
+
+	backends, _, err := tg.ExtractTargetGroupBackends(ingress)
+	if err != nil {
+		return nil, err
+	}
+	tgGroup := tg.TargetGroupGroup{TGByBackend: make(map[extensions.IngressBackend]tg.TargetGroup)}
+	svcExtractor := annotations.NewServiceAnnotationExtractor(r)
+	for _, backend := range backends {
+		svc := &corev1.Service{}
+		if err := reader.Get(ctx, types.NamespacedName{Namespace: ingress.Namespace, Name: backend.ServiceName}, svc); err != nil {
+			return nil, fmt.Errorf("failed to get service %v/%v due to %v", ingress.Namespace, backend.ServiceName, err)
+		}
+		serviceAnnos := svcExtractor.ExtractService(svc)
+		if serviceAnnos.Error != nil {
+			return nil, fmt.Errorf("failed to parse annotations of service %v due to %v", backend.ServiceName, serviceAnnos.Error)
+		}
+		serviceAnnos = serviceAnnos.Merge(ingressAnnos, cfg)
+		targetType := aws.StringValue(serviceAnnos.TargetGroup.TargetType)
+		tgName := nameGen.NameTG(ingress.Namespace, ingress.Name, backend.ServiceName, backend.ServicePort.String(),
+			targetType, tg.BackendProtocol(ingressAnnos, serviceAnnos))
+		tgGroup.TGByBackend[backend] = tg.TargetGroup{Arn: tgName, TargetType: targetType}
+		desired.TargetGroups[tgName] = fmt.Sprintf("%v:%v", backend.ServiceName, backend.ServicePort.String())
+	}
+
+	authModule := auth.NewReaderModule(reader)
+	for _, port := range ingressAnnos.LoadBalancer.Ports {
+		desired.Listeners[port.Port] = port.Scheme
+		// listeners of network LoadBalancers have no rules.
+		if port.Scheme == elbv2.ProtocolEnumTcp || port.Scheme == elbv2.ProtocolEnumTls {
+			continue
+		}
+		listener := &elbv2.Listener{Port: aws.Int64(port.Port), Protocol: aws.String(port.Scheme)}
+		desired.Rules[port.Port], err = ls.BuildRules(ctx, authModule, listener, ingress, ingressAnnos, tgGroup)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build rules of listener %v due to %v", port.Port, err)
+		}
+	}
+	return desired, nil
+}
+
+// loadLiveState loads the LoadBalancer serving ingress from AWS, preferring the ARN recorded on the ingress over lbName.
+// A nil LoadBalancer in the result means it doesn't exist.
+func loadLiveState(ctx context.Context, cloud aws.CloudAPI, ingress *extensions.Ingress, lbName string) (*liveState, error) {
+	live := &liveState{TargetGroups: make(map[string]liveTargetGroup)}
+
+	resources, err := controller.GetManagedResources(ingress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %v annotation due to %v", controller.ManagedResourcesAnnotation(), err)
+	}
+	if resources != nil && len(resources.LoadBalancerARN) != 0 {
+		live.LoadBalancer, err = cloud.GetLoadBalancerByArn(ctx, resources.LoadBalancerARN)
+	} else {
+		live.LoadBalancer, err = cloud.GetLoadBalancerByName(ctx, lbName)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find LoadBalancer due to %v", err)
+	}
+	if live.LoadBalancer == nil {
+		return live, nil
+	}
+
+	listeners, err := cloud.ListListenersByLoadBalancer(ctx, aws.StringValue(live.LoadBalancer.LoadBalancerArn))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list listeners due to %v", err)
+	}
+	for _, listener := range listeners {
+		rules, err := cloud.GetRules(ctx, aws.StringValue(listener.ListenerArn))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get rules of listener %v due to %v", aws.StringValue(listener.ListenerArn), err)
+		}
+		live.Listeners = append(live.Listeners, liveListener{Listener: listener, Rules: rules})
+
+		for _, rule := range rules {
+			for _, tgArn := range forwardTargetGroupARNs(rule.Actions) {
+				if _, ok := live.TargetGroups[tgArn]; ok {
+					continue
+				}
+				targetGroup, err := cloud.GetTargetGroupByArn(ctx, tgArn)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get targetGroup %v due to %v", tgArn, err)
+				}
+				if targetGroup == nil {
+					continue
+				}
+				health, err := cloud.DescribeTargetHealthWithContext(ctx, &elbv2.DescribeTargetHealthInput{TargetGroupArn: aws.String(tgArn)})
+				if err != nil {
+					return nil, fmt.Errorf("failed to describe target health of %v due to %v", tgArn, err)
+				}
+				live.TargetGroups[tgArn] = liveTargetGroup{TargetGroup: targetGroup, Health: health.TargetHealthDescriptions}
+			}
+		}
+	}
+	sort.Slice(live.Listeners, func(i, j int) bool {
+		return aws.Int64Value(live.Listeners[i].Listener.Port) < aws.Int64Value(live.Listeners[j].Listener.Port)
+	})
+	return live, nil
+}
+
+func forwardTargetGroupARNs(actions []*elbv2.Action) []string {
+	var arns []string
+	for _, action := range actions {
+		if aws.StringValue(action.Type) != elbv2.ActionTypeEnumForward {
+			continue
+		}
+		if action.TargetGroupArn != nil {
+			arns = append(arns, aws.StringValue(action.TargetGroupArn))
+			continue
+		}
+		if action.ForwardConfig != nil {
+			for _, tuple := range action.ForwardConfig.TargetGroups {
+				arns = append(arns, aws.StringValue(tuple.TargetGroupArn))
+			}
+		}
+	}
+	return arns
+}
+
+// diffStatus compares desired against live, returning a description of each drift found.
+func diffStatus(desired *desiredState, live *liveState) []string {
+	if live.LoadBalancer == nil {
+		return []string{fmt.Sprintf("LoadBalancer %v doesn't exist", desired.LBName)}
+	}
+
+	// live rules refer to the desired targetGroups by name to be compared to desired rules.
+	tgNames := make(map[string]string)
+	for arn, ltg := range live.TargetGroups {
+		name := aws.StringValue(ltg.TargetGroup.TargetGroupName)
+		if _, ok := desired.TargetGroups[name]; ok {
+			tgNames[arn] = name
+		}
+	}
+
+	var drifts []string
+	if scheme := aws.StringValue(live.LoadBalancer.Scheme); scheme != desired.Scheme {
+		drifts = append(drifts, fmt.Sprintf("LoadBalancer scheme is %v, desired %v", scheme, desired.Scheme))
+	}
+
+	livePorts := make(map[int64]bool)
+	for _, listener := range live.Listeners {
+		port := aws.Int64Value(listener.Listener.Port)
+		livePorts[port] = true
+		protocol, ok := desired.Listeners[port]
+		if !ok {
+			drifts = append(drifts, fmt.Sprintf("listener on port %v is not desired", port))
+			continue
+		}
+		if aws.StringValue(listener.Listener.Protocol) != protocol {
+			drifts = append(drifts, fmt.Sprintf("listener on port %v uses protocol %v, desired %v", port, aws.StringValue(listener.Listener.Protocol), protocol))
+		}
+		drifts = append(drifts, diffRules(port, desired.Rules[port], withTargetGroupNames(listener.Rules, tgNames))...)
+	}
+	for _, port := range sortedPorts(desired.Listeners) {
+		if !livePorts[port] {
+			drifts = append(drifts, fmt.Sprintf("listener on port %v doesn't exist", port))
+		}
+	}
+
+	liveTGNames := make(map[string]bool)
+	for _, arn := range sortedTargetGroupARNs(live.TargetGroups) {
+		ltg := live.TargetGroups[arn]
+		name := aws.StringValue(ltg.TargetGroup.TargetGroupName)
+		liveTGNames[name] = true
+		if _, ok := desired.TargetGroups[name]; !ok {
+			continue
+		}
+		if healthy, total := countHealthyTargets(ltg.Health); healthy != total {
+			drifts = append(drifts, fmt.Sprintf("targetGroup %v has %v/%v healthy targets", name, healthy, total))
+		}
+	}
+	for _, name := range sortedKeys(desired.TargetGroups) {
+		if !liveTGNames[name] {
+			drifts = append(drifts, fmt.Sprintf("targetGroup %v for backend %v isn't routed to", name, desired.TargetGroups[name]))
+		}
+	}
+	return drifts
+}
+
+// diffRules compares the live rules of the listener on port to the desired ones by priority, conditions and actions.
+func diffRules(port int64, desired []elbv2.Rule, live []elbv2.Rule) []string {
+	liveByPriority := make(map[string]elbv2.Rule, len(live))
+	for _, rule := range live {
+		liveByPriority[aws.StringValue(rule.Priority)] = rule
+	}
+
+	add, modify, remove := ls.DiffRules(live, desired)
+	var drifts []string
+	for _, rule := range sortedRules(add) {
+		drifts = append(drifts, fmt.Sprintf("listener on port %v lacks rule %v: %v", port, aws.StringValue(rule.Priority), describeRule(rule)))
+	}
+	for _, rule := range sortedRules(modify) {
+		priority := aws.StringValue(rule.Priority)
+		drifts = append(drifts, fmt.Sprintf("listener on port %v has rule %v: %v, desired %v", port, priority,
+			describeRule(liveByPriority[priority]), describeRule(rule)))
+	}
+	for _, rule := range sortedRules(remove) {
+		drifts = append(drifts, fmt.Sprintf("listener on port %v has rule %v not desired: %v", port, aws.StringValue(rule.Priority), describeRule(rule)))
+	}
+	return drifts
+}
+
+// withTargetGroupNames returns the non-default rules of rules, whose forward actions refer to the targetGroups of tgNames by name.
+// rules are left unchanged.
+func withTargetGroupNames(rules []*elbv2.Rule, tgNames map[string]string) []elbv2.Rule {
+	var output []elbv2.Rule
+	for _, rule := range rules {
+		if aws.BoolValue(rule.IsDefault) {
+			continue
+		}
+		named := *rule
+		named.Actions = nil
+		for _, action := range rule.Actions {
+			namedAction := *action
+			if action.ForwardConfig != nil {
+				forwardConfig := *action.ForwardConfig
+				forwardConfig.TargetGroups = nil
+				for _, tuple := range action.ForwardConfig.TargetGroups {
+					namedTuple := *tuple
+					if name, ok := tgNames[aws.StringValue(tuple.TargetGroupArn)]; ok {
+						namedTuple.TargetGroupArn = aws.String(name)
+					}
+					forwardConfig.TargetGroups = append(forwardConfig.TargetGroups, &namedTuple)
+				}
+				namedAction.ForwardConfig = &forwardConfig
+			}
+			named.Actions = append(named.Actions, &namedAction)
+		}
+		output = append(output, named)
+	}
+	return output
+}
+
+// printStatus writes live state side-by-side with desired state to w, and returns the drifts it highlighted.
+func printStatus(w io.Writer, desired *desiredState, live *liveState) []string {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	defer tw.Flush()
+
+	fmt.Fprintln(tw, "LOADBALANCER\tDESIRED\tLIVE")
+	if live.LoadBalancer == nil {
+		fmt.Fprintf(tw, "name\t%v\t<none>\n", desired.LBName)
+	} else {
+		fmt.Fprintf(tw, "name\t%v\t%v\n", desired.LBName, aws.StringValue(live.LoadBalancer.LoadBalancerName))
+		fmt.Fprintf(tw, "arn\t\t%v\n", aws.StringValue(live.LoadBalancer.LoadBalancerArn))
+		fmt.Fprintf(tw, "dns\t\t%v\n", aws.StringValue(live.LoadBalancer.DNSName))
+		fmt.Fprintf(tw, "scheme\t%v\t%v\n", desired.Scheme, aws.StringValue(live.LoadBalancer.Scheme))
+		if live.LoadBalancer.State != nil {
+			fmt.Fprintf(tw, "state\t\t%v\n", aws.StringValue(live.LoadBalancer.State.Code))
+		}
+	}
+
+	fmt.Fprintln(tw, "\nLISTENER\tDESIRED\tLIVE\tRULES")
+	liveListeners := make(map[int64]liveListener)
+	for _, listener := range live.Listeners {
+		liveListeners[aws.Int64Value(listener.Listener.Port)] = listener
+	}
+	allPorts := make(map[int64]string)
+	for port := range liveListeners {
+		allPorts[port] = ""
+	}
+	for port, protocol := range desired.Listeners {
+		allPorts[port] = protocol
+	}
+	for _, port := range sortedPorts(allPorts) {
+		listener, ok := liveListeners[port]
+		if !ok {
+			fmt.Fprintf(tw, "%v\t%v\t<none>\t\n", port, desired.Listeners[port])
+			continue
+		}
+		fmt.Fprintf(tw, "%v\t%v\t%v\t%v\n", port, valueOrNone(desired.Listeners[port]), aws.StringValue(listener.Listener.Protocol), countNonDefaultRules(listener.Rules))
+		for _, rule := range listener.Rules {
+			fmt.Fprintf(tw, "  rule %v\t\t%v\t%v\n", aws.StringValue(rule.Priority), describeConditions(rule.Conditions),
+				describeTargetGroups(live.TargetGroups, forwardTargetGroupARNs(rule.Actions)))
+		}
+	}
+
+	fmt.Fprintln(tw, "\nRULE\tCONDITIONS\tACTIONS")
+	for _, port := range sortedPorts(desired.Listeners) {
+		for _, rule := range desired.Rules[port] {
+			fmt.Fprintf(tw, "%v/%v\t%v\t%v\n", port, aws.StringValue(rule.Priority), describeConditions(rule.Conditions), describeActions(rule.Actions))
+		}
+	}
+
+	fmt.Fprintln(tw, "\nTARGETGROUP\tBACKEND\tTYPE\tHEALTHY")
+	for _, arn := range sortedTargetGroupARNs(live.TargetGroups) {
+		ltg := live.TargetGroups[arn]
+		name := aws.StringValue(ltg.TargetGroup.TargetGroupName)
+		healthy, total := countHealthyTargets(ltg.Health)
+		fmt.Fprintf(tw, "%v\t%v\t%v\t%v/%v\n", name, valueOrNone(desired.TargetGroups[name]), aws.StringValue(ltg.TargetGroup.TargetType), healthy, total)
+		for _, desc := range ltg.Health {
+			if desc.TargetHealth == nil || aws.StringValue(desc.TargetHealth.State) == elbv2.TargetHealthStateEnumHealthy {
+				continue
+			}
+			fmt.Fprintf(tw, "  %v:%v\t\t%v\t%v\n", aws.StringValue(desc.Target.Id), aws.Int64Value(desc.Target.Port),
+				aws.StringValue(desc.TargetHealth.State), aws.StringValue(desc.TargetHealth.Reason))
+		}
+	}
+
+	drifts := diffStatus(desired, live)
+	fmt.Fprintln(tw, "\nDRIFT")
+	if len(drifts) == 0 {
+		fmt.Fprintln(tw, "<none>")
+	}
+	for _, drift := range drifts {
+		fmt.Fprintf(tw, "! %v\n", drift)
+	}
+	return drifts
+}
+
+func describeConditions(conditions []*elbv2.RuleCondition) string {
+	var parts []string
+	for _, c := range conditions {
+		values := aws.StringValueSlice(c.Values)
+		if c.HostHeaderConfig != nil {
+			values = append(values, aws.StringValueSlice(c.HostHeaderConfig.Values)...)
+		}
+		if c.PathPatternConfig != nil {
+			values = append(values, aws.StringValueSlice(c.PathPatternConfig.Values)...)
+		}
+		parts = append(parts, fmt.Sprintf("%v=%v", aws.StringValue(c.Field), strings.Join(values, ",")))
+	}
+	if len(parts) == 0 {
+		return "default"
+	}
+	return strings.Join(parts, " ")
+}
+
+// describeActions describes actions in their order, forward actions by the targetGroups they refer to.
+func describeActions(actions []*elbv2.Action) string {
+	sorted := make([]*elbv2.Action, len(actions))
+	copy(sorted, actions)
+	sort.Slice(sorted, func(i, j int) bool { return aws.Int64Value(sorted[i].Order) < aws.Int64Value(sorted[j].Order) })
+
+	var parts []string
+	for _, action := range sorted {
+		actionType := aws.StringValue(action.Type)
+		if actionType != elbv2.ActionTypeEnumForward {
+			parts = append(parts, actionType)
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%v=%v", actionType, strings.Join(forwardTargetGroupARNs([]*elbv2.Action{action}), ",")))
+	}
+	return valueOrNone(strings.Join(parts, " "))
+}
+
+func describeRule(rule elbv2.Rule) string {
+	return fmt.Sprintf("%v -> %v", describeConditions(rule.Conditions), describeActions(rule.Actions))
+}
+
+func describeTargetGroups(targetGroups map[string]liveTargetGroup, arns []string) string {
+	var names []string
+	for _, arn := range arns {
+		if ltg, ok := targetGroups[arn]; ok {
+			names = append(names, aws.StringValue(ltg.TargetGroup.TargetGroupName))
+		} else {
+			names = append(names, arn)
+		}
+	}
+	return valueOrNone(strings.Join(names, ","))
+}
+
+func countNonDefaultRules(rules []*elbv2.Rule) int {
+	count := 0
+	for _, rule := range rules {
+		if !aws.BoolValue(rule.IsDefault) {
+			count++
+		}
+	}
+	return count
+}
+
+func countHealthyTargets(descs []*elbv2.TargetHealthDescription) (int, int) {
+	healthy := 0
+	for _, desc := range descs {
+		if desc.TargetHealth != nil && aws.StringValue(desc.TargetHealth.State) == elbv2.TargetHealthStateEnumHealthy {
+			healthy++
+		}
+	}
+	return healthy, len(descs)
+}
+
+func sortedRules(rules []elbv2.Rule) []elbv2.Rule {
+	sort.Slice(rules, func(i, j int) bool {
+		pi, _ := strconv.Atoi(aws.StringValue(rules[i].Priority))
+		pj, _ := strconv.Atoi(aws.StringValue(rules[j].Priority))
+		return pi < pj
+	})
+	return rules
+}
+
+func sortedPorts(listeners map[int64]string) []int64 {
+	var ports []int64
+	for port := range listeners {
+		ports = append(ports, port)
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
+	return ports
+}
+
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedTargetGroupARNs(targetGroups map[string]liveTargetGroup) []string {
+	var arns []string
+	for arn := range targetGroups {
+		arns = append(arns, arn)
+	}
+	sort.Strings(arns)
+	return arns
+}
+
+func valueOrNone(s string) string {
+	if len(s) == 0 {
+		return "<none>"
+	}
+	return s
+}
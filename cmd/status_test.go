@@ -0,0 +1,221 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/conditions"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/config"
+)
+
+func newStatusTestConfig(t *testing.T) *config.Configuration {
+	cfg := config.NewConfiguration()
+	fs := pflag.NewFlagSet("", pflag.ContinueOnError)
+	cfg.BindFlags(fs)
+	assert.NoError(t, fs.Parse([]string{"--cluster-name=cluster"}))
+	assert.NoError(t, cfg.Validate())
+	return &cfg
+}
+
+func Test_buildDesiredState(t *testing.T) {
+	cfg := newStatusTestConfig(t)
+	ingress := &extensions.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "namespace",
+			Name:      "ingress",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/scheme":       "internet-facing",
+				"alb.ingress.kubernetes.io/listen-ports": `[{"HTTP": 80}, {"HTTPS": 443}]`,
+			},
+		},
+		Spec: extensions.IngressSpec{
+			Rules: []extensions.IngressRule{
+				{
+					Host: "example.com",
+					IngressRuleValue: extensions.IngressRuleValue{
+						HTTP: &extensions.HTTPIngressRuleValue{
+							Paths: []extensions.HTTPIngressPath{
+								{
+									Path:    "/*",
+									Backend: extensions.IngressBackend{ServiceName: "web", ServicePort: intstr.FromInt(80)},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "web"}}
+
+	desired, err := buildDesiredState(context.Background(), fake.NewFakeClient(service), ingress, cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, elbv2.LoadBalancerSchemeEnumInternetFacing, desired.Scheme)
+	assert.Equal(t, map[int64]string{80: elbv2.ProtocolEnumHttp, 443: elbv2.ProtocolEnumHttps}, desired.Listeners)
+	assert.Len(t, desired.TargetGroups, 1)
+	for tgName, backend := range desired.TargetGroups {
+		assert.Equal(t, "web:80", backend)
+		for _, port := range []int64{80, 443} {
+			if assert.Len(t, desired.Rules[port], 1) {
+				rule := desired.Rules[port][0]
+				assert.Equal(t, "1", aws.StringValue(rule.Priority))
+				assert.Equal(t, "host-header=example.com path-pattern=/*", describeConditions(rule.Conditions))
+				assert.Equal(t, "forward="+tgName, describeActions(rule.Actions))
+			}
+		}
+	}
+
+	_, err = buildDesiredState(context.Background(), fake.NewFakeClient(), ingress, cfg)
+	assert.Error(t, err)
+}
+
+func Test_diffStatus(t *testing.T) {
+	desired := &desiredState{
+		LBName:       "lb",
+		Scheme:       elbv2.LoadBalancerSchemeEnumInternetFacing,
+		Listeners:    map[int64]string{80: elbv2.ProtocolEnumHttp, 443: elbv2.ProtocolEnumHttps},
+		TargetGroups: map[string]string{"tg-web": "web:80"},
+	}
+	pathCondition := func(path string) []*elbv2.RuleCondition {
+		return []*elbv2.RuleCondition{
+			{
+				Field:             aws.String(conditions.FieldPathPattern),
+				PathPatternConfig: &elbv2.PathPatternConditionConfig{Values: aws.StringSlice([]string{path})},
+			},
+		}
+	}
+	forwardActions := func(tgArn string) []*elbv2.Action {
+		return []*elbv2.Action{
+			{
+				Type:  aws.String(elbv2.ActionTypeEnumForward),
+				Order: aws.Int64(1),
+				ForwardConfig: &elbv2.ForwardActionConfig{
+					TargetGroups:                []*elbv2.TargetGroupTuple{{TargetGroupArn: aws.String(tgArn), Weight: aws.Int64(1)}},
+					TargetGroupStickinessConfig: &elbv2.TargetGroupStickinessConfig{Enabled: aws.Bool(false)},
+				},
+			},
+		}
+	}
+	desiredRules := []elbv2.Rule{
+		{Priority: aws.String("1"), IsDefault: aws.Bool(false), Conditions: pathCondition("/*"), Actions: forwardActions("tg-web")},
+	}
+	desired.Rules = map[int64][]elbv2.Rule{80: desiredRules, 443: desiredRules}
+	rules := []*elbv2.Rule{
+		{
+			Priority:   aws.String("1"),
+			IsDefault:  aws.Bool(false),
+			Conditions: pathCondition("/*"),
+			Actions:    forwardActions("tgArn"),
+		},
+		{
+			Priority:  aws.String("default"),
+			IsDefault: aws.Bool(true),
+		},
+	}
+	driftedRules := []*elbv2.Rule{
+		{
+			Priority:   aws.String("1"),
+			IsDefault:  aws.Bool(false),
+			Conditions: pathCondition("/api/*"),
+			Actions:    forwardActions("tgArn"),
+		},
+		{
+			Priority:   aws.String("2"),
+			IsDefault:  aws.Bool(false),
+			Conditions: pathCondition("/*"),
+			Actions:    forwardActions("otherTgArn"),
+		},
+		rules[1],
+	}
+	healthyTG := liveTargetGroup{
+		TargetGroup: &elbv2.TargetGroup{TargetGroupName: aws.String("tg-web")},
+		Health: []*elbv2.TargetHealthDescription{
+			{Target: &elbv2.TargetDescription{Id: aws.String("i-1")}, TargetHealth: &elbv2.TargetHealth{State: aws.String(elbv2.TargetHealthStateEnumHealthy)}},
+		},
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		Live           *liveState
+		ExpectedDrifts []string
+	}{
+		{
+			Name:           "LoadBalancer missing",
+			Live:           &liveState{},
+			ExpectedDrifts: []string{"LoadBalancer lb doesn't exist"},
+		},
+		{
+			Name: "in sync",
+			Live: &liveState{
+				LoadBalancer: &elbv2.LoadBalancer{Scheme: aws.String(elbv2.LoadBalancerSchemeEnumInternetFacing)},
+				Listeners: []liveListener{
+					{Listener: &elbv2.Listener{Port: aws.Int64(80), Protocol: aws.String(elbv2.ProtocolEnumHttp)}, Rules: rules},
+					{Listener: &elbv2.Listener{Port: aws.Int64(443), Protocol: aws.String(elbv2.ProtocolEnumHttps)}, Rules: rules},
+				},
+				TargetGroups: map[string]liveTargetGroup{"tgArn": healthyTG},
+			},
+		},
+		{
+			Name: "drifted",
+			Live: &liveState{
+				LoadBalancer: &elbv2.LoadBalancer{Scheme: aws.String(elbv2.LoadBalancerSchemeEnumInternal)},
+				Listeners: []liveListener{
+					{Listener: &elbv2.Listener{Port: aws.Int64(80), Protocol: aws.String(elbv2.ProtocolEnumHttps)}, Rules: rules[1:]},
+					{Listener: &elbv2.Listener{Port: aws.Int64(8080), Protocol: aws.String(elbv2.ProtocolEnumHttp)}},
+				},
+				TargetGroups: map[string]liveTargetGroup{
+					"tgArn": {
+						TargetGroup: &elbv2.TargetGroup{TargetGroupName: aws.String("tg-web")},
+						Health: []*elbv2.TargetHealthDescription{
+							{Target: &elbv2.TargetDescription{Id: aws.String("i-1")}, TargetHealth: &elbv2.TargetHealth{State: aws.String(elbv2.TargetHealthStateEnumUnhealthy)}},
+						},
+					},
+				},
+			},
+			ExpectedDrifts: []string{
+				"LoadBalancer scheme is internal, desired internet-facing",
+				"listener on port 80 uses protocol HTTPS, desired HTTP",
+				"listener on port 80 lacks rule 1: path-pattern=/* -> forward=tg-web",
+				"listener on port 8080 is not desired",
+				"listener on port 443 doesn't exist",
+				"targetGroup tg-web has 0/1 healthy targets",
+			},
+		},
+		{
+			Name: "rules drifted",
+			Live: &liveState{
+				LoadBalancer: &elbv2.LoadBalancer{Scheme: aws.String(elbv2.LoadBalancerSchemeEnumInternetFacing)},
+				Listeners: []liveListener{
+					{Listener: &elbv2.Listener{Port: aws.Int64(80), Protocol: aws.String(elbv2.ProtocolEnumHttp)}, Rules: driftedRules},
+					{Listener: &elbv2.Listener{Port: aws.Int64(443), Protocol: aws.String(elbv2.ProtocolEnumHttps)}, Rules: rules},
+				},
+				TargetGroups: map[string]liveTargetGroup{"tgArn": healthyTG},
+			},
+			ExpectedDrifts: []string{
+				"listener on port 80 has rule 1: path-pattern=/api/* -> forward=tg-web, desired path-pattern=/* -> forward=tg-web",
+				"listener on port 80 has rule 2 not desired: path-pattern=/* -> forward=otherTgArn",
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			assert.Equal(t, tc.ExpectedDrifts, diffStatus(desired, tc.Live))
+
+			buf := &bytes.Buffer{}
+			assert.Equal(t, tc.ExpectedDrifts, printStatus(buf, desired, tc.Live))
+			for _, drift := range tc.ExpectedDrifts {
+				assert.Contains(t, buf.String(), "! "+drift)
+			}
+		})
+	}
+}
@@ -20,17 +20,26 @@ type NameGenerator struct {
 	ALBNamePrefix string
 }
 
+// NameLB names the LoadBalancer of an ingress, or of an ingress group when namespace is empty.
 func (gen *NameGenerator) NameLB(namespace string, ingressName string) string {
 	hasher := md5.New()
 	_, _ = hasher.Write([]byte(namespace + ingressName))
 	hash := hex.EncodeToString(hasher.Sum(nil))[:4]
 
 	r, _ := regexp.Compile("[[:^alnum:]]")
-	name := fmt.Sprintf("%s-%s-%s",
-		r.ReplaceAllString(gen.ALBNamePrefix, "-"),
-		r.ReplaceAllString(namespace, ""),
-		r.ReplaceAllString(ingressName, ""),
-	)
+	var name string
+	if namespace == "" {
+		name = fmt.Sprintf("%s-%s",
+			r.ReplaceAllString(gen.ALBNamePrefix, "-"),
+			r.ReplaceAllString(ingressName, ""),
+		)
+	} else {
+		name = fmt.Sprintf("%s-%s-%s",
+			r.ReplaceAllString(gen.ALBNamePrefix, "-"),
+			r.ReplaceAllString(namespace, ""),
+			r.ReplaceAllString(ingressName, ""),
+		)
+	}
 	if len(name) > 26 {
 		name = name[:26]
 	}
@@ -38,6 +47,25 @@ func (gen *NameGenerator) NameLB(namespace string, ingressName string) string {
 	return name
 }
 
+// NameFrontendNLB names the NLB fronting the LoadBalancer of ingress, it keeps the hash suffix of NameLB.
+func (gen *NameGenerator) NameFrontendNLB(namespace string, ingressName string) string {
+	lbName := gen.NameLB(namespace, ingressName)
+	base, hash := lbName[:len(lbName)-5], lbName[len(lbName)-5:]
+	if len(base) > 22 {
+		base = base[:22]
+	}
+	return base + "-nlb" + hash
+}
+
+// NameFrontendTG names the ALB-type targetGroup forwarding port of the frontend NLB to the LoadBalancer of ingress.
+func (gen *NameGenerator) NameFrontendTG(namespace string, ingressName string, port int64) string {
+	hasher := md5.New()
+	_, _ = hasher.Write([]byte(gen.NameFrontendNLB(namespace, ingressName)))
+	_, _ = hasher.Write([]byte(fmt.Sprintf("%d", port)))
+
+	return fmt.Sprintf("%.12s-%.19s", gen.ALBNamePrefix, hex.EncodeToString(hasher.Sum(nil)))
+}
+
 func (gen *NameGenerator) NameTG(namespace string, ingressName string, serviceName, servicePort string,
 	targetType string, protocol string) string {
 	LBName := gen.NameLB(namespace, ingressName)
@@ -59,3 +87,8 @@ func (gen *NameGenerator) NameLBSG(namespace string, ingressName string) string
 func (gen *NameGenerator) NameInstanceSG(namespace string, ingressName string) string {
 	return "instance-" + gen.NameLB(namespace, ingressName)
 }
+
+// NameBackendSG names the backend securityGroup shared by the LoadBalancers of the cluster, it's unique per ALBNamePrefix as LoadBalancer names are.
+func (gen *NameGenerator) NameBackendSG() string {
+	return gen.ALBNamePrefix + "-backend"
+}
@@ -0,0 +1,26 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NameFrontendNLB(t *testing.T) {
+	gen := NameGenerator{ALBNamePrefix: "cluster"}
+
+	assert.Equal(t, "cluster-namespace-ingress-1829", gen.NameLB("namespace", "ingress"))
+	assert.Equal(t, "cluster-namespace-ingr-nlb-1829", gen.NameFrontendNLB("namespace", "ingress"))
+
+	name := gen.NameFrontendNLB("a-very-long-namespace", "with-a-very-long-ingress-name")
+	assert.Len(t, name, 31)
+	assert.Equal(t, gen.NameLB("a-very-long-namespace", "with-a-very-long-ingress-name")[27:], name[27:])
+}
+
+func Test_NameLB_group(t *testing.T) {
+	gen := NameGenerator{ALBNamePrefix: "cluster"}
+
+	name := gen.NameLB("", "shared-group")
+	assert.Regexp(t, "^cluster-sharedgroup-[0-9a-f]{4}$", name)
+	assert.NotEqual(t, gen.NameLB("shared", "group"), name)
+}
@@ -25,6 +25,10 @@ const (
 
 	V2ResourceIDLoadBalancer           = "LoadBalancer"
 	V2ResourceIDManagedLBSecurityGroup = "ManagedLBSecurityGroup"
+	V2ResourceIDBackendSecurityGroup   = "BackendSecurityGroup"
+	V2ResourceIDRoute53HealthCheck     = "Route53HealthCheck"
+	V2ResourceIDFrontendNLB            = "FrontendNLB"
+	V2ResourceIDFrontendTargetGroup    = "FrontendTargetGroup"
 )
 
 var _ tg.TagGenerator = (*TagGenerator)(nil)
@@ -42,6 +46,24 @@ func (gen *TagGenerator) TagLB(namespace string, ingressName string) map[string]
 	return resTags
 }
 
+func (gen *TagGenerator) TagHealthCheck(namespace string, ingressName string) map[string]string {
+	resTags := gen.tagIngressResources(namespace, ingressName)
+	resTags[V2TagKeyResourceID] = V2ResourceIDRoute53HealthCheck
+	return resTags
+}
+
+func (gen *TagGenerator) TagFrontendNLB(namespace string, ingressName string) map[string]string {
+	resTags := gen.tagFrontendResources(namespace, ingressName)
+	resTags[V2TagKeyResourceID] = V2ResourceIDFrontendNLB
+	return resTags
+}
+
+func (gen *TagGenerator) TagFrontendTG(namespace string, ingressName string) map[string]string {
+	resTags := gen.tagFrontendResources(namespace, ingressName)
+	resTags[V2TagKeyResourceID] = V2ResourceIDFrontendTargetGroup
+	return resTags
+}
+
 func (gen *TagGenerator) TagTGGroup(namespace string, ingressName string) map[string]string {
 	return gen.tagIngressResources(namespace, ingressName)
 }
@@ -66,13 +88,28 @@ func (gen *TagGenerator) TagInstanceSG(namespace string, ingressName string) map
 	return gen.tagSGs(namespace, ingressName)
 }
 
+// TagBackendSG tags the backend securityGroup without namespace and ingress-name, since it doesn't belong to any ingress.
+func (gen *TagGenerator) TagBackendSG() map[string]string {
+	m := make(map[string]string)
+	for label, value := range gen.DefaultTags {
+		m[label] = value
+	}
+	m[TagKeyClusterName] = gen.ClusterName
+	m[V2TagKeyClusterID] = gen.ClusterName
+	m[V2TagKeyResourceID] = V2ResourceIDBackendSecurityGroup
+	return m
+}
+
 func (gen *TagGenerator) tagIngressResources(namespace string, ingressName string) map[string]string {
 	m := make(map[string]string)
 	for label, value := range gen.DefaultTags {
 		m[label] = value
 	}
 	m["kubernetes.io/cluster/"+gen.ClusterName] = "owned"
-	m[TagKeyNamespace] = namespace
+	// the resources of an ingress group have no namespace, and are tagged with the group name as ingress-name.
+	if namespace != "" {
+		m[TagKeyNamespace] = namespace
+	}
 	m[TagKeyIngressName] = ingressName
 
 	v2Tags := gen.tagIngressResourcesV2(namespace, ingressName)
@@ -82,6 +119,22 @@ func (gen *TagGenerator) tagIngressResources(namespace string, ingressName strin
 	return m
 }
 
+// The frontend NLB and its targetGroups aren't tagged with the namespace and ingress-name tags,
+// so they are neither GCed as targetGroups of the ingress nor found as its LoadBalancer.
+func (gen *TagGenerator) tagFrontendResources(namespace string, ingressName string) map[string]string {
+	m := make(map[string]string)
+	for label, value := range gen.DefaultTags {
+		m[label] = value
+	}
+	m["kubernetes.io/cluster/"+gen.ClusterName] = "owned"
+
+	v2Tags := gen.tagIngressResourcesV2(namespace, ingressName)
+	for label, value := range v2Tags {
+		m[label] = value
+	}
+	return m
+}
+
 // Tagging for sg is optional since ingress controller used name to resolve tags, but it will be required when
 // * add support to clean up aws resources created by ingress controller
 // * add support for sharing instance securityGroup among ingresses.
@@ -98,7 +151,9 @@ func (gen *TagGenerator) tagSGs(namespace string, ingressName string) map[string
 	// we can do it when out-of-tree cloud-provider-aws is stable.
 	m[TagKeyClusterName] = gen.ClusterName
 
-	m[TagKeyNamespace] = namespace
+	if namespace != "" {
+		m[TagKeyNamespace] = namespace
+	}
 	m[TagKeyIngressName] = ingressName
 
 	v2Tags := gen.tagIngressResourcesV2(namespace, ingressName)
@@ -117,8 +172,11 @@ func (gen *TagGenerator) tagIngressResourcesV2(namespace string, ingressName str
 	return m
 }
 
-// buildV2StackID returns the stack ID that compatible with V2 version.
+// buildV2StackID returns the stack ID that compatible with V2 version, which is the group name for ingress groups.
 func (gen *TagGenerator) buildV2StackID(namespace string, ingressName string) string {
+	if namespace == "" {
+		return ingressName
+	}
 	return fmt.Sprintf("%s/%s", namespace, ingressName)
 }
 
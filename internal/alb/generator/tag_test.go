@@ -56,3 +56,54 @@ func Test_TagTG(t *testing.T) {
 	}
 	assert.Equal(t, gen.TagTG("namespace", "ingress", "service", "port"), expected)
 }
+
+func Test_TagFrontendNLB(t *testing.T) {
+	gen := TagGenerator{
+		ClusterName: "cluster",
+		DefaultTags: map[string]string{
+			"key": "value",
+		},
+	}
+	expected := map[string]string{
+		"kubernetes.io/cluster/cluster": "owned",
+
+		"ingress.k8s.aws/cluster":  "cluster",
+		"ingress.k8s.aws/stack":    "namespace/ingress",
+		"ingress.k8s.aws/resource": "FrontendNLB",
+		"key":                      "value",
+	}
+
+	assert.Equal(t, gen.TagFrontendNLB("namespace", "ingress"), expected)
+}
+
+func Test_TagLB_group(t *testing.T) {
+	gen := TagGenerator{ClusterName: "cluster"}
+	expected := map[string]string{
+		"kubernetes.io/cluster/cluster": "owned",
+		TagKeyIngressName:               "group",
+
+		"ingress.k8s.aws/cluster":  "cluster",
+		"ingress.k8s.aws/stack":    "group",
+		"ingress.k8s.aws/resource": "LoadBalancer",
+	}
+
+	assert.Equal(t, expected, gen.TagLB("", "group"))
+}
+
+func Test_TagBackendSG(t *testing.T) {
+	gen := TagGenerator{
+		ClusterName: "cluster",
+		DefaultTags: map[string]string{
+			"key": "value",
+		},
+	}
+	expected := map[string]string{
+		TagKeyClusterName: "cluster",
+
+		"ingress.k8s.aws/cluster":  "cluster",
+		"ingress.k8s.aws/resource": "BackendSecurityGroup",
+		"key":                      "value",
+	}
+
+	assert.Equal(t, expected, gen.TagBackendSG())
+}
@@ -0,0 +1,247 @@
+package lb
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/tracing"
+	extensions "k8s.io/api/extensions/v1beta1"
+)
+
+const (
+	defaultAlarmEvaluationPeriods = 3
+	alarmPeriodSeconds            = 60
+	alarmDescriptionPrefix        = "managed by aws-alb-ingress-controller"
+)
+
+// AlarmController manages the CloudWatch alarms of a LoadBalancer and its targetGroups, configured with annotation thresholds.
+// Alarms are named after the LoadBalancer, as "<lbName>/<alarm>", which identifies those owned by the controller.
+type AlarmController interface {
+	// Reconcile ensures the alarms with a threshold annotation on ingress exist, and deletes the others.
+	Reconcile(ctx context.Context, lbName string, lbArn string, tgArns []string, ingress *extensions.Ingress) error
+
+	// Delete deletes all alarms of the LoadBalancer.
+	Delete(ctx context.Context, lbName string) error
+}
+
+func NewAlarmController(cloud aws.CloudAPI, tagGen TagGenerator) AlarmController {
+	return &defaultAlarmController{
+		cloud:  cloud,
+		tagGen: tagGen,
+	}
+}
+
+type defaultAlarmController struct {
+	cloud  aws.CloudAPI
+	tagGen TagGenerator
+}
+
+func (c *defaultAlarmController) Reconcile(ctx context.Context, lbName string, lbArn string, tgArns []string, ingress *extensions.Ingress) error {
+	ctx, span := tracing.StartSpan(ctx, "lb.ReconcileAlarms")
+	defer span.End()
+
+	desired, err := c.buildAlarms(lbName, lbArn, tgArns, ingress)
+	if err != nil {
+		return err
+	}
+	current, err := c.cloud.GetMetricAlarmsByPrefix(ctx, alarmNamePrefix(lbName))
+	if err != nil {
+		return fmt.Errorf("failed to get CloudWatch alarms due to %v", err)
+	}
+
+	currentDescriptions := make(map[string]string)
+	var unneeded []string
+	for _, alarm := range current {
+		name := aws.StringValue(alarm.AlarmName)
+		if _, ok := desired[name]; !ok {
+			unneeded = append(unneeded, name)
+			continue
+		}
+		currentDescriptions[name] = aws.StringValue(alarm.AlarmDescription)
+	}
+	for _, name := range sortedAlarmNames(desired) {
+		input := desired[name]
+		if description, ok := currentDescriptions[name]; ok && description == aws.StringValue(input.AlarmDescription) {
+			continue
+		}
+		if err := c.cloud.PutMetricAlarm(ctx, input); err != nil {
+			return fmt.Errorf("failed to put CloudWatch alarm %v due to %v", name, err)
+		}
+		albctx.GetLogger(ctx).Infof("put CloudWatch alarm %v", name)
+	}
+	if len(unneeded) != 0 {
+		if err := c.cloud.DeleteMetricAlarms(ctx, unneeded); err != nil {
+			return fmt.Errorf("failed to delete CloudWatch alarms %v due to %v", unneeded, err)
+		}
+		albctx.GetLogger(ctx).Infof("deleted CloudWatch alarms %v", unneeded)
+	}
+	return nil
+}
+
+func (c *defaultAlarmController) Delete(ctx context.Context, lbName string) error {
+	current, err := c.cloud.GetMetricAlarmsByPrefix(ctx, alarmNamePrefix(lbName))
+	if err != nil {
+		return fmt.Errorf("failed to get CloudWatch alarms due to %v", err)
+	}
+	if len(current) == 0 {
+		return nil
+	}
+	var names []string
+	for _, alarm := range current {
+		names = append(names, aws.StringValue(alarm.AlarmName))
+	}
+	albctx.GetLogger(ctx).Infof("deleting CloudWatch alarms %v", names)
+	return c.cloud.DeleteMetricAlarms(ctx, names)
+}
+
+// buildAlarms builds the alarms configured by annotations of ingress, keyed by name.
+func (c *defaultAlarmController) buildAlarms(lbName string, lbArn string, tgArns []string, ingress *extensions.Ingress) (map[string]*cloudwatch.PutMetricAlarmInput, error) {
+	evaluationPeriods := int64(defaultAlarmEvaluationPeriods)
+	if _, err := annotations.LoadInt64Annotation("alarm-evaluation-periods", &evaluationPeriods, ingress.Annotations); err != nil {
+		return nil, err
+	}
+	if evaluationPeriods < 1 {
+		return nil, fmt.Errorf("alarm-evaluation-periods must be positive, got %d", evaluationPeriods)
+	}
+	var actions []string
+	annotations.LoadStringSliceAnnotation("alarm-actions", &actions, ingress.Annotations)
+
+	var tags []*cloudwatch.Tag
+	for k, v := range c.tagGen.TagLB(ingress.Namespace, ingress.Name) {
+		tags = append(tags, &cloudwatch.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	lbDimension := &cloudwatch.Dimension{Name: aws.String("LoadBalancer"), Value: aws.String(lbMetricDimension(lbArn))}
+	alarms := make(map[string]*cloudwatch.PutMetricAlarmInput)
+	add := func(suffix string, threshold float64, operator string, input *cloudwatch.PutMetricAlarmInput) {
+		input.AlarmName = aws.String(alarmNamePrefix(lbName) + suffix)
+		input.ActionsEnabled = aws.Bool(len(actions) != 0)
+		input.AlarmActions = aws.StringSlice(actions)
+		input.ComparisonOperator = aws.String(operator)
+		input.EvaluationPeriods = aws.Int64(evaluationPeriods)
+		input.Threshold = aws.Float64(threshold)
+		input.TreatMissingData = aws.String("notBreaching")
+		alarms[aws.StringValue(input.AlarmName)] = input
+	}
+
+	var rate float64
+	ok, err := annotations.LoadFloat64Annotation("alarm-5xx-rate-percent", &rate, ingress.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		sum := func(id string, metricName string) *cloudwatch.MetricDataQuery {
+			return &cloudwatch.MetricDataQuery{
+				Id:         aws.String(id),
+				ReturnData: aws.Bool(false),
+				MetricStat: &cloudwatch.MetricStat{
+					Metric: &cloudwatch.Metric{
+						Namespace:  aws.String("AWS/ApplicationELB"),
+						MetricName: aws.String(metricName),
+						Dimensions: []*cloudwatch.Dimension{lbDimension},
+					},
+					Period: aws.Int64(alarmPeriodSeconds),
+					Stat:   aws.String(cloudwatch.StatisticSum),
+				},
+			}
+		}
+		add("5xx-rate", rate, cloudwatch.ComparisonOperatorGreaterThanThreshold, &cloudwatch.PutMetricAlarmInput{
+			Metrics: []*cloudwatch.MetricDataQuery{
+				sum("elb5xx", "HTTPCode_ELB_5XX_Count"),
+				sum("target5xx", "HTTPCode_Target_5XX_Count"),
+				sum("requests", "RequestCount"),
+				{
+					Id:         aws.String("rate"),
+					Label:      aws.String("5xx rate (%)"),
+					Expression: aws.String("100 * (FILL(elb5xx, 0) + FILL(target5xx, 0)) / requests"),
+					ReturnData: aws.Bool(true),
+				},
+			},
+		})
+	}
+
+	var responseTime float64
+	ok, err = annotations.LoadFloat64Annotation("alarm-target-response-time-seconds", &responseTime, ingress.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		add("target-response-time", responseTime, cloudwatch.ComparisonOperatorGreaterThanThreshold, &cloudwatch.PutMetricAlarmInput{
+			Namespace:  aws.String("AWS/ApplicationELB"),
+			MetricName: aws.String("TargetResponseTime"),
+			Dimensions: []*cloudwatch.Dimension{lbDimension},
+			Statistic:  aws.String(cloudwatch.StatisticAverage),
+			Period:     aws.Int64(alarmPeriodSeconds),
+		})
+	}
+
+	var unhealthyHosts int64
+	ok, err = annotations.LoadInt64Annotation("alarm-unhealthy-host-count", &unhealthyHosts, ingress.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		for _, tgArn := range tgArns {
+			tgDimension := tgMetricDimension(tgArn)
+			add("unhealthy-hosts/"+strings.TrimPrefix(tgDimension, "targetgroup/"), float64(unhealthyHosts), cloudwatch.ComparisonOperatorGreaterThanOrEqualToThreshold, &cloudwatch.PutMetricAlarmInput{
+				Namespace:  aws.String("AWS/ApplicationELB"),
+				MetricName: aws.String("UnHealthyHostCount"),
+				Dimensions: []*cloudwatch.Dimension{
+					lbDimension,
+					{Name: aws.String("TargetGroup"), Value: aws.String(tgDimension)},
+				},
+				Statistic: aws.String(cloudwatch.StatisticMaximum),
+				Period:    aws.Int64(alarmPeriodSeconds),
+			})
+		}
+	}
+
+	for _, input := range alarms {
+		// the description carries a hash of the alarm settings, so unchanged alarms aren't put on every reconcile.
+		payload, err := json.Marshal(input)
+		if err != nil {
+			return nil, err
+		}
+		hash := sha256.Sum256(payload)
+		input.AlarmDescription = aws.String(fmt.Sprintf("%v for ingress %v/%v (%v)", alarmDescriptionPrefix, ingress.Namespace, ingress.Name, hex.EncodeToString(hash[:8])))
+		input.Tags = tags
+	}
+	return alarms, nil
+}
+
+func alarmNamePrefix(lbName string) string {
+	return lbName + "/"
+}
+
+// lbMetricDimension returns the LoadBalancer dimension of metrics, i.e. app/<name>/<id>.
+func lbMetricDimension(lbArn string) string {
+	if i := strings.Index(lbArn, ":loadbalancer/"); i >= 0 {
+		return lbArn[i+len(":loadbalancer/"):]
+	}
+	return lbArn
+}
+
+// tgMetricDimension returns the TargetGroup dimension of metrics, i.e. targetgroup/<name>/<id>.
+func tgMetricDimension(tgArn string) string {
+	if i := strings.Index(tgArn, ":targetgroup/"); i >= 0 {
+		return tgArn[i+1:]
+	}
+	return tgArn
+}
+
+func sortedAlarmNames(alarms map[string]*cloudwatch.PutMetricAlarmInput) []string {
+	names := make([]string, 0, len(alarms))
+	for name := range alarms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,98 @@
+package lb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	albtesting "github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/testing"
+	"github.com/stretchr/testify/assert"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// countingAlarmCloud counts the alarms put, to verify unchanged alarms are left alone.
+type countingAlarmCloud struct {
+	*albtesting.Cloud
+	puts int
+}
+
+func (c *countingAlarmCloud) PutMetricAlarm(ctx context.Context, input *cloudwatch.PutMetricAlarmInput) error {
+	c.puts++
+	return c.Cloud.PutMetricAlarm(ctx, input)
+}
+
+func TestDefaultAlarmController_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	cloud := &countingAlarmCloud{Cloud: albtesting.NewCloud("vpc-1", "cluster")}
+	controller := NewAlarmController(cloud, stubNameTagGenerator{})
+	lbArn := "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/lb-name/50dc6c495c0c9188"
+	tgArns := []string{
+		"arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/tg-a/73e2d6bc24d8a067",
+		"arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/tg-b/2453ed029918f21f",
+	}
+	ingress := &extensions.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "ingress",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/alarm-5xx-rate-percent":             "5",
+				"alb.ingress.kubernetes.io/alarm-target-response-time-seconds": "0.5",
+				"alb.ingress.kubernetes.io/alarm-unhealthy-host-count":         "1",
+				"alb.ingress.kubernetes.io/alarm-actions":                      "arn:aws:sns:us-west-2:123456789012:oncall",
+			},
+		},
+	}
+	alarmNames := func() []string {
+		alarms, err := cloud.GetMetricAlarmsByPrefix(ctx, "lb-name/")
+		assert.NoError(t, err)
+		var names []string
+		for _, alarm := range alarms {
+			names = append(names, aws.StringValue(alarm.AlarmName))
+		}
+		return names
+	}
+
+	assert.NoError(t, controller.Reconcile(ctx, "lb-name", lbArn, tgArns, ingress))
+	assert.Equal(t, []string{
+		"lb-name/5xx-rate",
+		"lb-name/target-response-time",
+		"lb-name/unhealthy-hosts/tg-a/73e2d6bc24d8a067",
+		"lb-name/unhealthy-hosts/tg-b/2453ed029918f21f",
+	}, alarmNames())
+	alarms, _ := cloud.GetMetricAlarmsByPrefix(ctx, "lb-name/unhealthy-hosts/tg-a")
+	if assert.Len(t, alarms, 1) {
+		assert.Equal(t, []*cloudwatch.Dimension{
+			{Name: aws.String("LoadBalancer"), Value: aws.String("app/lb-name/50dc6c495c0c9188")},
+			{Name: aws.String("TargetGroup"), Value: aws.String("targetgroup/tg-a/73e2d6bc24d8a067")},
+		}, alarms[0].Dimensions)
+		assert.Equal(t, 1.0, aws.Float64Value(alarms[0].Threshold))
+		assert.Equal(t, int64(defaultAlarmEvaluationPeriods), aws.Int64Value(alarms[0].EvaluationPeriods))
+		assert.Equal(t, []string{"arn:aws:sns:us-west-2:123456789012:oncall"}, aws.StringValueSlice(alarms[0].AlarmActions))
+		assert.True(t, aws.BoolValue(alarms[0].ActionsEnabled))
+	}
+	assert.Equal(t, 4, cloud.puts)
+
+	assert.NoError(t, controller.Reconcile(ctx, "lb-name", lbArn, tgArns, ingress))
+	assert.Equal(t, 4, cloud.puts, "unchanged alarms should not be put again")
+
+	ingress.Annotations["alb.ingress.kubernetes.io/alarm-5xx-rate-percent"] = "10"
+	delete(ingress.Annotations, "alb.ingress.kubernetes.io/alarm-unhealthy-host-count")
+	assert.NoError(t, controller.Reconcile(ctx, "lb-name", lbArn, tgArns, ingress))
+	assert.Equal(t, []string{"lb-name/5xx-rate", "lb-name/target-response-time"}, alarmNames())
+	assert.Equal(t, 5, cloud.puts)
+
+	ingress.Annotations["alb.ingress.kubernetes.io/alarm-evaluation-periods"] = "0"
+	assert.EqualError(t, controller.Reconcile(ctx, "lb-name", lbArn, tgArns, ingress), "alarm-evaluation-periods must be positive, got 0")
+
+	ingress.Annotations["alb.ingress.kubernetes.io/alarm-evaluation-periods"] = "2"
+	ingress.Annotations["alb.ingress.kubernetes.io/alarm-target-response-time-seconds"] = "fast"
+	assert.Error(t, controller.Reconcile(ctx, "lb-name", lbArn, tgArns, ingress))
+
+	assert.NoError(t, cloud.PutMetricAlarm(ctx, &cloudwatch.PutMetricAlarmInput{AlarmName: aws.String("other-lb/5xx-rate")}))
+	assert.NoError(t, controller.Delete(ctx, "lb-name"))
+	assert.Empty(t, alarmNames())
+	others, _ := cloud.GetMetricAlarmsByPrefix(ctx, "other-lb/")
+	assert.Len(t, others, 1, "alarms of other LoadBalancers should be kept")
+}
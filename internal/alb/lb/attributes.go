@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/tracing"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/util/log"
 	api "k8s.io/api/core/v1"
 )
@@ -20,6 +22,10 @@ const (
 	IdleTimeoutTimeoutSecondsKey      = "idle_timeout.timeout_seconds"
 	RoutingHTTP2EnabledKey            = "routing.http2.enabled"
 	DropInvalidHeaderFieldsEnabledKey = "routing.http.drop_invalid_header_fields.enabled"
+	DesyncMitigationModeKey           = "routing.http.desync_mitigation_mode"
+	ZonalShiftConfigEnabledKey        = "zonal_shift.config.enabled"
+	LoadBalancingCrossZoneEnabledKey  = "load_balancing.cross_zone.enabled"
+	WAFFailOpenEnabledKey             = "waf.fail_open.enabled"
 
 	DeletionProtectionEnabled      = false
 	AccessLogsS3Enabled            = false
@@ -28,6 +34,15 @@ const (
 	IdleTimeoutTimeoutSeconds      = 60
 	RoutingHTTP2Enabled            = true
 	DropInvalidHeaderFieldsEnabled = false
+	DesyncMitigationMode           = DesyncMitigationModeDefensive
+	ZonalShiftConfigEnabled        = false
+	WAFFailOpenEnabled             = false
+)
+
+const (
+	DesyncMitigationModeMonitor   = "monitor"
+	DesyncMitigationModeDefensive = "defensive"
+	DesyncMitigationModeStrictest = "strictest"
 )
 
 // Attributes represents the desired state of attributes for a load balancer.
@@ -61,6 +76,24 @@ type Attributes struct {
 	// DropInvalidHeaderFieldsEnabled: routing.http.drop_invalid_header_fields.enabled - Indicates if
 	// invalid headers will be dropped. The default is false.
 	DropInvalidHeaderFieldsEnabled bool
+
+	// DesyncMitigationMode: routing.http.desync_mitigation_mode - Determines how the load balancer
+	// handles requests that might pose a security risk to an application due to HTTP desync. The
+	// value is monitor, defensive or strictest. The default is defensive.
+	DesyncMitigationMode string
+
+	// ZonalShiftConfigEnabled: zonal_shift.config.enabled - Indicates whether zonal shift is
+	// enabled. The value is true or false. The default is false.
+	ZonalShiftConfigEnabled bool
+
+	// LoadBalancingCrossZoneEnabled: load_balancing.cross_zone.enabled - Indicates whether cross-zone
+	// load balancing is enabled. It can only be disabled on network LoadBalancers, so it's nil unless
+	// specified rather than defaulting to the false of network LoadBalancers.
+	LoadBalancingCrossZoneEnabled *bool
+
+	// WAFFailOpenEnabled: waf.fail_open.enabled - Indicates whether requests are routed to targets
+	// when they can't be forwarded to AWS WAF. The value is true or false. The default is false.
+	WAFFailOpenEnabled bool
 }
 
 func NewAttributes(attrs []*elbv2.LoadBalancerAttribute) (a *Attributes, err error) {
@@ -72,6 +105,9 @@ func NewAttributes(attrs []*elbv2.LoadBalancerAttribute) (a *Attributes, err err
 		IdleTimeoutTimeoutSeconds:      IdleTimeoutTimeoutSeconds,
 		RoutingHTTP2Enabled:            RoutingHTTP2Enabled,
 		DropInvalidHeaderFieldsEnabled: DropInvalidHeaderFieldsEnabled,
+		DesyncMitigationMode:           DesyncMitigationMode,
+		ZonalShiftConfigEnabled:        ZonalShiftConfigEnabled,
+		WAFFailOpenEnabled:             WAFFailOpenEnabled,
 	}
 	var e error
 	for _, attr := range attrs {
@@ -109,6 +145,29 @@ func NewAttributes(attrs []*elbv2.LoadBalancerAttribute) (a *Attributes, err err
 			if err != nil {
 				return a, fmt.Errorf("invalid load balancer attribute value %s=%s", attrKey, attrValue)
 			}
+		case DesyncMitigationModeKey:
+			switch attrValue {
+			case DesyncMitigationModeMonitor, DesyncMitigationModeDefensive, DesyncMitigationModeStrictest:
+				a.DesyncMitigationMode = attrValue
+			default:
+				return a, fmt.Errorf("%s must be one of %s, %s or %s", attrKey, DesyncMitigationModeMonitor, DesyncMitigationModeDefensive, DesyncMitigationModeStrictest)
+			}
+		case ZonalShiftConfigEnabledKey:
+			a.ZonalShiftConfigEnabled, err = strconv.ParseBool(attrValue)
+			if err != nil {
+				return a, fmt.Errorf("invalid load balancer attribute value %s=%s", attrKey, attrValue)
+			}
+		case LoadBalancingCrossZoneEnabledKey:
+			enabled, err := strconv.ParseBool(attrValue)
+			if err != nil {
+				return a, fmt.Errorf("invalid load balancer attribute value %s=%s", attrKey, attrValue)
+			}
+			a.LoadBalancingCrossZoneEnabled = aws.Bool(enabled)
+		case WAFFailOpenEnabledKey:
+			a.WAFFailOpenEnabled, err = strconv.ParseBool(attrValue)
+			if err != nil {
+				return a, fmt.Errorf("invalid load balancer attribute value %s=%s", attrKey, attrValue)
+			}
 		default:
 			e = NewInvalidAttribute(attrKey)
 		}
@@ -134,6 +193,9 @@ type attributesController struct {
 }
 
 func (c *attributesController) Reconcile(ctx context.Context, lbArn string, attrs []*elbv2.LoadBalancerAttribute) error {
+	ctx, span := tracing.StartSpan(ctx, "lb.ReconcileAttributes")
+	defer span.End()
+
 	desired, err := NewAttributes(attrs)
 	if err != nil {
 		return fmt.Errorf("failed parsing attributes; %v", err)
@@ -153,6 +215,9 @@ func (c *attributesController) Reconcile(ctx context.Context, lbArn string, attr
 
 	changeSet := attributesChangeSet(current, desired)
 	if len(changeSet) > 0 {
+		if err := c.validateAccessLogsBucket(ctx, lbArn, current, desired); err != nil {
+			return err
+		}
 		albctx.GetLogger(ctx).Infof("Modifying ELBV2 attributes to %v.", log.Prettify(changeSet))
 		_, err = c.cloud.ModifyLoadBalancerAttributesWithContext(ctx, &elbv2.ModifyLoadBalancerAttributesInput{
 			LoadBalancerArn: aws.String(lbArn),
@@ -160,9 +225,67 @@ func (c *attributesController) Reconcile(ctx context.Context, lbArn string, attr
 		})
 		if err != nil {
 			albctx.GetEventf(ctx)(api.EventTypeWarning, "ERROR", "%s attributes modification failed: %s", lbArn, err.Error())
+			c.rollback(ctx, lbArn, current)
 			return fmt.Errorf("failed modifying attributes: %s", err)
 		}
+		if current.IdleTimeoutTimeoutSeconds != desired.IdleTimeoutTimeoutSeconds {
+			albctx.GetEventf(ctx)(api.EventTypeNormal, "MODIFY", "idle timeout of %s modified from %v to %v seconds", lbArn, current.IdleTimeoutTimeoutSeconds, desired.IdleTimeoutTimeoutSeconds)
+		}
+
+	}
+	return nil
+}
+
+// rollback restores the attributes of the load balancer to previous, should a failed modification have applied some of them.
+// It's best effort, failures are only logged since the modification is retried by the next reconcile.
+func (c *attributesController) rollback(ctx context.Context, lbArn string, previous *Attributes) {
+	raw, err := c.cloud.DescribeLoadBalancerAttributesWithContext(ctx, &elbv2.DescribeLoadBalancerAttributesInput{
+		LoadBalancerArn: aws.String(lbArn),
+	})
+	if err != nil {
+		albctx.GetLogger(ctx).Errorf("failed to retrieve attributes of %s to roll back their modification: %v", lbArn, err)
+		return
+	}
+	current, err := NewAttributes(raw.Attributes)
+	if err != nil && !IsInvalidAttribute(err) {
+		albctx.GetLogger(ctx).Errorf("failed parsing attributes of %s to roll back their modification: %v", lbArn, err)
+		return
+	}
+	changeSet := attributesChangeSet(current, previous)
+	if len(changeSet) == 0 {
+		return
+	}
+	albctx.GetLogger(ctx).Infof("Rolling back ELBV2 attributes to %v.", log.Prettify(changeSet))
+	if _, err := c.cloud.ModifyLoadBalancerAttributesWithContext(ctx, &elbv2.ModifyLoadBalancerAttributesInput{
+		LoadBalancerArn: aws.String(lbArn),
+		Attributes:      changeSet,
+	}); err != nil {
+		albctx.GetLogger(ctx).Errorf("failed to roll back attributes of %s: %v", lbArn, err)
+	}
+}
 
+// validateAccessLogsBucket checks that the bucket access logs are enabled for exists in the region of the load balancer,
+// which ELBV2 would otherwise only report as an access denied to the bucket. Buckets are only checked when they change.
+func (c *attributesController) validateAccessLogsBucket(ctx context.Context, lbArn string, current, desired *Attributes) error {
+	if !desired.AccessLogsS3Enabled {
+		return nil
+	}
+	if desired.AccessLogsS3Bucket == "" {
+		return fmt.Errorf("%s is required when access logs are enabled", AccessLogsS3BucketKey)
+	}
+	if current.AccessLogsS3Enabled && current.AccessLogsS3Bucket == desired.AccessLogsS3Bucket {
+		return nil
+	}
+	parsedArn, err := arn.Parse(lbArn)
+	if err != nil {
+		return fmt.Errorf("failed to parse load balancer arn %s: %v", lbArn, err)
+	}
+	region, err := c.cloud.GetBucketRegion(ctx, desired.AccessLogsS3Bucket)
+	if err != nil {
+		return fmt.Errorf("failed to locate access logs bucket %s: %v", desired.AccessLogsS3Bucket, err)
+	}
+	if region != parsedArn.Region {
+		return fmt.Errorf("access logs bucket %s is in region %s, it must be in the region %s of the load balancer", desired.AccessLogsS3Bucket, region, parsedArn.Region)
 	}
 	return nil
 }
@@ -200,6 +323,22 @@ func attributesChangeSet(current, desired *Attributes) (changeSet []*elbv2.LoadB
 		changeSet = append(changeSet, lbAttribute(DropInvalidHeaderFieldsEnabledKey, fmt.Sprintf("%v", desired.DropInvalidHeaderFieldsEnabled)))
 	}
 
+	if current.DesyncMitigationMode != desired.DesyncMitigationMode {
+		changeSet = append(changeSet, lbAttribute(DesyncMitigationModeKey, desired.DesyncMitigationMode))
+	}
+
+	if current.ZonalShiftConfigEnabled != desired.ZonalShiftConfigEnabled {
+		changeSet = append(changeSet, lbAttribute(ZonalShiftConfigEnabledKey, fmt.Sprintf("%v", desired.ZonalShiftConfigEnabled)))
+	}
+
+	if current.WAFFailOpenEnabled != desired.WAFFailOpenEnabled {
+		changeSet = append(changeSet, lbAttribute(WAFFailOpenEnabledKey, fmt.Sprintf("%v", desired.WAFFailOpenEnabled)))
+	}
+
+	if desired.LoadBalancingCrossZoneEnabled != nil && aws.BoolValue(current.LoadBalancingCrossZoneEnabled) != aws.BoolValue(desired.LoadBalancingCrossZoneEnabled) {
+		changeSet = append(changeSet, lbAttribute(LoadBalancingCrossZoneEnabledKey, fmt.Sprintf("%v", aws.BoolValue(desired.LoadBalancingCrossZoneEnabled))))
+	}
+
 	return
 }
 
@@ -7,7 +7,10 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
+	albtesting "github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/testing"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/mocks"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elbv2"
@@ -68,6 +71,26 @@ func Test_NewAttributes(t *testing.T) {
 			ok:         false,
 			attributes: []*elbv2.LoadBalancerAttribute{lbAttribute(DropInvalidHeaderFieldsEnabledKey, "falfadssdfdsse")},
 		},
+		{
+			name:       fmt.Sprintf("%v is invalid", DesyncMitigationModeKey),
+			ok:         false,
+			attributes: []*elbv2.LoadBalancerAttribute{lbAttribute(DesyncMitigationModeKey, "paranoid")},
+		},
+		{
+			name:       fmt.Sprintf("%v is invalid", ZonalShiftConfigEnabledKey),
+			ok:         false,
+			attributes: []*elbv2.LoadBalancerAttribute{lbAttribute(ZonalShiftConfigEnabledKey, "falfadssdfdsse")},
+		},
+		{
+			name:       fmt.Sprintf("%v is invalid", LoadBalancingCrossZoneEnabledKey),
+			ok:         false,
+			attributes: []*elbv2.LoadBalancerAttribute{lbAttribute(LoadBalancingCrossZoneEnabledKey, "falfadssdfdsse")},
+		},
+		{
+			name:       fmt.Sprintf("%v is invalid", WAFFailOpenEnabledKey),
+			ok:         false,
+			attributes: []*elbv2.LoadBalancerAttribute{lbAttribute(WAFFailOpenEnabledKey, "falfadssdfdsse")},
+		},
 		{
 			name:       fmt.Sprintf("undefined attribute"),
 			ok:         false,
@@ -84,6 +107,10 @@ func Test_NewAttributes(t *testing.T) {
 				lbAttribute(IdleTimeoutTimeoutSecondsKey, "45"),
 				lbAttribute(RoutingHTTP2EnabledKey, "false"),
 				lbAttribute(DropInvalidHeaderFieldsEnabledKey, "true"),
+				lbAttribute(DesyncMitigationModeKey, "strictest"),
+				lbAttribute(ZonalShiftConfigEnabledKey, "true"),
+				lbAttribute(LoadBalancingCrossZoneEnabledKey, "true"),
+				lbAttribute(WAFFailOpenEnabledKey, "true"),
 			},
 			output: &Attributes{
 				DeletionProtectionEnabled:      true,
@@ -93,6 +120,10 @@ func Test_NewAttributes(t *testing.T) {
 				IdleTimeoutTimeoutSeconds:      45,
 				RoutingHTTP2Enabled:            false,
 				DropInvalidHeaderFieldsEnabled: true,
+				DesyncMitigationMode:           "strictest",
+				ZonalShiftConfigEnabled:        true,
+				LoadBalancingCrossZoneEnabled:  aws.Bool(true),
+				WAFFailOpenEnabled:             true,
 			},
 		},
 	} {
@@ -168,6 +199,35 @@ func Test_attributesChangeSet(t *testing.T) {
 			b:         MustNewAttributes([]*elbv2.LoadBalancerAttribute{lbAttribute(DropInvalidHeaderFieldsEnabledKey, "true")}),
 			changeSet: []*elbv2.LoadBalancerAttribute{lbAttribute(DropInvalidHeaderFieldsEnabledKey, "true")},
 		},
+		{
+			name:      fmt.Sprintf("a contains default, b contains non-default DesyncMitigationModeKey, make a change"),
+			a:         MustNewAttributes(nil),
+			b:         MustNewAttributes([]*elbv2.LoadBalancerAttribute{lbAttribute(DesyncMitigationModeKey, "monitor")}),
+			changeSet: []*elbv2.LoadBalancerAttribute{lbAttribute(DesyncMitigationModeKey, "monitor")},
+		},
+		{
+			name:      fmt.Sprintf("a contains default, b contains non-default ZonalShiftConfigEnabledKey, make a change"),
+			a:         MustNewAttributes(nil),
+			b:         MustNewAttributes([]*elbv2.LoadBalancerAttribute{lbAttribute(ZonalShiftConfigEnabledKey, "true")}),
+			changeSet: []*elbv2.LoadBalancerAttribute{lbAttribute(ZonalShiftConfigEnabledKey, "true")},
+		},
+		{
+			name:      fmt.Sprintf("a contains default, b contains non-default LoadBalancingCrossZoneEnabledKey, make a change"),
+			a:         MustNewAttributes(nil),
+			b:         MustNewAttributes([]*elbv2.LoadBalancerAttribute{lbAttribute(LoadBalancingCrossZoneEnabledKey, "true")}),
+			changeSet: []*elbv2.LoadBalancerAttribute{lbAttribute(LoadBalancingCrossZoneEnabledKey, "true")},
+		},
+		{
+			name:      fmt.Sprintf("a contains default, b contains non-default WAFFailOpenEnabledKey, make a change"),
+			a:         MustNewAttributes(nil),
+			b:         MustNewAttributes([]*elbv2.LoadBalancerAttribute{lbAttribute(WAFFailOpenEnabledKey, "true")}),
+			changeSet: []*elbv2.LoadBalancerAttribute{lbAttribute(WAFFailOpenEnabledKey, "true")},
+		},
+		{
+			name: fmt.Sprintf("a contains LoadBalancingCrossZoneEnabledKey, b doesn't specify it, no change"),
+			a:    MustNewAttributes([]*elbv2.LoadBalancerAttribute{lbAttribute(LoadBalancingCrossZoneEnabledKey, "true")}),
+			b:    MustNewAttributes(nil),
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			changeSet := attributesChangeSet(tc.a, tc.b)
@@ -202,6 +262,8 @@ func defaultAttributes() []*elbv2.LoadBalancerAttribute {
 		lbAttribute(IdleTimeoutTimeoutSecondsKey, "60"),
 		lbAttribute(RoutingHTTP2EnabledKey, "true"),
 		lbAttribute(DropInvalidHeaderFieldsEnabledKey, "false"),
+		lbAttribute(DesyncMitigationModeKey, "defensive"),
+		lbAttribute(ZonalShiftConfigEnabledKey, "false"),
 	}
 }
 
@@ -297,3 +359,131 @@ func TestReconcile(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcile_accessLogsBucket(t *testing.T) {
+	lbArn := "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/lb/50dc6c495c0c9188"
+	accessLogs := []*elbv2.LoadBalancerAttribute{
+		lbAttribute(AccessLogsS3EnabledKey, "true"),
+		lbAttribute(AccessLogsS3BucketKey, "access-logs"),
+	}
+	for _, tc := range []struct {
+		Name          string
+		Current       []*elbv2.LoadBalancerAttribute
+		Desired       []*elbv2.LoadBalancerAttribute
+		BucketRegion  string
+		BucketErr     error
+		ExpectModify  bool
+		ExpectedError error
+	}{
+		{
+			Name:         "bucket of the load balancer region",
+			Current:      defaultAttributes(),
+			Desired:      accessLogs,
+			BucketRegion: "us-west-2",
+			ExpectModify: true,
+		},
+		{
+			Name:          "bucket of another region",
+			Current:       defaultAttributes(),
+			Desired:       accessLogs,
+			BucketRegion:  "eu-west-1",
+			ExpectedError: errors.New("access logs bucket access-logs is in region eu-west-1, it must be in the region us-west-2 of the load balancer"),
+		},
+		{
+			Name:          "missing bucket",
+			Current:       defaultAttributes(),
+			Desired:       accessLogs,
+			BucketErr:     errors.New("NoSuchBucket"),
+			ExpectedError: errors.New("failed to locate access logs bucket access-logs: NoSuchBucket"),
+		},
+		{
+			Name:          "access logs without bucket",
+			Current:       defaultAttributes(),
+			Desired:       []*elbv2.LoadBalancerAttribute{lbAttribute(AccessLogsS3EnabledKey, "true")},
+			ExpectedError: errors.New("access_logs.s3.bucket is required when access logs are enabled"),
+		},
+		{
+			Name:         "unchanged bucket isn't located again",
+			Current:      append(accessLogs, lbAttribute(AccessLogsS3PrefixKey, "old")),
+			Desired:      append(accessLogs, lbAttribute(AccessLogsS3PrefixKey, "new")),
+			ExpectModify: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctx := context.Background()
+			cloud := &mocks.CloudAPI{}
+			cloud.On("DescribeLoadBalancerAttributesWithContext", ctx, &elbv2.DescribeLoadBalancerAttributesInput{LoadBalancerArn: aws.String(lbArn)}).Return(
+				&elbv2.DescribeLoadBalancerAttributesOutput{Attributes: tc.Current}, nil)
+			if tc.BucketRegion != "" || tc.BucketErr != nil {
+				cloud.On("GetBucketRegion", ctx, "access-logs").Return(tc.BucketRegion, tc.BucketErr)
+			}
+			if tc.ExpectModify {
+				cloud.On("ModifyLoadBalancerAttributesWithContext", ctx, mock.Anything).Return(&elbv2.ModifyLoadBalancerAttributesOutput{}, nil)
+			}
+
+			err := NewAttributesController(cloud).Reconcile(ctx, lbArn, tc.Desired)
+			assert.Equal(t, tc.ExpectedError, err)
+			cloud.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReconcile_idleTimeout(t *testing.T) {
+	var events []string
+	ctx := albctx.SetEventf(context.Background(), func(eventType, reason, format string, vals ...interface{}) {
+		events = append(events, reason+" "+fmt.Sprintf(format, vals...))
+	})
+	cloud := albtesting.NewCloud("vpc-1", "cluster")
+	output, err := cloud.CreateLoadBalancerWithContext(ctx, &elbv2.CreateLoadBalancerInput{Name: aws.String("lb")})
+	assert.NoError(t, err)
+	lbArn := aws.StringValue(output.LoadBalancers[0].LoadBalancerArn)
+	controller := NewAttributesController(cloud)
+
+	assert.NoError(t, controller.Reconcile(ctx, lbArn, []*elbv2.LoadBalancerAttribute{lbAttribute(IdleTimeoutTimeoutSecondsKey, "300")}))
+	assert.NoError(t, controller.Reconcile(ctx, lbArn, []*elbv2.LoadBalancerAttribute{lbAttribute(IdleTimeoutTimeoutSecondsKey, "300")}))
+	assert.NoError(t, controller.Reconcile(ctx, lbArn, []*elbv2.LoadBalancerAttribute{lbAttribute(RoutingHTTP2EnabledKey, "false")}))
+	assert.Equal(t, []string{
+		"MODIFY idle timeout of " + lbArn + " modified from 60 to 300 seconds",
+		"MODIFY idle timeout of " + lbArn + " modified from 300 to 60 seconds",
+	}, events)
+
+	attrs, err := cloud.DescribeLoadBalancerAttributesWithContext(ctx, &elbv2.DescribeLoadBalancerAttributesInput{LoadBalancerArn: aws.String(lbArn)})
+	assert.NoError(t, err)
+	current, err := NewAttributes(attrs.Attributes)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(60), current.IdleTimeoutTimeoutSeconds)
+	assert.False(t, current.RoutingHTTP2Enabled)
+}
+
+func TestReconcile_rollback(t *testing.T) {
+	ctx := context.Background()
+	lbArn := "arn"
+	applied := append(defaultAttributes(), lbAttribute(LoadBalancingCrossZoneEnabledKey, "true"), lbAttribute(DeletionProtectionEnabledKey, "true"))
+	cloud := &mocks.CloudAPI{}
+	cloud.On("DescribeLoadBalancerAttributesWithContext", ctx, &elbv2.DescribeLoadBalancerAttributesInput{LoadBalancerArn: aws.String(lbArn)}).Return(
+		&elbv2.DescribeLoadBalancerAttributesOutput{Attributes: append(defaultAttributes(), lbAttribute(LoadBalancingCrossZoneEnabledKey, "false"))}, nil).Once()
+	cloud.On("ModifyLoadBalancerAttributesWithContext", ctx, &elbv2.ModifyLoadBalancerAttributesInput{
+		LoadBalancerArn: aws.String(lbArn),
+		Attributes: []*elbv2.LoadBalancerAttribute{
+			lbAttribute(DeletionProtectionEnabledKey, "true"),
+			lbAttribute(LoadBalancingCrossZoneEnabledKey, "true"),
+		},
+	}).Return(nil, errors.New("ValidationError")).Once()
+	// the attributes of the failed modification were applied regardless, they are reverted.
+	cloud.On("DescribeLoadBalancerAttributesWithContext", ctx, &elbv2.DescribeLoadBalancerAttributesInput{LoadBalancerArn: aws.String(lbArn)}).Return(
+		&elbv2.DescribeLoadBalancerAttributesOutput{Attributes: applied}, nil).Once()
+	cloud.On("ModifyLoadBalancerAttributesWithContext", ctx, &elbv2.ModifyLoadBalancerAttributesInput{
+		LoadBalancerArn: aws.String(lbArn),
+		Attributes: []*elbv2.LoadBalancerAttribute{
+			lbAttribute(DeletionProtectionEnabledKey, "false"),
+			lbAttribute(LoadBalancingCrossZoneEnabledKey, "false"),
+		},
+	}).Return(&elbv2.ModifyLoadBalancerAttributesOutput{}, nil).Once()
+
+	err := NewAttributesController(cloud).Reconcile(ctx, lbArn, []*elbv2.LoadBalancerAttribute{
+		lbAttribute(DeletionProtectionEnabledKey, "true"),
+		lbAttribute(LoadBalancingCrossZoneEnabledKey, "true"),
+	})
+	assert.Equal(t, errors.New("failed modifying attributes: ValidationError"), err)
+	cloud.AssertExpectations(t)
+}
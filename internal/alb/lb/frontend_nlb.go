@@ -0,0 +1,366 @@
+package lb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/tags"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/loadbalancer"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/tracing"
+	corev1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// AnnotationFrontendNLB enables an NLB forwarding every listen-port of the ALB, giving the ingress static IPs.
+	AnnotationFrontendNLB = "frontend-nlb"
+	// AnnotationFrontendNLBEIPAllocations lists the Elastic IPs of an internet-facing frontend NLB, one per subnet of the ALB.
+	AnnotationFrontendNLBEIPAllocations = "frontend-nlb-eip-allocations"
+
+	frontendTGHealthCheckPath    = "/"
+	frontendTGHealthCheckMatcher = "200-499"
+)
+
+// FrontendNLBController manages an NLB in front of the ALB of an ingress, with an ALB-type targetGroup per listen-port.
+// Clients get the stable IPs of the NLB, while routing stays on the ALB.
+type FrontendNLBController interface {
+	// Reconcile ensures the frontend NLB of instance exists when enabled by annotation, and is deleted when disabled.
+	// It returns nil when there is no frontend NLB.
+	Reconcile(ctx context.Context, instance *elbv2.LoadBalancer, ingress *extensions.Ingress, ingressAnnos *annotations.Ingress) (*FrontendNLB, error)
+
+	// Delete deletes the frontend NLB of ingress and its targetGroups.
+	Delete(ctx context.Context, ingressKey types.NamespacedName) error
+}
+
+func NewFrontendNLBController(cloud aws.CloudAPI, nameTagGen NameTagGenerator, tagsController tags.Controller, defaultTags map[string]string) FrontendNLBController {
+	return &defaultFrontendNLBController{
+		cloud:          cloud,
+		nameTagGen:     nameTagGen,
+		tagsController: tagsController,
+		defaultTags:    defaultTags,
+	}
+}
+
+type frontendNLBConfig struct {
+	Name string
+	Tags map[string]string
+
+	Scheme         *string
+	SubnetMappings []*elbv2.SubnetMapping
+	Ports          []loadbalancer.PortData
+}
+
+type defaultFrontendNLBController struct {
+	cloud          aws.CloudAPI
+	nameTagGen     NameTagGenerator
+	tagsController tags.Controller
+	defaultTags    map[string]string
+}
+
+func (c *defaultFrontendNLBController) Reconcile(ctx context.Context, instance *elbv2.LoadBalancer, ingress *extensions.Ingress, ingressAnnos *annotations.Ingress) (*FrontendNLB, error) {
+	ctx, span := tracing.StartSpan(ctx, "lb.ReconcileFrontendNLB")
+	defer span.End()
+
+	ingressKey := types.NamespacedName{Namespace: ingress.Namespace, Name: ingress.Name}
+	var enabled bool
+	if _, err := annotations.LoadBoolAnnocation(AnnotationFrontendNLB, &enabled, ingress.Annotations); err != nil {
+		return nil, err
+	}
+	if !enabled {
+		nlb, err := c.cloud.GetLoadBalancerByName(ctx, c.nameTagGen.NameFrontendNLB(ingressKey.Namespace, ingressKey.Name))
+		if err != nil || nlb == nil {
+			return nil, err
+		}
+		return nil, c.Delete(ctx, ingressKey)
+	}
+
+	nlbConfig, err := c.buildNLBConfig(instance, ingress, ingressAnnos)
+	if err != nil {
+		return nil, err
+	}
+	nlb, err := c.ensureNLB(ctx, ingressKey, nlbConfig)
+	if err != nil {
+		return nil, err
+	}
+	nlbArn := aws.StringValue(nlb.LoadBalancerArn)
+
+	tgArnByPort := make(map[int64]string)
+	for _, port := range nlbConfig.Ports {
+		tgArn, err := c.ensureTargetGroup(ctx, ingressKey, instance, port)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reconcile frontend targetGroup of port %v due to %v", port.Port, err)
+		}
+		tgArnByPort[port.Port] = tgArn
+	}
+	if err := c.reconcileListeners(ctx, nlbArn, tgArnByPort); err != nil {
+		return nil, fmt.Errorf("failed to reconcile listeners of frontend NLB %v due to %v", nlbArn, err)
+	}
+
+	var tgArns []string
+	for _, tgArn := range tgArnByPort {
+		tgArns = append(tgArns, tgArn)
+	}
+	sort.Strings(tgArns)
+	if err := c.gcTargetGroups(ctx, ingressKey, sets.NewString(tgArns...)); err != nil {
+		return nil, err
+	}
+	return &FrontendNLB{
+		Arn:                   nlbArn,
+		DNSName:               aws.StringValue(nlb.DNSName),
+		CanonicalHostedZoneID: aws.StringValue(nlb.CanonicalHostedZoneId),
+		TargetGroupARNs:       tgArns,
+	}, nil
+}
+
+func (c *defaultFrontendNLBController) Delete(ctx context.Context, ingressKey types.NamespacedName) error {
+	nlbName := c.nameTagGen.NameFrontendNLB(ingressKey.Namespace, ingressKey.Name)
+	nlb, err := c.cloud.GetLoadBalancerByName(ctx, nlbName)
+	if err != nil {
+		return fmt.Errorf("failed to find frontend NLB %v due to %v", nlbName, err)
+	}
+	if nlb != nil {
+		albctx.GetLogger(ctx).Infof("deleting frontend NLB %v", aws.StringValue(nlb.LoadBalancerArn))
+		if err := c.cloud.DeleteLoadBalancerByArn(ctx, aws.StringValue(nlb.LoadBalancerArn)); err != nil {
+			return fmt.Errorf("failed to delete frontend NLB %v due to %v", aws.StringValue(nlb.LoadBalancerArn), err)
+		}
+	}
+	return c.gcTargetGroups(ctx, ingressKey, sets.NewString())
+}
+
+// buildNLBConfig builds the frontend NLB of instance, with the same scheme and subnets.
+// Elastic IPs are assigned to the subnets in the order of their availability zones.
+func (c *defaultFrontendNLBController) buildNLBConfig(instance *elbv2.LoadBalancer, ingress *extensions.Ingress, ingressAnnos *annotations.Ingress) (*frontendNLBConfig, error) {
+	nlbTags := c.nameTagGen.TagFrontendNLB(ingress.Namespace, ingress.Name)
+	for k, v := range ingressAnnos.Tags.LoadBalancer {
+		nlbTags[k] = v
+	}
+
+	azs := append([]*elbv2.AvailabilityZone{}, instance.AvailabilityZones...)
+	sort.Slice(azs, func(i, j int) bool { return aws.StringValue(azs[i].ZoneName) < aws.StringValue(azs[j].ZoneName) })
+	var allocations []string
+	if annotations.LoadStringSliceAnnotation(AnnotationFrontendNLBEIPAllocations, &allocations, ingress.Annotations) {
+		if aws.StringValue(instance.Scheme) != elbv2.LoadBalancerSchemeEnumInternetFacing {
+			return nil, fmt.Errorf("annotation %v is only supported by internet-facing LoadBalancers", AnnotationFrontendNLBEIPAllocations)
+		}
+		if len(allocations) != len(azs) {
+			return nil, fmt.Errorf("annotation %v lists %v Elastic IPs, expected one per subnet of the LoadBalancer (%v)", AnnotationFrontendNLBEIPAllocations, len(allocations), len(azs))
+		}
+	}
+	var mappings []*elbv2.SubnetMapping
+	for i, az := range azs {
+		mapping := &elbv2.SubnetMapping{SubnetId: az.SubnetId}
+		if len(allocations) != 0 {
+			mapping.AllocationId = aws.String(allocations[i])
+		}
+		mappings = append(mappings, mapping)
+	}
+
+	return &frontendNLBConfig{
+		Name:           c.nameTagGen.NameFrontendNLB(ingress.Namespace, ingress.Name),
+		Tags:           nlbTags,
+		Scheme:         instance.Scheme,
+		SubnetMappings: mappings,
+		Ports:          ingressAnnos.LoadBalancer.Ports,
+	}, nil
+}
+
+// ensureNLB creates the frontend NLB, or recreates it when its scheme or subnet mappings changed, as those can't be modified.
+func (c *defaultFrontendNLBController) ensureNLB(ctx context.Context, ingressKey types.NamespacedName, nlbConfig *frontendNLBConfig) (*elbv2.LoadBalancer, error) {
+	nlb, err := c.cloud.GetLoadBalancerByName(ctx, nlbConfig.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find frontend NLB %v due to %v", nlbConfig.Name, err)
+	}
+	if nlb != nil && (!subnetMappingsMatch(nlb.AvailabilityZones, nlbConfig.SubnetMappings) || aws.StringValue(nlb.Scheme) != aws.StringValue(nlbConfig.Scheme)) {
+		albctx.GetLogger(ctx).Infof("frontend NLB %v need recreation due to scheme or subnet mappings changed", nlbConfig.Name)
+		if err := c.Delete(ctx, ingressKey); err != nil {
+			return nil, err
+		}
+		nlb = nil
+	}
+	if nlb == nil {
+		albctx.GetLogger(ctx).Infof("creating frontend NLB %v", nlbConfig.Name)
+		resp, err := c.cloud.CreateLoadBalancerWithContext(ctx, &elbv2.CreateLoadBalancerInput{
+			Name:           aws.String(nlbConfig.Name),
+			Type:           aws.String(elbv2.LoadBalancerTypeEnumNetwork),
+			Scheme:         nlbConfig.Scheme,
+			SubnetMappings: nlbConfig.SubnetMappings,
+			Tags:           tags.ConvertToELBV2(nlbConfig.Tags),
+		})
+		if err != nil {
+			albctx.GetEventf(ctx)(corev1.EventTypeWarning, "ERROR", "failed to create frontend NLB %v due to %v", nlbConfig.Name, err)
+			return nil, fmt.Errorf("failed to create frontend NLB %v due to %v", nlbConfig.Name, err)
+		}
+		nlb = resp.LoadBalancers[0]
+		albctx.GetEventf(ctx)(corev1.EventTypeNormal, "CREATE", "frontend NLB %v created, ARN: %v", nlbConfig.Name, aws.StringValue(nlb.LoadBalancerArn))
+		return nlb, nil
+	}
+	if err := c.tagsController.ReconcileELB(ctx, aws.StringValue(nlb.LoadBalancerArn), nlbConfig.Tags); err != nil {
+		return nil, fmt.Errorf("failed to reconcile tags of %v due to %v", aws.StringValue(nlb.LoadBalancerArn), err)
+	}
+	return nlb, nil
+}
+
+// ensureTargetGroup ensures the ALB-type targetGroup of port exists, with instance as its only target.
+func (c *defaultFrontendNLBController) ensureTargetGroup(ctx context.Context, ingressKey types.NamespacedName, instance *elbv2.LoadBalancer, port loadbalancer.PortData) (string, error) {
+	tgName := c.nameTagGen.NameFrontendTG(ingressKey.Namespace, ingressKey.Name, port.Port)
+	tg, err := c.cloud.GetTargetGroupByName(ctx, tgName)
+	if err != nil {
+		return "", err
+	}
+	if tg == nil {
+		albctx.GetLogger(ctx).Infof("creating frontend targetGroup %v", tgName)
+		resp, err := c.cloud.CreateTargetGroupWithContext(ctx, &elbv2.CreateTargetGroupInput{
+			Name:                aws.String(tgName),
+			Port:                aws.Int64(port.Port),
+			Protocol:            aws.String(elbv2.ProtocolEnumTcp),
+			TargetType:          aws.String(elbv2.TargetTypeEnumAlb),
+			VpcId:               instance.VpcId,
+			HealthCheckProtocol: aws.String(port.Scheme),
+			HealthCheckPath:     aws.String(frontendTGHealthCheckPath),
+			Matcher:             &elbv2.Matcher{HttpCode: aws.String(frontendTGHealthCheckMatcher)},
+		})
+		if err != nil {
+			return "", err
+		}
+		tg = resp.TargetGroups[0]
+	}
+	tgArn := aws.StringValue(tg.TargetGroupArn)
+	if err := c.tagsController.ReconcileELB(ctx, tgArn, c.nameTagGen.TagFrontendTG(ingressKey.Namespace, ingressKey.Name)); err != nil {
+		return "", fmt.Errorf("failed to reconcile tags of %v due to %v", tgArn, err)
+	}
+
+	lbArn := aws.StringValue(instance.LoadBalancerArn)
+	resp, err := c.cloud.DescribeTargetHealthWithContext(ctx, &elbv2.DescribeTargetHealthInput{TargetGroupArn: aws.String(tgArn)})
+	if err != nil {
+		return "", err
+	}
+	registered := false
+	for _, desc := range resp.TargetHealthDescriptions {
+		if aws.StringValue(desc.Target.Id) == lbArn && aws.Int64Value(desc.Target.Port) == port.Port {
+			registered = true
+			continue
+		}
+		albctx.GetLogger(ctx).Infof("deregistering %v from frontend targetGroup %v", aws.StringValue(desc.Target.Id), tgArn)
+		if _, err := c.cloud.DeregisterTargetsWithContext(ctx, &elbv2.DeregisterTargetsInput{
+			TargetGroupArn: aws.String(tgArn),
+			Targets:        []*elbv2.TargetDescription{desc.Target},
+		}); err != nil {
+			return "", err
+		}
+	}
+	if !registered {
+		albctx.GetLogger(ctx).Infof("registering %v to frontend targetGroup %v", lbArn, tgArn)
+		if _, err := c.cloud.RegisterTargetsWithContext(ctx, &elbv2.RegisterTargetsInput{
+			TargetGroupArn: aws.String(tgArn),
+			Targets:        []*elbv2.TargetDescription{{Id: aws.String(lbArn), Port: aws.Int64(port.Port)}},
+		}); err != nil {
+			return "", err
+		}
+	}
+	return tgArn, nil
+}
+
+// reconcileListeners ensures the frontend NLB has a TCP listener forwarding to the targetGroup of each port, and no other listener.
+func (c *defaultFrontendNLBController) reconcileListeners(ctx context.Context, nlbArn string, tgArnByPort map[int64]string) error {
+	current, err := c.cloud.ListListenersByLoadBalancer(ctx, nlbArn)
+	if err != nil {
+		return err
+	}
+	currentByPort := make(map[int64]*elbv2.Listener)
+	for _, ls := range current {
+		port := aws.Int64Value(ls.Port)
+		if _, ok := tgArnByPort[port]; ok {
+			currentByPort[port] = ls
+			continue
+		}
+		albctx.GetLogger(ctx).Infof("deleting listener %v of frontend NLB", aws.StringValue(ls.ListenerArn))
+		if err := c.cloud.DeleteListenersByArn(ctx, aws.StringValue(ls.ListenerArn)); err != nil {
+			return err
+		}
+	}
+
+	var ports []int64
+	for port := range tgArnByPort {
+		ports = append(ports, port)
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
+	for _, port := range ports {
+		actions := []*elbv2.Action{{Type: aws.String(elbv2.ActionTypeEnumForward), TargetGroupArn: aws.String(tgArnByPort[port])}}
+		ls, ok := currentByPort[port]
+		if !ok {
+			albctx.GetLogger(ctx).Infof("creating listener %v of frontend NLB", port)
+			if _, err := c.cloud.CreateListenerWithContext(ctx, &elbv2.CreateListenerInput{
+				LoadBalancerArn: aws.String(nlbArn),
+				Port:            aws.Int64(port),
+				Protocol:        aws.String(elbv2.ProtocolEnumTcp),
+				DefaultActions:  actions,
+			}); err != nil {
+				return err
+			}
+			continue
+		}
+		if aws.StringValue(ls.Protocol) == elbv2.ProtocolEnumTcp && len(ls.DefaultActions) == 1 &&
+			aws.StringValue(ls.DefaultActions[0].Type) == elbv2.ActionTypeEnumForward &&
+			aws.StringValue(ls.DefaultActions[0].TargetGroupArn) == tgArnByPort[port] {
+			continue
+		}
+		albctx.GetLogger(ctx).Infof("modifying listener %v of frontend NLB", aws.StringValue(ls.ListenerArn))
+		if _, err := c.cloud.ModifyListenerWithContext(ctx, &elbv2.ModifyListenerInput{
+			ListenerArn:    ls.ListenerArn,
+			Protocol:       aws.String(elbv2.ProtocolEnumTcp),
+			DefaultActions: actions,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// gcTargetGroups deletes the frontend targetGroups of ingress which aren't in use.
+func (c *defaultFrontendNLBController) gcTargetGroups(ctx context.Context, ingressKey types.NamespacedName, used sets.String) error {
+	tagFilters := make(map[string][]string)
+	for k, v := range c.nameTagGen.TagFrontendTG(ingressKey.Namespace, ingressKey.Name) {
+		if _, ok := c.defaultTags[k]; ok {
+			continue
+		}
+		tagFilters[k] = []string{v}
+	}
+	tgArns, err := c.cloud.GetResourcesByFilters(tagFilters, aws.ResourceTypeEnumELBTargetGroup)
+	if err != nil {
+		return fmt.Errorf("failed to find frontend targetGroups due to %v", err)
+	}
+	for _, tgArn := range tgArns {
+		if used.Has(tgArn) {
+			continue
+		}
+		albctx.GetLogger(ctx).Infof("deleting frontend targetGroup %v", tgArn)
+		if err := c.cloud.DeleteTargetGroupByArn(ctx, tgArn); err != nil {
+			return fmt.Errorf("failed to delete frontend targetGroup %v due to %v", tgArn, err)
+		}
+	}
+	return nil
+}
+
+// subnetMappingsMatch compares the subnets and Elastic IPs of the availability zones of an NLB with desired.
+func subnetMappingsMatch(azs []*elbv2.AvailabilityZone, desired []*elbv2.SubnetMapping) bool {
+	current := sets.NewString()
+	for _, az := range azs {
+		allocationID := ""
+		for _, address := range az.LoadBalancerAddresses {
+			allocationID = aws.StringValue(address.AllocationId)
+		}
+		current.Insert(aws.StringValue(az.SubnetId) + "/" + allocationID)
+	}
+	expected := sets.NewString()
+	for _, mapping := range desired {
+		expected.Insert(aws.StringValue(mapping.SubnetId) + "/" + aws.StringValue(mapping.AllocationId))
+	}
+	return current.Equal(expected)
+}
@@ -0,0 +1,136 @@
+package lb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/tags"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/loadbalancer"
+	annoTags "github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/tags"
+	albtesting "github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/testing"
+	"github.com/stretchr/testify/assert"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestDefaultFrontendNLBController_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	cloud := albtesting.NewCloud("vpc-1", "cluster")
+	cloud.AddSubnet(&ec2.Subnet{SubnetId: aws.String("subnet-b"), AvailabilityZone: aws.String("us-west-2b")})
+	cloud.AddSubnet(&ec2.Subnet{SubnetId: aws.String("subnet-a"), AvailabilityZone: aws.String("us-west-2a")})
+	resp, err := cloud.CreateLoadBalancerWithContext(ctx, &elbv2.CreateLoadBalancerInput{
+		Name:    aws.String("default-ingress"),
+		Subnets: aws.StringSlice([]string{"subnet-b", "subnet-a"}),
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	instance := resp.LoadBalancers[0]
+	lbArn := aws.StringValue(instance.LoadBalancerArn)
+
+	controller := NewFrontendNLBController(cloud, stubNameTagGenerator{}, tags.NewController(cloud), map[string]string{"team": "web"})
+	ingressKey := types.NamespacedName{Namespace: "default", Name: "ingress"}
+	ingress := &extensions.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   ingressKey.Namespace,
+			Name:        ingressKey.Name,
+			Annotations: map[string]string{"alb.ingress.kubernetes.io/frontend-nlb": "true"},
+		},
+	}
+	ingressAnnos := &annotations.Ingress{
+		LoadBalancer: &loadbalancer.Config{
+			Ports: []loadbalancer.PortData{{Port: 80, Scheme: elbv2.ProtocolEnumHttp}, {Port: 443, Scheme: elbv2.ProtocolEnumHttps}},
+		},
+		Tags: &annoTags.Config{LoadBalancer: map[string]string{"env": "prod"}},
+	}
+
+	nlb, err := controller.Reconcile(ctx, instance, ingress, ingressAnnos)
+	if !assert.NoError(t, err) {
+		return
+	}
+	nlbInstance, _ := cloud.GetLoadBalancerByName(ctx, "default-ingress-nlb")
+	if !assert.NotNil(t, nlbInstance) {
+		return
+	}
+	assert.Equal(t, aws.StringValue(nlbInstance.LoadBalancerArn), nlb.Arn)
+	assert.Equal(t, aws.StringValue(nlbInstance.DNSName), nlb.DNSName)
+	assert.Equal(t, elbv2.LoadBalancerTypeEnumNetwork, aws.StringValue(nlbInstance.Type))
+	assert.Len(t, nlb.TargetGroupARNs, 2)
+	listeners, _ := cloud.ListListenersByLoadBalancer(ctx, nlb.Arn)
+	if assert.Len(t, listeners, 2) {
+		for _, ls := range listeners {
+			assert.Equal(t, elbv2.ProtocolEnumTcp, aws.StringValue(ls.Protocol))
+			tgArn := aws.StringValue(ls.DefaultActions[0].TargetGroupArn)
+			tg, _ := cloud.GetTargetGroupByArn(ctx, tgArn)
+			assert.Equal(t, "alb", aws.StringValue(tg.TargetType))
+			assert.Equal(t, aws.Int64Value(ls.Port), aws.Int64Value(tg.Port))
+			health, _ := cloud.DescribeTargetHealthWithContext(ctx, &elbv2.DescribeTargetHealthInput{TargetGroupArn: aws.String(tgArn)})
+			if assert.Len(t, health.TargetHealthDescriptions, 1) {
+				assert.Equal(t, lbArn, aws.StringValue(health.TargetHealthDescriptions[0].Target.Id))
+			}
+		}
+	}
+	nlbTags, _ := cloud.DescribeELBV2TagsWithContext(ctx, &elbv2.DescribeTagsInput{ResourceArns: aws.StringSlice([]string{nlb.Arn})})
+	assert.Contains(t, nlbTags.TagDescriptions[0].Tags, &elbv2.Tag{Key: aws.String("env"), Value: aws.String("prod")})
+
+	again, err := controller.Reconcile(ctx, instance, ingress, ingressAnnos)
+	assert.NoError(t, err)
+	assert.Equal(t, nlb, again, "unchanged NLB should be kept")
+
+	err = cloud.DeleteLoadBalancerByArn(ctx, lbArn)
+	assert.Equal(t, elbv2.ErrCodeResourceInUseException, err.(awserr.Error).Code(), "the ALB can't be deleted while it's the target of the NLB")
+
+	ingressAnnos.LoadBalancer.Ports = ingressAnnos.LoadBalancer.Ports[:1]
+	ingress.Annotations["alb.ingress.kubernetes.io/frontend-nlb-eip-allocations"] = "eipalloc-a,eipalloc-b"
+	recreated, err := controller.Reconcile(ctx, instance, ingress, ingressAnnos)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NotEqual(t, nlb.Arn, recreated.Arn, "the NLB should be recreated for its Elastic IPs")
+	assert.Len(t, recreated.TargetGroupARNs, 1)
+	nlbInstance, _ = cloud.GetLoadBalancerByArn(ctx, recreated.Arn)
+	allocations := make(map[string]string)
+	for _, az := range nlbInstance.AvailabilityZones {
+		allocations[aws.StringValue(az.ZoneName)] = aws.StringValue(az.LoadBalancerAddresses[0].AllocationId)
+	}
+	assert.Equal(t, map[string]string{"us-west-2a": "eipalloc-a", "us-west-2b": "eipalloc-b"}, allocations)
+	tgArns, _ := cloud.GetResourcesByFilters(map[string][]string{"ingress.k8s.aws/resource": {"FrontendTargetGroup"}}, aws.ResourceTypeEnumELBTargetGroup)
+	assert.Equal(t, recreated.TargetGroupARNs, tgArns, "the targetGroup of the removed port should be deleted")
+
+	ingress.Annotations["alb.ingress.kubernetes.io/frontend-nlb-eip-allocations"] = "eipalloc-a"
+	_, err = controller.Reconcile(ctx, instance, ingress, ingressAnnos)
+	assert.EqualError(t, err, "annotation frontend-nlb-eip-allocations lists 1 Elastic IPs, expected one per subnet of the LoadBalancer (2)")
+
+	ingress.Annotations = map[string]string{"alb.ingress.kubernetes.io/frontend-nlb": "false"}
+	disabled, err := controller.Reconcile(ctx, instance, ingress, ingressAnnos)
+	assert.NoError(t, err)
+	assert.Nil(t, disabled)
+	nlbInstance, _ = cloud.GetLoadBalancerByName(ctx, "default-ingress-nlb")
+	assert.Nil(t, nlbInstance)
+	tgArns, _ = cloud.GetResourcesByFilters(map[string][]string{"ingress.k8s.aws/resource": {"FrontendTargetGroup"}}, aws.ResourceTypeEnumELBTargetGroup)
+	assert.Empty(t, tgArns)
+	assert.NoError(t, cloud.DeleteLoadBalancerByArn(ctx, lbArn))
+}
+
+func TestDefaultFrontendNLBController_buildNLBConfig(t *testing.T) {
+	controller := &defaultFrontendNLBController{nameTagGen: stubNameTagGenerator{}}
+	instance := &elbv2.LoadBalancer{
+		Scheme:            aws.String(elbv2.LoadBalancerSchemeEnumInternal),
+		AvailabilityZones: []*elbv2.AvailabilityZone{{SubnetId: aws.String("subnet-a"), ZoneName: aws.String("us-west-2a")}},
+	}
+	ingress := &extensions.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "ingress",
+			Annotations: map[string]string{"alb.ingress.kubernetes.io/frontend-nlb-eip-allocations": "eipalloc-a"},
+		},
+	}
+	_, err := controller.buildNLBConfig(instance, ingress, &annotations.Ingress{LoadBalancer: &loadbalancer.Config{}, Tags: &annoTags.Config{}})
+	assert.EqualError(t, err, "annotation frontend-nlb-eip-allocations is only supported by internet-facing LoadBalancers")
+}
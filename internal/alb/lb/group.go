@@ -0,0 +1,129 @@
+package lb
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/ls"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/loadbalancer"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/parser"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// AnnotationGroupName adds an ingress to the ingress group sharing a single LoadBalancer, even across namespaces.
+	AnnotationGroupName = "group.name"
+	// AnnotationGroupOrder orders the ingress among the members of its group, from the lowest order, defaulting to 0.
+	AnnotationGroupOrder = "group.order"
+)
+
+// GroupName returns the name of the ingress group of ingress, empty if it isn't a member of one.
+func GroupName(ingress *extensions.Ingress) string {
+	var name string
+	_ = annotations.LoadStringAnnotation(AnnotationGroupName, &name, ingress.Annotations)
+	return name
+}
+
+// GroupKey is the key of the LoadBalancer of ingress group name, it has no namespace as the members of the group may be in several ones.
+func GroupKey(name string) types.NamespacedName {
+	return types.NamespacedName{Name: name}
+}
+
+// NewGroup builds the ingress group name of members, ordered by their AnnotationGroupOrder, then by namespace and name.
+func NewGroup(name string, members []*extensions.Ingress) (Group, error) {
+	if errs := validation.IsDNS1123Label(name); len(errs) != 0 {
+		return Group{}, fmt.Errorf("invalid group name %q in annotation %v: %v",
+			name, parser.GetAnnotationWithPrefix(AnnotationGroupName), strings.Join(errs, ", "))
+	}
+	orders := make(map[*extensions.Ingress]int64, len(members))
+	for _, ingress := range members {
+		var order int64
+		if _, err := annotations.LoadInt64Annotation(AnnotationGroupOrder, &order, ingress.Annotations); err != nil {
+			return Group{}, fmt.Errorf("invalid order of ingress %v/%v due to %v", ingress.Namespace, ingress.Name, err)
+		}
+		orders[ingress] = order
+	}
+
+	sorted := append([]*extensions.Ingress{}, members...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if orders[sorted[i]] != orders[sorted[j]] {
+			return orders[sorted[i]] < orders[sorted[j]]
+		}
+		if sorted[i].Namespace != sorted[j].Namespace {
+			return sorted[i].Namespace < sorted[j].Namespace
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+	return Group{Name: name, Members: sorted}, nil
+}
+
+// buildGroupIngress merges the members of ingress group name into an ingress keyed by GroupKey, configuring their shared LoadBalancer.
+// It has the annotations of the first member, the certificates of all members, and their rules and TLS hosts for certificate discovery.
+// Its listen-ports are the union of the listen-ports of members, which must agree on their protocol and LoadBalancer type.
+func buildGroupIngress(name string, members []ls.Member) (*extensions.Ingress, *annotations.Ingress, error) {
+	leader := members[0]
+	groupAnnotations := make(map[string]string, len(leader.Ingress.Annotations))
+	for k, v := range leader.Ingress.Annotations {
+		groupAnnotations[k] = v
+	}
+	ingress := &extensions.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: groupAnnotations,
+		},
+	}
+
+	var certificateARNs []string
+	seenCertificateARNs := sets.NewString()
+	var ports []loadbalancer.PortData
+	portOwners := make(map[int64]ls.Member)
+	for _, member := range members {
+		if aws.StringValue(member.IngressAnnos.LoadBalancer.Type) != aws.StringValue(leader.IngressAnnos.LoadBalancer.Type) {
+			return nil, nil, fmt.Errorf("LoadBalancer type is %v in ingress %v/%v but %v in ingress %v/%v",
+				aws.StringValue(leader.IngressAnnos.LoadBalancer.Type), leader.Ingress.Namespace, leader.Ingress.Name,
+				aws.StringValue(member.IngressAnnos.LoadBalancer.Type), member.Ingress.Namespace, member.Ingress.Name)
+		}
+		var arns []string
+		_ = annotations.LoadStringSliceAnnotation(ls.AnnotationCertificateARN, &arns, member.Ingress.Annotations)
+		for _, arn := range arns {
+			if !seenCertificateARNs.Has(arn) {
+				seenCertificateARNs.Insert(arn)
+				certificateARNs = append(certificateARNs, arn)
+			}
+		}
+		ingress.Spec.Rules = append(ingress.Spec.Rules, member.Ingress.Spec.Rules...)
+		ingress.Spec.TLS = append(ingress.Spec.TLS, member.Ingress.Spec.TLS...)
+
+		for _, port := range member.IngressAnnos.LoadBalancer.Ports {
+			owner, ok := portOwners[port.Port]
+			if !ok {
+				portOwners[port.Port] = member
+				ports = append(ports, port)
+				continue
+			}
+			for _, ownerPort := range owner.IngressAnnos.LoadBalancer.Ports {
+				if ownerPort.Port == port.Port && ownerPort.Scheme != port.Scheme {
+					return nil, nil, fmt.Errorf("listen-port %v is %v in ingress %v/%v but %v in ingress %v/%v",
+						port.Port, ownerPort.Scheme, owner.Ingress.Namespace, owner.Ingress.Name, port.Scheme, member.Ingress.Namespace, member.Ingress.Name)
+				}
+			}
+		}
+	}
+	if len(certificateARNs) != 0 {
+		groupAnnotations[parser.GetAnnotationWithPrefix(ls.AnnotationCertificateARN)] = strings.Join(certificateARNs, ",")
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i].Port < ports[j].Port })
+
+	lbConfig := *leader.IngressAnnos.LoadBalancer
+	lbConfig.Ports = ports
+	ingressAnnos := *leader.IngressAnnos
+	ingressAnnos.LoadBalancer = &lbConfig
+	return ingress, &ingressAnnos, nil
+}
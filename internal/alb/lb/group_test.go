@@ -0,0 +1,85 @@
+package lb
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/ls"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/loadbalancer"
+	"github.com/stretchr/testify/assert"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newGroupIngress(namespace string, name string, annotations map[string]string) *extensions.Ingress {
+	return &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Annotations: annotations}}
+}
+
+func TestNewGroup(t *testing.T) {
+	first := newGroupIngress("beta", "ingress", map[string]string{"alb.ingress.kubernetes.io/group.order": "-10"})
+	second := newGroupIngress("alpha", "ingress", nil)
+	third := newGroupIngress("beta", "ingress", nil)
+	last := newGroupIngress("alpha", "ingress", map[string]string{"alb.ingress.kubernetes.io/group.order": "10"})
+
+	group, err := NewGroup("shared", []*extensions.Ingress{last, third, second, first})
+	assert.NoError(t, err)
+	assert.Equal(t, Group{Name: "shared", Members: []*extensions.Ingress{first, second, third, last}}, group)
+
+	_, err = NewGroup("Shared_Group", []*extensions.Ingress{first})
+	assert.Error(t, err)
+
+	invalid := newGroupIngress("alpha", "invalid", map[string]string{"alb.ingress.kubernetes.io/group.order": "first"})
+	_, err = NewGroup("shared", []*extensions.Ingress{invalid})
+	assert.EqualError(t, err, `invalid order of ingress alpha/invalid due to failed to parse annotation, alb.ingress.kubernetes.io/group.order: first: strconv.ParseInt: parsing "first": invalid syntax`)
+}
+
+func Test_buildGroupIngress(t *testing.T) {
+	member := func(namespace string, annos map[string]string, ports ...loadbalancer.PortData) ls.Member {
+		return ls.Member{
+			Ingress: newGroupIngress(namespace, "ingress", annos),
+			IngressAnnos: &annotations.Ingress{
+				LoadBalancer: &loadbalancer.Config{Scheme: aws.String(elbv2.LoadBalancerSchemeEnumInternal), Ports: ports},
+			},
+		}
+	}
+	http := loadbalancer.PortData{Port: 80, Scheme: elbv2.ProtocolEnumHttp}
+	https := loadbalancer.PortData{Port: 443, Scheme: elbv2.ProtocolEnumHttps}
+
+	leader := member("alpha", map[string]string{
+		"alb.ingress.kubernetes.io/ssl-policy":      "ELBSecurityPolicy-TLS-1-2-2017-01",
+		"alb.ingress.kubernetes.io/certificate-arn": "arn:aws:acm:us-west-2:123456789012:certificate/alpha",
+	}, https)
+	other := member("beta", map[string]string{
+		"alb.ingress.kubernetes.io/ssl-policy":      "ELBSecurityPolicy-2016-08",
+		"alb.ingress.kubernetes.io/certificate-arn": "arn:aws:acm:us-west-2:123456789012:certificate/beta,arn:aws:acm:us-west-2:123456789012:certificate/alpha",
+	}, http, https)
+	other.Ingress.Spec.Rules = []extensions.IngressRule{{Host: "beta.example.com"}}
+
+	ingress, ingressAnnos, err := buildGroupIngress("shared", []ls.Member{leader, other})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "", ingress.Namespace)
+	assert.Equal(t, "shared", ingress.Name)
+	assert.Equal(t, map[string]string{
+		"alb.ingress.kubernetes.io/ssl-policy":      "ELBSecurityPolicy-TLS-1-2-2017-01",
+		"alb.ingress.kubernetes.io/certificate-arn": "arn:aws:acm:us-west-2:123456789012:certificate/alpha,arn:aws:acm:us-west-2:123456789012:certificate/beta",
+	}, ingress.Annotations)
+	assert.Equal(t, other.Ingress.Spec.Rules, ingress.Spec.Rules)
+	assert.Equal(t, []loadbalancer.PortData{http, https}, ingressAnnos.LoadBalancer.Ports)
+	assert.Equal(t, elbv2.LoadBalancerSchemeEnumInternal, aws.StringValue(ingressAnnos.LoadBalancer.Scheme))
+	assert.Equal(t, []loadbalancer.PortData{https}, leader.IngressAnnos.LoadBalancer.Ports, "annotations of the leader should be left untouched")
+	assert.Len(t, leader.Ingress.Annotations, 2)
+
+	conflicting := member("gamma", nil, loadbalancer.PortData{Port: 443, Scheme: elbv2.ProtocolEnumHttp})
+	_, _, err = buildGroupIngress("shared", []ls.Member{leader, conflicting})
+	assert.EqualError(t, err, "listen-port 443 is HTTPS in ingress alpha/ingress but HTTP in ingress gamma/ingress")
+
+	network := member("delta", nil, loadbalancer.PortData{Port: 5432, Scheme: elbv2.ProtocolEnumTcp})
+	network.IngressAnnos.LoadBalancer.Type = aws.String(elbv2.LoadBalancerTypeEnumNetwork)
+	leader.IngressAnnos.LoadBalancer.Type = aws.String(elbv2.LoadBalancerTypeEnumApplication)
+	_, _, err = buildGroupIngress("shared", []ls.Member{leader, network})
+	assert.EqualError(t, err, "LoadBalancer type is application in ingress alpha/ingress but network in ingress delta/ingress")
+}
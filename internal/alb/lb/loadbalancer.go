@@ -8,6 +8,7 @@ import (
 
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/config"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/ls"
@@ -19,6 +20,7 @@ import (
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/store"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/k8s"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/tracing"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/util/log"
 	util "github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/util/types"
 	corev1 "k8s.io/api/core/v1"
@@ -27,13 +29,24 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
+const (
+	// AnnotationEIPAllocations lists the Elastic IPs of an internet-facing network LoadBalancer, one per subnet.
+	AnnotationEIPAllocations = "eip-allocations"
+	// AnnotationMigrated marks the ingresses generated by the migrate command, whose LoadBalancer isn't named after them.
+	AnnotationMigrated = "migrated"
+)
+
 // LoadBalancerController manages loadBalancer for ingress objects
 type Controller interface {
 	// Reconcile will make sure an LoadBalancer exists for specified ingress.
 	Reconcile(ctx context.Context, ingress *extensions.Ingress) (*LoadBalancer, error)
 
-	// Deletes will ensure no LoadBalancer exists for specified ingressKey.
-	Delete(ctx context.Context, ingressKey types.NamespacedName) error
+	// ReconcileGroup will make sure an LoadBalancer exists for the members of specified ingress group, which must have at least one.
+	ReconcileGroup(ctx context.Context, group Group) (*LoadBalancer, error)
+
+	// Deletes will ensure no LoadBalancer exists for specified ingressKey, which is the GroupKey of ingress groups.
+	// The targetGroups of the ingress are deleted as well. migrated tells whether the ingress is marked by AnnotationMigrated.
+	Delete(ctx context.Context, ingressKey types.NamespacedName, migrated bool) error
 }
 
 func NewController(
@@ -48,6 +61,10 @@ func NewController(
 	wafController := NewWAFController(cloud)
 	wafV2Controller := NewWAFV2Controller(cloud)
 	shieldController := NewShieldController(cloud)
+	healthCheckController := NewHealthCheckController(cloud, nameTagGen, store.GetConfig().DefaultTags)
+	alarmController := NewAlarmController(cloud, nameTagGen)
+	zonalShiftController := NewZonalShiftController(cloud)
+	frontendNLBController := NewFrontendNLBController(cloud, nameTagGen, tagsController, store.GetConfig().DefaultTags)
 
 	return &defaultController{
 		cloud:                   cloud,
@@ -61,6 +78,10 @@ func NewController(
 		wafController:           wafController,
 		wafV2Controller:         wafV2Controller,
 		shieldController:        shieldController,
+		healthCheckController:   healthCheckController,
+		alarmController:         alarmController,
+		zonalShiftController:    zonalShiftController,
+		frontendNLBController:   frontendNLBController,
 	}
 }
 
@@ -72,6 +93,8 @@ type loadBalancerConfig struct {
 	Scheme        *string
 	IpAddressType *string
 	Subnets       []string
+	// SubnetMappings assign Elastic IPs to the Subnets of network LoadBalancers, nil if they don't have Elastic IPs.
+	SubnetMappings []*elbv2.SubnetMapping
 }
 
 type defaultController struct {
@@ -87,45 +110,94 @@ type defaultController struct {
 	wafController           WAFController
 	wafV2Controller         WAFV2Controller
 	shieldController        ShieldController
+	healthCheckController   HealthCheckController
+	alarmController         AlarmController
+	zonalShiftController    ZonalShiftController
+	frontendNLBController   FrontendNLBController
 }
 
 var _ Controller = (*defaultController)(nil)
 
 func (controller *defaultController) Reconcile(ctx context.Context, ingress *extensions.Ingress) (*LoadBalancer, error) {
+	ctx, span := tracing.StartSpan(ctx, "lb.Reconcile")
+	defer span.End()
+
 	ingressAnnos, err := controller.store.GetIngressAnnotations(k8s.MetaNamespaceKey(ingress))
 	if err != nil {
 		return nil, err
 	}
+	return controller.reconcile(ctx, ingress, ingressAnnos, nil)
+}
+
+func (controller *defaultController) ReconcileGroup(ctx context.Context, group Group) (*LoadBalancer, error) {
+	ctx, span := tracing.StartSpan(ctx, "lb.ReconcileGroup")
+	defer span.End()
+
+	members := make([]ls.Member, 0, len(group.Members))
+	for _, ingress := range group.Members {
+		ingressAnnos, err := controller.store.GetIngressAnnotations(k8s.MetaNamespaceKey(ingress))
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, ls.Member{Ingress: ingress, IngressAnnos: ingressAnnos})
+	}
+	ingress, ingressAnnos, err := buildGroupIngress(group.Name, members)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge ingress group %v due to %v", group.Name, err)
+	}
+	lbInfo, err := controller.reconcile(ctx, ingress, ingressAnnos, members)
+	if err != nil {
+		return nil, err
+	}
+	lbInfo.Group = group.Name
+	return lbInfo, nil
+}
 
+// reconcile reconciles the LoadBalancer of ingress, or of the ingress group merged into ingress when members are specified.
+func (controller *defaultController) reconcile(ctx context.Context, ingress *extensions.Ingress, ingressAnnos *annotations.Ingress, members []ls.Member) (*LoadBalancer, error) {
 	lbConfig, err := controller.buildLBConfig(ctx, ingress, ingressAnnos)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build LoadBalancer configuration due to %v", err)
 	}
-	if err := controller.validateLBConfig(ctx, ingress, lbConfig); err != nil {
-		return nil, err
+	// the LoadBalancer of a group must be allowed for each of its members.
+	ingresses := []*extensions.Ingress{ingress}
+	if members != nil {
+		ingresses = nil
+		for _, member := range members {
+			ingresses = append(ingresses, member.Ingress)
+		}
+	}
+	for _, ing := range ingresses {
+		if err := controller.validateLBConfig(ctx, ing, lbConfig); err != nil {
+			return nil, err
+		}
 	}
 
 	ingKey := k8s.NamespacedName(ingress)
-	sgAttachment, err := controller.sgAssociationController.Setup(ctx, ingKey)
-	if err != nil {
-		return nil, err
+	network := aws.StringValue(lbConfig.Type) == elbv2.LoadBalancerTypeEnumNetwork
+	var sgAttachment sg.LbAttachmentInfo
+	if !network {
+		if sgAttachment, err = controller.sgAssociationController.Setup(ctx, ingKey, ingressAnnos); err != nil {
+			return nil, err
+		}
 	}
-	instance, err := controller.ensureLBInstance(ctx, lbConfig, sgAttachment)
+	instance, err := controller.ensureLBInstance(ctx, ingKey, IsMigrated(ingress), lbConfig, sgAttachment)
 	if err != nil {
 		return nil, err
 	}
 	lbArn := aws.StringValue(instance.LoadBalancerArn)
+	ctx = albctx.SetLogger(ctx, albctx.GetLogger(ctx).WithField("albARN", lbArn))
 	if err := controller.attrsController.Reconcile(ctx, lbArn, ingressAnnos.LoadBalancer.Attributes); err != nil {
 		return nil, fmt.Errorf("failed to reconcile attributes of %v due to %v", lbArn, err)
 	}
 
-	if controller.store.GetConfig().FeatureGate.Enabled(config.WAF) {
+	if !network && controller.store.GetConfig().FeatureGate.Enabled(config.WAF) {
 		if err := controller.wafController.Reconcile(ctx, lbArn, ingress); err != nil {
 			return nil, err
 		}
 	}
 
-	if controller.store.GetConfig().FeatureGate.Enabled(config.WAFV2) {
+	if !network && controller.store.GetConfig().FeatureGate.Enabled(config.WAFV2) {
 		if err := controller.wafV2Controller.Reconcile(ctx, lbArn, ingress); err != nil {
 			return nil, err
 		}
@@ -137,45 +209,136 @@ func (controller *defaultController) Reconcile(ctx context.Context, ingress *ext
 		}
 	}
 
-	tgGroup, err := controller.tgGroupController.Reconcile(ctx, ingress)
-	if err != nil {
-		return nil, fmt.Errorf("failed to reconcile targetGroups due to %v", err)
+	if controller.store.GetConfig().FeatureGate.Enabled(config.ZonalShift) {
+		if err := controller.zonalShiftController.Reconcile(ctx, lbArn, ingress); err != nil {
+			return nil, fmt.Errorf("failed to reconcile zonal shift configuration due to %v", err)
+		}
 	}
-	if err := controller.lsGroupController.Reconcile(ctx, lbArn, ingress, tgGroup); err != nil {
-		return nil, fmt.Errorf("failed to reconcile listeners due to %v", err)
+
+	var healthCheckIDs []string
+	if controller.store.GetConfig().FeatureGate.Enabled(config.Route53) {
+		healthCheckIDs, err = controller.healthCheckController.Reconcile(ctx, instance, ingress, ingressAnnos)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reconcile Route 53 health checks due to %v", err)
+		}
 	}
-	if err := controller.tgGroupController.GC(ctx, tgGroup); err != nil {
-		return nil, fmt.Errorf("failed to GC targetGroups due to %v", err)
+
+	var tgGroups []tg.TargetGroupGroup
+	var lsArns []string
+	if members == nil {
+		tgGroup, err := controller.tgGroupController.Reconcile(ctx, ingress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reconcile targetGroups due to %v", err)
+		}
+		tgGroups = append(tgGroups, tgGroup)
+		lsArns, err = controller.lsGroupController.Reconcile(ctx, lbArn, ingress, tgGroup)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reconcile listeners due to %v", err)
+		}
+	} else {
+		for i := range members {
+			tgGroup, err := controller.tgGroupController.Reconcile(ctx, members[i].Ingress)
+			if err != nil {
+				return nil, fmt.Errorf("failed to reconcile targetGroups of ingress %v due to %v", k8s.MetaNamespaceKey(members[i].Ingress), err)
+			}
+			members[i].TGGroup = tgGroup
+			tgGroups = append(tgGroups, tgGroup)
+		}
+		lsArns, err = controller.lsGroupController.ReconcileGroup(ctx, lbArn, ingress, ingressAnnos, members)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reconcile listeners due to %v", err)
+		}
+	}
+	// the LoadBalancer can only be registered to the frontend targetGroups once it has listeners on their ports.
+	frontendNLB, err := controller.frontendNLBController.Reconcile(ctx, instance, ingress, ingressAnnos)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconcile frontend NLB due to %v", err)
+	}
+	for _, tgGroup := range tgGroups {
+		if err := controller.tgGroupController.GC(ctx, tgGroup); err != nil {
+			return nil, fmt.Errorf("failed to GC targetGroups due to %v", err)
+		}
 	}
 
-	if err := controller.sgAssociationController.Reconcile(ctx, ingKey, sgAttachment, instance, tgGroup); err != nil {
+	if network {
+		// network LoadBalancers have no securityGroups, the ones managed for an application LoadBalancer of ingress are cleaned up.
+		if err := controller.sgAssociationController.Delete(ctx, ingKey); err != nil {
+			return nil, fmt.Errorf("failed to clean up securityGroups due to %v", err)
+		}
+	} else if err := controller.sgAssociationController.Reconcile(ctx, ingKey, ingressAnnos, sgAttachment, instance, tgGroups); err != nil {
 		return nil, fmt.Errorf("failed to reconcile securityGroup associations due to %v", err)
 	}
-	return &LoadBalancer{
-		Arn:     lbArn,
-		DNSName: aws.StringValue(instance.DNSName),
-	}, nil
+	var tgArns []string
+	for _, tgGroup := range tgGroups {
+		for _, t := range tgGroup.TGByBackend {
+			tgArns = append(tgArns, t.Arn)
+		}
+	}
+	if controller.store.GetConfig().FeatureGate.Enabled(config.CloudWatch) {
+		if err := controller.alarmController.Reconcile(ctx, lbConfig.Name, lbArn, tgArns, ingress); err != nil {
+			return nil, fmt.Errorf("failed to reconcile CloudWatch alarms due to %v", err)
+		}
+	}
+	lbInfo := &LoadBalancer{
+		Arn:                   lbArn,
+		DNSName:               aws.StringValue(instance.DNSName),
+		Scheme:                aws.StringValue(instance.Scheme),
+		CanonicalHostedZoneID: aws.StringValue(instance.CanonicalHostedZoneId),
+		ListenerARNs:          lsArns,
+		TargetGroupARNs:       tgArns,
+		SecurityGroupIDs:      sgAttachment.SGIDs(),
+		HealthCheckIDs:        healthCheckIDs,
+		FrontendNLB:           frontendNLB,
+	}
+	if instance.State != nil {
+		lbInfo.State = aws.StringValue(instance.State.Code)
+		lbInfo.StateReason = aws.StringValue(instance.State.Reason)
+	}
+	return lbInfo, nil
 }
 
-func (controller *defaultController) Delete(ctx context.Context, ingressKey types.NamespacedName) error {
+func (controller *defaultController) Delete(ctx context.Context, ingressKey types.NamespacedName, migrated bool) error {
+	ctx, span := tracing.StartSpan(ctx, "lb.Delete")
+	defer span.End()
+
 	lbName := controller.nameTagGen.NameLB(ingressKey.Namespace, ingressKey.Name)
-	instance, err := controller.cloud.GetLoadBalancerByName(ctx, lbName)
+	instance, err := controller.findLBInstance(ctx, ingressKey, lbName, migrated)
 	if err != nil {
 		return fmt.Errorf("failed to find existing LoadBalancer due to %v", err)
 	}
+	if instance != nil {
+		if err = controller.ensureDeletable(ctx, instance); err != nil {
+			return err
+		}
+	}
+	if err = controller.frontendNLBController.Delete(ctx, ingressKey); err != nil {
+		return err
+	}
 	if instance != nil {
 		if err = controller.lsGroupController.Delete(ctx, aws.StringValue(instance.LoadBalancerArn)); err != nil {
 			return fmt.Errorf("failed to delete listeners due to %v", err)
 		}
-		if err = controller.tgGroupController.Delete(ctx, ingressKey); err != nil {
-			return fmt.Errorf("failed to GC targetGroups due to %v", err)
-		}
-
+	}
+	// the targetGroups of an ingress leaving its group remain once the rules of the group no longer use them.
+	if err = controller.tgGroupController.Delete(ctx, ingressKey); err != nil {
+		return fmt.Errorf("failed to GC targetGroups due to %v", err)
+	}
+	if instance != nil {
 		albctx.GetLogger(ctx).Infof("deleting LoadBalancer %v", aws.StringValue(instance.LoadBalancerArn))
 		if err = controller.cloud.DeleteLoadBalancerByArn(ctx, aws.StringValue(instance.LoadBalancerArn)); err != nil {
 			return err
 		}
 	}
+	if controller.store.GetConfig().FeatureGate.Enabled(config.Route53) {
+		if err = controller.healthCheckController.Delete(ctx, ingressKey); err != nil {
+			return fmt.Errorf("failed to delete Route 53 health checks due to %v", err)
+		}
+	}
+	if controller.store.GetConfig().FeatureGate.Enabled(config.CloudWatch) {
+		if err = controller.alarmController.Delete(ctx, lbName); err != nil {
+			return fmt.Errorf("failed to delete CloudWatch alarms due to %v", err)
+		}
+	}
 	if err = controller.sgAssociationController.Delete(ctx, ingressKey); err != nil {
 		return fmt.Errorf("failed to clean up securityGroups due to %v", err)
 	}
@@ -183,8 +346,40 @@ func (controller *defaultController) Delete(ctx context.Context, ingressKey type
 	return nil
 }
 
-func (controller *defaultController) ensureLBInstance(ctx context.Context, lbConfig *loadBalancerConfig, sgAttachment sg.LbAttachmentInfo) (*elbv2.LoadBalancer, error) {
-	instance, err := controller.cloud.GetLoadBalancerByName(ctx, lbConfig.Name)
+// ensureDeletable checks the deletion protection of instance before any of its resources are deleted, so that a protected
+// LoadBalancer isn't left behind without listeners. Deletion protection is only turned off with DisableDeletionProtectionOnDelete.
+func (controller *defaultController) ensureDeletable(ctx context.Context, instance *elbv2.LoadBalancer) error {
+	lbArn := aws.StringValue(instance.LoadBalancerArn)
+	raw, err := controller.cloud.DescribeLoadBalancerAttributesWithContext(ctx, &elbv2.DescribeLoadBalancerAttributesInput{
+		LoadBalancerArn: instance.LoadBalancerArn,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to retrieve attributes of LoadBalancer %v due to %v", lbArn, err)
+	}
+	protected := false
+	for _, attr := range raw.Attributes {
+		if aws.StringValue(attr.Key) == DeletionProtectionEnabledKey {
+			protected = aws.StringValue(attr.Value) == "true"
+		}
+	}
+	if !protected {
+		return nil
+	}
+	if !controller.store.GetConfig().DisableDeletionProtectionOnDelete {
+		return fmt.Errorf("LoadBalancer %v has deletion protection enabled, %v must be turned off before it can be deleted", lbArn, DeletionProtectionEnabledKey)
+	}
+	albctx.GetLogger(ctx).Infof("disabling deletion protection of LoadBalancer %v", lbArn)
+	if _, err := controller.cloud.ModifyLoadBalancerAttributesWithContext(ctx, &elbv2.ModifyLoadBalancerAttributesInput{
+		LoadBalancerArn: instance.LoadBalancerArn,
+		Attributes:      []*elbv2.LoadBalancerAttribute{lbAttribute(DeletionProtectionEnabledKey, "false")},
+	}); err != nil {
+		return fmt.Errorf("failed to disable deletion protection of LoadBalancer %v due to %v", lbArn, err)
+	}
+	return nil
+}
+
+func (controller *defaultController) ensureLBInstance(ctx context.Context, ingKey types.NamespacedName, migrated bool, lbConfig *loadBalancerConfig, sgAttachment sg.LbAttachmentInfo) (*elbv2.LoadBalancer, error) {
+	instance, err := controller.findLBInstance(ctx, ingKey, lbConfig.Name, migrated)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find existing LoadBalancer due to %v", err)
 	}
@@ -196,6 +391,10 @@ func (controller *defaultController) ensureLBInstance(ctx context.Context, lbCon
 		return instance, nil
 	}
 	if controller.isLBInstanceNeedRecreation(ctx, instance, lbConfig) {
+		// the frontend NLB targets the existing LoadBalancer, which can't be deleted while registered.
+		if err := controller.frontendNLBController.Delete(ctx, ingKey); err != nil {
+			return nil, err
+		}
 		instance, err = controller.recreateLBInstance(ctx, instance, lbConfig, sgAttachment)
 		if err != nil {
 			return nil, fmt.Errorf("failed to recreate LoadBalancer due to %v", err)
@@ -208,17 +407,68 @@ func (controller *defaultController) ensureLBInstance(ctx context.Context, lbCon
 	return instance, nil
 }
 
+// IsMigrated returns whether ingress is marked by AnnotationMigrated.
+func IsMigrated(ingress *extensions.Ingress) bool {
+	migrated := false
+	_, _ = annotations.LoadBoolAnnocation(AnnotationMigrated, &migrated, ingress.Annotations)
+	return migrated
+}
+
+// findLBInstance finds the LoadBalancer of ingress by its generated name, falling back to its ownership tags if it's migrated.
+// The fallback finds LoadBalancers which weren't created by the controller but adopted via the migrate command.
+func (controller *defaultController) findLBInstance(ctx context.Context, ingKey types.NamespacedName, lbName string, migrated bool) (*elbv2.LoadBalancer, error) {
+	instance, err := controller.cloud.GetLoadBalancerByName(ctx, lbName)
+	if err != nil || instance != nil || !migrated {
+		return instance, err
+	}
+
+	defaultTags := controller.store.GetConfig().DefaultTags
+	tagFilters := make(map[string][]string)
+	for k, v := range controller.nameTagGen.TagLB(ingKey.Namespace, ingKey.Name) {
+		if _, ok := defaultTags[k]; ok {
+			continue
+		}
+		tagFilters[k] = []string{v}
+	}
+	arns, err := controller.cloud.GetResourcesByFilters(tagFilters, aws.ResourceTypeEnumELBLoadBalancer)
+	if err != nil {
+		return nil, err
+	}
+	switch len(arns) {
+	case 0:
+		return nil, nil
+	case 1:
+	default:
+		return nil, fmt.Errorf("found multiple LoadBalancers tagged for ingress %v: %v", ingKey, arns)
+	}
+	instance, err = controller.cloud.GetLoadBalancerByArn(ctx, arns[0])
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == elbv2.ErrCodeLoadBalancerNotFoundException {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return instance, nil
+}
+
 func (controller *defaultController) newLBInstance(ctx context.Context, lbConfig *loadBalancerConfig, sgAttachment sg.LbAttachmentInfo) (*elbv2.LoadBalancer, error) {
 	albctx.GetLogger(ctx).Infof("creating LoadBalancer %v", lbConfig.Name)
-	resp, err := controller.cloud.CreateLoadBalancerWithContext(ctx, &elbv2.CreateLoadBalancerInput{
-		Name:           aws.String(lbConfig.Name),
-		Type:           lbConfig.Type,
-		Scheme:         lbConfig.Scheme,
-		IpAddressType:  lbConfig.IpAddressType,
-		SecurityGroups: aws.StringSlice(sgAttachment.SGIDs()),
-		Subnets:        aws.StringSlice(lbConfig.Subnets),
-		Tags:           tags.ConvertToELBV2(lbConfig.Tags),
-	})
+	input := &elbv2.CreateLoadBalancerInput{
+		Name:          aws.String(lbConfig.Name),
+		Type:          lbConfig.Type,
+		Scheme:        lbConfig.Scheme,
+		IpAddressType: lbConfig.IpAddressType,
+		Tags:          tags.ConvertToELBV2(lbConfig.Tags),
+	}
+	if len(lbConfig.SubnetMappings) != 0 {
+		input.SubnetMappings = lbConfig.SubnetMappings
+	} else {
+		input.Subnets = aws.StringSlice(lbConfig.Subnets)
+	}
+	if sgIDs := sgAttachment.SGIDs(); len(sgIDs) != 0 {
+		input.SecurityGroups = aws.StringSlice(sgIDs)
+	}
+	resp, err := controller.cloud.CreateLoadBalancerWithContext(ctx, input)
 	if err != nil {
 		albctx.GetLogger(ctx).Errorf("failed to create LoadBalancer %v due to %v", lbConfig.Name, err)
 		albctx.GetEventf(ctx)(corev1.EventTypeWarning, "ERROR", "failed to create LoadBalancer %v due to %v", lbConfig.Name, err)
@@ -274,11 +524,37 @@ func (controller *defaultController) reconcileLBInstance(ctx context.Context, in
 }
 
 func (controller *defaultController) isLBInstanceNeedRecreation(ctx context.Context, instance *elbv2.LoadBalancer, lbConfig *loadBalancerConfig) bool {
+	if !util.DeepEqual(instance.Type, lbConfig.Type) {
+		albctx.GetLogger(ctx).Infof("LoadBalancer %s need recreation due to type changed(%s => %s)",
+			lbConfig.Name, aws.StringValue(instance.Type), aws.StringValue(lbConfig.Type))
+		return true
+	}
 	if !util.DeepEqual(instance.Scheme, lbConfig.Scheme) {
 		albctx.GetLogger(ctx).Infof("LoadBalancer %s need recreation due to scheme changed(%s => %s)",
 			lbConfig.Name, aws.StringValue(instance.Scheme), aws.StringValue(lbConfig.Scheme))
 		return true
 	}
+	// the Elastic IPs of network LoadBalancers can't be modified.
+	if len(lbConfig.SubnetMappings) != 0 && !subnetMappingsMatch(instance.AvailabilityZones, lbConfig.SubnetMappings) {
+		albctx.GetLogger(ctx).Infof("LoadBalancer %s need recreation due to Elastic IPs changed", lbConfig.Name)
+		return true
+	}
+	if len(lbConfig.SubnetMappings) == 0 && hasEIPAllocations(instance.AvailabilityZones) {
+		albctx.GetLogger(ctx).Infof("LoadBalancer %s need recreation due to Elastic IPs removed", lbConfig.Name)
+		return true
+	}
+	return false
+}
+
+// hasEIPAllocations returns whether any of azs has an Elastic IP.
+func hasEIPAllocations(azs []*elbv2.AvailabilityZone) bool {
+	for _, az := range azs {
+		for _, address := range az.LoadBalancerAddresses {
+			if aws.StringValue(address.AllocationId) != "" {
+				return true
+			}
+		}
+	}
 	return false
 }
 
@@ -291,18 +567,60 @@ func (controller *defaultController) buildLBConfig(ctx context.Context, ingress
 	if err != nil {
 		return nil, err
 	}
+	lbType := elbv2.LoadBalancerTypeEnumApplication
+	if ingressAnnos.LoadBalancer.Type != nil {
+		lbType = aws.StringValue(ingressAnnos.LoadBalancer.Type)
+	}
+	if lbType == elbv2.LoadBalancerTypeEnumNetwork {
+		var frontendNLB bool
+		if _, err := annotations.LoadBoolAnnocation(AnnotationFrontendNLB, &frontendNLB, ingress.Annotations); err != nil {
+			return nil, err
+		}
+		if frontendNLB {
+			return nil, fmt.Errorf("annotation %v is only supported by application LoadBalancers", AnnotationFrontendNLB)
+		}
+	}
+	var subnetMappings []*elbv2.SubnetMapping
+	if len(ingressAnnos.LoadBalancer.EIPAllocations) != 0 {
+		if subnetMappings, err = controller.buildEIPSubnetMappings(ctx, subnets, ingressAnnos.LoadBalancer.EIPAllocations); err != nil {
+			return nil, err
+		}
+	}
 
 	return &loadBalancerConfig{
 		Name: controller.nameTagGen.NameLB(ingress.Namespace, ingress.Name),
 		Tags: lbTags,
 
-		Type:          aws.String(elbv2.LoadBalancerTypeEnumApplication),
-		Scheme:        ingressAnnos.LoadBalancer.Scheme,
-		IpAddressType: ingressAnnos.LoadBalancer.IPAddressType,
-		Subnets:       subnets,
+		Type:           aws.String(lbType),
+		Scheme:         ingressAnnos.LoadBalancer.Scheme,
+		IpAddressType:  ingressAnnos.LoadBalancer.IPAddressType,
+		Subnets:        subnets,
+		SubnetMappings: subnetMappings,
 	}, nil
 }
 
+// buildEIPSubnetMappings assigns allocations to subnets in the order of their availability zones, like the Elastic IPs of frontend NLBs.
+func (controller *defaultController) buildEIPSubnetMappings(ctx context.Context, subnets []string, allocations []string) ([]*elbv2.SubnetMapping, error) {
+	if len(allocations) != len(subnets) {
+		return nil, fmt.Errorf("annotation %v lists %v Elastic IPs, expected one per subnet of the LoadBalancer (%v)", AnnotationEIPAllocations, len(allocations), len(subnets))
+	}
+	ec2Subnets, err := controller.cloud.GetSubnetsByNameOrID(ctx, subnets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve availability zones of subnets due to %v", err)
+	}
+	if len(ec2Subnets) != len(subnets) {
+		return nil, fmt.Errorf("failed to resolve availability zones of subnets %v", strings.Join(subnets, ","))
+	}
+	sort.Slice(ec2Subnets, func(i, j int) bool {
+		return aws.StringValue(ec2Subnets[i].AvailabilityZone) < aws.StringValue(ec2Subnets[j].AvailabilityZone)
+	})
+	mappings := make([]*elbv2.SubnetMapping, 0, len(ec2Subnets))
+	for i, subnet := range ec2Subnets {
+		mappings = append(mappings, &elbv2.SubnetMapping{SubnetId: subnet.SubnetId, AllocationId: aws.String(allocations[i])})
+	}
+	return mappings, nil
+}
+
 func (controller *defaultController) validateLBConfig(ctx context.Context, ingress *extensions.Ingress, lbConfig *loadBalancerConfig) error {
 	controllerCfg := controller.store.GetConfig()
 	if controllerCfg.RestrictScheme && aws.StringValue(lbConfig.Scheme) == elbv2.LoadBalancerSchemeEnumInternetFacing {
@@ -362,16 +680,18 @@ func (controller *defaultController) clusterSubnets(ctx context.Context, scheme
 	var useableSubnets []*ec2.Subnet
 	var out []string
 	var key string
+	var configuredTags map[string]string
 
 	if scheme == elbv2.LoadBalancerSchemeEnumInternal {
-		key = aws.TagNameSubnetInternalELB
+		key, configuredTags = aws.TagNameSubnetInternalELB, controller.store.GetConfig().InternalSubnetTags
 	} else if scheme == elbv2.LoadBalancerSchemeEnumInternetFacing {
-		key = aws.TagNameSubnetPublicELB
+		key, configuredTags = aws.TagNameSubnetPublicELB, controller.store.GetConfig().InternetFacingSubnetTags
 	} else {
 		return nil, fmt.Errorf("invalid scheme [%s]", scheme)
 	}
 
-	clusterSubnets, err := controller.cloud.GetClusterSubnets(key)
+	tags, tagsDescription := subnetDiscoveryTags(key, configuredTags)
+	clusterSubnets, err := controller.cloud.GetClusterSubnets(tags)
 	if err != nil {
 		return nil, fmt.Errorf("unable to fetch subnets. Error: %s", err.Error())
 	}
@@ -384,14 +704,35 @@ func (controller *defaultController) clusterSubnets(ctx context.Context, scheme
 	}
 
 	if len(out) < 2 {
-		return nil, fmt.Errorf(`failed to resolve 2 qualified subnet with at least 8 free IP Addresses for ALB. Subnets must contains these tags: '%s/%s': ['shared' or 'owned'] and '%s': ['' or '1']. See https://kubernetes-sigs.github.io/aws-alb-ingress-controller/guide/controller/config/#subnet-auto-discovery for more details. Resolved qualified subnets: '%s'`,
-			aws.TagNameCluster, controller.cloud.GetClusterName(), key, log.Prettify(out))
+		return nil, fmt.Errorf(`failed to resolve 2 qualified subnet with at least 8 free IP Addresses for ALB. Subnets must contains these tags: '%s/%s': ['shared' or 'owned'] and %s. See https://kubernetes-sigs.github.io/aws-alb-ingress-controller/guide/controller/config/#subnet-auto-discovery for more details. Resolved qualified subnets: '%s'`,
+			aws.TagNameCluster, controller.cloud.GetClusterName(), tagsDescription, log.Prettify(out))
 	}
 
 	sort.Strings(out)
 	return out, nil
 }
 
+// subnetDiscoveryTags returns the tags subnets are discovered by along with their description, which are the configured tags if any,
+// or the role tag key otherwise.
+func subnetDiscoveryTags(key string, configuredTags map[string]string) (map[string][]string, string) {
+	if len(configuredTags) == 0 {
+		return map[string][]string{key: {"", "1"}}, fmt.Sprintf("'%s': ['' or '1']", key)
+	}
+	tags := make(map[string][]string, len(configuredTags))
+	descriptions := make([]string, 0, len(configuredTags))
+	for k, v := range configuredTags {
+		if v == "" {
+			tags[k] = nil
+			descriptions = append(descriptions, fmt.Sprintf("'%s': [any value]", k))
+			continue
+		}
+		tags[k] = []string{v}
+		descriptions = append(descriptions, fmt.Sprintf("'%s': ['%s']", k, v))
+	}
+	sort.Strings(descriptions)
+	return tags, strings.Join(descriptions, " and ")
+}
+
 // subnetIsUsable determines if the subnet shares the same availability zone as a subnet in the
 // existing list. If it does, false is returned as you cannot have albs provisioned to 2 subnets in
 // the same availability zone.
@@ -1 +1,354 @@
 package lb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/loadbalancer"
+	annoTags "github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/tags"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/config"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/store"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/mocks"
+	"github.com/stretchr/testify/assert"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type stubNameTagGenerator struct{}
+
+func (stubNameTagGenerator) NameLB(namespace string, ingressName string) string {
+	return namespace + "-" + ingressName
+}
+
+func (g stubNameTagGenerator) NameFrontendNLB(namespace string, ingressName string) string {
+	return g.NameLB(namespace, ingressName) + "-nlb"
+}
+
+func (g stubNameTagGenerator) NameFrontendTG(namespace string, ingressName string, port int64) string {
+	return fmt.Sprintf("%v-%v", g.NameFrontendNLB(namespace, ingressName), port)
+}
+
+func (stubNameTagGenerator) TagLB(namespace string, ingressName string) map[string]string {
+	return map[string]string{
+		"kubernetes.io/namespace":    namespace,
+		"kubernetes.io/ingress-name": ingressName,
+		"team":                       "web",
+	}
+}
+
+func (g stubNameTagGenerator) TagHealthCheck(namespace string, ingressName string) map[string]string {
+	tags := g.TagLB(namespace, ingressName)
+	tags["ingress.k8s.aws/resource"] = "Route53HealthCheck"
+	return tags
+}
+
+func (stubNameTagGenerator) TagFrontendNLB(namespace string, ingressName string) map[string]string {
+	return map[string]string{
+		"ingress.k8s.aws/stack":    namespace + "/" + ingressName,
+		"ingress.k8s.aws/resource": "FrontendNLB",
+		"team":                     "web",
+	}
+}
+
+func (stubNameTagGenerator) TagFrontendTG(namespace string, ingressName string) map[string]string {
+	return map[string]string{
+		"ingress.k8s.aws/stack":    namespace + "/" + ingressName,
+		"ingress.k8s.aws/resource": "FrontendTargetGroup",
+		"team":                     "web",
+	}
+}
+
+func Test_defaultController_findLBInstance(t *testing.T) {
+	ingKey := types.NamespacedName{Namespace: "namespace", Name: "ingress"}
+	tagFilters := map[string][]string{
+		"kubernetes.io/namespace":    {"namespace"},
+		"kubernetes.io/ingress-name": {"ingress"},
+	}
+	instance := &elbv2.LoadBalancer{LoadBalancerArn: aws.String("lbArn")}
+
+	for _, tc := range []struct {
+		Name             string
+		Migrated         bool
+		ByName           *elbv2.LoadBalancer
+		ByNameErr        error
+		TaggedARNs       []string
+		LookupTags       bool
+		ByArn            *elbv2.LoadBalancer
+		ByArnErr         error
+		LookupArn        bool
+		ExpectedInstance *elbv2.LoadBalancer
+		ExpectedErr      error
+	}{
+		{
+			Name:             "found by name",
+			ByName:           instance,
+			ExpectedInstance: instance,
+		},
+		{
+			Name:        "name lookup failed",
+			ByNameErr:   errors.New("GetLoadBalancerByName"),
+			ExpectedErr: errors.New("GetLoadBalancerByName"),
+		},
+		{
+			Name: "not found",
+		},
+		{
+			Name:       "migrated not found",
+			Migrated:   true,
+			LookupTags: true,
+		},
+		{
+			Name:             "migrated found by ownership tags",
+			Migrated:         true,
+			LookupTags:       true,
+			TaggedARNs:       []string{"lbArn"},
+			LookupArn:        true,
+			ByArn:            instance,
+			ExpectedInstance: instance,
+		},
+		{
+			Name:       "tagged LoadBalancer already deleted",
+			Migrated:   true,
+			LookupTags: true,
+			TaggedARNs: []string{"lbArn"},
+			LookupArn:  true,
+			ByArnErr:   awserr.New(elbv2.ErrCodeLoadBalancerNotFoundException, "", nil),
+		},
+		{
+			Name:        "multiple LoadBalancers tagged",
+			Migrated:    true,
+			LookupTags:  true,
+			TaggedARNs:  []string{"lbArn1", "lbArn2"},
+			ExpectedErr: errors.New("found multiple LoadBalancers tagged for ingress namespace/ingress: [lbArn1 lbArn2]"),
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctx := context.Background()
+			cloud := &mocks.CloudAPI{}
+			cloud.On("GetLoadBalancerByName", ctx, "namespace-ingress").Return(tc.ByName, tc.ByNameErr)
+			if tc.LookupTags {
+				cloud.On("GetResourcesByFilters", tagFilters, aws.ResourceTypeEnumELBLoadBalancer).Return(tc.TaggedARNs, nil)
+			}
+			if tc.LookupArn {
+				cloud.On("GetLoadBalancerByArn", ctx, "lbArn").Return(tc.ByArn, tc.ByArnErr)
+			}
+			mockStore := &store.MockStorer{}
+			mockStore.On("GetConfig").Return(&config.Configuration{DefaultTags: map[string]string{"team": "web"}})
+
+			controller := &defaultController{
+				cloud:      cloud,
+				store:      mockStore,
+				nameTagGen: stubNameTagGenerator{},
+			}
+			actual, err := controller.findLBInstance(ctx, ingKey, "namespace-ingress", tc.Migrated)
+			assert.Equal(t, tc.ExpectedInstance, actual)
+			assert.Equal(t, tc.ExpectedErr, err)
+			cloud.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_defaultController_buildLBConfig_network(t *testing.T) {
+	controller := &defaultController{nameTagGen: stubNameTagGenerator{}}
+	ingress := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "ingress"}}
+	ingressAnnos := &annotations.Ingress{
+		LoadBalancer: &loadbalancer.Config{
+			Type:    aws.String(elbv2.LoadBalancerTypeEnumNetwork),
+			Scheme:  aws.String(elbv2.LoadBalancerSchemeEnumInternetFacing),
+			Subnets: []string{"subnet-b", "subnet-a"},
+		},
+		Tags: &annoTags.Config{},
+	}
+
+	lbConfig, err := controller.buildLBConfig(context.Background(), ingress, ingressAnnos)
+	if assert.NoError(t, err) {
+		assert.Equal(t, elbv2.LoadBalancerTypeEnumNetwork, aws.StringValue(lbConfig.Type))
+		assert.Equal(t, []string{"subnet-a", "subnet-b"}, lbConfig.Subnets)
+	}
+	instance := &elbv2.LoadBalancer{
+		Type:   aws.String(elbv2.LoadBalancerTypeEnumApplication),
+		Scheme: aws.String(elbv2.LoadBalancerSchemeEnumInternetFacing),
+	}
+	assert.True(t, controller.isLBInstanceNeedRecreation(context.Background(), instance, lbConfig), "the LoadBalancer should be recreated for its type")
+
+	ingress.Annotations = map[string]string{"alb.ingress.kubernetes.io/frontend-nlb": "true"}
+	_, err = controller.buildLBConfig(context.Background(), ingress, ingressAnnos)
+	assert.EqualError(t, err, "annotation frontend-nlb is only supported by application LoadBalancers")
+}
+
+func Test_defaultController_ensureDeletable(t *testing.T) {
+	instance := &elbv2.LoadBalancer{LoadBalancerArn: aws.String("lbArn")}
+	describeInput := &elbv2.DescribeLoadBalancerAttributesInput{LoadBalancerArn: aws.String("lbArn")}
+	modifyInput := &elbv2.ModifyLoadBalancerAttributesInput{
+		LoadBalancerArn: aws.String("lbArn"),
+		Attributes:      []*elbv2.LoadBalancerAttribute{{Key: aws.String("deletion_protection.enabled"), Value: aws.String("false")}},
+	}
+
+	for _, tc := range []struct {
+		Name                      string
+		DeletionProtection        string
+		DisableDeletionProtection bool
+		ModifyErr                 error
+		ExpectModify              bool
+		ExpectedErr               error
+	}{
+		{
+			Name:               "unprotected",
+			DeletionProtection: "false",
+		},
+		{
+			Name:               "protected",
+			DeletionProtection: "true",
+			ExpectedErr:        errors.New("LoadBalancer lbArn has deletion protection enabled, deletion_protection.enabled must be turned off before it can be deleted"),
+		},
+		{
+			Name:                      "protected with disable deletion protection",
+			DeletionProtection:        "true",
+			DisableDeletionProtection: true,
+			ExpectModify:              true,
+		},
+		{
+			Name:                      "failed to disable deletion protection",
+			DeletionProtection:        "true",
+			DisableDeletionProtection: true,
+			ModifyErr:                 errors.New("ModifyLoadBalancerAttributes"),
+			ExpectModify:              true,
+			ExpectedErr:               errors.New("failed to disable deletion protection of LoadBalancer lbArn due to ModifyLoadBalancerAttributes"),
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctx := context.Background()
+			cloud := &mocks.CloudAPI{}
+			cloud.On("DescribeLoadBalancerAttributesWithContext", ctx, describeInput).Return(&elbv2.DescribeLoadBalancerAttributesOutput{
+				Attributes: []*elbv2.LoadBalancerAttribute{
+					{Key: aws.String("deletion_protection.enabled"), Value: aws.String(tc.DeletionProtection)},
+					{Key: aws.String("idle_timeout.timeout_seconds"), Value: aws.String("60")},
+				},
+			}, nil)
+			if tc.ExpectModify {
+				cloud.On("ModifyLoadBalancerAttributesWithContext", ctx, modifyInput).Return(nil, tc.ModifyErr)
+			}
+			mockStore := &store.MockStorer{}
+			mockStore.On("GetConfig").Return(&config.Configuration{DisableDeletionProtectionOnDelete: tc.DisableDeletionProtection})
+
+			controller := &defaultController{
+				cloud: cloud,
+				store: mockStore,
+			}
+			err := controller.ensureDeletable(ctx, instance)
+			assert.Equal(t, tc.ExpectedErr, err)
+			cloud.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_defaultController_clusterSubnets(t *testing.T) {
+	subnet := func(id string, az string) *ec2.Subnet {
+		return &ec2.Subnet{SubnetId: aws.String(id), AvailabilityZone: aws.String(az), AvailableIpAddressCount: aws.Int64(100)}
+	}
+	for _, tc := range []struct {
+		Name            string
+		Scheme          string
+		Cfg             config.Configuration
+		ExpectedTags    map[string][]string
+		Subnets         []*ec2.Subnet
+		ExpectedSubnets []string
+		ExpectedErr     error
+	}{
+		{
+			Name:            "role tag",
+			Scheme:          elbv2.LoadBalancerSchemeEnumInternal,
+			ExpectedTags:    map[string][]string{"kubernetes.io/role/internal-elb": {"", "1"}},
+			Subnets:         []*ec2.Subnet{subnet("subnet-b", "us-west-2b"), subnet("subnet-a", "us-west-2a")},
+			ExpectedSubnets: []string{"subnet-a", "subnet-b"},
+		},
+		{
+			Name:            "configured tags",
+			Scheme:          elbv2.LoadBalancerSchemeEnumInternetFacing,
+			Cfg:             config.Configuration{InternetFacingSubnetTags: map[string]string{"network": "public", "alb": ""}},
+			ExpectedTags:    map[string][]string{"network": {"public"}, "alb": nil},
+			Subnets:         []*ec2.Subnet{subnet("subnet-a", "us-west-2a"), subnet("subnet-b", "us-west-2b")},
+			ExpectedSubnets: []string{"subnet-a", "subnet-b"},
+		},
+		{
+			Name:         "not enough subnets with configured tags",
+			Scheme:       elbv2.LoadBalancerSchemeEnumInternetFacing,
+			Cfg:          config.Configuration{InternetFacingSubnetTags: map[string]string{"network": "public", "alb": ""}},
+			ExpectedTags: map[string][]string{"network": {"public"}, "alb": nil},
+			Subnets:      []*ec2.Subnet{subnet("subnet-a", "us-west-2a"), subnet("subnet-b", "us-west-2a")},
+			ExpectedErr: errors.New(`failed to resolve 2 qualified subnet with at least 8 free IP Addresses for ALB. Subnets must contains these tags: ` +
+				`'kubernetes.io/cluster/cluster': ['shared' or 'owned'] and 'alb': [any value] and 'network': ['public']. ` +
+				`See https://kubernetes-sigs.github.io/aws-alb-ingress-controller/guide/controller/config/#subnet-auto-discovery for more details. Resolved qualified subnets: '["subnet-a"]'`),
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			cloud := &mocks.CloudAPI{}
+			cloud.On("GetClusterSubnets", tc.ExpectedTags).Return(tc.Subnets, nil)
+			cloud.On("GetClusterName").Return("cluster")
+			mockStore := &store.MockStorer{}
+			mockStore.On("GetConfig").Return(&tc.Cfg)
+
+			controller := &defaultController{
+				cloud: cloud,
+				store: mockStore,
+			}
+			subnets, err := controller.clusterSubnets(context.Background(), tc.Scheme)
+			assert.Equal(t, tc.ExpectedErr, err)
+			assert.Equal(t, tc.ExpectedSubnets, subnets)
+		})
+	}
+}
+
+func Test_defaultController_buildLBConfig_eipAllocations(t *testing.T) {
+	ctx := context.Background()
+	cloud := &mocks.CloudAPI{}
+	cloud.On("GetSubnetsByNameOrID", ctx, []string{"subnet-a", "subnet-b"}).Return([]*ec2.Subnet{
+		{SubnetId: aws.String("subnet-a"), AvailabilityZone: aws.String("us-west-2b")},
+		{SubnetId: aws.String("subnet-b"), AvailabilityZone: aws.String("us-west-2a")},
+	}, nil)
+	controller := &defaultController{cloud: cloud, nameTagGen: stubNameTagGenerator{}}
+	ingress := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "ingress"}}
+	ingressAnnos := &annotations.Ingress{
+		LoadBalancer: &loadbalancer.Config{
+			Type:           aws.String(elbv2.LoadBalancerTypeEnumNetwork),
+			Scheme:         aws.String(elbv2.LoadBalancerSchemeEnumInternetFacing),
+			Subnets:        []string{"subnet-b", "subnet-a"},
+			EIPAllocations: []string{"eipalloc-a", "eipalloc-b"},
+		},
+		Tags: &annoTags.Config{},
+	}
+
+	lbConfig, err := controller.buildLBConfig(ctx, ingress, ingressAnnos)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []*elbv2.SubnetMapping{
+			{SubnetId: aws.String("subnet-b"), AllocationId: aws.String("eipalloc-a")},
+			{SubnetId: aws.String("subnet-a"), AllocationId: aws.String("eipalloc-b")},
+		}, lbConfig.SubnetMappings)
+	}
+
+	instance := &elbv2.LoadBalancer{
+		Type:   aws.String(elbv2.LoadBalancerTypeEnumNetwork),
+		Scheme: aws.String(elbv2.LoadBalancerSchemeEnumInternetFacing),
+		AvailabilityZones: []*elbv2.AvailabilityZone{
+			{SubnetId: aws.String("subnet-a"), LoadBalancerAddresses: []*elbv2.LoadBalancerAddress{{AllocationId: aws.String("eipalloc-b")}}},
+			{SubnetId: aws.String("subnet-b"), LoadBalancerAddresses: []*elbv2.LoadBalancerAddress{{AllocationId: aws.String("eipalloc-a")}}},
+		},
+	}
+	assert.False(t, controller.isLBInstanceNeedRecreation(ctx, instance, lbConfig))
+	instance.AvailabilityZones[0].LoadBalancerAddresses[0].AllocationId = aws.String("eipalloc-c")
+	assert.True(t, controller.isLBInstanceNeedRecreation(ctx, instance, lbConfig), "the LoadBalancer should be recreated for its Elastic IPs")
+	lbConfig.SubnetMappings = nil
+	assert.True(t, controller.isLBInstanceNeedRecreation(ctx, instance, lbConfig), "the LoadBalancer should be recreated to release its Elastic IPs")
+
+	ingressAnnos.LoadBalancer.EIPAllocations = []string{"eipalloc-a"}
+	_, err = controller.buildLBConfig(ctx, ingress, ingressAnnos)
+	assert.EqualError(t, err, "annotation eip-allocations lists 1 Elastic IPs, expected one per subnet of the LoadBalancer (2)")
+}
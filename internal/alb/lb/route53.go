@@ -0,0 +1,217 @@
+package lb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/tracing"
+	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	defaultHealthCheckRequestInterval  = 30
+	defaultHealthCheckFailureThreshold = 3
+)
+
+// HealthCheckController manages the Route 53 health checks of the hosts and paths served by an ALB, for use in failover routing policies.
+type HealthCheckController interface {
+	// Reconcile ensures a health check exists per host and path of ingress when enabled by annotation, it returns their IDs.
+	Reconcile(ctx context.Context, instance *elbv2.LoadBalancer, ingress *extensions.Ingress, ingressAnnos *annotations.Ingress) ([]string, error)
+
+	// Delete deletes the health checks of ingress.
+	Delete(ctx context.Context, ingressKey types.NamespacedName) error
+}
+
+func NewHealthCheckController(cloud aws.CloudAPI, tagGen TagGenerator, defaultTags map[string]string) HealthCheckController {
+	return &defaultHealthCheckController{
+		cloud:       cloud,
+		tagGen:      tagGen,
+		defaultTags: defaultTags,
+	}
+}
+
+type defaultHealthCheckController struct {
+	cloud       aws.CloudAPI
+	tagGen      TagGenerator
+	defaultTags map[string]string
+}
+
+func (c *defaultHealthCheckController) Reconcile(ctx context.Context, instance *elbv2.LoadBalancer, ingress *extensions.Ingress, ingressAnnos *annotations.Ingress) ([]string, error) {
+	ctx, span := tracing.StartSpan(ctx, "lb.ReconcileHealthChecks")
+	defer span.End()
+
+	var enabled bool
+	annotationPresent, err := annotations.LoadBoolAnnocation("route53-health-checks", &enabled, ingress.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	if !annotationPresent {
+		return nil, nil
+	}
+
+	ingressKey := types.NamespacedName{Namespace: ingress.Namespace, Name: ingress.Name}
+	current, err := c.cloud.GetHealthChecksByTags(ctx, c.ownershipTags(ingressKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Route 53 health checks due to %v", err)
+	}
+	desired := make(map[string]*route53.HealthCheckConfig)
+	if enabled {
+		desired, err = buildHealthCheckConfigs(instance, ingress, ingressAnnos)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var ids []string
+	for _, healthCheck := range current {
+		key := healthCheckConfigKey(healthCheck.HealthCheckConfig)
+		if _, ok := desired[key]; ok {
+			delete(desired, key)
+			ids = append(ids, aws.StringValue(healthCheck.Id))
+			continue
+		}
+		albctx.GetLogger(ctx).Infof("deleting Route 53 health check %v for %v", aws.StringValue(healthCheck.Id), key)
+		if err := c.cloud.DeleteHealthCheck(ctx, aws.StringValue(healthCheck.Id)); err != nil {
+			return nil, fmt.Errorf("failed to delete Route 53 health check %v due to %v", aws.StringValue(healthCheck.Id), err)
+		}
+	}
+	for _, key := range sortedHealthCheckKeys(desired) {
+		config := desired[key]
+		tags := c.tagGen.TagHealthCheck(ingressKey.Namespace, ingressKey.Name)
+		tags["Name"] = aws.StringValue(config.FullyQualifiedDomainName) + aws.StringValue(config.ResourcePath)
+		healthCheck, err := c.cloud.CreateHealthCheck(ctx, config, tags)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Route 53 health check for %v due to %v", key, err)
+		}
+		albctx.GetLogger(ctx).Infof("created Route 53 health check %v for %v", aws.StringValue(healthCheck.Id), key)
+		ids = append(ids, aws.StringValue(healthCheck.Id))
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+func (c *defaultHealthCheckController) Delete(ctx context.Context, ingressKey types.NamespacedName) error {
+	current, err := c.cloud.GetHealthChecksByTags(ctx, c.ownershipTags(ingressKey))
+	if err != nil {
+		return fmt.Errorf("failed to get Route 53 health checks due to %v", err)
+	}
+	for _, healthCheck := range current {
+		albctx.GetLogger(ctx).Infof("deleting Route 53 health check %v", aws.StringValue(healthCheck.Id))
+		if err := c.cloud.DeleteHealthCheck(ctx, aws.StringValue(healthCheck.Id)); err != nil {
+			return fmt.Errorf("failed to delete Route 53 health check %v due to %v", aws.StringValue(healthCheck.Id), err)
+		}
+	}
+	return nil
+}
+
+// ownershipTags returns the tags identifying the health checks of ingress, default tags are excluded since they may change.
+func (c *defaultHealthCheckController) ownershipTags(ingressKey types.NamespacedName) map[string]string {
+	tags := c.tagGen.TagHealthCheck(ingressKey.Namespace, ingressKey.Name)
+	for k := range c.defaultTags {
+		delete(tags, k)
+	}
+	return tags
+}
+
+// buildHealthCheckConfigs builds a health check config per host and path of ingress rules, keyed by healthCheckConfigKey.
+// The checks target the HTTPS listener if there is one, and hosts default to the ALB's DNS name.
+func buildHealthCheckConfigs(instance *elbv2.LoadBalancer, ingress *extensions.Ingress, ingressAnnos *annotations.Ingress) (map[string]*route53.HealthCheckConfig, error) {
+	requestInterval := int64(defaultHealthCheckRequestInterval)
+	if _, err := annotations.LoadInt64Annotation("route53-health-check-request-interval", &requestInterval, ingress.Annotations); err != nil {
+		return nil, err
+	}
+	if requestInterval != 10 && requestInterval != 30 {
+		return nil, fmt.Errorf("route53-health-check-request-interval must be 10 or 30, got %d", requestInterval)
+	}
+	failureThreshold := int64(defaultHealthCheckFailureThreshold)
+	if _, err := annotations.LoadInt64Annotation("route53-health-check-failure-threshold", &failureThreshold, ingress.Annotations); err != nil {
+		return nil, err
+	}
+	if failureThreshold < 1 || failureThreshold > 10 {
+		return nil, fmt.Errorf("route53-health-check-failure-threshold must be between 1 and 10, got %d", failureThreshold)
+	}
+
+	checkType, port := route53.HealthCheckTypeHttp, int64(80)
+	httpPortFound := false
+	for _, p := range ingressAnnos.LoadBalancer.Ports {
+		if p.Scheme == elbv2.ProtocolEnumHttps {
+			checkType, port = route53.HealthCheckTypeHttps, p.Port
+			break
+		}
+		if !httpPortFound {
+			port, httpPortFound = p.Port, true
+		}
+	}
+
+	configs := make(map[string]*route53.HealthCheckConfig)
+	add := func(host string, path string) {
+		// wildcard hosts aren't domain names, their paths are checked on the ALB instead
+		if len(host) == 0 || strings.HasPrefix(host, "*.") {
+			host = aws.StringValue(instance.DNSName)
+		}
+		config := &route53.HealthCheckConfig{
+			Type:                     aws.String(checkType),
+			FullyQualifiedDomainName: aws.String(host),
+			Port:                     aws.Int64(port),
+			ResourcePath:             aws.String(healthCheckPath(path)),
+			RequestInterval:          aws.Int64(requestInterval),
+			FailureThreshold:         aws.Int64(failureThreshold),
+		}
+		if checkType == route53.HealthCheckTypeHttps {
+			config.EnableSNI = aws.Bool(true)
+		}
+		configs[healthCheckConfigKey(config)] = config
+	}
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil || len(rule.HTTP.Paths) == 0 {
+			add(rule.Host, "/")
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			add(rule.Host, path.Path)
+		}
+	}
+	if len(configs) == 0 {
+		add("", "/")
+	}
+	return configs, nil
+}
+
+// healthCheckPath converts an ingress path into a concrete path to check, by truncating it at the first wildcard.
+func healthCheckPath(path string) string {
+	if i := strings.IndexAny(path, "*?"); i >= 0 {
+		path = path[:i]
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return path
+}
+
+// healthCheckConfigKey identifies a health check by its settings, health checks are replaced rather than updated when settings change.
+func healthCheckConfigKey(config *route53.HealthCheckConfig) string {
+	return fmt.Sprintf("%v://%v:%v%v (interval %v, threshold %v)",
+		strings.ToLower(aws.StringValue(config.Type)),
+		aws.StringValue(config.FullyQualifiedDomainName),
+		aws.Int64Value(config.Port),
+		aws.StringValue(config.ResourcePath),
+		aws.Int64Value(config.RequestInterval),
+		aws.Int64Value(config.FailureThreshold))
+}
+
+func sortedHealthCheckKeys(configs map[string]*route53.HealthCheckConfig) []string {
+	keys := make([]string, 0, len(configs))
+	for k := range configs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,135 @@
+package lb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/loadbalancer"
+	albtesting "github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/testing"
+	"github.com/stretchr/testify/assert"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestDefaultHealthCheckController_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	cloud := albtesting.NewCloud("vpc-1", "cluster")
+	controller := NewHealthCheckController(cloud, stubNameTagGenerator{}, map[string]string{"team": "web"})
+	instance := &elbv2.LoadBalancer{DNSName: aws.String("lb.us-west-2.elb.amazonaws.com")}
+	ingressAnnos := &annotations.Ingress{LoadBalancer: &loadbalancer.Config{
+		Ports: []loadbalancer.PortData{{Port: 80, Scheme: "HTTP"}, {Port: 443, Scheme: "HTTPS"}},
+	}}
+	ingress := &extensions.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "ingress",
+			Annotations: map[string]string{"alb.ingress.kubernetes.io/route53-health-checks": "true"},
+		},
+		Spec: extensions.IngressSpec{
+			Rules: []extensions.IngressRule{
+				{
+					Host: "a.example.com",
+					IngressRuleValue: extensions.IngressRuleValue{HTTP: &extensions.HTTPIngressRuleValue{
+						Paths: []extensions.HTTPIngressPath{{Path: "/api/*"}, {Path: "/api/v1*"}},
+					}},
+				},
+				{},
+			},
+		},
+	}
+	ingressKey := types.NamespacedName{Namespace: "default", Name: "ingress"}
+	ownershipTags := map[string]string{
+		"kubernetes.io/namespace":    "default",
+		"kubernetes.io/ingress-name": "ingress",
+		"ingress.k8s.aws/resource":   "Route53HealthCheck",
+	}
+
+	ids, err := controller.Reconcile(ctx, instance, ingress, ingressAnnos)
+	assert.NoError(t, err)
+	assert.Len(t, ids, 3)
+	healthChecks, _ := cloud.GetHealthChecksByTags(ctx, ownershipTags)
+	var endpoints []string
+	for _, healthCheck := range healthChecks {
+		endpoints = append(endpoints, healthCheckConfigKey(healthCheck.HealthCheckConfig))
+		assert.True(t, aws.BoolValue(healthCheck.HealthCheckConfig.EnableSNI))
+	}
+	assert.ElementsMatch(t, []string{
+		"https://a.example.com:443/api/ (interval 30, threshold 3)",
+		"https://a.example.com:443/api/v1 (interval 30, threshold 3)",
+		"https://lb.us-west-2.elb.amazonaws.com:443/ (interval 30, threshold 3)",
+	}, endpoints)
+
+	again, err := controller.Reconcile(ctx, instance, ingress, ingressAnnos)
+	assert.NoError(t, err)
+	assert.Equal(t, ids, again, "unchanged health checks should be kept")
+
+	ingress.Annotations["alb.ingress.kubernetes.io/route53-health-check-failure-threshold"] = "5"
+	ingress.Spec.Rules = ingress.Spec.Rules[:1]
+	ingress.Spec.Rules[0].HTTP.Paths = ingress.Spec.Rules[0].HTTP.Paths[:1]
+	ids, err = controller.Reconcile(ctx, instance, ingress, ingressAnnos)
+	assert.NoError(t, err)
+	healthChecks, _ = cloud.GetHealthChecksByTags(ctx, ownershipTags)
+	if assert.Len(t, healthChecks, 1) {
+		assert.Equal(t, []string{aws.StringValue(healthChecks[0].Id)}, ids)
+		assert.Equal(t, "https://a.example.com:443/api/ (interval 30, threshold 5)", healthCheckConfigKey(healthChecks[0].HealthCheckConfig))
+	}
+
+	ingress.Annotations["alb.ingress.kubernetes.io/route53-health-check-request-interval"] = "15"
+	_, err = controller.Reconcile(ctx, instance, ingress, ingressAnnos)
+	assert.EqualError(t, err, "route53-health-check-request-interval must be 10 or 30, got 15")
+
+	ingress.Annotations = map[string]string{"alb.ingress.kubernetes.io/route53-health-checks": "false"}
+	ids, err = controller.Reconcile(ctx, instance, ingress, ingressAnnos)
+	assert.NoError(t, err)
+	assert.Empty(t, ids)
+	healthChecks, _ = cloud.GetHealthChecksByTags(ctx, ownershipTags)
+	assert.Empty(t, healthChecks)
+
+	_, err = cloud.CreateHealthCheck(ctx, &route53.HealthCheckConfig{}, controller.(*defaultHealthCheckController).tagGen.TagHealthCheck("default", "ingress"))
+	assert.NoError(t, err)
+	assert.NoError(t, controller.Delete(ctx, ingressKey))
+	healthChecks, _ = cloud.GetHealthChecksByTags(ctx, ownershipTags)
+	assert.Empty(t, healthChecks)
+}
+
+func Test_buildHealthCheckConfigs_wildcardHost(t *testing.T) {
+	instance := &elbv2.LoadBalancer{DNSName: aws.String("lb.us-west-2.elb.amazonaws.com")}
+	ingressAnnos := &annotations.Ingress{LoadBalancer: &loadbalancer.Config{Ports: []loadbalancer.PortData{{Port: 80, Scheme: "HTTP"}}}}
+	ingress := &extensions.Ingress{
+		Spec: extensions.IngressSpec{
+			Rules: []extensions.IngressRule{
+				{
+					Host: "*.example.com",
+					IngressRuleValue: extensions.IngressRuleValue{HTTP: &extensions.HTTPIngressRuleValue{
+						Paths: []extensions.HTTPIngressPath{{Path: "/api/*"}},
+					}},
+				},
+			},
+		},
+	}
+	configs, err := buildHealthCheckConfigs(instance, ingress, ingressAnnos)
+	assert.NoError(t, err)
+	var keys []string
+	for key := range configs {
+		keys = append(keys, key)
+	}
+	assert.Equal(t, []string{"http://lb.us-west-2.elb.amazonaws.com:80/api/ (interval 30, threshold 3)"}, keys)
+}
+
+func Test_healthCheckPath(t *testing.T) {
+	for path, expected := range map[string]string{
+		"":           "/",
+		"/":          "/",
+		"/*":         "/",
+		"/api/*":     "/api/",
+		"/img/?.png": "/img/",
+		"healthz":    "/healthz",
+	} {
+		assert.Equal(t, expected, healthCheckPath(path), path)
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/pkg/errors"
 	extensions "k8s.io/api/extensions/v1beta1"
 
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/tracing"
 	"k8s.io/apimachinery/pkg/util/cache"
 )
 
@@ -51,6 +52,9 @@ type defaultShieldController struct {
 }
 
 func (c *defaultShieldController) Reconcile(ctx context.Context, lbArn string, ingress *extensions.Ingress) error {
+	ctx, span := tracing.StartSpan(ctx, "lb.ReconcileShield")
+	defer span.End()
+
 	var enableProtection bool
 	annotationPresent, err := annotations.LoadBoolAnnocation("shield-advanced-protection", &enableProtection, ingress.Annotations)
 	if err != nil {
@@ -1,19 +1,61 @@
 package lb
 
+import (
+	extensions "k8s.io/api/extensions/v1beta1"
+)
+
+// Group is an ingress group, whose member ingresses share a single LoadBalancer.
+type Group struct {
+	Name string
+	// Members are in the order of their rules, the first one configures the LoadBalancer.
+	Members []*extensions.Ingress
+}
+
 // LoadBalancer contains information of LoadBalancer in AWS
 type LoadBalancer struct {
 	Arn     string
 	DNSName string
+	Scheme  string
+	// CanonicalHostedZoneID is the Route 53 hosted zone of DNSName, for alias records.
+	CanonicalHostedZoneID string
+	// State is the state code of the LoadBalancer, e.g. provisioning or active, StateReason explains it if it's impaired or failed.
+	State       string
+	StateReason string
+
+	// AWS resources managed alongside the LoadBalancer for this ingress.
+	ListenerARNs     []string
+	TargetGroupARNs  []string
+	SecurityGroupIDs []string
+	HealthCheckIDs   []string
+
+	// FrontendNLB is the NLB giving the LoadBalancer static IPs, if enabled by annotation.
+	FrontendNLB *FrontendNLB
+
+	// Group is the name of the ingress group sharing the LoadBalancer, empty for the LoadBalancer of a single ingress.
+	Group string
+}
+
+// FrontendNLB contains information of the NLB fronting a LoadBalancer in AWS
+type FrontendNLB struct {
+	Arn                   string
+	DNSName               string
+	CanonicalHostedZoneID string
+	TargetGroupARNs       []string
 }
 
 // NameGenerator generates name for loadBalancer resources
 type NameGenerator interface {
 	NameLB(namespace string, ingressName string) string
+	NameFrontendNLB(namespace string, ingressName string) string
+	NameFrontendTG(namespace string, ingressName string, port int64) string
 }
 
 // TagGenerator generates tags for loadBalancer resources
 type TagGenerator interface {
 	TagLB(namespace string, ingressName string) map[string]string
+	TagHealthCheck(namespace string, ingressName string) map[string]string
+	TagFrontendNLB(namespace string, ingressName string) map[string]string
+	TagFrontendTG(namespace string, ingressName string) map[string]string
 }
 
 // NameTagGenerator combines NameGenerator & TagGenerator
@@ -7,6 +7,7 @@ import (
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/tracing"
 	"github.com/pkg/errors"
 	extensions "k8s.io/api/extensions/v1beta1"
 	"k8s.io/apimachinery/pkg/util/cache"
@@ -25,12 +26,14 @@ type WAFController interface {
 func NewWAFController(cloud aws.CloudAPI) WAFController {
 	return &defaultWAFController{
 		cloud:              cloud,
+		webACLResolver:     newWebACLResolver(cloud),
 		webACLIdForLBCache: cache.NewLRUExpireCache(webACLIdForLBCacheMaxSize),
 	}
 }
 
 type defaultWAFController struct {
-	cloud aws.CloudAPI
+	cloud          aws.CloudAPI
+	webACLResolver *webACLResolver
 
 	// cache that stores webACLIdForLBCache for LoadBalancerARN.
 	// The cache value is string, while "" represents no webACL.
@@ -38,11 +41,17 @@ type defaultWAFController struct {
 }
 
 func (c *defaultWAFController) Reconcile(ctx context.Context, lbArn string, ing *extensions.Ingress) error {
+	ctx, span := tracing.StartSpan(ctx, "lb.ReconcileWAF")
+	defer span.End()
+
 	currentWebACLId, err := c.getCurrentWebACLId(ctx, lbArn)
 	if err != nil {
 		return err
 	}
-	desiredWebACLId := c.getDesiredWebACLId(ctx, ing)
+	desiredWebACLId, err := c.getDesiredWebACLId(ctx, ing)
+	if err != nil {
+		return err
+	}
 
 	switch {
 	case desiredWebACLId == "" && currentWebACLId != "":
@@ -67,12 +76,20 @@ func (c *defaultWAFController) Reconcile(ctx context.Context, lbArn string, ing
 	return nil
 }
 
-func (c *defaultWAFController) getDesiredWebACLId(ctx context.Context, ing *extensions.Ingress) string {
+func (c *defaultWAFController) getDesiredWebACLId(ctx context.Context, ing *extensions.Ingress) (string, error) {
 	var webACLId string
 	// support legacy waf-acl-id annotation
 	_ = annotations.LoadStringAnnotation("waf-acl-id", &webACLId, ing.Annotations)
 	_ = annotations.LoadStringAnnotation("web-acl-id", &webACLId, ing.Annotations)
-	return webACLId
+	webACLId, err := c.webACLResolver.resolve(ctx, webACLId)
+	if err != nil {
+		return "", err
+	}
+	// the ARN of a WAFv2 webACL is associated by the WAFV2Controller.
+	if isWAFV2WebACLARN(webACLId) {
+		return "", nil
+	}
+	return webACLId, nil
 }
 
 func (c *defaultWAFController) getCurrentWebACLId(ctx context.Context, lbArn string) (string, error) {
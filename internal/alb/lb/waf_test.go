@@ -2,10 +2,13 @@ package lb
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/parser"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/mocks"
+	"github.com/stretchr/testify/assert"
 	extensions "k8s.io/api/extensions/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/cache"
@@ -13,9 +16,11 @@ import (
 
 func Test_defaultWAFController_getDesiredWebACLId(t *testing.T) {
 	tests := []struct {
-		name string
-		ing  *extensions.Ingress
-		want string
+		name      string
+		ing       *extensions.Ingress
+		lookupErr error
+		want      string
+		wantErr   string
 	}{
 		{
 			name: "ingress without waf settings",
@@ -51,15 +56,51 @@ func Test_defaultWAFController_getDesiredWebACLId(t *testing.T) {
 			},
 			want: "my-web-acl-id",
 		},
+		{
+			name: "ingress with the ARN of a WAFv2 webACL in web-acl-id",
+			ing: &extensions.Ingress{
+				ObjectMeta: v1.ObjectMeta{
+					Name: "ingress",
+					Annotations: map[string]string{
+						parser.AnnotationsPrefix + "/web-acl-id": "arn:aws:wafv2:us-east-1:000000000000:regional/webacl/name/0aa00000-00a0-00a0-a0a0-0a0000a0000a",
+					},
+				},
+			},
+			want: "",
+		},
+		{
+			name: "ingress with waf-acl-id failed to look up",
+			ing: &extensions.Ingress{
+				ObjectMeta: v1.ObjectMeta{
+					Name: "ingress",
+					Annotations: map[string]string{
+						parser.AnnotationsPrefix + "/waf-acl-id": "my-web-acl-id",
+					},
+				},
+			},
+			lookupErr: errors.New("access denied"),
+			wantErr:   "failed to look up WAFv2 webACL my-web-acl-id: access denied",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			cloud := &mocks.CloudAPI{}
+			// my-web-acl-id isn't the ID of a WAF regional webACL, so it's looked up as the name of one
+			cloud.On("GetWAFV2WebACLARNByName", ctx, "my-web-acl-id").Return(nil, tt.lookupErr)
+			cloud.On("WAFRegionalAvailable").Return(true)
+			cloud.On("GetWebACLIdByName", ctx, "my-web-acl-id").Return(aws.String("my-web-acl-id"), nil)
 			c := &defaultWAFController{
-				cloud:              &mocks.CloudAPI{},
+				cloud:              cloud,
+				webACLResolver:     newWebACLResolver(cloud),
 				webACLIdForLBCache: cache.NewLRUExpireCache(10),
 			}
-			if got := c.getDesiredWebACLId(context.Background(), tt.ing); got != tt.want {
-				t.Errorf("getDesiredWebACLId() = %v, want %v", got, tt.want)
+			got, err := c.getDesiredWebACLId(ctx, tt.ing)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
 			}
 		})
 	}
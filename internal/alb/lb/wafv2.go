@@ -4,10 +4,15 @@ import (
 	"context"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/tracing"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	extensions "k8s.io/api/extensions/v1beta1"
 	"k8s.io/apimachinery/pkg/util/cache"
 )
@@ -25,12 +30,14 @@ type WAFV2Controller interface {
 func NewWAFV2Controller(cloud aws.CloudAPI) WAFV2Controller {
 	return &defaultWAFV2Controller{
 		cloud:               cloud,
+		webACLResolver:      newWebACLResolver(cloud),
 		webACLARNForLBCache: cache.NewLRUExpireCache(webACLARNForLBCacheMaxSize),
 	}
 }
 
 type defaultWAFV2Controller struct {
-	cloud aws.CloudAPI
+	cloud          aws.CloudAPI
+	webACLResolver *webACLResolver
 
 	// cache that stores webACLARNForLBCache for LoadBalancerARN.
 	// The cache value is string, while "" represents no webACL.
@@ -38,9 +45,18 @@ type defaultWAFV2Controller struct {
 }
 
 func (c *defaultWAFV2Controller) Reconcile(ctx context.Context, lbArn string, ing *extensions.Ingress) error {
-	var desiredWebACLARN string
+	ctx, span := tracing.StartSpan(ctx, "lb.ReconcileWAFV2")
+	defer span.End()
 
-	_ = annotations.LoadStringAnnotation("wafv2-acl-arn", &desiredWebACLARN, ing.Annotations)
+	desiredWebACLARN, err := c.getDesiredWebACLARN(ctx, ing)
+	if err != nil {
+		return err
+	}
+	if desiredWebACLARN != "" {
+		if err := aws.ValidateARNPartition(desiredWebACLARN, aws.ARNPartition(lbArn)); err != nil {
+			return errors.Wrapf(err, "invalid WAFv2 webACL for LoadBalancer %v", lbArn)
+		}
+	}
 
 	currentWebACLId, err := c.getCurrentWebACLARN(ctx, lbArn)
 	if err != nil {
@@ -51,26 +67,82 @@ func (c *defaultWAFV2Controller) Reconcile(ctx context.Context, lbArn string, in
 	case desiredWebACLARN == "" && currentWebACLId != "":
 		albctx.GetLogger(ctx).Infof("disassociate WAFv2 webACL on %v", lbArn)
 		if _, err := c.cloud.DisassociateWAFV2(ctx, aws.String(lbArn)); err != nil {
-			return errors.Wrapf(err, "failed to disassociate WAFv2 webACL on LoadBalancer %v", lbArn)
+			return c.associationFailed(ctx, lbArn, errors.Wrapf(err, "failed to disassociate WAFv2 webACL on LoadBalancer %v", lbArn))
 		}
-		c.webACLARNForLBCache.Add(lbArn, desiredWebACLARN, webACLARNForLBCacheTTL)
 	case desiredWebACLARN != "" && currentWebACLId != "" && desiredWebACLARN != currentWebACLId:
 		albctx.GetLogger(ctx).Infof("change WAFv2 webACL on %v from %v to %v", lbArn, currentWebACLId, desiredWebACLARN)
 		if _, err := c.cloud.AssociateWAFV2(ctx, aws.String(lbArn), aws.String(desiredWebACLARN)); err != nil {
-			return errors.Wrapf(err, "failed to associate WAFv2 webACL on LoadBalancer %v", lbArn)
+			return c.associationFailed(ctx, lbArn, errors.Wrapf(err, "failed to associate WAFv2 webACL on LoadBalancer %v", lbArn))
 		}
-		c.webACLARNForLBCache.Add(lbArn, desiredWebACLARN, webACLARNForLBCacheTTL)
 	case desiredWebACLARN != "" && currentWebACLId == "":
 		albctx.GetLogger(ctx).Infof("associate WAFv2 webACL %v on %v", desiredWebACLARN, lbArn)
 		if _, err := c.cloud.AssociateWAFV2(ctx, aws.String(lbArn), aws.String(desiredWebACLARN)); err != nil {
-			return errors.Wrapf(err, "failed to associate WAFv2 webACL on LoadBalancer %v", lbArn)
+			return c.associationFailed(ctx, lbArn, errors.Wrapf(err, "failed to associate WAFv2 webACL on LoadBalancer %v", lbArn))
 		}
-		c.webACLARNForLBCache.Add(lbArn, desiredWebACLARN, webACLARNForLBCacheTTL)
+	default:
+		return nil
+	}
+
+	if err := c.verifyWebACLARN(ctx, lbArn, desiredWebACLARN); err != nil {
+		return c.associationFailed(ctx, lbArn, err)
 	}
+	c.webACLARNForLBCache.Add(lbArn, desiredWebACLARN, webACLARNForLBCacheTTL)
+	return nil
+}
 
+// verifyWebACLARN checks that GetWebACLForResource reports webACLARN as the WAFv2 webACL of lbArn, "" for none.
+// GetWebACLForResource is eventually consistent, an association it doesn't report yet fails the reconcile rather than blocking it,
+// so that it's checked again once the reconcile is retried.
+func (c *defaultWAFV2Controller) verifyWebACLARN(ctx context.Context, lbArn string, webACLARN string) error {
+	webACL, err := c.cloud.GetWAFV2WebACLSummary(ctx, aws.String(lbArn))
+	if err != nil {
+		return errors.Wrapf(err, "failed get WAFv2 webACL for load balancer %v", lbArn)
+	}
+	var currentWebACLARN string
+	if webACL != nil {
+		currentWebACLARN = aws.StringValue(webACL.ARN)
+	}
+	if currentWebACLARN != webACLARN {
+		return errors.Errorf("WAFv2 webACL of LoadBalancer %v is not yet consistent, it's %q rather than %q after its association", lbArn, currentWebACLARN, webACLARN)
+	}
 	return nil
 }
 
+// associationFailed records err, the failure to associate a WAFv2 webACL to lbArn, as an event of the ingress and returns it.
+// The cached webACL of lbArn is dropped, since the association may have partly taken effect or not be reported yet.
+func (c *defaultWAFV2Controller) associationFailed(ctx context.Context, lbArn string, err error) error {
+	c.webACLARNForLBCache.Remove(lbArn)
+	albctx.GetEventf(ctx)(corev1.EventTypeWarning, "WAFV2", "%v", err)
+	return err
+}
+
+// getDesiredWebACLARN returns the WAFv2 webACL of ing, specified by the wafv2-acl-arn annotation or by its ARN or name in the WAF annotations.
+func (c *defaultWAFV2Controller) getDesiredWebACLARN(ctx context.Context, ing *extensions.Ingress) (string, error) {
+	var webACLARN string
+	_ = annotations.LoadStringAnnotation("wafv2-acl-arn", &webACLARN, ing.Annotations)
+
+	var webACLId string
+	_ = annotations.LoadStringAnnotation("waf-acl-id", &webACLId, ing.Annotations)
+	_ = annotations.LoadStringAnnotation("web-acl-id", &webACLId, ing.Annotations)
+	webACLId, err := c.webACLResolver.resolve(ctx, webACLId)
+	if err != nil {
+		return "", err
+	}
+	if !isWAFV2WebACLARN(webACLId) {
+		return webACLARN, nil
+	}
+	if webACLARN != "" && webACLARN != webACLId {
+		return "", errors.Errorf("conflicting WAFv2 webACLs %v and %v in annotations wafv2-acl-arn and web-acl-id", webACLARN, webACLId)
+	}
+	return webACLId, nil
+}
+
+// isWAFV2WebACLARN tells whether webACL, the value of a WAF annotation, is the ARN of a WAFv2 webACL rather than the ID of a WAF regional one.
+func isWAFV2WebACLARN(webACL string) bool {
+	a, err := arn.Parse(webACL)
+	return err == nil && a.Service == wafv2.EndpointsID
+}
+
 func (c *defaultWAFV2Controller) getCurrentWebACLARN(ctx context.Context, lbArn string) (string, error) {
 	cachedWebACLARN, exists := c.webACLARNForLBCache.Get(lbArn)
 	if exists {
@@ -2,11 +2,13 @@ package lb
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/mocks"
 	"github.com/stretchr/testify/assert"
@@ -121,7 +123,7 @@ func Test_defaultWAFV2Controller_Reconcile(t *testing.T) {
 				map[string]string{},
 			),
 			DesiredWebACLARN:                 "",
-			GetWAFV2WebACLSummaryTimesCalled: 1,
+			GetWAFV2WebACLSummaryTimesCalled: 2,
 			AssociateWAFV2TimesCalled:        0,
 			DisassociateWAFV2TimesCalled:     1,
 		},
@@ -141,7 +143,7 @@ func Test_defaultWAFV2Controller_Reconcile(t *testing.T) {
 				},
 			),
 			DesiredWebACLARN:                 "",
-			GetWAFV2WebACLSummaryTimesCalled: 1,
+			GetWAFV2WebACLSummaryTimesCalled: 2,
 			AssociateWAFV2TimesCalled:        0,
 			DisassociateWAFV2TimesCalled:     1,
 		},
@@ -161,7 +163,7 @@ func Test_defaultWAFV2Controller_Reconcile(t *testing.T) {
 				},
 			),
 			DesiredWebACLARN:                 "arn:aws:wafv2:us-east-1:000000000000:regional/webacl/name/0aa00000-00a0-00a0-a0a0-0a0000a0000a",
-			GetWAFV2WebACLSummaryTimesCalled: 1,
+			GetWAFV2WebACLSummaryTimesCalled: 2,
 			AssociateWAFV2TimesCalled:        1,
 			DisassociateWAFV2TimesCalled:     0,
 		},
@@ -181,7 +183,7 @@ func Test_defaultWAFV2Controller_Reconcile(t *testing.T) {
 				},
 			),
 			DesiredWebACLARN:                 "arn:aws:wafv2:us-east-1:000000000000:regional/webacl/name/0aa00000-00a0-00a0-a0a0-0a0000a0000a",
-			GetWAFV2WebACLSummaryTimesCalled: 1,
+			GetWAFV2WebACLSummaryTimesCalled: 2,
 			AssociateWAFV2TimesCalled:        1,
 			DisassociateWAFV2TimesCalled:     0,
 		},
@@ -190,7 +192,12 @@ func Test_defaultWAFV2Controller_Reconcile(t *testing.T) {
 			ctx := context.Background()
 			cloud := &mocks.CloudAPI{}
 
-			cloud.On("GetWAFV2WebACLSummary", ctx, aws.String(tc.LoadBalancerARN)).Return(tc.GetWAFV2WebACLSummaryResponse, tc.GetWAFV2WebACLSummaryError)
+			cloud.On("GetWAFV2WebACLSummary", ctx, aws.String(tc.LoadBalancerARN)).Return(tc.GetWAFV2WebACLSummaryResponse, tc.GetWAFV2WebACLSummaryError).Once()
+			var associatedWebACL *wafv2.WebACL
+			if tc.DesiredWebACLARN != "" {
+				associatedWebACL = &wafv2.WebACL{ARN: aws.String(tc.DesiredWebACLARN)}
+			}
+			cloud.On("GetWAFV2WebACLSummary", ctx, aws.String(tc.LoadBalancerARN)).Return(associatedWebACL, nil)
 			cloud.On("AssociateWAFV2", ctx, aws.String(tc.LoadBalancerARN), aws.String(tc.DesiredWebACLARN)).Return(tc.AssociateWAFV2Response, tc.AssociateWAFV2Error)
 			cloud.On("DisassociateWAFV2", ctx, aws.String(tc.LoadBalancerARN)).Return(tc.DisassociateWAFV2Response, tc.DisassociateWAFV2Error)
 
@@ -203,3 +210,127 @@ func Test_defaultWAFV2Controller_Reconcile(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultWAFV2Controller_Reconcile_partitionMismatch(t *testing.T) {
+	ctx := context.Background()
+	cloud := &mocks.CloudAPI{}
+	lbArn := "arn:aws-cn:elasticloadbalancing:cn-north-1:000000000000:loadbalancer/app/lb/0123456789abcdef"
+	ing := buildWAFV2TestIngress(map[string]string{
+		"alb.ingress.kubernetes.io/wafv2-acl-arn": "arn:aws:wafv2:us-east-1:000000000000:regional/webacl/name/0aa00000-00a0-00a0-a0a0-0a0000a0000a",
+	})
+
+	controller := NewWAFV2Controller(cloud)
+	err := controller.Reconcile(ctx, lbArn, ing)
+	assert.EqualError(t, err, "invalid WAFv2 webACL for LoadBalancer "+lbArn+": "+
+		"arn:aws:wafv2:us-east-1:000000000000:regional/webacl/name/0aa00000-00a0-00a0-a0a0-0a0000a0000a is in partition aws, expected partition aws-cn")
+	cloud.AssertNotCalled(t, "AssociateWAFV2")
+}
+
+func TestDefaultWAFV2Controller_Reconcile_verifyAssociation(t *testing.T) {
+	const lbArn = "arn:aws:elasticloadbalancing:us-east-1:000000000000:loadbalancer/app/lb/0123456789abcdef"
+	const webACLARN = "arn:aws:wafv2:us-east-1:000000000000:regional/webacl/name/0aa00000-00a0-00a0-a0a0-0a0000a0000a"
+	ing := buildWAFV2TestIngress(map[string]string{"alb.ingress.kubernetes.io/wafv2-acl-arn": webACLARN})
+
+	for _, tc := range []struct {
+		Name          string
+		Associated    *wafv2.WebACL
+		ExpectedError string
+	}{
+		{
+			Name:       "association visible",
+			Associated: &wafv2.WebACL{ARN: aws.String(webACLARN)},
+		},
+		{
+			Name:          "association not yet visible",
+			ExpectedError: `WAFv2 webACL of LoadBalancer ` + lbArn + ` is not yet consistent, it's "" rather than "` + webACLARN + `" after its association`,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			var events []string
+			ctx := albctx.SetEventf(context.Background(), func(eventType, reason, format string, vals ...interface{}) {
+				events = append(events, eventType+" "+reason+" "+fmt.Sprintf(format, vals...))
+			})
+			cloud := &mocks.CloudAPI{}
+			cloud.On("GetWAFV2WebACLSummary", ctx, aws.String(lbArn)).Return(nil, nil).Once()
+			cloud.On("AssociateWAFV2", ctx, aws.String(lbArn), aws.String(webACLARN)).Return(&wafv2.AssociateWebACLOutput{}, nil).Once()
+			cloud.On("GetWAFV2WebACLSummary", ctx, aws.String(lbArn)).Return(tc.Associated, nil).Once()
+
+			controller := NewWAFV2Controller(cloud)
+			err := controller.Reconcile(ctx, lbArn, ing)
+			cloud.AssertExpectations(t)
+			if tc.ExpectedError == "" {
+				assert.NoError(t, err)
+				assert.Empty(t, events)
+				// the verified association is cached
+				assert.NoError(t, controller.Reconcile(ctx, lbArn, ing))
+				return
+			}
+			assert.EqualError(t, err, tc.ExpectedError)
+			assert.Equal(t, []string{"Warning WAFV2 " + tc.ExpectedError}, events)
+
+			// the retried reconcile looks the association up again, rather than associating the webACL anew once it's visible.
+			cloud.On("GetWAFV2WebACLSummary", ctx, aws.String(lbArn)).Return(&wafv2.WebACL{ARN: aws.String(webACLARN)}, nil).Once()
+			assert.NoError(t, controller.Reconcile(ctx, lbArn, ing))
+			cloud.AssertNumberOfCalls(t, "AssociateWAFV2", 1)
+		})
+	}
+}
+
+func TestDefaultWAFV2Controller_getDesiredWebACLARN(t *testing.T) {
+	const webACLARN = "arn:aws:wafv2:us-east-1:000000000000:regional/webacl/name/0aa00000-00a0-00a0-a0a0-0a0000a0000a"
+	const otherWebACLARN = "arn:aws:wafv2:us-east-1:000000000000:regional/webacl/other/0bb00000-00b0-00b0-b0b0-0b0000b0000b"
+	for _, tc := range []struct {
+		Name          string
+		Annotations   map[string]string
+		Expected      string
+		ExpectedError string
+	}{
+		{
+			Name:        "wafv2-acl-arn",
+			Annotations: map[string]string{"alb.ingress.kubernetes.io/wafv2-acl-arn": webACLARN},
+			Expected:    webACLARN,
+		},
+		{
+			Name:        "WAFv2 ARN in web-acl-id",
+			Annotations: map[string]string{"alb.ingress.kubernetes.io/web-acl-id": webACLARN},
+			Expected:    webACLARN,
+		},
+		{
+			Name:        "WAFv2 ARN in legacy waf-acl-id",
+			Annotations: map[string]string{"alb.ingress.kubernetes.io/waf-acl-id": webACLARN},
+			Expected:    webACLARN,
+		},
+		{
+			Name:        "WAF regional ID in web-acl-id",
+			Annotations: map[string]string{"alb.ingress.kubernetes.io/web-acl-id": "0aa00000-00a0-00a0-a0a0-0a0000a0000a"},
+			Expected:    "",
+		},
+		{
+			Name: "same WAFv2 ARN in both annotations",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/wafv2-acl-arn": webACLARN,
+				"alb.ingress.kubernetes.io/web-acl-id":    webACLARN,
+			},
+			Expected: webACLARN,
+		},
+		{
+			Name: "conflicting WAFv2 ARNs",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/wafv2-acl-arn": webACLARN,
+				"alb.ingress.kubernetes.io/web-acl-id":    otherWebACLARN,
+			},
+			ExpectedError: "conflicting WAFv2 webACLs " + webACLARN + " and " + otherWebACLARN + " in annotations wafv2-acl-arn and web-acl-id",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			controller := &defaultWAFV2Controller{webACLResolver: newWebACLResolver(&mocks.CloudAPI{})}
+			actual, err := controller.getDesiredWebACLARN(context.Background(), buildWAFV2TestIngress(tc.Annotations))
+			if tc.ExpectedError != "" {
+				assert.EqualError(t, err, tc.ExpectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.Expected, actual)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package lb
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/cache"
+)
+
+const (
+	webACLForNameCacheMaxSize = 1024
+	webACLForNameCacheTTL     = 10 * time.Minute
+)
+
+// webACLIdPattern matches the IDs of WAF regional webACLs.
+var webACLIdPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+// webACLResolver resolves the values of the WAF annotations naming a webACL, rather than specifying its ID or ARN.
+type webACLResolver struct {
+	cloud aws.CloudAPI
+
+	// cache that stores the ARN of the WAFv2 webACL or the ID of the WAF regional webACL for its name.
+	webACLForNameCache *cache.LRUExpireCache
+}
+
+func newWebACLResolver(cloud aws.CloudAPI) *webACLResolver {
+	return &webACLResolver{
+		cloud:              cloud,
+		webACLForNameCache: cache.NewLRUExpireCache(webACLForNameCacheMaxSize),
+	}
+}
+
+// resolve returns webACL if it's the ID of a WAF regional webACL or the ARN of a WAFv2 one,
+// and otherwise the ARN of the WAFv2 webACL it names, or else the ID of the WAF regional webACL it names.
+func (r *webACLResolver) resolve(ctx context.Context, webACL string) (string, error) {
+	if webACL == "" || webACLIdPattern.MatchString(webACL) || isWAFV2WebACLARN(webACL) {
+		return webACL, nil
+	}
+	if cached, exists := r.webACLForNameCache.Get(webACL); exists {
+		return cached.(string), nil
+	}
+
+	resolved, err := r.cloud.GetWAFV2WebACLARNByName(ctx, webACL)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to look up WAFv2 webACL %v", webACL)
+	}
+	if resolved == nil && r.cloud.WAFRegionalAvailable() {
+		if resolved, err = r.cloud.GetWebACLIdByName(ctx, webACL); err != nil {
+			return "", errors.Wrapf(err, "failed to look up webACL %v", webACL)
+		}
+	}
+	if resolved == nil {
+		return "", errors.Errorf("webACL %v not found", webACL)
+	}
+
+	r.webACLForNameCache.Add(webACL, aws.StringValue(resolved), webACLForNameCacheTTL)
+	return aws.StringValue(resolved), nil
+}
@@ -0,0 +1,92 @@
+package lb
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_webACLResolver_resolve(t *testing.T) {
+	const webACLARN = "arn:aws:wafv2:us-east-1:000000000000:regional/webacl/name/0aa00000-00a0-00a0-a0a0-0a0000a0000a"
+	const webACLId = "0bb00000-00b0-00b0-b0b0-0b0000b0000b"
+	for _, tc := range []struct {
+		Name                string
+		WebACL              string
+		WAFV2WebACLARN      *string
+		WAFRegionalWebACLId *string
+		WAFV2Error          error
+		Expected            string
+		ExpectedError       string
+	}{
+		{
+			Name:     "no webACL",
+			WebACL:   "",
+			Expected: "",
+		},
+		{
+			Name:     "WAF regional ID",
+			WebACL:   webACLId,
+			Expected: webACLId,
+		},
+		{
+			Name:     "WAFv2 ARN",
+			WebACL:   webACLARN,
+			Expected: webACLARN,
+		},
+		{
+			Name:           "name of a WAFv2 webACL",
+			WebACL:         "name",
+			WAFV2WebACLARN: aws.String(webACLARN),
+			Expected:       webACLARN,
+		},
+		{
+			Name:                "name of a WAF regional webACL",
+			WebACL:              "name",
+			WAFRegionalWebACLId: aws.String(webACLId),
+			Expected:            webACLId,
+		},
+		{
+			Name:          "name of no webACL",
+			WebACL:        "name",
+			ExpectedError: "webACL name not found",
+		},
+		{
+			Name:          "failed lookup",
+			WebACL:        "name",
+			WAFV2Error:    errors.New("access denied"),
+			ExpectedError: "failed to look up WAFv2 webACL name: access denied",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctx := context.Background()
+			cloud := &mocks.CloudAPI{}
+			cloud.On("GetWAFV2WebACLARNByName", ctx, tc.WebACL).Return(tc.WAFV2WebACLARN, tc.WAFV2Error)
+			cloud.On("WAFRegionalAvailable").Return(true)
+			cloud.On("GetWebACLIdByName", ctx, tc.WebACL).Return(tc.WAFRegionalWebACLId, nil)
+
+			r := newWebACLResolver(cloud)
+			for i := 0; i < 2; i++ {
+				actual, err := r.resolve(ctx, tc.WebACL)
+				if tc.ExpectedError != "" {
+					assert.EqualError(t, err, tc.ExpectedError)
+				} else {
+					assert.NoError(t, err)
+					assert.Equal(t, tc.Expected, actual)
+				}
+			}
+			// resolved names are cached, while the ones not found are looked up again as their webACL may have been created since
+			switch {
+			case tc.Expected == tc.WebACL:
+				cloud.AssertNotCalled(t, "GetWAFV2WebACLARNByName", ctx, tc.WebACL)
+			case tc.ExpectedError != "":
+				cloud.AssertNumberOfCalls(t, "GetWAFV2WebACLARNByName", 2)
+			default:
+				cloud.AssertNumberOfCalls(t, "GetWAFV2WebACLARNByName", 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package lb
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/service/arczonalshift"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/tracing"
+	corev1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+)
+
+const (
+	AnnotationZonalAutoshift            = "zonal-autoshift"
+	AnnotationPracticeRunOutcomeAlarm   = "practice-run-outcome-alarm"
+	AnnotationPracticeRunBlockingAlarm  = "practice-run-blocking-alarm"
+	AnnotationPracticeRunBlockedDates   = "practice-run-blocked-dates"
+	AnnotationPracticeRunBlockedWindows = "practice-run-blocked-windows"
+)
+
+// ZonalShiftController manages the zonal autoshift and practice runs of a LoadBalancer,
+// once it's registered for zonal shifts with the zonal_shift.config.enabled attribute.
+type ZonalShiftController interface {
+	// Reconcile ensures the practice runs and zonal autoshift of the LoadBalancer match the annotations of ingress.
+	// LoadBalancers of ingresses without any of the annotations are left alone.
+	Reconcile(ctx context.Context, lbArn string, ingress *extensions.Ingress) error
+}
+
+func NewZonalShiftController(cloud aws.CloudAPI) ZonalShiftController {
+	return &defaultZonalShiftController{
+		cloud: cloud,
+	}
+}
+
+type defaultZonalShiftController struct {
+	cloud aws.CloudAPI
+}
+
+type zonalShiftConfig struct {
+	autoshiftStatus string
+	// practiceRun is nil when practice runs are disabled.
+	practiceRun *arczonalshift.PracticeRunConfiguration
+}
+
+func (c *defaultZonalShiftController) Reconcile(ctx context.Context, lbArn string, ingress *extensions.Ingress) error {
+	ctx, span := tracing.StartSpan(ctx, "lb.ReconcileZonalShift")
+	defer span.End()
+
+	desired, err := buildZonalShiftConfig(ingress)
+	if err != nil || desired == nil {
+		return err
+	}
+	resource, err := c.cloud.GetZonalShiftManagedResource(ctx, lbArn)
+	if err != nil {
+		return fmt.Errorf("failed to get zonal shift configuration of %v due to %v", lbArn, err)
+	}
+	if resource == nil {
+		return fmt.Errorf("loadBalancer %v isn't registered for zonal shifts, it needs the load balancer attribute %v=true", lbArn, ZonalShiftConfigEnabledKey)
+	}
+
+	autoshiftStatus := aws.StringValue(resource.ZonalAutoshiftStatus)
+	if autoshiftStatus == "" {
+		autoshiftStatus = arczonalshift.ZonalAutoshiftStatusDisabled
+	}
+	current := resource.PracticeRunConfiguration
+	// zonal autoshift must be disabled before practice runs are deleted, and practice runs created before it's enabled.
+	disableAutoshift := func() error {
+		if autoshiftStatus != arczonalshift.ZonalAutoshiftStatusEnabled {
+			return nil
+		}
+		if err := c.setAutoshiftStatus(ctx, lbArn, arczonalshift.ZonalAutoshiftStatusDisabled); err != nil {
+			return err
+		}
+		autoshiftStatus = arczonalshift.ZonalAutoshiftStatusDisabled
+		return nil
+	}
+	deletePracticeRun := func() error {
+		if err := disableAutoshift(); err != nil {
+			return err
+		}
+		if err := c.cloud.DeletePracticeRunConfiguration(ctx, lbArn); err != nil {
+			albctx.GetEventf(ctx)(corev1.EventTypeWarning, "ERROR", "failed to delete practice runs of %v due to %v", lbArn, err)
+			return fmt.Errorf("failed to delete practice runs of %v due to %v", lbArn, err)
+		}
+		current = nil
+		return nil
+	}
+
+	switch {
+	case desired.practiceRun == nil:
+		if current != nil {
+			if err := deletePracticeRun(); err != nil {
+				return err
+			}
+			albctx.GetEventf(ctx)(corev1.EventTypeNormal, "DELETE", "practice runs of %v deleted", lbArn)
+		}
+	case current == nil:
+		if err := c.createPracticeRun(ctx, lbArn, desired.practiceRun); err != nil {
+			return err
+		}
+	case !practiceRunConfigEqual(current, desired.practiceRun):
+		// an update can't remove the blocking alarm, so the practice runs are recreated instead.
+		if len(current.BlockingAlarms) != 0 && len(desired.practiceRun.BlockingAlarms) == 0 {
+			if err := deletePracticeRun(); err != nil {
+				return err
+			}
+			if err := c.createPracticeRun(ctx, lbArn, desired.practiceRun); err != nil {
+				return err
+			}
+			break
+		}
+		if err := c.cloud.UpdatePracticeRunConfiguration(ctx, lbArn, desired.practiceRun); err != nil {
+			albctx.GetEventf(ctx)(corev1.EventTypeWarning, "ERROR", "failed to modify practice runs of %v due to %v", lbArn, err)
+			return fmt.Errorf("failed to modify practice runs of %v due to %v", lbArn, err)
+		}
+		albctx.GetEventf(ctx)(corev1.EventTypeNormal, "MODIFY", "practice runs of %v modified", lbArn)
+	}
+
+	if autoshiftStatus != desired.autoshiftStatus {
+		return c.setAutoshiftStatus(ctx, lbArn, desired.autoshiftStatus)
+	}
+	return nil
+}
+
+func (c *defaultZonalShiftController) createPracticeRun(ctx context.Context, lbArn string, config *arczonalshift.PracticeRunConfiguration) error {
+	if err := c.cloud.CreatePracticeRunConfiguration(ctx, lbArn, config); err != nil {
+		albctx.GetEventf(ctx)(corev1.EventTypeWarning, "ERROR", "failed to create practice runs of %v due to %v", lbArn, err)
+		return fmt.Errorf("failed to create practice runs of %v due to %v", lbArn, err)
+	}
+	albctx.GetEventf(ctx)(corev1.EventTypeNormal, "CREATE", "practice runs of %v created", lbArn)
+	return nil
+}
+
+func (c *defaultZonalShiftController) setAutoshiftStatus(ctx context.Context, lbArn string, status string) error {
+	if err := c.cloud.SetZonalAutoshiftStatus(ctx, lbArn, status); err != nil {
+		albctx.GetEventf(ctx)(corev1.EventTypeWarning, "ERROR", "failed to modify zonal autoshift of %v due to %v", lbArn, err)
+		return fmt.Errorf("failed to modify zonal autoshift of %v due to %v", lbArn, err)
+	}
+	albctx.GetEventf(ctx)(corev1.EventTypeNormal, "MODIFY", "zonal autoshift of %v %v", lbArn, status)
+	return nil
+}
+
+// buildZonalShiftConfig returns the zonal shift configuration of ingress, or nil if it has none of the annotations.
+func buildZonalShiftConfig(ingress *extensions.Ingress) (*zonalShiftConfig, error) {
+	var autoshift bool
+	autoshiftPresent, err := annotations.LoadBoolAnnocation(AnnotationZonalAutoshift, &autoshift, ingress.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	var outcomeAlarm, blockingAlarm string
+	var blockedDates, blockedWindows []string
+	outcomeAlarmPresent := annotations.LoadStringAnnotation(AnnotationPracticeRunOutcomeAlarm, &outcomeAlarm, ingress.Annotations)
+	blockingAlarmPresent := annotations.LoadStringAnnotation(AnnotationPracticeRunBlockingAlarm, &blockingAlarm, ingress.Annotations)
+	blockedDatesPresent := annotations.LoadStringSliceAnnotation(AnnotationPracticeRunBlockedDates, &blockedDates, ingress.Annotations)
+	blockedWindowsPresent := annotations.LoadStringSliceAnnotation(AnnotationPracticeRunBlockedWindows, &blockedWindows, ingress.Annotations)
+	if !autoshiftPresent && !outcomeAlarmPresent && !blockingAlarmPresent && !blockedDatesPresent && !blockedWindowsPresent {
+		return nil, nil
+	}
+
+	config := &zonalShiftConfig{autoshiftStatus: arczonalshift.ZonalAutoshiftStatusDisabled}
+	if autoshift {
+		config.autoshiftStatus = arczonalshift.ZonalAutoshiftStatusEnabled
+	}
+	if outcomeAlarm == "" {
+		if autoshift {
+			return nil, fmt.Errorf("%v requires practice runs, configured with %v", AnnotationZonalAutoshift, AnnotationPracticeRunOutcomeAlarm)
+		}
+		if blockingAlarm != "" || len(blockedDates) != 0 || len(blockedWindows) != 0 {
+			return nil, fmt.Errorf("practice runs must have an outcome alarm, configured with %v", AnnotationPracticeRunOutcomeAlarm)
+		}
+		return config, nil
+	}
+
+	config.practiceRun = &arczonalshift.PracticeRunConfiguration{
+		OutcomeAlarms:  []*arczonalshift.ControlCondition{cloudWatchControlCondition(outcomeAlarm)},
+		BlockedDates:   aws.StringSlice(blockedDates),
+		BlockedWindows: aws.StringSlice(blockedWindows),
+	}
+	if blockingAlarm != "" {
+		config.practiceRun.BlockingAlarms = []*arczonalshift.ControlCondition{cloudWatchControlCondition(blockingAlarm)}
+	}
+	return config, nil
+}
+
+func cloudWatchControlCondition(alarmArn string) *arczonalshift.ControlCondition {
+	return &arczonalshift.ControlCondition{
+		AlarmIdentifier: aws.String(alarmArn),
+		Type:            aws.String(arczonalshift.ControlConditionTypeCloudwatch),
+	}
+}
+
+func practiceRunConfigEqual(a, b *arczonalshift.PracticeRunConfiguration) bool {
+	alarms := func(conditions []*arczonalshift.ControlCondition) []string {
+		var arns []string
+		for _, condition := range conditions {
+			arns = append(arns, aws.StringValue(condition.AlarmIdentifier))
+		}
+		sort.Strings(arns)
+		return arns
+	}
+	sorted := func(values []*string) []string {
+		var s []string
+		for _, v := range values {
+			s = append(s, aws.StringValue(v))
+		}
+		sort.Strings(s)
+		return s
+	}
+	return reflect.DeepEqual(alarms(a.OutcomeAlarms), alarms(b.OutcomeAlarms)) &&
+		reflect.DeepEqual(alarms(a.BlockingAlarms), alarms(b.BlockingAlarms)) &&
+		reflect.DeepEqual(sorted(a.BlockedDates), sorted(b.BlockedDates)) &&
+		reflect.DeepEqual(sorted(a.BlockedWindows), sorted(b.BlockedWindows))
+}
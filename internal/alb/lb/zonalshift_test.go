@@ -0,0 +1,133 @@
+package lb
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/arczonalshift"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	albtesting "github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/testing"
+	"github.com/stretchr/testify/assert"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDefaultZonalShiftController_Reconcile(t *testing.T) {
+	outcomeAlarm := "arn:aws:cloudwatch:us-west-2:123456789012:alarm:outcome"
+	blockingAlarm := "arn:aws:cloudwatch:us-west-2:123456789012:alarm:blocking"
+	var events []string
+	ctx := albctx.SetEventf(context.Background(), func(eventType, reason, format string, vals ...interface{}) {
+		events = append(events, reason+" "+fmt.Sprintf(format, vals...))
+	})
+	cloud := albtesting.NewCloud("vpc-1", "cluster")
+	output, err := cloud.CreateLoadBalancerWithContext(ctx, &elbv2.CreateLoadBalancerInput{Name: aws.String("lb")})
+	assert.NoError(t, err)
+	lbArn := aws.StringValue(output.LoadBalancers[0].LoadBalancerArn)
+	controller := NewZonalShiftController(cloud)
+	ingress := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ingress"}}
+
+	assert.NoError(t, controller.Reconcile(ctx, lbArn, ingress), "ingresses without annotations are left alone")
+
+	ingress.Annotations = map[string]string{
+		"alb.ingress.kubernetes.io/zonal-autoshift":             "true",
+		"alb.ingress.kubernetes.io/practice-run-outcome-alarm":  outcomeAlarm,
+		"alb.ingress.kubernetes.io/practice-run-blocking-alarm": blockingAlarm,
+		"alb.ingress.kubernetes.io/practice-run-blocked-dates":  "2020-12-24, 2020-12-25",
+	}
+	assert.EqualError(t, controller.Reconcile(ctx, lbArn, ingress),
+		fmt.Sprintf("loadBalancer %v isn't registered for zonal shifts, it needs the load balancer attribute zonal_shift.config.enabled=true", lbArn))
+
+	_, err = cloud.ModifyLoadBalancerAttributesWithContext(ctx, &elbv2.ModifyLoadBalancerAttributesInput{
+		LoadBalancerArn: aws.String(lbArn),
+		Attributes:      []*elbv2.LoadBalancerAttribute{lbAttribute(ZonalShiftConfigEnabledKey, "true")},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, controller.Reconcile(ctx, lbArn, ingress))
+	resource, err := cloud.GetZonalShiftManagedResource(ctx, lbArn)
+	assert.NoError(t, err)
+	assert.Equal(t, arczonalshift.ZonalAutoshiftStatusEnabled, aws.StringValue(resource.ZonalAutoshiftStatus))
+	assert.Equal(t, &arczonalshift.PracticeRunConfiguration{
+		OutcomeAlarms:  []*arczonalshift.ControlCondition{cloudWatchControlCondition(outcomeAlarm)},
+		BlockingAlarms: []*arczonalshift.ControlCondition{cloudWatchControlCondition(blockingAlarm)},
+		BlockedDates:   aws.StringSlice([]string{"2020-12-24", "2020-12-25"}),
+		BlockedWindows: []*string{},
+	}, resource.PracticeRunConfiguration)
+
+	events = nil
+	assert.NoError(t, controller.Reconcile(ctx, lbArn, ingress))
+	assert.Nil(t, events, "unchanged configuration should not be modified")
+
+	delete(ingress.Annotations, "alb.ingress.kubernetes.io/practice-run-blocking-alarm")
+	assert.NoError(t, controller.Reconcile(ctx, lbArn, ingress))
+	resource, _ = cloud.GetZonalShiftManagedResource(ctx, lbArn)
+	assert.Equal(t, arczonalshift.ZonalAutoshiftStatusEnabled, aws.StringValue(resource.ZonalAutoshiftStatus))
+	assert.Nil(t, resource.PracticeRunConfiguration.BlockingAlarms)
+	assert.Equal(t, []string{
+		"MODIFY zonal autoshift of " + lbArn + " DISABLED",
+		"CREATE practice runs of " + lbArn + " created",
+		"MODIFY zonal autoshift of " + lbArn + " ENABLED",
+	}, events, "removing the blocking alarm recreates the practice runs")
+
+	ingress.Annotations = map[string]string{"alb.ingress.kubernetes.io/zonal-autoshift": "false"}
+	assert.NoError(t, controller.Reconcile(ctx, lbArn, ingress))
+	resource, _ = cloud.GetZonalShiftManagedResource(ctx, lbArn)
+	assert.Equal(t, arczonalshift.ZonalAutoshiftStatusDisabled, aws.StringValue(resource.ZonalAutoshiftStatus))
+	assert.Nil(t, resource.PracticeRunConfiguration)
+}
+
+func TestBuildZonalShiftConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		annotations map[string]string
+		expected    *zonalShiftConfig
+		expectedErr string
+	}{
+		{
+			name:     "no annotations",
+			expected: nil,
+		},
+		{
+			name:        "autoshift without practice runs",
+			annotations: map[string]string{"alb.ingress.kubernetes.io/zonal-autoshift": "true"},
+			expectedErr: "zonal-autoshift requires practice runs, configured with practice-run-outcome-alarm",
+		},
+		{
+			name:        "practice runs without outcome alarm",
+			annotations: map[string]string{"alb.ingress.kubernetes.io/practice-run-blocked-windows": "Mon:00:00-Mon:08:00"},
+			expectedErr: "practice runs must have an outcome alarm, configured with practice-run-outcome-alarm",
+		},
+		{
+			name:        "invalid autoshift",
+			annotations: map[string]string{"alb.ingress.kubernetes.io/zonal-autoshift": "maybe"},
+			expectedErr: `failed to parse annotation, alb.ingress.kubernetes.io/zonal-autoshift: maybe: strconv.ParseBool: parsing "maybe": invalid syntax`,
+		},
+		{
+			name: "practice runs without autoshift",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/practice-run-outcome-alarm":   "arn:aws:cloudwatch:us-west-2:123456789012:alarm:outcome",
+				"alb.ingress.kubernetes.io/practice-run-blocked-windows": "Mon:00:00-Mon:08:00",
+			},
+			expected: &zonalShiftConfig{
+				autoshiftStatus: arczonalshift.ZonalAutoshiftStatusDisabled,
+				practiceRun: &arczonalshift.PracticeRunConfiguration{
+					OutcomeAlarms:  []*arczonalshift.ControlCondition{cloudWatchControlCondition("arn:aws:cloudwatch:us-west-2:123456789012:alarm:outcome")},
+					BlockedDates:   []*string{},
+					BlockedWindows: aws.StringSlice([]string{"Mon:00:00-Mon:08:00"}),
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			config, err := buildZonalShiftConfig(&extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations}})
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, config)
+			}
+		})
+	}
+}
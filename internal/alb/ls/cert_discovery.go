@@ -16,37 +16,70 @@ import (
 const (
 	// the domain names for imported certificate will be cached for 1 minute.(cache invalidation is hard problem right? :D)
 	importedCertDomainsCacheDuration = 1 * time.Minute
+	// tags of certificates can be changed anytime, so they are only cached briefly.
+	certTagsCacheDuration = 1 * time.Minute
+	// the issued certificates are listed at most once a minute, as every HTTPS listener without certificate annotation discovers them.
+	certARNsCacheDuration = 1 * time.Minute
 )
 
+const certARNsCacheKey = "issuedCertARNs"
+
+// CertFilter restricts the certificates Discover may pick, the zero value allows all certificates.
+type CertFilter struct {
+	// CertificateAuthorityARN only allows private certificates issued by this ACM Private CA.
+	CertificateAuthorityARN string
+	// Tags only allows certificates carrying all of these tags.
+	Tags map[string]string
+}
+
 type CertDiscovery interface {
-	// Discover will try to find valid certificates for each tlsHost.
-	Discover(ctx context.Context, tlsHosts sets.String) ([]string, error)
+	// Discover will try to find valid certificates for each tlsHost, among the certificates allowed by filter.
+	Discover(ctx context.Context, tlsHosts sets.String, filter CertFilter) ([]string, error)
 }
 
 func NewACMCertDiscovery(cloud aws.CloudAPI) CertDiscovery {
 	return &acmCertDiscovery{
 		cloud:            cloud,
+		certARNsCache:    utils.NewCache(),
 		certDomainsCache: utils.NewCache(),
+		certTagsCache:    utils.NewCache(),
 	}
 }
 
 type acmCertDiscovery struct {
 	cloud            aws.CloudAPI
+	certARNsCache    utils.Cache
 	certDomainsCache utils.Cache
+	certTagsCache    utils.Cache
+}
+
+// certInfo is what is cached about a certificate, to match it against hosts and CertFilter.
+type certInfo struct {
+	domains sets.String
+	caArn   string
 }
 
-func (d *acmCertDiscovery) Discover(ctx context.Context, tlsHosts sets.String) ([]string, error) {
-	domainsByCertArn, err := d.loadDomainsForCertificates(ctx)
+func (d *acmCertDiscovery) Discover(ctx context.Context, tlsHosts sets.String, filter CertFilter) ([]string, error) {
+	infoByCertArn, err := d.loadDomainsForCertificates(ctx)
 	if err != nil {
 		return nil, err
 	}
 	certArns := sets.NewString()
 	for host := range tlsHosts {
 		certArnsForHost := sets.NewString()
-		for certArn, domains := range domainsByCertArn {
-			for domain := range domains {
+		excludedCertArns := sets.NewString()
+		for certArn, info := range infoByCertArn {
+			for domain := range info.domains {
 				if d.domainMatchesHost(domain, host) {
-					certArnsForHost.Insert(certArn)
+					allowed, err := d.allowedByFilter(ctx, certArn, info, filter)
+					if err != nil {
+						return nil, err
+					}
+					if allowed {
+						certArnsForHost.Insert(certArn)
+					} else {
+						excludedCertArns.Insert(certArn)
+					}
 					break
 				}
 			}
@@ -54,6 +87,9 @@ func (d *acmCertDiscovery) Discover(ctx context.Context, tlsHosts sets.String) (
 		if len(certArnsForHost) > 1 {
 			return nil, errors.Errorf("multiple certificate found for host: %s, certARNs: %v", host, certArnsForHost.List())
 		}
+		if len(certArnsForHost) == 0 && len(excludedCertArns) != 0 {
+			return nil, errors.Errorf("none certificate found for host: %s, certARNs excluded by discovery filters: %v", host, excludedCertArns.List())
+		}
 		if len(certArnsForHost) == 0 {
 			return nil, errors.Errorf("none certificate found for host: %s", host)
 		}
@@ -62,42 +98,92 @@ func (d *acmCertDiscovery) Discover(ctx context.Context, tlsHosts sets.String) (
 	return certArns.List(), nil
 }
 
-func (d *acmCertDiscovery) loadDomainsForCertificates(ctx context.Context) (map[string]sets.String, error) {
+func (d *acmCertDiscovery) allowedByFilter(ctx context.Context, certArn string, info certInfo, filter CertFilter) (bool, error) {
+	if filter.CertificateAuthorityARN != "" && info.caArn != filter.CertificateAuthorityARN {
+		return false, nil
+	}
+	if len(filter.Tags) == 0 {
+		return true, nil
+	}
+	tags, err := d.loadTagsForCertificate(ctx, certArn)
+	if err != nil {
+		return false, err
+	}
+	for key, value := range filter.Tags {
+		if actual, ok := tags[key]; !ok || actual != value {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (d *acmCertDiscovery) loadDomainsForCertificates(ctx context.Context) (map[string]certInfo, error) {
+	certArns, err := d.loadIssuedCertificateARNs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	infoByCertArn := make(map[string]certInfo, len(certArns))
+	for _, certArn := range certArns {
+		info, err := d.loadDomainsForCertificate(ctx, certArn)
+		if err != nil {
+			return nil, err
+		}
+		infoByCertArn[certArn] = info
+	}
+	d.certDomainsCache.Shrink(sets.StringKeySet(infoByCertArn))
+	d.certTagsCache.Shrink(sets.StringKeySet(infoByCertArn))
+	return infoByCertArn, nil
+}
+
+func (d *acmCertDiscovery) loadIssuedCertificateARNs(ctx context.Context) ([]string, error) {
+	if certArns, ok := d.certARNsCache.Get(certARNsCacheKey); ok {
+		return certArns.([]string), nil
+	}
 	certSummaries, err := d.cloud.ListCertificates(ctx, &acm.ListCertificatesInput{
 		CertificateStatuses: aws.StringSlice([]string{acm.CertificateStatusIssued}),
 	})
 	if err != nil {
 		return nil, err
 	}
-	domainsByCertArn := make(map[string]sets.String, len(certSummaries))
+	certArns := make([]string, 0, len(certSummaries))
 	for _, certSummary := range certSummaries {
-		certArn := aws.StringValue(certSummary.CertificateArn)
-		certDomains, err := d.loadDomainsForCertificate(ctx, certArn)
-		if err != nil {
-			return nil, err
-		}
-		domainsByCertArn[certArn] = certDomains
+		certArns = append(certArns, aws.StringValue(certSummary.CertificateArn))
 	}
-	d.certDomainsCache.Shrink(sets.StringKeySet(domainsByCertArn))
-	return domainsByCertArn, nil
+	d.certARNsCache.Set(certARNsCacheKey, certArns, certARNsCacheDuration)
+	return certArns, nil
 }
 
-func (d *acmCertDiscovery) loadDomainsForCertificate(ctx context.Context, certArn string) (sets.String, error) {
-	if domains, ok := d.certDomainsCache.Get(certArn); ok {
-		return domains.(sets.String), nil
+func (d *acmCertDiscovery) loadDomainsForCertificate(ctx context.Context, certArn string) (certInfo, error) {
+	if info, ok := d.certDomainsCache.Get(certArn); ok {
+		return info.(certInfo), nil
 	}
 	certDetail, err := d.cloud.DescribeCertificate(ctx, certArn)
 	if err != nil {
-		return nil, err
+		return certInfo{}, err
+	}
+	info := certInfo{
+		domains: sets.NewString(aws.StringValueSlice(certDetail.SubjectAlternativeNames)...),
+		caArn:   aws.StringValue(certDetail.CertificateAuthorityArn),
 	}
-	domains := sets.NewString(aws.StringValueSlice(certDetail.SubjectAlternativeNames)...)
 	switch aws.StringValue(certDetail.Type) {
 	case acm.CertificateTypeAmazonIssued, acm.CertificateTypePrivate:
-		d.certDomainsCache.Set(certArn, domains, utils.CacheNoExpiration)
+		d.certDomainsCache.Set(certArn, info, utils.CacheNoExpiration)
 	case acm.CertificateTypeImported:
-		d.certDomainsCache.Set(certArn, domains, importedCertDomainsCacheDuration)
+		d.certDomainsCache.Set(certArn, info, importedCertDomainsCacheDuration)
+	}
+	return info, nil
+}
+
+func (d *acmCertDiscovery) loadTagsForCertificate(ctx context.Context, certArn string) (map[string]string, error) {
+	if tags, ok := d.certTagsCache.Get(certArn); ok {
+		return tags.(map[string]string), nil
+	}
+	tags, err := d.cloud.ListTagsForCertificate(ctx, certArn)
+	if err != nil {
+		return nil, err
 	}
-	return domains, nil
+	d.certTagsCache.Set(certArn, tags, certTagsCacheDuration)
+	return tags, nil
 }
 
 func (d *acmCertDiscovery) domainMatchesHost(domainName string, tlsHost string) bool {
@@ -24,14 +24,22 @@ type describeCertificateCall struct {
 	err     error
 }
 
+type listTagsForCertificateCall struct {
+	certArn string
+	output  map[string]string
+	err     error
+}
+
 func Test_CertDiscovery_Discover(t *testing.T) {
 	for _, tc := range []struct {
-		name                     string
-		hosts                    []string
-		listCertificateCall      *listCertificatesCall
-		describeCertificateCalls []describeCertificateCall
-		expectedCerts            []string
-		expectedErr              string
+		name                        string
+		hosts                       []string
+		filter                      CertFilter
+		listCertificateCall         *listCertificatesCall
+		describeCertificateCalls    []describeCertificateCall
+		listTagsForCertificateCalls []listTagsForCertificateCall
+		expectedCerts               []string
+		expectedErr                 string
 	}{
 		{
 			name:  "when ACM has exact match with TLS host",
@@ -157,6 +165,101 @@ func Test_CertDiscovery_Discover(t *testing.T) {
 			expectedCerts: nil,
 			expectedErr:   "none certificate found for host: foo.example.com",
 		},
+		{
+			name:   "when filtered by private CA",
+			hosts:  []string{"foo.example.com"},
+			filter: CertFilter{CertificateAuthorityARN: "arn:aws:acm-pca:us-west-2:xxx:certificate-authority/ca"},
+			listCertificateCall: &listCertificatesCall{
+				input: &acm.ListCertificatesInput{CertificateStatuses: aws.StringSlice([]string{acm.CertificateStatusIssued})},
+				output: []*acm.CertificateSummary{
+					{CertificateArn: aws.String("arn:aws:acm:us-west-2:xxx:certificate/public")},
+					{CertificateArn: aws.String("arn:aws:acm:us-west-2:xxx:certificate/private")},
+				},
+			},
+			describeCertificateCalls: []describeCertificateCall{
+				{
+					certArn: "arn:aws:acm:us-west-2:xxx:certificate/public",
+					output: &acm.CertificateDetail{
+						SubjectAlternativeNames: aws.StringSlice([]string{"*.example.com"}),
+						Type:                    aws.String(acm.CertificateTypeAmazonIssued),
+					},
+				},
+				{
+					certArn: "arn:aws:acm:us-west-2:xxx:certificate/private",
+					output: &acm.CertificateDetail{
+						SubjectAlternativeNames: aws.StringSlice([]string{"foo.example.com"}),
+						Type:                    aws.String(acm.CertificateTypePrivate),
+						CertificateAuthorityArn: aws.String("arn:aws:acm-pca:us-west-2:xxx:certificate-authority/ca"),
+					},
+				},
+			},
+			expectedCerts: []string{"arn:aws:acm:us-west-2:xxx:certificate/private"},
+		},
+		{
+			name:   "when filtered by tags",
+			hosts:  []string{"foo.example.com"},
+			filter: CertFilter{Tags: map[string]string{"team": "web", "ingress": "true"}},
+			listCertificateCall: &listCertificatesCall{
+				input: &acm.ListCertificatesInput{CertificateStatuses: aws.StringSlice([]string{acm.CertificateStatusIssued})},
+				output: []*acm.CertificateSummary{
+					{CertificateArn: aws.String("arn:aws:acm:us-west-2:xxx:certificate/yyy")},
+					{CertificateArn: aws.String("arn:aws:acm:us-west-2:xxx:certificate/zzz")},
+					{CertificateArn: aws.String("arn:aws:acm:us-west-2:xxx:certificate/other")},
+				},
+			},
+			describeCertificateCalls: []describeCertificateCall{
+				{
+					certArn: "arn:aws:acm:us-west-2:xxx:certificate/yyy",
+					output: &acm.CertificateDetail{
+						SubjectAlternativeNames: aws.StringSlice([]string{"foo.example.com"}),
+					},
+				},
+				{
+					certArn: "arn:aws:acm:us-west-2:xxx:certificate/zzz",
+					output: &acm.CertificateDetail{
+						SubjectAlternativeNames: aws.StringSlice([]string{"foo.example.com"}),
+					},
+				},
+				{
+					certArn: "arn:aws:acm:us-west-2:xxx:certificate/other",
+					output: &acm.CertificateDetail{
+						SubjectAlternativeNames: aws.StringSlice([]string{"bar.example.com"}),
+					},
+				},
+			},
+			listTagsForCertificateCalls: []listTagsForCertificateCall{
+				{
+					certArn: "arn:aws:acm:us-west-2:xxx:certificate/yyy",
+					output:  map[string]string{"team": "web"},
+				},
+				{
+					certArn: "arn:aws:acm:us-west-2:xxx:certificate/zzz",
+					output:  map[string]string{"team": "web", "ingress": "true", "env": "prod"},
+				},
+			},
+			expectedCerts: []string{"arn:aws:acm:us-west-2:xxx:certificate/zzz"},
+		},
+		{
+			name:   "when all matches are excluded by filter",
+			hosts:  []string{"foo.example.com"},
+			filter: CertFilter{CertificateAuthorityARN: "arn:aws:acm-pca:us-west-2:xxx:certificate-authority/ca"},
+			listCertificateCall: &listCertificatesCall{
+				input: &acm.ListCertificatesInput{CertificateStatuses: aws.StringSlice([]string{acm.CertificateStatusIssued})},
+				output: []*acm.CertificateSummary{
+					{CertificateArn: aws.String("arn:aws:acm:us-west-2:xxx:certificate/yyy")},
+				},
+			},
+			describeCertificateCalls: []describeCertificateCall{
+				{
+					certArn: "arn:aws:acm:us-west-2:xxx:certificate/yyy",
+					output: &acm.CertificateDetail{
+						SubjectAlternativeNames: aws.StringSlice([]string{"foo.example.com"}),
+					},
+				},
+			},
+			expectedCerts: nil,
+			expectedErr:   "none certificate found for host: foo.example.com, certARNs excluded by discovery filters: [arn:aws:acm:us-west-2:xxx:certificate/yyy]",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := context.Background()
@@ -167,19 +270,43 @@ func Test_CertDiscovery_Discover(t *testing.T) {
 			for _, call := range tc.describeCertificateCalls {
 				mockedCloud.On("DescribeCertificate", ctx, call.certArn).Return(call.output, call.err)
 			}
+			for _, call := range tc.listTagsForCertificateCalls {
+				mockedCloud.On("ListTagsForCertificate", ctx, call.certArn).Return(call.output, call.err)
+			}
 
 			certDiscovery := NewACMCertDiscovery(mockedCloud)
-			certArns, err := certDiscovery.Discover(ctx, sets.NewString(tc.hosts...))
+			certArns, err := certDiscovery.Discover(ctx, sets.NewString(tc.hosts...), tc.filter)
 			if tc.expectedErr != "" {
 				assert.EqualError(t, err, tc.expectedErr)
 			} else {
 				assert.Nil(t, err)
 			}
 			assert.ElementsMatch(t, certArns, tc.expectedCerts)
+			mockedCloud.AssertExpectations(t)
 		})
 	}
 }
 
+func Test_CertDiscovery_Discover_cachesCertificateList(t *testing.T) {
+	ctx := context.Background()
+	certArn := "arn:aws:acm:us-west-2:xxx:certificate/xxx"
+	mockedCloud := &mocks.CloudAPI{}
+	mockedCloud.On("ListCertificates", ctx, &acm.ListCertificatesInput{CertificateStatuses: aws.StringSlice([]string{acm.CertificateStatusIssued})}).
+		Return([]*acm.CertificateSummary{{CertificateArn: aws.String(certArn)}}, nil).Once()
+	mockedCloud.On("DescribeCertificate", ctx, certArn).Return(&acm.CertificateDetail{
+		SubjectAlternativeNames: aws.StringSlice([]string{"*.example.com"}),
+		Type:                    aws.String(acm.CertificateTypeAmazonIssued),
+	}, nil).Once()
+
+	certDiscovery := NewACMCertDiscovery(mockedCloud)
+	for _, host := range []string{"foo.example.com", "bar.example.com"} {
+		certArns, err := certDiscovery.Discover(ctx, sets.NewString(host), CertFilter{})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{certArn}, certArns)
+	}
+	mockedCloud.AssertExpectations(t)
+}
+
 func Test_domainMatchesHost(t *testing.T) {
 	var tests = []struct {
 		domain string
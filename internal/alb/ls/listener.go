@@ -3,6 +3,8 @@ package ls
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/util/sets"
 
@@ -11,6 +13,7 @@ import (
 
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/auth"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/tg"
@@ -20,12 +23,24 @@ import (
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/action"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/loadbalancer"
 	util "github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/util/types"
+	corev1 "k8s.io/api/core/v1"
 	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 const (
 	AnnotationSSLPolicy      = "ssl-policy"
 	AnnotationCertificateARN = "certificate-arn"
+
+	// AnnotationCertificateDiscoveryCAARN and AnnotationCertificateDiscoveryTags restrict the certificates auto-discovered for the hosts of an ingress.
+	AnnotationCertificateDiscoveryCAARN = "certificate-discovery-ca-arn"
+	AnnotationCertificateDiscoveryTags  = "certificate-discovery-tags"
+
+	// AnnotationSSLRedirect redirects the requests of HTTP listeners to the HTTPS listen-port it specifies, instead of routing them by rules.
+	AnnotationSSLRedirect = "ssl-redirect"
+
+	// AnnotationDefaultAction names the actions.${action-name} annotation of the action taken on requests matching no rule, instead of the default backend.
+	AnnotationDefaultAction = "default-action"
 )
 
 const (
@@ -39,13 +54,17 @@ type ReconcileOptions struct {
 	Port         loadbalancer.PortData
 	TGGroup      tg.TargetGroupGroup
 
+	// Members are the ingresses of the ingress group sharing the listener, whose rules replace the ones of Ingress.
+	// The default backend of the listener is the one of the first member having one, or a default action.
+	Members []Member
+
 	// If instance is specified, reconcile will operate on this instance, otherwise new listener instance will be created.
 	Instance *elbv2.Listener
 }
 
 type Controller interface {
-	// Reconcile will make sure an AWS listener exists to satisfy requirements specified as options.
-	Reconcile(ctx context.Context, options ReconcileOptions) error
+	// Reconcile will make sure an AWS listener exists to satisfy requirements specified as options, and returns it.
+	Reconcile(ctx context.Context, options ReconcileOptions) (*elbv2.Listener, error)
 }
 
 func NewController(cloud aws.CloudAPI, authModule auth.Module) Controller {
@@ -74,36 +93,57 @@ type listenerConfig struct {
 	SslPolicy            *string
 	DefaultCertificate   []*elbv2.Certificate
 	ExtraCertificateARNs []string
+	MutualAuthentication *elbv2.MutualAuthenticationAttributes
 }
 
-func (controller *defaultController) Reconcile(ctx context.Context, options ReconcileOptions) error {
+func (controller *defaultController) Reconcile(ctx context.Context, options ReconcileOptions) (*elbv2.Listener, error) {
 	config, err := controller.buildListenerConfig(ctx, options)
 	if err != nil {
-		return fmt.Errorf("failed to build listener config due to %v", err)
+		return nil, fmt.Errorf("failed to build listener config due to %v", err)
 	}
 
 	instance := options.Instance
+	// the sslPolicy is only validated when it's assigned, rather than on each reconcile.
+	if config.SslPolicy != nil && (instance == nil || aws.StringValue(instance.SslPolicy) != aws.StringValue(config.SslPolicy)) {
+		if err := controller.validateSSLPolicy(ctx, aws.StringValue(config.SslPolicy)); err != nil {
+			return nil, err
+		}
+	}
 	if instance == nil {
 		if instance, err = controller.newLSInstance(ctx, options.LBArn, config); err != nil {
-			return fmt.Errorf("failed to create listener due to %v", err)
+			return nil, fmt.Errorf("failed to create listener due to %v", err)
 		}
 	} else {
 		if instance, err = controller.reconcileLSInstance(ctx, instance, config); err != nil {
-			return fmt.Errorf("failed to reconcile listener due to %v", err)
+			return nil, fmt.Errorf("failed to reconcile listener due to %v", err)
 		}
 	}
 
-	if options.Port.Scheme == elbv2.ProtocolEnumHttps {
+	if isSecureProtocol(options.Port.Scheme) {
 		lsArn := aws.StringValue(instance.ListenerArn)
 		if err := controller.reconcileExtraCertificates(ctx, lsArn, config.ExtraCertificateARNs); err != nil {
-			return errors.Wrapf(err, "failed to reconcile extra certificates on listener %v", lsArn)
+			return nil, errors.Wrapf(err, "failed to reconcile extra certificates on listener %v", lsArn)
+		}
+		if config.MutualAuthentication != nil {
+			if err := controller.reconcileMutualAuthentication(ctx, instance, config.MutualAuthentication); err != nil {
+				return nil, errors.Wrapf(err, "failed to reconcile mutual authentication on listener %v", lsArn)
+			}
 		}
 	}
 
-	if err := controller.rulesController.Reconcile(ctx, instance, options.Ingress, options.IngressAnnos, options.TGGroup); err != nil {
-		return fmt.Errorf("failed to reconcile rules due to %v", err)
+	if isNetworkProtocol(options.Port.Scheme) {
+		// listeners of network LoadBalancers have no rules.
+		return instance, nil
 	}
-	return nil
+	if options.Members != nil {
+		err = controller.rulesController.ReconcileMembers(ctx, instance, options.Members)
+	} else {
+		err = controller.rulesController.Reconcile(ctx, instance, options.Ingress, options.IngressAnnos, options.TGGroup)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconcile rules due to %v", err)
+	}
+	return instance, nil
 }
 
 func (controller *defaultController) newLSInstance(ctx context.Context, lbArn string, config listenerConfig) (*elbv2.Listener, error) {
@@ -144,23 +184,23 @@ func (controller *defaultController) reconcileLSInstance(ctx context.Context, in
 func (controller *defaultController) LSInstanceNeedsModification(ctx context.Context, instance *elbv2.Listener, config listenerConfig) bool {
 	needModification := false
 	if !util.DeepEqual(instance.Port, config.Port) {
-		albctx.GetLogger(ctx).DebugLevelf(1, "listener port needs modification: %v => %v", awsutil.Prettify(instance.Port), awsutil.Prettify(config.Port))
+		albctx.GetLogger(ctx).Debugf("listener port needs modification: %v => %v", awsutil.Prettify(instance.Port), awsutil.Prettify(config.Port))
 		needModification = true
 	}
 	if !util.DeepEqual(instance.Protocol, config.Protocol) {
-		albctx.GetLogger(ctx).DebugLevelf(1, "listener protocol needs modification: %v => %v", awsutil.Prettify(instance.Protocol), awsutil.Prettify(config.Protocol))
+		albctx.GetLogger(ctx).Debugf("listener protocol needs modification: %v => %v", awsutil.Prettify(instance.Protocol), awsutil.Prettify(config.Protocol))
 		needModification = true
 	}
 	if !util.DeepEqual(instance.Certificates, config.DefaultCertificate) {
-		albctx.GetLogger(ctx).DebugLevelf(1, "listener certificates needs modification: %v => %v", awsutil.Prettify(instance.Certificates), awsutil.Prettify(config.DefaultCertificate))
+		albctx.GetLogger(ctx).Debugf("listener certificates needs modification: %v => %v", awsutil.Prettify(instance.Certificates), awsutil.Prettify(config.DefaultCertificate))
 		needModification = true
 	}
 	if !util.DeepEqual(instance.SslPolicy, config.SslPolicy) {
-		albctx.GetLogger(ctx).DebugLevelf(1, "listener sslPolicy needs modification: %v => %v", awsutil.Prettify(instance.SslPolicy), awsutil.Prettify(config.SslPolicy))
+		albctx.GetLogger(ctx).Debugf("listener sslPolicy needs modification: %v => %v", awsutil.Prettify(instance.SslPolicy), awsutil.Prettify(config.SslPolicy))
 		needModification = true
 	}
 	if !actionsMatches(instance.DefaultActions, config.DefaultActions) {
-		albctx.GetLogger(ctx).DebugLevelf(1, "listener defaultActions needs modification",
+		albctx.GetLogger(ctx).Debugf("listener defaultActions needs modification: %v => %v",
 			awsutil.Prettify(redactActions(instance.DefaultActions)),
 			awsutil.Prettify(redactActions(config.DefaultActions)))
 		needModification = true
@@ -217,9 +257,11 @@ func (controller *defaultController) buildListenerConfig(ctx context.Context, op
 		Port:     aws.Int64(options.Port.Port),
 		Protocol: aws.String(options.Port.Scheme),
 	}
-	if options.Port.Scheme == elbv2.ProtocolEnumHttps {
-		sslPolicy := DefaultSSLPolicy
-		_ = annotations.LoadStringAnnotation(AnnotationSSLPolicy, &sslPolicy, options.Ingress.Annotations)
+	if isSecureProtocol(options.Port.Scheme) {
+		sslPolicy, err := BuildSSLPolicy(options.Ingress.Annotations, options.Port.Port)
+		if err != nil {
+			return config, err
+		}
 		config.SslPolicy = aws.String(sslPolicy)
 
 		var certificateARNs []string
@@ -238,6 +280,13 @@ func (controller *defaultController) buildListenerConfig(ctx context.Context, op
 			albctx.GetLogger(ctx).Infof("Auto-detected and added %d certificates to listener", len(certs))
 			certificateARNs = certs
 		}
+		certificateARNs = uniqueCertificateARNs(certificateARNs)
+		partition := aws.ARNPartition(options.LBArn)
+		for _, certificateARN := range certificateARNs {
+			if err := aws.ValidateARNPartition(certificateARN, partition); err != nil {
+				return config, errors.Wrapf(err, "invalid certificate in annotation %v", parser.GetAnnotationWithPrefix(AnnotationCertificateARN))
+			}
+		}
 		config.DefaultCertificate = []*elbv2.Certificate{
 			{
 				CertificateArn: aws.String(certificateARNs[0]),
@@ -245,6 +294,13 @@ func (controller *defaultController) buildListenerConfig(ctx context.Context, op
 		}
 		config.ExtraCertificateARNs = certificateARNs[1:]
 	}
+	if options.Port.Scheme == elbv2.ProtocolEnumHttps {
+		mutualAuthentication, err := BuildMutualAuthentication(options)
+		if err != nil {
+			return config, err
+		}
+		config.MutualAuthentication = mutualAuthentication
+	}
 
 	actions, err := controller.buildDefaultActions(ctx, options)
 	if err != nil {
@@ -255,16 +311,192 @@ func (controller *defaultController) buildListenerConfig(ctx context.Context, op
 	return config, nil
 }
 
+// BuildSSLPolicy returns the sslPolicy of the listener on port, configured by the ssl-policy annotation as a policy name for all listeners,
+// and policies for specific ports as <port>=<policy>, e.g. `ELBSecurityPolicy-2016-08, 8443=ELBSecurityPolicy-TLS-1-2-2017-01`.
+func BuildSSLPolicy(ingressAnnotations map[string]string, port int64) (string, error) {
+	var entries []string
+	_ = annotations.LoadStringSliceAnnotation(AnnotationSSLPolicy, &entries, ingressAnnotations)
+
+	sslPolicy, portSSLPolicy := DefaultSSLPolicy, ""
+	for _, entry := range entries {
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) == 1 {
+			sslPolicy = entry
+			continue
+		}
+		entryPort, err := strconv.ParseInt(strings.TrimSpace(parts[0]), 10, 64)
+		if err != nil || len(strings.TrimSpace(parts[1])) == 0 {
+			return "", errors.Errorf("invalid %v annotation entry %v, expected <policy> or <port>=<policy>", parser.GetAnnotationWithPrefix(AnnotationSSLPolicy), entry)
+		}
+		if entryPort == port {
+			portSSLPolicy = strings.TrimSpace(parts[1])
+		}
+	}
+	if portSSLPolicy != "" {
+		return portSSLPolicy, nil
+	}
+	return sslPolicy, nil
+}
+
+// validateSSLPolicy ensures sslPolicy is known to ELBV2 before it's assigned to a listener, so that a typo is reported as such.
+func (controller *defaultController) validateSSLPolicy(ctx context.Context, sslPolicy string) error {
+	_, err := controller.cloud.DescribeSSLPoliciesWithContext(ctx, &elbv2.DescribeSSLPoliciesInput{
+		Names: aws.StringSlice([]string{sslPolicy}),
+	})
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == elbv2.ErrCodeSSLPolicyNotFoundException {
+		albctx.GetEventf(ctx)(corev1.EventTypeWarning, "ERROR", "unknown sslPolicy %v in annotation %v", sslPolicy, parser.GetAnnotationWithPrefix(AnnotationSSLPolicy))
+		return fmt.Errorf("unknown sslPolicy %v", sslPolicy)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe sslPolicy %v", sslPolicy)
+	}
+	return nil
+}
+
+// uniqueCertificateARNs removes the repeated certificates of certificateARNs, keeping their order,
+// so that the default certificate isn't also reconciled as an extra certificate of the listener.
+func uniqueCertificateARNs(certificateARNs []string) []string {
+	seen := sets.NewString()
+	var unique []string
+	for _, arn := range certificateARNs {
+		if !seen.Has(arn) {
+			seen.Insert(arn)
+			unique = append(unique, arn)
+		}
+	}
+	return unique
+}
+
+// BuildDefaultActions builds the default actions of the listener for options.Port, without calling AWS.
+// The secrets of authenticate actions are redacted.
+func BuildDefaultActions(ctx context.Context, authModule auth.Module, options ReconcileOptions) ([]*elbv2.Action, error) {
+	controller := &defaultController{authModule: authModule}
+	actions, err := controller.buildDefaultActions(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	return redactActions(actions), nil
+}
+
 func (controller *defaultController) buildDefaultActions(ctx context.Context, options ReconcileOptions) ([]*elbv2.Action, error) {
+	ingress, ingressAnnos, tgGroup := options.Ingress, options.IngressAnnos, options.TGGroup
+	if len(options.Members) != 0 {
+		member := options.Members[0]
+		for _, m := range options.Members {
+			if _, ok := defaultActionName(m.Ingress); ok || tg.DefaultBackend(m.Ingress) != nil {
+				member = m
+				break
+			}
+		}
+		ingress, ingressAnnos, tgGroup = member.Ingress, member.IngressAnnos, member.TGGroup
+	}
+
+	if options.Port.Scheme == elbv2.ProtocolEnumHttp {
+		redirectPort, err := sslRedirectPort(options.Ingress, options.IngressAnnos)
+		if err != nil {
+			return nil, err
+		}
+		if redirectPort != 0 {
+			return []*elbv2.Action{buildSSLRedirectAction(redirectPort)}, nil
+		}
+	}
+
 	backend := action.Default404Backend()
-	if options.Ingress.Spec.Backend != nil {
-		backend = *options.Ingress.Spec.Backend
+	if isNetworkProtocol(options.Port.Scheme) {
+		var err error
+		if backend, err = networkBackend(ingress); err != nil {
+			return nil, err
+		}
+	} else if actionName, ok := defaultActionName(ingress); ok {
+		backend = extensions.IngressBackend{ServiceName: actionName, ServicePort: intstr.FromString(action.UseActionAnnotation)}
+	} else if defaultBackend := tg.DefaultBackend(ingress); defaultBackend != nil {
+		backend = *defaultBackend
 	}
-	authCfg, err := controller.authModule.NewConfig(ctx, options.Ingress, backend, options.Port.Scheme)
+	authCfg, err := controller.authModule.NewConfig(ctx, ingress, backend, options.Port.Scheme)
 	if err != nil {
 		return nil, err
 	}
-	return buildActions(ctx, authCfg, options.IngressAnnos, backend, options.TGGroup)
+	return buildActions(ctx, authCfg, ingressAnnos, backend, tgGroup)
+}
+
+// defaultActionName returns the name of the action ingress takes on requests matching no rule, if it has one.
+func defaultActionName(ingress *extensions.Ingress) (string, bool) {
+	var actionName string
+	if !annotations.LoadStringAnnotation(AnnotationDefaultAction, &actionName, ingress.Annotations) || actionName == "" {
+		return "", false
+	}
+	return actionName, true
+}
+
+// sslRedirectPort returns the HTTPS listen-port the HTTP listeners of ingress redirect to, or 0 if they don't redirect.
+func sslRedirectPort(ingress *extensions.Ingress, ingressAnnos *annotations.Ingress) (int64, error) {
+	var port int64
+	exists, err := annotations.LoadInt64Annotation(AnnotationSSLRedirect, &port, ingress.Annotations)
+	if err != nil || !exists {
+		return 0, err
+	}
+	for _, p := range ingressAnnos.LoadBalancer.Ports {
+		if p.Port == port && p.Scheme == elbv2.ProtocolEnumHttps {
+			return port, nil
+		}
+	}
+	return 0, errors.Errorf("annotation %v redirects to port %v, which isn't an HTTPS listen-port",
+		parser.GetAnnotationWithPrefix(AnnotationSSLRedirect), port)
+}
+
+// buildSSLRedirectAction builds the action permanently redirecting requests to HTTPS on port, keeping their host, path and query.
+func buildSSLRedirectAction(port int64) *elbv2.Action {
+	return &elbv2.Action{
+		Type: aws.String(elbv2.ActionTypeEnumRedirect),
+		RedirectConfig: &elbv2.RedirectActionConfig{
+			Host:       aws.String("#{host}"),
+			Path:       aws.String("/#{path}"),
+			Port:       aws.String(strconv.FormatInt(port, 10)),
+			Protocol:   aws.String(elbv2.ProtocolEnumHttps),
+			Query:      aws.String("#{query}"),
+			StatusCode: aws.String(elbv2.RedirectActionStatusCodeEnumHttp301),
+		},
+	}
+}
+
+// networkBackend returns the backend the listeners of a network LoadBalancer forward to, as they can't route on requests:
+// the default backend of ingress, or else the single backend of its rules.
+func networkBackend(ingress *extensions.Ingress) (extensions.IngressBackend, error) {
+	if defaultBackend := tg.DefaultBackend(ingress); defaultBackend != nil {
+		return *defaultBackend, nil
+	}
+	var backends []extensions.IngressBackend
+	seen := make(map[extensions.IngressBackend]bool)
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			if !seen[path.Backend] {
+				seen[path.Backend] = true
+				backends = append(backends, path.Backend)
+			}
+		}
+	}
+	switch len(backends) {
+	case 0:
+		return extensions.IngressBackend{}, errors.Errorf("ingress %v/%v has no backend for the listeners of its network LoadBalancer", ingress.Namespace, ingress.Name)
+	case 1:
+		return backends[0], nil
+	default:
+		return extensions.IngressBackend{}, errors.Errorf("ingress %v/%v has %v backends but network LoadBalancers forward to a single one, specify it as default backend",
+			ingress.Namespace, ingress.Name, len(backends))
+	}
+}
+
+// isNetworkProtocol tells whether protocol is the one of a listener of network LoadBalancers.
+func isNetworkProtocol(protocol string) bool {
+	return protocol == elbv2.ProtocolEnumTcp || protocol == elbv2.ProtocolEnumTls
+}
+
+// isSecureProtocol tells whether listeners of protocol terminate TLS, with an SSL policy and certificates.
+func isSecureProtocol(protocol string) bool {
+	return protocol == elbv2.ProtocolEnumHttps || protocol == elbv2.ProtocolEnumTls
 }
 
 // inferCertARNs retrieves a set of certificates from ACM that matches the ingress' hosts list
@@ -274,7 +506,31 @@ func (controller *defaultController) inferCertARNs(ctx context.Context, ingress
 	if len(ingressHosts) == 0 {
 		return nil, nil
 	}
-	return controller.certDiscovery.Discover(ctx, ingressHosts)
+	filter, err := buildCertFilter(ingress)
+	if err != nil {
+		return nil, err
+	}
+	return controller.certDiscovery.Discover(ctx, ingressHosts, filter)
+}
+
+func buildCertFilter(ingress *extensions.Ingress) (CertFilter, error) {
+	filter := CertFilter{}
+	_ = annotations.LoadStringAnnotation(AnnotationCertificateDiscoveryCAARN, &filter.CertificateAuthorityARN, ingress.Annotations)
+
+	var tags []string
+	_ = annotations.LoadStringSliceAnnotation(AnnotationCertificateDiscoveryTags, &tags, ingress.Annotations)
+	for _, tag := range tags {
+		parts := strings.SplitN(tag, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return filter, errors.Errorf("unable to parse %v of annotation %v into Key=Value pair",
+				tag, parser.GetAnnotationWithPrefix(AnnotationCertificateDiscoveryTags))
+		}
+		if filter.Tags == nil {
+			filter.Tags = make(map[string]string)
+		}
+		filter.Tags[parts[0]] = parts[1]
+	}
+	return filter, nil
 }
 
 func uniqueHosts(ingress *extensions.Ingress) sets.String {
@@ -10,15 +10,28 @@ import (
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/tg"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/store"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/k8s"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/tracing"
 	extensions "k8s.io/api/extensions/v1beta1"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
+// Member is an ingress of an ingress group, whose rules are reconciled on the listeners shared by the group.
+type Member struct {
+	Ingress      *extensions.Ingress
+	IngressAnnos *annotations.Ingress
+	TGGroup      tg.TargetGroupGroup
+}
+
 type GroupController interface {
-	// Reconcile ensures listeners exists in LB to satisfy ingress requirements.
-	Reconcile(ctx context.Context, lbArn string, ingress *extensions.Ingress, tgGroup tg.TargetGroupGroup) error
+	// Reconcile ensures listeners exists in LB to satisfy ingress requirements, and returns their ARNs.
+	Reconcile(ctx context.Context, lbArn string, ingress *extensions.Ingress, tgGroup tg.TargetGroupGroup) ([]string, error)
+
+	// ReconcileGroup ensures listeners exists in LB to satisfy the requirements of an ingress group, and returns their ARNs.
+	// ingress and ingressAnnos configure the listeners of the group, and the rules of members follow each other in their order.
+	ReconcileGroup(ctx context.Context, lbArn string, ingress *extensions.Ingress, ingressAnnos *annotations.Ingress, members []Member) ([]string, error)
 
 	// Delete ensures all listeners are deleted
 	Delete(ctx context.Context, lbArn string) error
@@ -40,43 +53,69 @@ type defaultGroupController struct {
 	lsController Controller
 }
 
-func (controller *defaultGroupController) Reconcile(ctx context.Context, lbArn string, ingress *extensions.Ingress, tgGroup tg.TargetGroupGroup) error {
+func (controller *defaultGroupController) Reconcile(ctx context.Context, lbArn string, ingress *extensions.Ingress, tgGroup tg.TargetGroupGroup) ([]string, error) {
+	ctx, span := tracing.StartSpan(ctx, "ls.Reconcile")
+	defer span.End()
+
 	ingressAnnos, err := controller.store.GetIngressAnnotations(k8s.MetaNamespaceKey(ingress))
 	if err != nil {
-		return err
+		return nil, err
 	}
-	instancesByPort, err := controller.loadListenerInstances(ctx, lbArn)
+	return controller.reconcileListeners(ctx, ReconcileOptions{
+		LBArn:        lbArn,
+		Ingress:      ingress,
+		IngressAnnos: ingressAnnos,
+		TGGroup:      tgGroup,
+	})
+}
+
+func (controller *defaultGroupController) ReconcileGroup(ctx context.Context, lbArn string, ingress *extensions.Ingress, ingressAnnos *annotations.Ingress, members []Member) ([]string, error) {
+	ctx, span := tracing.StartSpan(ctx, "ls.ReconcileGroup")
+	defer span.End()
+
+	return controller.reconcileListeners(ctx, ReconcileOptions{
+		LBArn:        lbArn,
+		Ingress:      ingress,
+		IngressAnnos: ingressAnnos,
+		Members:      members,
+	})
+}
+
+// reconcileListeners reconciles a listener with options for each listen-port of options.IngressAnnos, and deletes the other listeners of the LoadBalancer.
+func (controller *defaultGroupController) reconcileListeners(ctx context.Context, options ReconcileOptions) ([]string, error) {
+	instancesByPort, err := controller.loadListenerInstances(ctx, options.LBArn)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	var lsArns []string
 	portsInUse := sets.NewInt64()
-	for _, port := range ingressAnnos.LoadBalancer.Ports {
+	for _, port := range options.IngressAnnos.LoadBalancer.Ports {
 		portsInUse.Insert(port.Port)
-		instance := instancesByPort[port.Port]
-		if err := controller.lsController.Reconcile(ctx, ReconcileOptions{
-			LBArn:        lbArn,
-			Ingress:      ingress,
-			IngressAnnos: ingressAnnos,
-			Port:         port,
-			TGGroup:      tgGroup,
-			Instance:     instance,
-		}); err != nil {
-			return err
+		portOptions := options
+		portOptions.Port = port
+		portOptions.Instance = instancesByPort[port.Port]
+		listener, err := controller.lsController.Reconcile(ctx, portOptions)
+		if err != nil {
+			return nil, err
 		}
+		lsArns = append(lsArns, aws.StringValue(listener.ListenerArn))
 	}
 	portsUnsed := sets.Int64KeySet(instancesByPort).Difference(portsInUse)
 	for port := range portsUnsed {
 		instance := instancesByPort[port]
 		albctx.GetLogger(ctx).Infof("deleting listener %v, arn: %v", aws.Int64Value(instance.Port), aws.StringValue(instance.ListenerArn))
 		if err := controller.cloud.DeleteListenersByArn(ctx, aws.StringValue(instance.ListenerArn)); err != nil {
-			return err
+			return nil, err
 		}
 	}
-	return nil
+	return lsArns, nil
 }
 
 func (controller *defaultGroupController) Delete(ctx context.Context, lbArn string) error {
+	ctx, span := tracing.StartSpan(ctx, "ls.Delete")
+	defer span.End()
+
 	instancesByPort, err := controller.loadListenerInstances(ctx, lbArn)
 	if err != nil {
 		return err
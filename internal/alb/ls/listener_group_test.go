@@ -32,6 +32,7 @@ type ListListenersByLoadBalancerCall struct {
 type LSControllerReconcileCall struct {
 	Port     loadbalancer.PortData
 	Instance *elbv2.Listener
+	Listener *elbv2.Listener
 	Err      error
 }
 
@@ -55,6 +56,7 @@ func TestDefaultGroupController_Reconcile(t *testing.T) {
 		ListListenersByLoadBalancerCall *ListListenersByLoadBalancerCall
 		LSControllerReconcileCalls      []LSControllerReconcileCall
 		DeleteListenersByArnCalls       []DeleteListenersByArnCall
+		ExpectedLSArns                  []string
 		ExpectedErr                     error
 	}{
 		{
@@ -86,6 +88,10 @@ func TestDefaultGroupController_Reconcile(t *testing.T) {
 						Scheme: elbv2.ProtocolEnumHttp,
 					},
 					Instance: nil,
+					Listener: &elbv2.Listener{
+						ListenerArn: aws.String("lsArn1"),
+						Port:        aws.Int64(80),
+					},
 				},
 				{
 					Port: loadbalancer.PortData{
@@ -93,8 +99,13 @@ func TestDefaultGroupController_Reconcile(t *testing.T) {
 						Scheme: elbv2.ProtocolEnumHttps,
 					},
 					Instance: nil,
+					Listener: &elbv2.Listener{
+						ListenerArn: aws.String("lsArn2"),
+						Port:        aws.Int64(443),
+					},
 				},
 			},
+			ExpectedLSArns: []string{"lsArn1", "lsArn2"},
 		},
 		{
 			Name: "Reconcile succeed by modify listeners",
@@ -137,6 +148,10 @@ func TestDefaultGroupController_Reconcile(t *testing.T) {
 						ListenerArn: aws.String("lsArn1"),
 						Port:        aws.Int64(80),
 					},
+					Listener: &elbv2.Listener{
+						ListenerArn: aws.String("lsArn1"),
+						Port:        aws.Int64(80),
+					},
 				},
 				{
 					Port: loadbalancer.PortData{
@@ -147,8 +162,13 @@ func TestDefaultGroupController_Reconcile(t *testing.T) {
 						ListenerArn: aws.String("lsArn2"),
 						Port:        aws.Int64(443),
 					},
+					Listener: &elbv2.Listener{
+						ListenerArn: aws.String("lsArn2"),
+						Port:        aws.Int64(443),
+					},
 				},
 			},
+			ExpectedLSArns: []string{"lsArn1", "lsArn2"},
 		},
 		{
 			Name: "Reconcile succeed by create|delete|modify listeners",
@@ -191,6 +211,10 @@ func TestDefaultGroupController_Reconcile(t *testing.T) {
 						ListenerArn: aws.String("lsArn1"),
 						Port:        aws.Int64(80),
 					},
+					Listener: &elbv2.Listener{
+						ListenerArn: aws.String("lsArn1"),
+						Port:        aws.Int64(80),
+					},
 				},
 				{
 					Port: loadbalancer.PortData{
@@ -198,6 +222,10 @@ func TestDefaultGroupController_Reconcile(t *testing.T) {
 						Scheme: elbv2.ProtocolEnumHttp,
 					},
 					Instance: nil,
+					Listener: &elbv2.Listener{
+						ListenerArn: aws.String("lsArn3"),
+						Port:        aws.Int64(8080),
+					},
 				},
 			},
 			DeleteListenersByArnCalls: []DeleteListenersByArnCall{
@@ -205,6 +233,7 @@ func TestDefaultGroupController_Reconcile(t *testing.T) {
 					LSArn: "lsArn2",
 				},
 			},
+			ExpectedLSArns: []string{"lsArn1", "lsArn3"},
 		},
 		{
 			Name: "Reconcile failed when get ingress annotations",
@@ -305,6 +334,10 @@ func TestDefaultGroupController_Reconcile(t *testing.T) {
 						ListenerArn: aws.String("lsArn1"),
 						Port:        aws.Int64(80),
 					},
+					Listener: &elbv2.Listener{
+						ListenerArn: aws.String("lsArn1"),
+						Port:        aws.Int64(80),
+					},
 				},
 			},
 			DeleteListenersByArnCalls: []DeleteListenersByArnCall{
@@ -339,7 +372,7 @@ func TestDefaultGroupController_Reconcile(t *testing.T) {
 					TGGroup:      targetGroup,
 					Port:         call.Port,
 					Instance:     call.Instance,
-				}).Return(call.Err)
+				}).Return(call.Listener, call.Err)
 			}
 
 			controller := &defaultGroupController{
@@ -348,8 +381,9 @@ func TestDefaultGroupController_Reconcile(t *testing.T) {
 				lsController: mockLSController,
 			}
 
-			err := controller.Reconcile(context.Background(), lbArn, &ingress, targetGroup)
+			lsArns, err := controller.Reconcile(context.Background(), lbArn, &ingress, targetGroup)
 			assert.Equal(t, tc.ExpectedErr, err)
+			assert.Equal(t, tc.ExpectedLSArns, lsArns)
 			cloud.AssertExpectations(t)
 			mockStore.AssertExpectations(t)
 			mockLSController.AssertExpectations(t)
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/golang/mock/gomock"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/tg"
@@ -34,6 +35,11 @@ type ModifyListenerCall struct {
 	Err      error
 }
 
+type DescribeSSLPoliciesCall struct {
+	SSLPolicy string
+	Err       error
+}
+
 type DescribeListenerCertificatesCall struct {
 	LSArn        string
 	Certificates []*elbv2.Certificate
@@ -66,6 +72,7 @@ func TestDefaultController_Reconcile(t *testing.T) {
 		Instance     *elbv2.Listener
 		AuthConfig   auth.Config
 
+		DescribeSSLPoliciesCall          *DescribeSSLPoliciesCall
 		CreateListenerCall               *CreateListenerCall
 		ModifyListenerCall               *ModifyListenerCall
 		DescribeListenerCertificatesCall *DescribeListenerCertificatesCall
@@ -230,6 +237,7 @@ func TestDefaultController_Reconcile(t *testing.T) {
 				Type: auth.TypeNone,
 			},
 
+			DescribeSSLPoliciesCall: &DescribeSSLPoliciesCall{SSLPolicy: "sslPolicy"},
 			CreateListenerCall: &CreateListenerCall{
 				Input: elbv2.CreateListenerInput{
 					LoadBalancerArn: aws.String(LBArn),
@@ -442,6 +450,7 @@ func TestDefaultController_Reconcile(t *testing.T) {
 				},
 			},
 
+			DescribeSSLPoliciesCall: &DescribeSSLPoliciesCall{SSLPolicy: "sslPolicy"},
 			ModifyListenerCall: &ModifyListenerCall{
 				Input: elbv2.ModifyListenerInput{
 					ListenerArn: aws.String("lsArn"),
@@ -548,7 +557,7 @@ func TestDefaultController_Reconcile(t *testing.T) {
 					Namespace: "namespace",
 					Annotations: map[string]string{
 						"alb.ingress.kubernetes.io/ssl-policy":      "sslPolicy",
-						"alb.ingress.kubernetes.io/certificate-arn": "certificateArn,certificateArn4,certificateArn5",
+						"alb.ingress.kubernetes.io/certificate-arn": "certificateArn,certificateArn4,certificateArn5,certificateArn4,certificateArn",
 					},
 				},
 				Spec: extensions.IngressSpec{
@@ -759,6 +768,7 @@ func TestDefaultController_Reconcile(t *testing.T) {
 				},
 			},
 
+			DescribeSSLPoliciesCall: &DescribeSSLPoliciesCall{SSLPolicy: "sslPolicy"},
 			ModifyListenerCall: &ModifyListenerCall{
 				Input: elbv2.ModifyListenerInput{
 					ListenerArn: aws.String("lsArn"),
@@ -982,6 +992,122 @@ func TestDefaultController_Reconcile(t *testing.T) {
 			},
 			ExpectedError: errors.New("failed to reconcile rules due to RulesReconcileCall"),
 		},
+		{
+			Name: "Reconcile failed for unknown sslPolicy",
+			Ingress: extensions.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ingress",
+					Namespace: "namespace",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/ssl-policy":      "ELBSecurityPolicy-2016-08, 443=sslPolicy",
+						"alb.ingress.kubernetes.io/certificate-arn": "certificateArn",
+					},
+				},
+				Spec: extensions.IngressSpec{
+					Backend: &extensions.IngressBackend{
+						ServiceName: "service",
+						ServicePort: intstr.FromInt(8443),
+					},
+				},
+			},
+			IngressAnnos: annotations.Ingress{},
+			Port: loadbalancer.PortData{
+				Port:   443,
+				Scheme: elbv2.ProtocolEnumHttps,
+			},
+			TGGroup: tg.TargetGroupGroup{
+				TGByBackend: map[extensions.IngressBackend]tg.TargetGroup{
+					{
+						ServiceName: "service",
+						ServicePort: intstr.FromInt(8443),
+					}: {
+						Arn: "tgArn",
+					},
+				},
+			},
+			AuthConfig: auth.Config{
+				Type: auth.TypeNone,
+			},
+
+			DescribeSSLPoliciesCall: &DescribeSSLPoliciesCall{
+				SSLPolicy: "sslPolicy",
+				Err:       awserr.New(elbv2.ErrCodeSSLPolicyNotFoundException, "SSL policy 'sslPolicy' not found", nil),
+			},
+			ExpectedError: errors.New("unknown sslPolicy sslPolicy"),
+		},
+		{
+			Name: "Reconcile succeed by creating tcp listener without rules for the backend of rules",
+			Ingress: extensions.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ingress",
+					Namespace: "namespace",
+				},
+				Spec: extensions.IngressSpec{
+					Rules: []extensions.IngressRule{
+						{
+							IngressRuleValue: extensions.IngressRuleValue{
+								HTTP: &extensions.HTTPIngressRuleValue{
+									Paths: []extensions.HTTPIngressPath{
+										{
+											Backend: extensions.IngressBackend{
+												ServiceName: "service",
+												ServicePort: intstr.FromInt(5432),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			IngressAnnos: annotations.Ingress{},
+			Port: loadbalancer.PortData{
+				Port:   5432,
+				Scheme: elbv2.ProtocolEnumTcp,
+			},
+			TGGroup: tg.TargetGroupGroup{
+				TGByBackend: map[extensions.IngressBackend]tg.TargetGroup{
+					{
+						ServiceName: "service",
+						ServicePort: intstr.FromInt(5432),
+					}: {
+						Arn: "tgArn",
+					},
+				},
+			},
+			AuthConfig: auth.Config{
+				Type: auth.TypeNone,
+			},
+
+			CreateListenerCall: &CreateListenerCall{
+				Input: elbv2.CreateListenerInput{
+					LoadBalancerArn: aws.String(LBArn),
+					Protocol:        aws.String(elbv2.ProtocolEnumTcp),
+					Port:            aws.Int64(5432),
+					DefaultActions: []*elbv2.Action{
+						{
+							Order: aws.Int64(1),
+							Type:  aws.String(elbv2.ActionTypeEnumForward),
+							ForwardConfig: &elbv2.ForwardActionConfig{
+								TargetGroupStickinessConfig: &elbv2.TargetGroupStickinessConfig{
+									Enabled: aws.Bool(false),
+								},
+								TargetGroups: []*elbv2.TargetGroupTuple{
+									{
+										TargetGroupArn: aws.String("tgArn"),
+										Weight:         aws.Int64(1),
+									},
+								},
+							},
+						},
+					},
+				},
+				Instance: &elbv2.Listener{
+					ListenerArn: aws.String("lsArn"),
+				},
+			},
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
@@ -989,6 +1115,10 @@ func TestDefaultController_Reconcile(t *testing.T) {
 
 			ctx := context.Background()
 			cloud := &mocks.CloudAPI{}
+			if tc.DescribeSSLPoliciesCall != nil {
+				cloud.On("DescribeSSLPoliciesWithContext", ctx, &elbv2.DescribeSSLPoliciesInput{Names: aws.StringSlice([]string{tc.DescribeSSLPoliciesCall.SSLPolicy})}).Return(
+					&elbv2.DescribeSSLPoliciesOutput{}, tc.DescribeSSLPoliciesCall.Err)
+			}
 			if tc.CreateListenerCall != nil {
 				cloud.On("CreateListenerWithContext", ctx, &tc.CreateListenerCall.Input).Return(
 					&elbv2.CreateListenerOutput{
@@ -1024,7 +1154,7 @@ func TestDefaultController_Reconcile(t *testing.T) {
 				authModule:      mockAuthModule,
 				rulesController: mockRulesController,
 			}
-			err := controller.Reconcile(ctx, ReconcileOptions{
+			_, err := controller.Reconcile(ctx, ReconcileOptions{
 				LBArn:        LBArn,
 				Ingress:      &tc.Ingress,
 				IngressAnnos: &tc.IngressAnnos,
@@ -1039,6 +1169,193 @@ func TestDefaultController_Reconcile(t *testing.T) {
 	}
 }
 
+func Test_networkBackend(t *testing.T) {
+	backend := func(name string) extensions.IngressBackend {
+		return extensions.IngressBackend{ServiceName: name, ServicePort: intstr.FromInt(80)}
+	}
+	ingressWithPaths := func(backends ...extensions.IngressBackend) *extensions.Ingress {
+		ingress := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "ingress"}}
+		for _, b := range backends {
+			ingress.Spec.Rules = append(ingress.Spec.Rules, extensions.IngressRule{
+				IngressRuleValue: extensions.IngressRuleValue{
+					HTTP: &extensions.HTTPIngressRuleValue{Paths: []extensions.HTTPIngressPath{{Backend: b}}},
+				},
+			})
+		}
+		return ingress
+	}
+
+	withDefault := ingressWithPaths(backend("a"), backend("b"))
+	withDefault.Spec.Backend = &extensions.IngressBackend{ServiceName: "default", ServicePort: intstr.FromInt(80)}
+	actual, err := networkBackend(withDefault)
+	assert.NoError(t, err)
+	assert.Equal(t, backend("default"), actual)
+
+	actual, err = networkBackend(ingressWithPaths(backend("a"), backend("a")))
+	assert.NoError(t, err)
+	assert.Equal(t, backend("a"), actual)
+
+	_, err = networkBackend(ingressWithPaths(backend("a"), backend("b")))
+	assert.EqualError(t, err, "ingress namespace/ingress has 2 backends but network LoadBalancers forward to a single one, specify it as default backend")
+
+	_, err = networkBackend(ingressWithPaths())
+	assert.EqualError(t, err, "ingress namespace/ingress has no backend for the listeners of its network LoadBalancer")
+}
+
+func Test_sslRedirect(t *testing.T) {
+	ctx := context.Background()
+	ingress := &extensions.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "namespace",
+			Name:        "ingress",
+			Annotations: map[string]string{"alb.ingress.kubernetes.io/ssl-redirect": "8443"},
+		},
+		Spec: extensions.IngressSpec{
+			Rules: []extensions.IngressRule{
+				{
+					IngressRuleValue: extensions.IngressRuleValue{
+						HTTP: &extensions.HTTPIngressRuleValue{
+							Paths: []extensions.HTTPIngressPath{{Backend: extensions.IngressBackend{ServiceName: "service", ServicePort: intstr.FromInt(80)}}},
+						},
+					},
+				},
+			},
+		},
+	}
+	ingressAnnos := &annotations.Ingress{
+		LoadBalancer: &loadbalancer.Config{
+			Ports: []loadbalancer.PortData{{Port: 80, Scheme: elbv2.ProtocolEnumHttp}, {Port: 8443, Scheme: elbv2.ProtocolEnumHttps}},
+		},
+	}
+	options := ReconcileOptions{Ingress: ingress, IngressAnnos: ingressAnnos, Port: loadbalancer.PortData{Port: 80, Scheme: elbv2.ProtocolEnumHttp}}
+
+	controller := &defaultController{}
+	actions, err := controller.buildDefaultActions(ctx, options)
+	assert.NoError(t, err)
+	assert.Equal(t, []*elbv2.Action{
+		{
+			Type: aws.String(elbv2.ActionTypeEnumRedirect),
+			RedirectConfig: &elbv2.RedirectActionConfig{
+				Host:       aws.String("#{host}"),
+				Path:       aws.String("/#{path}"),
+				Port:       aws.String("8443"),
+				Protocol:   aws.String(elbv2.ProtocolEnumHttps),
+				Query:      aws.String("#{query}"),
+				StatusCode: aws.String(elbv2.RedirectActionStatusCodeEnumHttp301),
+			},
+		},
+	}, actions)
+
+	rules, err := (&rulesController{}).getDesiredRules(ctx, &elbv2.Listener{Protocol: aws.String(elbv2.ProtocolEnumHttp)}, ingress, ingressAnnos, tg.TargetGroupGroup{})
+	assert.NoError(t, err)
+	assert.Empty(t, rules, "HTTP listeners redirecting to HTTPS should have no rules")
+
+	ingress.Annotations["alb.ingress.kubernetes.io/ssl-redirect"] = "443"
+	_, err = controller.buildDefaultActions(ctx, options)
+	assert.EqualError(t, err, "annotation alb.ingress.kubernetes.io/ssl-redirect redirects to port 443, which isn't an HTTPS listen-port")
+}
+
+func Test_defaultAction(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAuthModule := mock_auth.NewMockModule(ctrl)
+	mockAuthModule.EXPECT().NewConfig(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(auth.Config{Type: auth.TypeNone}, nil).AnyTimes()
+
+	ingress := &extensions.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "namespace",
+			Name:        "ingress",
+			Annotations: map[string]string{"alb.ingress.kubernetes.io/default-action": "response-404"},
+		},
+		Spec: extensions.IngressSpec{
+			Backend: &extensions.IngressBackend{ServiceName: "service", ServicePort: intstr.FromInt(80)},
+		},
+	}
+	ingressAnnos := &annotations.Ingress{
+		Action: &action.Config{
+			Actions: map[string]action.Action{
+				"response-404": {
+					Type: aws.String(elbv2.ActionTypeEnumFixedResponse),
+					FixedResponseConfig: &action.FixedResponseActionConfig{
+						ContentType: aws.String("text/plain"),
+						StatusCode:  aws.String("404"),
+					},
+				},
+			},
+		},
+	}
+	options := ReconcileOptions{Ingress: ingress, IngressAnnos: ingressAnnos, Port: loadbalancer.PortData{Port: 80, Scheme: elbv2.ProtocolEnumHttp}}
+
+	controller := &defaultController{authModule: mockAuthModule}
+	actions, err := controller.buildDefaultActions(ctx, options)
+	assert.NoError(t, err)
+	assert.Equal(t, []*elbv2.Action{
+		{
+			Type: aws.String(elbv2.ActionTypeEnumFixedResponse),
+			FixedResponseConfig: &elbv2.FixedResponseActionConfig{
+				ContentType: aws.String("text/plain"),
+				StatusCode:  aws.String("404"),
+			},
+			Order: aws.Int64(1),
+		},
+	}, actions)
+
+	// the member of an ingress group with a default action is preferred to the others
+	options.Members = []Member{
+		{Ingress: &extensions.Ingress{}, IngressAnnos: &annotations.Ingress{Action: &action.Config{}}},
+		{Ingress: ingress, IngressAnnos: ingressAnnos},
+	}
+	actions, err = controller.buildDefaultActions(ctx, options)
+	assert.NoError(t, err)
+	assert.Equal(t, aws.String(elbv2.ActionTypeEnumFixedResponse), actions[0].Type)
+
+	options.Members = nil
+	ingress.Annotations["alb.ingress.kubernetes.io/default-action"] = "response-503"
+	_, err = controller.buildDefaultActions(ctx, options)
+	assert.EqualError(t, err, "backend with `servicePort: use-annotation` was configured with `serviceName: response-503` but an action annotation for response-503 is not set")
+}
+
+func Test_default404(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAuthModule := mock_auth.NewMockModule(ctrl)
+	mockAuthModule.EXPECT().NewConfig(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(auth.Config{Type: auth.TypeNone}, nil).AnyTimes()
+
+	expected := []*elbv2.Action{
+		{
+			Type: aws.String(elbv2.ActionTypeEnumFixedResponse),
+			FixedResponseConfig: &elbv2.FixedResponseActionConfig{
+				ContentType: aws.String("text/plain"),
+				StatusCode:  aws.String("404"),
+			},
+			Order: aws.Int64(1),
+		},
+	}
+	controller := &defaultController{authModule: mockAuthModule}
+	port := loadbalancer.PortData{Port: 80, Scheme: elbv2.ProtocolEnumHttp}
+	ingressAnnos := &annotations.Ingress{Action: &action.Config{}}
+
+	// a default backend without service, e.g. a resource backend, is no default backend
+	ingress := &extensions.Ingress{Spec: extensions.IngressSpec{Backend: &extensions.IngressBackend{}}}
+	actions, err := controller.buildDefaultActions(ctx, ReconcileOptions{Ingress: ingress, IngressAnnos: ingressAnnos, Port: port})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actions)
+
+	// no member of the ingress group has a default backend
+	actions, err = controller.buildDefaultActions(ctx, ReconcileOptions{Ingress: ingress, IngressAnnos: ingressAnnos, Port: port, Members: []Member{
+		{Ingress: &extensions.Ingress{}, IngressAnnos: ingressAnnos},
+		{Ingress: ingress, IngressAnnos: ingressAnnos},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actions)
+
+	backends, _, err := tg.ExtractTargetGroupBackends(ingress)
+	assert.NoError(t, err)
+	assert.Empty(t, backends)
+}
+
 func Test_uniqueHosts(t *testing.T) {
 	var tests = []struct {
 		expected int
@@ -1092,3 +1409,76 @@ func Test_uniqueHosts(t *testing.T) {
 		}
 	}
 }
+
+func Test_buildCertFilter(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		annotations    map[string]string
+		expectedFilter CertFilter
+		expectedErr    string
+	}{
+		{
+			name:           "no filter",
+			expectedFilter: CertFilter{},
+		},
+		{
+			name: "private CA and tags",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/certificate-discovery-ca-arn": "arn:aws:acm-pca:us-west-2:xxx:certificate-authority/ca",
+				"alb.ingress.kubernetes.io/certificate-discovery-tags":   "team=web, purpose=ingress=public",
+			},
+			expectedFilter: CertFilter{
+				CertificateAuthorityARN: "arn:aws:acm-pca:us-west-2:xxx:certificate-authority/ca",
+				Tags:                    map[string]string{"team": "web", "purpose": "ingress=public"},
+			},
+		},
+		{
+			name: "invalid tag",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/certificate-discovery-tags": "team",
+			},
+			expectedErr: "unable to parse team of annotation alb.ingress.kubernetes.io/certificate-discovery-tags into Key=Value pair",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			filter, err := buildCertFilter(&extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations}})
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedFilter, filter)
+			}
+		})
+	}
+}
+
+func Test_BuildSSLPolicy(t *testing.T) {
+	for _, tc := range []struct {
+		Name        string
+		Annotation  string
+		Port        int64
+		Expected    string
+		ExpectedErr string
+	}{
+		{Name: "without annotation", Port: 443, Expected: DefaultSSLPolicy},
+		{Name: "policy for all ports", Annotation: "ELBSecurityPolicy-TLS-1-2-2017-01", Port: 443, Expected: "ELBSecurityPolicy-TLS-1-2-2017-01"},
+		{Name: "policy for the port", Annotation: "ELBSecurityPolicy-TLS-1-2-2017-01, 8443=ELBSecurityPolicy-FS-2018-06", Port: 8443, Expected: "ELBSecurityPolicy-FS-2018-06"},
+		{Name: "policy for another port", Annotation: "8443=ELBSecurityPolicy-FS-2018-06", Port: 443, Expected: DefaultSSLPolicy},
+		{Name: "invalid port", Annotation: "https=ELBSecurityPolicy-FS-2018-06", Port: 443, ExpectedErr: "invalid alb.ingress.kubernetes.io/ssl-policy annotation entry https=ELBSecurityPolicy-FS-2018-06, expected <policy> or <port>=<policy>"},
+		{Name: "missing policy", Annotation: "443=", Port: 443, ExpectedErr: "invalid alb.ingress.kubernetes.io/ssl-policy annotation entry 443=, expected <policy> or <port>=<policy>"},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			ingressAnnotations := map[string]string{}
+			if len(tc.Annotation) != 0 {
+				ingressAnnotations["alb.ingress.kubernetes.io/ssl-policy"] = tc.Annotation
+			}
+			sslPolicy, err := BuildSSLPolicy(ingressAnnotations, tc.Port)
+			if len(tc.ExpectedErr) != 0 {
+				assert.EqualError(t, err, tc.ExpectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.Expected, sslPolicy)
+		})
+	}
+}
@@ -5,6 +5,8 @@ package ls
 import (
 	context "context"
 
+	elbv2 "github.com/aws/aws-sdk-go/service/elbv2"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -14,15 +16,24 @@ type MockController struct {
 }
 
 // Reconcile provides a mock function with given fields: ctx, options
-func (_m *MockController) Reconcile(ctx context.Context, options ReconcileOptions) error {
+func (_m *MockController) Reconcile(ctx context.Context, options ReconcileOptions) (*elbv2.Listener, error) {
 	ret := _m.Called(ctx, options)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, ReconcileOptions) error); ok {
+	var r0 *elbv2.Listener
+	if rf, ok := ret.Get(0).(func(context.Context, ReconcileOptions) *elbv2.Listener); ok {
 		r0 = rf(ctx, options)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.Listener)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ReconcileOptions) error); ok {
+		r1 = rf(ctx, options)
+	} else {
+		r1 = ret.Error(1)
 	}
 
-	return r0
+	return r0, r1
 }
@@ -34,3 +34,17 @@ func (_m *MockRulesController) Reconcile(ctx context.Context, listener *elbv2.Li
 
 	return r0
 }
+
+// ReconcileMembers provides a mock function with given fields: ctx, listener, members
+func (_m *MockRulesController) ReconcileMembers(ctx context.Context, listener *elbv2.Listener, members []Member) error {
+	ret := _m.Called(ctx, listener, members)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *elbv2.Listener, []Member) error); ok {
+		r0 = rf(ctx, listener, members)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
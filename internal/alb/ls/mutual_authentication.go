@@ -0,0 +1,128 @@
+package ls
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/parser"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// AnnotationMutualAuthentication configures the mutual TLS authentication of HTTPS listeners by port, as a JSON list of mutualAuthenticationConfig.
+const AnnotationMutualAuthentication = "mutual-authentication"
+
+// The modes of mutual TLS authentication, elbv2 has no enum for them.
+const (
+	MutualAuthenticationModeOff         = "off"
+	MutualAuthenticationModePassthrough = "passthrough"
+	MutualAuthenticationModeVerify      = "verify"
+)
+
+type mutualAuthenticationConfig struct {
+	Port                          int64  `json:"port"`
+	Mode                          string `json:"mode"`
+	TrustStore                    string `json:"trustStore,omitempty"`
+	IgnoreClientCertificateExpiry *bool  `json:"ignoreClientCertificateExpiry,omitempty"`
+}
+
+// BuildMutualAuthentication builds the mutual authentication of the HTTPS listener for options.Port, without calling AWS.
+// HTTPS listeners missing from the annotation have mutual authentication turned off, and none is returned when the annotation is absent,
+// so that listeners whose mutual authentication is managed outside of the controller are left untouched.
+func BuildMutualAuthentication(options ReconcileOptions) (*elbv2.MutualAuthenticationAttributes, error) {
+	var raw string
+	if !annotations.LoadStringAnnotation(AnnotationMutualAuthentication, &raw, options.Ingress.Annotations) {
+		return nil, nil
+	}
+	annotation := parser.GetAnnotationWithPrefix(AnnotationMutualAuthentication)
+
+	var configs []mutualAuthenticationConfig
+	decoder := json.NewDecoder(bytes.NewReader([]byte(raw)))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&configs); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse annotation %v", annotation)
+	}
+
+	httpsPorts := sets.NewInt64()
+	if options.IngressAnnos != nil && options.IngressAnnos.LoadBalancer != nil {
+		for _, port := range options.IngressAnnos.LoadBalancer.Ports {
+			if port.Scheme == elbv2.ProtocolEnumHttps {
+				httpsPorts.Insert(port.Port)
+			}
+		}
+	}
+	modes := sets.NewString(MutualAuthenticationModeOff, MutualAuthenticationModePassthrough, MutualAuthenticationModeVerify)
+	partition := aws.ARNPartition(options.LBArn)
+
+	desired := &elbv2.MutualAuthenticationAttributes{Mode: aws.String(MutualAuthenticationModeOff)}
+	configuredPorts := sets.NewInt64()
+	for _, config := range configs {
+		if !httpsPorts.Has(config.Port) {
+			return nil, errors.Errorf("port %v in annotation %v isn't an HTTPS listen-port", config.Port, annotation)
+		}
+		if configuredPorts.Has(config.Port) {
+			return nil, errors.Errorf("port %v is configured more than once in annotation %v", config.Port, annotation)
+		}
+		configuredPorts.Insert(config.Port)
+		if !modes.Has(config.Mode) {
+			return nil, errors.Errorf("invalid mode %q of port %v in annotation %v, must be one of %v", config.Mode, config.Port, annotation, modes.List())
+		}
+		if config.Mode == MutualAuthenticationModeVerify {
+			if config.TrustStore == "" {
+				return nil, errors.Errorf("missing trustStore of port %v in annotation %v, it's required by the %v mode", config.Port, annotation, config.Mode)
+			}
+			if err := aws.ValidateARNPartition(config.TrustStore, partition); err != nil {
+				return nil, errors.Wrapf(err, "invalid trustStore of port %v in annotation %v", config.Port, annotation)
+			}
+		} else if config.TrustStore != "" || config.IgnoreClientCertificateExpiry != nil {
+			return nil, errors.Errorf("trustStore and ignoreClientCertificateExpiry of port %v in annotation %v are only supported by the %v mode",
+				config.Port, annotation, MutualAuthenticationModeVerify)
+		}
+
+		if config.Port != options.Port.Port {
+			continue
+		}
+		desired.Mode = aws.String(config.Mode)
+		if config.Mode == MutualAuthenticationModeVerify {
+			desired.TrustStoreArn = aws.String(config.TrustStore)
+			desired.IgnoreClientCertificateExpiry = aws.Bool(aws.BoolValue(config.IgnoreClientCertificateExpiry))
+		}
+	}
+	return desired, nil
+}
+
+func (controller *defaultController) reconcileMutualAuthentication(ctx context.Context, instance *elbv2.Listener, desired *elbv2.MutualAuthenticationAttributes) error {
+	current := instance.MutualAuthentication
+	if mutualAuthenticationMatches(current, desired) {
+		return nil
+	}
+	lsArn := aws.StringValue(instance.ListenerArn)
+	albctx.GetLogger(ctx).Infof("modifying mutual authentication of listener %v: %v => %v", lsArn, awsutil.Prettify(current), awsutil.Prettify(desired))
+	_, err := controller.cloud.ModifyListenerWithContext(ctx, &elbv2.ModifyListenerInput{
+		ListenerArn:          instance.ListenerArn,
+		MutualAuthentication: desired,
+	})
+	return err
+}
+
+// mutualAuthenticationMatches compares the trust store settings of current only in the verify mode, as they don't apply to the other ones.
+func mutualAuthenticationMatches(current *elbv2.MutualAuthenticationAttributes, desired *elbv2.MutualAuthenticationAttributes) bool {
+	currentMode := MutualAuthenticationModeOff
+	if current != nil && current.Mode != nil {
+		currentMode = aws.StringValue(current.Mode)
+	}
+	if currentMode != aws.StringValue(desired.Mode) {
+		return false
+	}
+	if currentMode != MutualAuthenticationModeVerify {
+		return true
+	}
+	return aws.StringValue(current.TrustStoreArn) == aws.StringValue(desired.TrustStoreArn) &&
+		aws.BoolValue(current.IgnoreClientCertificateExpiry) == aws.BoolValue(desired.IgnoreClientCertificateExpiry)
+}
@@ -0,0 +1,207 @@
+package ls
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/loadbalancer"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/mocks"
+	"github.com/stretchr/testify/assert"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBuildMutualAuthentication(t *testing.T) {
+	const (
+		lbArn         = "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/lb/50dc6c495c0c9188"
+		trustStoreArn = "arn:aws:elasticloadbalancing:us-west-2:123456789012:truststore/partners/73e2d6bc24d8a067"
+	)
+	ingressAnnos := &annotations.Ingress{
+		LoadBalancer: &loadbalancer.Config{
+			Ports: []loadbalancer.PortData{
+				{Port: 80, Scheme: elbv2.ProtocolEnumHttp},
+				{Port: 443, Scheme: elbv2.ProtocolEnumHttps},
+				{Port: 8443, Scheme: elbv2.ProtocolEnumHttps},
+			},
+		},
+	}
+	for _, tc := range []struct {
+		name        string
+		annotation  *string
+		port        int64
+		expected    *elbv2.MutualAuthenticationAttributes
+		expectedErr string
+	}{
+		{
+			name: "annotation absent",
+			port: 443,
+		},
+		{
+			name:       "verify on the listener's port",
+			annotation: aws.String(`[{"port": 443, "mode": "passthrough"}, {"port": 8443, "mode": "verify", "trustStore": "` + trustStoreArn + `", "ignoreClientCertificateExpiry": true}]`),
+			port:       8443,
+			expected: &elbv2.MutualAuthenticationAttributes{
+				Mode:                          aws.String(MutualAuthenticationModeVerify),
+				TrustStoreArn:                 aws.String(trustStoreArn),
+				IgnoreClientCertificateExpiry: aws.Bool(true),
+			},
+		},
+		{
+			name:       "passthrough on the listener's port",
+			annotation: aws.String(`[{"port": 443, "mode": "passthrough"}, {"port": 8443, "mode": "verify", "trustStore": "` + trustStoreArn + `"}]`),
+			port:       443,
+			expected:   &elbv2.MutualAuthenticationAttributes{Mode: aws.String(MutualAuthenticationModePassthrough)},
+		},
+		{
+			name:       "listener's port missing from the annotation",
+			annotation: aws.String(`[{"port": 8443, "mode": "verify", "trustStore": "` + trustStoreArn + `"}]`),
+			port:       443,
+			expected:   &elbv2.MutualAuthenticationAttributes{Mode: aws.String(MutualAuthenticationModeOff)},
+		},
+		{
+			name:        "invalid JSON",
+			annotation:  aws.String(`{"port": 443}`),
+			port:        443,
+			expectedErr: "failed to parse annotation alb.ingress.kubernetes.io/mutual-authentication: json: cannot unmarshal object into Go value of type []ls.mutualAuthenticationConfig",
+		},
+		{
+			name:        "unknown field",
+			annotation:  aws.String(`[{"port": 443, "mode": "verify", "trustStoreArn": "` + trustStoreArn + `"}]`),
+			port:        443,
+			expectedErr: `failed to parse annotation alb.ingress.kubernetes.io/mutual-authentication: json: unknown field "trustStoreArn"`,
+		},
+		{
+			name:        "HTTP listen-port",
+			annotation:  aws.String(`[{"port": 80, "mode": "passthrough"}]`),
+			port:        443,
+			expectedErr: "port 80 in annotation alb.ingress.kubernetes.io/mutual-authentication isn't an HTTPS listen-port",
+		},
+		{
+			name:        "duplicate port",
+			annotation:  aws.String(`[{"port": 443, "mode": "passthrough"}, {"port": 443, "mode": "off"}]`),
+			port:        443,
+			expectedErr: "port 443 is configured more than once in annotation alb.ingress.kubernetes.io/mutual-authentication",
+		},
+		{
+			name:        "invalid mode",
+			annotation:  aws.String(`[{"port": 443, "mode": "optional"}]`),
+			port:        443,
+			expectedErr: `invalid mode "optional" of port 443 in annotation alb.ingress.kubernetes.io/mutual-authentication, must be one of [off passthrough verify]`,
+		},
+		{
+			name:        "verify without trust store",
+			annotation:  aws.String(`[{"port": 8443, "mode": "verify"}]`),
+			port:        443,
+			expectedErr: "missing trustStore of port 8443 in annotation alb.ingress.kubernetes.io/mutual-authentication, it's required by the verify mode",
+		},
+		{
+			name:        "trust store in another partition",
+			annotation:  aws.String(`[{"port": 443, "mode": "verify", "trustStore": "arn:aws-cn:elasticloadbalancing:cn-north-1:123456789012:truststore/partners/73e2d6bc24d8a067"}]`),
+			port:        443,
+			expectedErr: "invalid trustStore of port 443 in annotation alb.ingress.kubernetes.io/mutual-authentication: arn:aws-cn:elasticloadbalancing:cn-north-1:123456789012:truststore/partners/73e2d6bc24d8a067 is in partition aws-cn, expected partition aws",
+		},
+		{
+			name:        "trust store with passthrough",
+			annotation:  aws.String(`[{"port": 443, "mode": "passthrough", "trustStore": "` + trustStoreArn + `"}]`),
+			port:        443,
+			expectedErr: "trustStore and ignoreClientCertificateExpiry of port 443 in annotation alb.ingress.kubernetes.io/mutual-authentication are only supported by the verify mode",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ingress := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{}}}
+			if tc.annotation != nil {
+				ingress.Annotations["alb.ingress.kubernetes.io/mutual-authentication"] = *tc.annotation
+			}
+			attributes, err := BuildMutualAuthentication(ReconcileOptions{
+				LBArn:        lbArn,
+				Ingress:      ingress,
+				IngressAnnos: ingressAnnos,
+				Port:         loadbalancer.PortData{Port: tc.port, Scheme: elbv2.ProtocolEnumHttps},
+			})
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, attributes)
+			}
+		})
+	}
+}
+
+func TestDefaultController_reconcileMutualAuthentication(t *testing.T) {
+	const trustStoreArn = "arn:aws:elasticloadbalancing:us-west-2:123456789012:truststore/partners/73e2d6bc24d8a067"
+	verify := &elbv2.MutualAuthenticationAttributes{
+		Mode:                          aws.String(MutualAuthenticationModeVerify),
+		TrustStoreArn:                 aws.String(trustStoreArn),
+		IgnoreClientCertificateExpiry: aws.Bool(false),
+	}
+	for _, tc := range []struct {
+		name         string
+		current      *elbv2.MutualAuthenticationAttributes
+		desired      *elbv2.MutualAuthenticationAttributes
+		expectModify bool
+		modifyErr    error
+		expectedErr  error
+	}{
+		{
+			name:    "off matches a listener which never had mutual authentication",
+			desired: &elbv2.MutualAuthenticationAttributes{Mode: aws.String(MutualAuthenticationModeOff)},
+		},
+		{
+			name: "trust store settings are ignored outside of the verify mode",
+			current: &elbv2.MutualAuthenticationAttributes{
+				Mode:                          aws.String(MutualAuthenticationModePassthrough),
+				IgnoreClientCertificateExpiry: aws.Bool(false),
+			},
+			desired: &elbv2.MutualAuthenticationAttributes{Mode: aws.String(MutualAuthenticationModePassthrough)},
+		},
+		{
+			name:         "verify is enabled",
+			current:      &elbv2.MutualAuthenticationAttributes{Mode: aws.String(MutualAuthenticationModeOff)},
+			desired:      verify,
+			expectModify: true,
+		},
+		{
+			name: "trust store is changed",
+			current: &elbv2.MutualAuthenticationAttributes{
+				Mode:          aws.String(MutualAuthenticationModeVerify),
+				TrustStoreArn: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:truststore/browsers/0a8e3b5d0c7c1f42"),
+			},
+			desired:      verify,
+			expectModify: true,
+		},
+		{
+			name:    "verify matches",
+			current: verify,
+			desired: verify,
+		},
+		{
+			name:         "modify fails",
+			current:      &elbv2.MutualAuthenticationAttributes{Mode: aws.String(MutualAuthenticationModeOff)},
+			desired:      verify,
+			expectModify: true,
+			modifyErr:    errors.New("TrustStoreNotFound"),
+			expectedErr:  errors.New("TrustStoreNotFound"),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			cloud := &mocks.CloudAPI{}
+			if tc.expectModify {
+				cloud.On("ModifyListenerWithContext", ctx, &elbv2.ModifyListenerInput{
+					ListenerArn:          aws.String("lsArn"),
+					MutualAuthentication: tc.desired,
+				}).Return(&elbv2.ModifyListenerOutput{}, tc.modifyErr)
+			}
+
+			controller := &defaultController{cloud: cloud}
+			err := controller.reconcileMutualAuthentication(ctx, &elbv2.Listener{ListenerArn: aws.String("lsArn"), MutualAuthentication: tc.current}, tc.desired)
+			assert.Equal(t, tc.expectedErr, err)
+			cloud.AssertExpectations(t)
+		})
+	}
+}
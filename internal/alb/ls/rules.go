@@ -3,11 +3,14 @@ package ls
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/util"
 
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/conditions"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/parser"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
@@ -18,16 +21,27 @@ import (
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/action"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/auth"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/ingressapi"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/tracing"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/util/log"
 	corev1 "k8s.io/api/core/v1"
 	extensions "k8s.io/api/extensions/v1beta1"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
+// maxHostHeaderLength is the maximum length of a host-header condition value.
+const maxHostHeaderLength = 128
+
+// AnnotationRuleOrder orders the rules generated for the paths of an ingress, by a JSON map from host and path to an order, e.g. {"example.com/api/*": -1, "/*": 1}.
+const AnnotationRuleOrder = "rule-order"
+
 // RulesController provides functionality to manage rules on listeners
 type RulesController interface {
 	// Reconcile ensures the listener rules in AWS match the rules configured in the Ingress resource.
 	Reconcile(ctx context.Context, listener *elbv2.Listener, ingress *extensions.Ingress, ingressAnnos *annotations.Ingress, tgGroup tg.TargetGroupGroup) error
+
+	// ReconcileMembers ensures the listener rules in AWS match the rules of the members of an ingress group, in their order.
+	ReconcileMembers(ctx context.Context, listener *elbv2.Listener, members []Member) error
 }
 
 // NewRulesController constructs RulesController
@@ -45,10 +59,29 @@ type rulesController struct {
 
 // Reconcile modifies AWS resources to match the rules defined in the Ingress
 func (c *rulesController) Reconcile(ctx context.Context, listener *elbv2.Listener, ingress *extensions.Ingress, ingressAnnos *annotations.Ingress, tgGroup tg.TargetGroupGroup) error {
+	ctx, span := tracing.StartSpan(ctx, "ls.ReconcileRules")
+	defer span.End()
+
 	desired, err := c.getDesiredRules(ctx, listener, ingress, ingressAnnos, tgGroup)
 	if err != nil {
 		return err
 	}
+	return c.reconcileListenerRules(ctx, listener, desired)
+}
+
+// ReconcileMembers modifies AWS resources to match the rules defined in the Ingresses of members
+func (c *rulesController) ReconcileMembers(ctx context.Context, listener *elbv2.Listener, members []Member) error {
+	ctx, span := tracing.StartSpan(ctx, "ls.ReconcileRules")
+	defer span.End()
+
+	desired, err := c.getMembersDesiredRules(ctx, listener, members)
+	if err != nil {
+		return err
+	}
+	return c.reconcileListenerRules(ctx, listener, desired)
+}
+
+func (c *rulesController) reconcileListenerRules(ctx context.Context, listener *elbv2.Listener, desired []elbv2.Rule) error {
 	lsArn := aws.StringValue(listener.ListenerArn)
 	current, err := c.getCurrentRules(ctx, lsArn)
 	if err != nil {
@@ -120,48 +153,169 @@ func (c *rulesController) reconcileRules(ctx context.Context, lsArn string, curr
 	return nil
 }
 
+// BuildRules builds the rules of ingress on listener the same way they're reconciled, without calling AWS.
+// The secrets of authenticate actions are redacted.
+func BuildRules(ctx context.Context, authModule auth.Module, listener *elbv2.Listener, ingress *extensions.Ingress, ingressAnnos *annotations.Ingress, tgGroup tg.TargetGroupGroup) ([]elbv2.Rule, error) {
+	c := &rulesController{authModule: authModule}
+	rules, err := c.getDesiredRules(ctx, listener, ingress, ingressAnnos, tgGroup)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rules {
+		rules[i].Actions = redactActions(rules[i].Actions)
+	}
+	return rules, nil
+}
+
+// DiffRules compares the rules built by BuildRules to the non-default current ones the same way they're reconciled,
+// returning the rules to add, modify and remove from current to match desired.
+// The secrets of both are redacted, so that they compare alike.
+func DiffRules(current []elbv2.Rule, desired []elbv2.Rule) (add []elbv2.Rule, modify []elbv2.Rule, remove []elbv2.Rule) {
+	return rulesChangeSets(redactRules(current), redactRules(desired))
+}
+
+func redactRules(rules []elbv2.Rule) []elbv2.Rule {
+	redacted := make([]elbv2.Rule, 0, len(rules))
+	for _, rule := range rules {
+		rule.Actions = redactActions(rule.Actions)
+		redacted = append(redacted, rule)
+	}
+	return redacted
+}
+
 func (c *rulesController) getDesiredRules(ctx context.Context, listener *elbv2.Listener, ingress *extensions.Ingress, ingressAnnos *annotations.Ingress, tgGroup tg.TargetGroupGroup) ([]elbv2.Rule, error) {
 	var output []elbv2.Rule
 
+	if aws.StringValue(listener.Protocol) == elbv2.ProtocolEnumHttp {
+		var redirectPort int64
+		redirect, err := annotations.LoadInt64Annotation(AnnotationSSLRedirect, &redirectPort, ingress.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if redirect {
+			// the default action of the listener redirects all requests to HTTPS.
+			return nil, nil
+		}
+	}
+
+	paths, err := orderedPaths(ingress)
+	if err != nil {
+		return nil, err
+	}
 	nextPriority := 1
-	for _, ingressRule := range ingress.Spec.Rules {
-		// Ingress spec allows empty HTTP, and we will 'route all traffic to the default backend'(which relies on default action of listeners)
-		if ingressRule.HTTP == nil {
+	seenUnconditionalRedirect := make(map[int]bool)
+	for _, p := range paths {
+		ingressRule, path := ingress.Spec.Rules[p.ruleIndex], p.path
+		if seenUnconditionalRedirect[p.ruleIndex] {
+			// Ignore rules that follow a unconditional redirect, they are moot
 			continue
 		}
+		authCfg, err := c.authModule.NewConfig(ctx, ingress, path.Backend, aws.StringValue(listener.Protocol))
+		if err != nil {
+			return nil, err
+		}
+		elbActions, err := buildActions(ctx, authCfg, ingressAnnos, path.Backend, tgGroup)
+		if err != nil {
+			return nil, err
+		}
+		elbConditions := buildConditions(ctx, ingressAnnos, ingressRule, path)
+		elbRule := elbv2.Rule{
+			IsDefault:  aws.Bool(false),
+			Priority:   aws.String(strconv.Itoa(nextPriority)),
+			Actions:    elbActions,
+			Conditions: elbConditions,
+		}
+		if createsRedirectLoop(listener, elbRule) {
+			continue
+		} else if isUnconditionalRedirect(listener, elbRule, ingressRule.Host) {
+			seenUnconditionalRedirect[p.ruleIndex] = true
+		}
+		output = append(output, elbRule)
+		nextPriority++
+	}
+	return output, nil
+}
+
+// getMembersDesiredRules concatenates the rules of members, renumbering their priorities to follow each other.
+func (c *rulesController) getMembersDesiredRules(ctx context.Context, listener *elbv2.Listener, members []Member) ([]elbv2.Rule, error) {
+	var output []elbv2.Rule
+	for _, member := range members {
+		rules, err := c.getDesiredRules(ctx, listener, member.Ingress, member.IngressAnnos, member.TGGroup)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to build rules of ingress %v/%v", member.Ingress.Namespace, member.Ingress.Name)
+		}
+		for _, rule := range rules {
+			rule.Priority = aws.String(strconv.Itoa(len(output) + 1))
+			output = append(output, rule)
+		}
+	}
+	return output, nil
+}
+
+// ingressPath is a path of the ingress rule at ruleIndex.
+type ingressPath struct {
+	ruleIndex int
+	path      extensions.HTTPIngressPath
+	order     int64
+}
 
-		seenUnconditionalRedirect := false
+// orderedPaths returns the paths of ingress in the order of their rules, which is the order of the ingress spec,
+// unless changed by the orders of the AnnotationRuleOrder annotation. Paths without an order default to 0, and keep their relative order.
+func orderedPaths(ingress *extensions.Ingress) ([]ingressPath, error) {
+	if err := ingressapi.ValidatePaths(ingress); err != nil {
+		return nil, err
+	}
+	orders := make(map[string]int64)
+	if _, err := annotations.LoadJSONAnnotation(AnnotationRuleOrder, &orders, ingress.Annotations); err != nil {
+		return nil, err
+	}
 
+	var paths []ingressPath
+	seenKeys := sets.NewString()
+	for i, ingressRule := range ingress.Spec.Rules {
+		if err := validateHost(ingressRule.Host); err != nil {
+			return nil, err
+		}
+		// Ingress spec allows empty HTTP, and we will 'route all traffic to the default backend'(which relies on default action of listeners)
+		if ingressRule.HTTP == nil {
+			continue
+		}
 		for _, path := range ingressRule.HTTP.Paths {
-			if seenUnconditionalRedirect {
-				// Ignore rules that follow a unconditional redirect, they are moot
-				continue
-			}
-			authCfg, err := c.authModule.NewConfig(ctx, ingress, path.Backend, aws.StringValue(listener.Protocol))
-			if err != nil {
-				return nil, err
-			}
-			elbActions, err := buildActions(ctx, authCfg, ingressAnnos, path.Backend, tgGroup)
-			if err != nil {
-				return nil, err
-			}
-			elbConditions := buildConditions(ctx, ingressAnnos, ingressRule, path)
-			elbRule := elbv2.Rule{
-				IsDefault:  aws.Bool(false),
-				Priority:   aws.String(strconv.Itoa(nextPriority)),
-				Actions:    elbActions,
-				Conditions: elbConditions,
-			}
-			if createsRedirectLoop(listener, elbRule) {
-				continue
-			} else if isUnconditionalRedirect(listener, elbRule, ingressRule.Host) {
-				seenUnconditionalRedirect = true
-			}
-			output = append(output, elbRule)
-			nextPriority++
+			key := ingressRule.Host + path.Path
+			seenKeys.Insert(key)
+			paths = append(paths, ingressPath{ruleIndex: i, path: path, order: orders[key]})
 		}
 	}
-	return output, nil
+	for key := range orders {
+		if !seenKeys.Has(key) {
+			return nil, errors.Errorf("%v of annotation %v matches no host and path of the ingress rules", key, parser.GetAnnotationWithPrefix(AnnotationRuleOrder))
+		}
+	}
+	// the paths of wildcard hosts follow the other paths of the same order, so that exact hosts take precedence over wildcard ones
+	sort.SliceStable(paths, func(i, j int) bool {
+		if paths[i].order != paths[j].order {
+			return paths[i].order < paths[j].order
+		}
+		return !isWildcardHost(ingress.Spec.Rules[paths[i].ruleIndex].Host) && isWildcardHost(ingress.Spec.Rules[paths[j].ruleIndex].Host)
+	})
+	return paths, nil
+}
+
+// isWildcardHost returns whether host is a wildcard host, e.g. *.example.com
+func isWildcardHost(host string) bool {
+	return strings.HasPrefix(host, "*.")
+}
+
+// validateHost checks that host can be matched by a host-header condition: the wildcard of a wildcard host must be its whole first label,
+// as in the Ingress spec, since a host-header value matches `*` against any characters and `?` against a single one.
+func validateHost(host string) error {
+	if len(host) > maxHostHeaderLength {
+		return errors.Errorf("host %v must be %v characters or less", host, maxHostHeaderLength)
+	}
+	if strings.ContainsAny(strings.TrimPrefix(host, "*."), "*?") {
+		return errors.Errorf("host %v must only have a wildcard as first label, e.g. *.example.com", host)
+	}
+	return nil
 }
 
 func (c *rulesController) getCurrentRules(ctx context.Context, listenerArn string) ([]elbv2.Rule, error) {
@@ -205,11 +359,12 @@ func buildActions(ctx context.Context, authCfg auth.Config, ingressAnnos *annota
 		}
 		elbActions = append(elbActions, annotationELBAction)
 	} else {
-		// backend is based on service
-		targetGroup, ok := tgGroup.TGByBackend[backend]
+		// backend is based on service, whose requests may be swapped to another service by annotation
+		activeBackend := extensions.IngressBackend{ServiceName: ingressAnnos.BlueGreen.GetActiveService(backend.ServiceName), ServicePort: backend.ServicePort}
+		targetGroup, ok := tgGroup.TGByBackend[activeBackend]
 		if !ok {
 			return nil, fmt.Errorf("unable to find targetGroup for backend %v:%v",
-				backend.ServiceName, backend.ServicePort.String())
+				activeBackend.ServiceName, activeBackend.ServicePort.String())
 		}
 		backendAction := elbv2.Action{
 			Type: aws.String(elbv2.ActionTypeEnumForward),
@@ -225,6 +380,24 @@ func buildActions(ctx context.Context, authCfg auth.Config, ingressAnnos *annota
 				},
 			},
 		}
+		if c, ok := ingressAnnos.Canary.GetCanary(backend.ServiceName); ok {
+			canaryBackend := extensions.IngressBackend{ServiceName: c.ServiceName, ServicePort: backend.ServicePort}
+			canaryTargetGroup, ok := tgGroup.TGByBackend[canaryBackend]
+			if !ok {
+				return nil, fmt.Errorf("unable to find targetGroup for canary backend %v:%v",
+					canaryBackend.ServiceName, canaryBackend.ServicePort.String())
+			}
+			backendAction.ForwardConfig.TargetGroups = []*elbv2.TargetGroupTuple{
+				{
+					TargetGroupArn: aws.String(targetGroup.Arn),
+					Weight:         aws.Int64(100 - c.Weight),
+				},
+				{
+					TargetGroupArn: aws.String(canaryTargetGroup.Arn),
+					Weight:         aws.Int64(c.Weight),
+				},
+			}
+		}
 		elbActions = append(elbActions, &backendAction)
 	}
 
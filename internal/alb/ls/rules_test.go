@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/action"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/bluegreen"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/canary"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/conditions"
 	"github.com/pkg/errors"
 
@@ -18,6 +20,7 @@ import (
 	mock_auth "github.com/kubernetes-sigs/aws-alb-ingress-controller/mocks/aws-alb-ingress-controller/ingress/auth"
 	"github.com/stretchr/testify/assert"
 	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -327,6 +330,163 @@ func Test_rulesController_getDesiredRules(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "one path with a canary service backend",
+			ingress: extensions.Ingress{
+				Spec: extensions.IngressSpec{
+					Rules: []extensions.IngressRule{
+						{
+							IngressRuleValue: extensions.IngressRuleValue{
+								HTTP: &extensions.HTTPIngressRuleValue{
+									Paths: []extensions.HTTPIngressPath{
+										{
+											Path: "/homepage",
+											Backend: extensions.IngressBackend{
+												ServiceName: "service",
+												ServicePort: intstr.FromString("http"),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			ingressAnnos: annotations.Ingress{
+				Action: &action.Config{
+					Actions: nil,
+				},
+				Canary: &canary.Config{
+					Canaries: map[string]canary.Canary{"service": {ServiceName: "service-canary", Weight: 20}},
+				},
+				Conditions: &conditions.Config{
+					Conditions: nil,
+				},
+			},
+			tgGroup: tg.TargetGroupGroup{
+				TGByBackend: map[extensions.IngressBackend]tg.TargetGroup{
+					{ServiceName: "service", ServicePort: intstr.FromString("http")}:        {Arn: "tgArn"},
+					{ServiceName: "service-canary", ServicePort: intstr.FromString("http")}: {Arn: "canaryTgArn"},
+				},
+			},
+			authNewConfigCalls: []AuthNewConfigCall{
+				{
+					backend: extensions.IngressBackend{
+						ServiceName: "service",
+						ServicePort: intstr.FromString("http"),
+					},
+					authCfg: auth.Config{Type: auth.TypeNone},
+				},
+			},
+			expected: []elbv2.Rule{
+				{
+					IsDefault: aws.Bool(false),
+					Priority:  aws.String("1"),
+					Conditions: []*elbv2.RuleCondition{
+						{
+							Field: aws.String(conditions.FieldPathPattern),
+							PathPatternConfig: &elbv2.PathPatternConditionConfig{
+								Values: aws.StringSlice([]string{"/homepage"}),
+							},
+						},
+					},
+					Actions: []*elbv2.Action{
+						{
+							Order: aws.Int64(1),
+							Type:  aws.String(elbv2.ActionTypeEnumForward),
+							ForwardConfig: &elbv2.ForwardActionConfig{
+								TargetGroupStickinessConfig: &elbv2.TargetGroupStickinessConfig{
+									Enabled: aws.Bool(false),
+								},
+								TargetGroups: []*elbv2.TargetGroupTuple{
+									{TargetGroupArn: aws.String("tgArn"), Weight: aws.Int64(80)},
+									{TargetGroupArn: aws.String("canaryTgArn"), Weight: aws.Int64(20)},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "one path with a swapped service backend",
+			ingress: extensions.Ingress{
+				Spec: extensions.IngressSpec{
+					Rules: []extensions.IngressRule{
+						{
+							IngressRuleValue: extensions.IngressRuleValue{
+								HTTP: &extensions.HTTPIngressRuleValue{
+									Paths: []extensions.HTTPIngressPath{
+										{
+											Path: "/homepage",
+											Backend: extensions.IngressBackend{
+												ServiceName: "service",
+												ServicePort: intstr.FromString("http"),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			ingressAnnos: annotations.Ingress{
+				Action: &action.Config{
+					Actions: nil,
+				},
+				BlueGreen: &bluegreen.Config{
+					ActiveServices: map[string]string{"service": "service-green"},
+				},
+				Conditions: &conditions.Config{
+					Conditions: nil,
+				},
+			},
+			tgGroup: tg.TargetGroupGroup{
+				TGByBackend: map[extensions.IngressBackend]tg.TargetGroup{
+					{ServiceName: "service", ServicePort: intstr.FromString("http")}:       {Arn: "tgArn"},
+					{ServiceName: "service-green", ServicePort: intstr.FromString("http")}: {Arn: "greenTgArn"},
+				},
+			},
+			authNewConfigCalls: []AuthNewConfigCall{
+				{
+					backend: extensions.IngressBackend{
+						ServiceName: "service",
+						ServicePort: intstr.FromString("http"),
+					},
+					authCfg: auth.Config{Type: auth.TypeNone},
+				},
+			},
+			expected: []elbv2.Rule{
+				{
+					IsDefault: aws.Bool(false),
+					Priority:  aws.String("1"),
+					Conditions: []*elbv2.RuleCondition{
+						{
+							Field: aws.String(conditions.FieldPathPattern),
+							PathPatternConfig: &elbv2.PathPatternConditionConfig{
+								Values: aws.StringSlice([]string{"/homepage"}),
+							},
+						},
+					},
+					Actions: []*elbv2.Action{
+						{
+							Order: aws.Int64(1),
+							Type:  aws.String(elbv2.ActionTypeEnumForward),
+							ForwardConfig: &elbv2.ForwardActionConfig{
+								TargetGroupStickinessConfig: &elbv2.TargetGroupStickinessConfig{
+									Enabled: aws.Bool(false),
+								},
+								TargetGroups: []*elbv2.TargetGroupTuple{
+									{TargetGroupArn: aws.String("greenTgArn"), Weight: aws.Int64(1)},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "one path with an service backend(refers to missing service)",
 			ingress: extensions.Ingress{
@@ -1740,6 +1900,56 @@ func Test_rulesController_getDesiredRules(t *testing.T) {
 	}
 }
 
+func Test_rulesController_getMembersDesiredRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAuthModule := mock_auth.NewMockModule(ctrl)
+	mockAuthModule.EXPECT().NewConfig(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(auth.Config{Type: auth.TypeNone}, nil).AnyTimes()
+
+	backend := extensions.IngressBackend{ServiceName: "service", ServicePort: intstr.FromInt(80)}
+	member := func(namespace string, hosts []string, tgArn string) Member {
+		ingress := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "ingress"}}
+		for _, host := range hosts {
+			ingress.Spec.Rules = append(ingress.Spec.Rules, extensions.IngressRule{
+				Host: host,
+				IngressRuleValue: extensions.IngressRuleValue{
+					HTTP: &extensions.HTTPIngressRuleValue{
+						Paths: []extensions.HTTPIngressPath{{Path: "/*", Backend: backend}},
+					},
+				},
+			})
+		}
+		return Member{
+			Ingress:      ingress,
+			IngressAnnos: &annotations.Ingress{Conditions: &conditions.Config{}},
+			TGGroup:      tg.TargetGroupGroup{TGByBackend: map[extensions.IngressBackend]tg.TargetGroup{backend: {Arn: tgArn}}},
+		}
+	}
+	c := &rulesController{authModule: mockAuthModule}
+
+	got, err := c.getMembersDesiredRules(context.Background(), &elbv2.Listener{}, []Member{
+		member("alpha", []string{"a.example.com", "b.example.com"}, "tgArn-alpha"),
+		member("beta", []string{"c.example.com"}, "tgArn-beta"),
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	var priorities, hosts, tgArns []string
+	for _, rule := range got {
+		priorities = append(priorities, aws.StringValue(rule.Priority))
+		hosts = append(hosts, aws.StringValue(rule.Conditions[0].HostHeaderConfig.Values[0]))
+		tgArns = append(tgArns, aws.StringValue(rule.Actions[0].ForwardConfig.TargetGroups[0].TargetGroupArn))
+	}
+	assert.Equal(t, []string{"1", "2", "3"}, priorities)
+	assert.Equal(t, []string{"a.example.com", "b.example.com", "c.example.com"}, hosts)
+	assert.Equal(t, []string{"tgArn-alpha", "tgArn-alpha", "tgArn-beta"}, tgArns, "rules of each member should forward to its own targetGroups")
+
+	broken := member("gamma", []string{"d.example.com"}, "tgArn-gamma")
+	broken.TGGroup = tg.TargetGroupGroup{}
+	_, err = c.getMembersDesiredRules(context.Background(), &elbv2.Listener{}, []Member{broken})
+	assert.EqualError(t, err, "failed to build rules of ingress gamma/ingress: unable to find targetGroup for backend service:80")
+}
+
 type GetRulesCall struct {
 	Output []*elbv2.Rule
 	Error  error
@@ -2575,6 +2785,113 @@ func Test_reconcileRules(t *testing.T) {
 	}
 }
 
+func Test_orderedPaths(t *testing.T) {
+	spec := extensions.IngressSpec{
+		Rules: []extensions.IngressRule{
+			{
+				Host: "example.com",
+				IngressRuleValue: extensions.IngressRuleValue{
+					HTTP: &extensions.HTTPIngressRuleValue{
+						Paths: []extensions.HTTPIngressPath{{Path: "/*"}, {Path: "/api/*"}},
+					},
+				},
+			},
+			{
+				IngressRuleValue: extensions.IngressRuleValue{
+					HTTP: &extensions.HTTPIngressRuleValue{
+						Paths: []extensions.HTTPIngressPath{{Path: "/static/*"}, {Path: "/health"}},
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range []struct {
+		name          string
+		annotations   map[string]string
+		expected      []string
+		expectedError string
+	}{
+		{
+			name:     "spec order without annotation",
+			expected: []string{"example.com/*", "example.com/api/*", "/static/*", "/health"},
+		},
+		{
+			name: "catch-all last and api first",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/rule-order": `{"example.com/*": 10, "example.com/api/*": -1}`,
+			},
+			expected: []string{"example.com/api/*", "/static/*", "/health", "example.com/*"},
+		},
+		{
+			name: "unknown path",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/rule-order": `{"/api/*": -1}`,
+			},
+			expectedError: "/api/* of annotation alb.ingress.kubernetes.io/rule-order matches no host and path of the ingress rules",
+		},
+		{
+			name: "invalid order",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/rule-order": `{"/health": "first"}`,
+			},
+			expectedError: `failed to parse annotation, alb.ingress.kubernetes.io/rule-order: {"/health": "first"}: json: cannot unmarshal string`,
+		},
+		{
+			name: "paths rejected by conversion",
+			annotations: map[string]string{
+				"ingressapi.alb.ingress.kubernetes.io/rejected paths": `["/api*"]`,
+			},
+			expectedError: "paths /api* of type Exact or Prefix must not contain * or ?",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ingress := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations}, Spec: spec}
+			paths, err := orderedPaths(ingress)
+			if tc.expectedError != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tc.expectedError)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			var got []string
+			for _, p := range paths {
+				got = append(got, ingress.Spec.Rules[p.ruleIndex].Host+p.path.Path)
+			}
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func Test_orderedPaths_wildcardHosts(t *testing.T) {
+	rule := func(host string) extensions.IngressRule {
+		return extensions.IngressRule{
+			Host: host,
+			IngressRuleValue: extensions.IngressRuleValue{
+				HTTP: &extensions.HTTPIngressRuleValue{Paths: []extensions.HTTPIngressPath{{Path: "/*"}}},
+			},
+		}
+	}
+	ingress := &extensions.Ingress{Spec: extensions.IngressSpec{
+		Rules: []extensions.IngressRule{rule("*.example.com"), rule("www.example.com"), rule("api.example.com")},
+	}}
+	paths, err := orderedPaths(ingress)
+	assert.NoError(t, err)
+	var got []string
+	for _, p := range paths {
+		got = append(got, ingress.Spec.Rules[p.ruleIndex].Host+p.path.Path)
+	}
+	assert.Equal(t, []string{"www.example.com/*", "api.example.com/*", "*.example.com/*"}, got)
+
+	ingress.Spec.Rules = []extensions.IngressRule{rule("www.*.example.com")}
+	_, err = orderedPaths(ingress)
+	assert.EqualError(t, err, "host www.*.example.com must only have a wildcard as first label, e.g. *.example.com")
+
+	ingress.Spec.Rules = []extensions.IngressRule{rule("*.*.example.com")}
+	_, err = orderedPaths(ingress)
+	assert.EqualError(t, err, "host *.*.example.com must only have a wildcard as first label, e.g. *.example.com")
+}
+
 func Test_createsRedirectLoop(t *testing.T) {
 	for _, tc := range []struct {
 		name     string
@@ -3201,3 +3518,50 @@ func Test_redactActions(t *testing.T) {
 		})
 	}
 }
+
+func Test_DiffRules(t *testing.T) {
+	oidcAction := func(clientID, clientSecret *string) *elbv2.Action {
+		return &elbv2.Action{
+			Type:  aws.String(elbv2.ActionTypeEnumAuthenticateOidc),
+			Order: aws.Int64(1),
+			AuthenticateOidcConfig: &elbv2.AuthenticateOidcActionConfig{
+				ClientId:      clientID,
+				ClientSecret:  clientSecret,
+				TokenEndpoint: aws.String("endpoint"),
+			},
+		}
+	}
+	pathRule := func(priority string, path string, actions ...*elbv2.Action) elbv2.Rule {
+		return elbv2.Rule{
+			Priority: aws.String(priority),
+			Actions:  actions,
+			Conditions: []*elbv2.RuleCondition{
+				{
+					Field:             aws.String(conditions.FieldPathPattern),
+					PathPatternConfig: &elbv2.PathPatternConditionConfig{Values: aws.StringSlice([]string{path})},
+				},
+			},
+		}
+	}
+	desired := []elbv2.Rule{
+		pathRule("1", "/auth", oidcAction(aws.String("<redacted>"), aws.String("<redacted>"))),
+		pathRule("2", "/new", oidcAction(aws.String("<redacted>"), aws.String("<redacted>"))),
+		pathRule("3", "/added", oidcAction(aws.String("<redacted>"), aws.String("<redacted>"))),
+	}
+	current := []elbv2.Rule{
+		// DescribeRules doesn't return the client secret
+		pathRule("1", "/auth", oidcAction(aws.String("client-id"), nil)),
+		pathRule("2", "/old", oidcAction(aws.String("client-id"), nil)),
+		pathRule("4", "/removed", oidcAction(aws.String("client-id"), nil)),
+	}
+
+	add, modify, remove := DiffRules(current, desired)
+	assert.Equal(t, []elbv2.Rule{desired[2]}, add)
+	if assert.Len(t, modify, 1) {
+		assert.Equal(t, "2", aws.StringValue(modify[0].Priority))
+	}
+	if assert.Len(t, remove, 1) {
+		assert.Equal(t, "4", aws.StringValue(remove[0].Priority))
+	}
+	assert.Equal(t, "client-id", aws.StringValue(current[0].Actions[0].AuthenticateOidcConfig.ClientId))
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/tg"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/tracing"
 )
 
 /**
@@ -27,6 +29,8 @@ are provided via annotation `alb.ingress.kubernetes.io/security-groups`.
 	1. the external specified SecurityGroups will be applied to LoadBalancer.
 	2. no changes will be done to worker node SecurityGroups, customer need to grant inbound permission
 		from these external SecurityGroups to worker node SecurityGroups.
+		unless annotation `alb.ingress.kubernetes.io/manage-backend-security-group-rules` is true, in which case the backend securityGroup
+		described below is attached alongside the external SecurityGroups, and worker node SecurityGroups allow inbound traffic from it.
 
 * external SecurityGroups unspecified:
 	1. controller will automatically create an SecurityGroup, which will be applied to LoadBalancer.
@@ -40,19 +44,23 @@ are provided via annotation `alb.ingress.kubernetes.io/security-groups`.
 		2. if there are multiple SecurityGroup on ENI, the single SecurityGroup with tag `kubernetes.io/cluster/<cluster-name>` will be chosen.
 		3. otherwise, error will be raised.
 
+	With the backend securityGroup enabled (`--backend-security-group`), a single controller-managed securityGroup is attached to all LoadBalancers
+	alongside their managed SecurityGroup, and worker node SecurityGroups only allow inbound traffic from it, instead of from each LB SecurityGroup.
+	The backend securityGroup is never deleted by the controller since it's shared by all LoadBalancers.
+
 	NOTE: older versions will try to create an standalone SecurityGroup which allows from traffic from LB SecurityGroup and attach to worker nodes ENI.
 	This behavior is changed to above due un-scalability caused by AWS limits of allow securityGroup per ENI.
 */
 
 // AssociationController provides functionality to manage Association
 type AssociationController interface {
-	// Setup will provides SecurityGroups that should be used by LoadBalancer.
-	Setup(ctx context.Context, ingKey types.NamespacedName) (LbAttachmentInfo, error)
+	// Setup will provides SecurityGroups that should be used by LoadBalancer, configured by ingressAnnos.
+	Setup(ctx context.Context, ingKey types.NamespacedName, ingressAnnos *annotations.Ingress) (LbAttachmentInfo, error)
 
 	// Reconcile will configure LB to use specified SecurityGroup in attachmentInfo.
-	// Also, if managed LoadBalancer SG is used, the SecurityGroups on worker nodes will be adjusted to grant inbound traffic permission to tgGroup.
-	Reconcile(ctx context.Context, ingKey types.NamespacedName, attachmentInfo LbAttachmentInfo,
-		lbInstance *elbv2.LoadBalancer, tgGroup tg.TargetGroupGroup) error
+	// Also, if managed LoadBalancer SG is used, the SecurityGroups on worker nodes will be adjusted to grant inbound traffic permission to tgGroups.
+	Reconcile(ctx context.Context, ingKey types.NamespacedName, ingressAnnos *annotations.Ingress, attachmentInfo LbAttachmentInfo,
+		lbInstance *elbv2.LoadBalancer, tgGroups []tg.TargetGroupGroup) error
 
 	// Delete ensures the SecurityGroup created for LB are deleted.
 	// Also, if managed LB SecurityGroup is used, the SecurityGroups on worker nodes will be adjusted to remove inbound traffic permission from it.
@@ -60,7 +68,8 @@ type AssociationController interface {
 }
 
 // NewAssociationController constructs a new association controller
-func NewAssociationController(store store.Storer, cloud aws.CloudAPI, tagsController tags.Controller, nameTagGen NameTagGenerator) AssociationController {
+// The backend securityGroup is shared by the LoadBalancers with a managed securityGroup when backendSG is true.
+func NewAssociationController(store store.Storer, cloud aws.CloudAPI, tagsController tags.Controller, nameTagGen NameTagGenerator, backendSG bool) AssociationController {
 	lbAttachmentController := &lbAttachmentController{
 		cloud: cloud,
 	}
@@ -71,10 +80,13 @@ func NewAssociationController(store store.Storer, cloud aws.CloudAPI, tagsContro
 	targetENIsResolver := NewTargetENIsResolver(store, cloud)
 	instanceAttachmentController := NewInstanceAttachmentController(
 		sgController, targetENIsResolver, nameTagGen, store, cloud)
+	backendSGController := NewBackendSGController(sgController, targetENIsResolver, nameTagGen, store, cloud)
 
 	return &associationController{
 		lbAttachmentController:       lbAttachmentController,
 		instanceAttachmentController: instanceAttachmentController,
+		backendSGController:          backendSGController,
+		backendSG:                    backendSG,
 		sgController:                 sgController,
 		nameTagGen:                   nameTagGen,
 		store:                        store,
@@ -85,30 +97,46 @@ func NewAssociationController(store store.Storer, cloud aws.CloudAPI, tagsContro
 type associationController struct {
 	lbAttachmentController       LbAttachmentController
 	instanceAttachmentController InstanceAttachmentController
+	backendSGController          BackendSGController
 	sgController                 SecurityGroupController
 	nameTagGen                   NameTagGenerator
 
+	// backendSG attaches the backend securityGroup to all LoadBalancers with a managed securityGroup.
+	backendSG bool
+
 	store store.Storer
 	cloud aws.CloudAPI
 }
 
 type associationConfig struct {
-	LbPorts          []int64
-	LbInboundCIDRs   []string
-	LbInboundV6CIDRs []string
-	LbExternalSGs    []string
-	AdditionalTags   map[string]string
+	LbPorts              []int64
+	LbInboundCIDRs       []string
+	LbInboundV6CIDRs     []string
+	LbInboundPrefixLists []string
+	LbExternalSGs        []string
+	ManageBackendSGRules bool
+	AdditionalTags       map[string]string
 }
 
-func (c *associationController) Setup(ctx context.Context, ingKey types.NamespacedName) (LbAttachmentInfo, error) {
-	cfg, err := c.buildAssociationConfig(ctx, ingKey)
+func (c *associationController) Setup(ctx context.Context, ingKey types.NamespacedName, ingressAnnos *annotations.Ingress) (LbAttachmentInfo, error) {
+	ctx, span := tracing.StartSpan(ctx, "sg.Setup")
+	defer span.End()
+
+	cfg, err := c.buildAssociationConfig(ctx, ingressAnnos)
 	if err != nil {
 		return LbAttachmentInfo{}, errors.Wrap(err, "failed to build SG association config")
 	}
 	if len(cfg.LbExternalSGs) != 0 {
+		var backendSG string
+		if cfg.ManageBackendSGRules {
+			if backendSG, err = c.backendSGController.Setup(ctx); err != nil {
+				return LbAttachmentInfo{}, err
+			}
+		}
 		return LbAttachmentInfo{
 			ManagedSGID:   "",
 			ExternalSGIDs: cfg.LbExternalSGs,
+			BackendSGID:   backendSG,
 		}, nil
 	}
 
@@ -116,22 +144,34 @@ func (c *associationController) Setup(ctx context.Context, ingKey types.Namespac
 	if err != nil {
 		return LbAttachmentInfo{}, errors.Wrap(err, "failed to reconcile LB managed SecurityGroup")
 	}
+	var backendSG string
+	if c.backendSG {
+		if backendSG, err = c.backendSGController.Setup(ctx); err != nil {
+			return LbAttachmentInfo{}, err
+		}
+	}
 	return LbAttachmentInfo{
 		ManagedSGID:   lbManagedSG,
 		ExternalSGIDs: nil,
+		BackendSGID:   backendSG,
 	}, nil
 }
 
-func (c *associationController) Reconcile(ctx context.Context, ingKey types.NamespacedName, attachmentInfo LbAttachmentInfo,
-	lbInstance *elbv2.LoadBalancer, tgGroup tg.TargetGroupGroup) error {
+func (c *associationController) Reconcile(ctx context.Context, ingKey types.NamespacedName, ingressAnnos *annotations.Ingress, attachmentInfo LbAttachmentInfo,
+	lbInstance *elbv2.LoadBalancer, tgGroups []tg.TargetGroupGroup) error {
+	ctx, span := tracing.StartSpan(ctx, "sg.Reconcile")
+	defer span.End()
 
 	if len(attachmentInfo.ExternalSGIDs) != 0 {
-		return c.reconcileWithExternalSGs(ctx, ingKey, lbInstance, attachmentInfo.ExternalSGIDs)
+		return c.reconcileWithExternalSGs(ctx, ingKey, lbInstance, attachmentInfo, tgGroups)
 	}
-	return c.reconcileWithManagedSGs(ctx, ingKey, lbInstance, attachmentInfo.ManagedSGID, tgGroup)
+	return c.reconcileWithManagedSGs(ctx, ingKey, ingressAnnos, lbInstance, attachmentInfo, tgGroups)
 }
 
 func (c *associationController) Delete(ctx context.Context, ingKey types.NamespacedName) error {
+	ctx, span := tracing.StartSpan(ctx, "sg.Delete")
+	defer span.End()
+
 	if err := c.instanceAttachmentController.Delete(ctx, ingKey); err != nil {
 		return errors.Wrap(err, "failed to delete instance securityGroup attachment")
 	}
@@ -141,10 +181,16 @@ func (c *associationController) Delete(ctx context.Context, ingKey types.Namespa
 	return nil
 }
 
-func (c *associationController) reconcileWithExternalSGs(ctx context.Context, ingKey types.NamespacedName, lbInstance *elbv2.LoadBalancer, lbExternalSGIDs []string) error {
-	if err := c.lbAttachmentController.Reconcile(ctx, lbInstance, lbExternalSGIDs); err != nil {
+func (c *associationController) reconcileWithExternalSGs(ctx context.Context, ingKey types.NamespacedName, lbInstance *elbv2.LoadBalancer,
+	attachmentInfo LbAttachmentInfo, tgGroups []tg.TargetGroupGroup) error {
+	if err := c.lbAttachmentController.Reconcile(ctx, lbInstance, attachmentInfo.SGIDs()); err != nil {
 		return errors.Wrap(err, "failed to reconcile external LoadBalancer securityGroup attachment")
 	}
+	if attachmentInfo.BackendSGID != "" {
+		if err := c.backendSGController.Reconcile(ctx, attachmentInfo.BackendSGID, tgGroups); err != nil {
+			return errors.Wrap(err, "failed to reconcile backend securityGroup attachment")
+		}
+	}
 	if err := c.instanceAttachmentController.Delete(ctx, ingKey); err != nil {
 		return errors.Wrap(err, "failed to delete instance securityGroup attachment")
 	}
@@ -154,11 +200,23 @@ func (c *associationController) reconcileWithExternalSGs(ctx context.Context, in
 	return nil
 }
 
-func (c *associationController) reconcileWithManagedSGs(ctx context.Context, ingKey types.NamespacedName, lbInstance *elbv2.LoadBalancer, lbManagedSGID string, tgGroup tg.TargetGroupGroup) error {
-	if err := c.lbAttachmentController.Reconcile(ctx, lbInstance, []string{lbManagedSGID}); err != nil {
+func (c *associationController) reconcileWithManagedSGs(ctx context.Context, ingKey types.NamespacedName, ingressAnnos *annotations.Ingress,
+	lbInstance *elbv2.LoadBalancer, attachmentInfo LbAttachmentInfo, tgGroups []tg.TargetGroupGroup) error {
+	if err := c.lbAttachmentController.Reconcile(ctx, lbInstance, attachmentInfo.SGIDs()); err != nil {
 		return errors.Wrap(err, "failed to reconcile managed LoadBalancer securityGroup attachment")
 	}
-	if err := c.instanceAttachmentController.Reconcile(ctx, ingKey, lbManagedSGID, tgGroup); err != nil {
+	if attachmentInfo.BackendSGID != "" {
+		if err := c.backendSGController.Reconcile(ctx, attachmentInfo.BackendSGID, tgGroups); err != nil {
+			return errors.Wrap(err, "failed to reconcile backend securityGroup attachment")
+		}
+		// the permissions from the LB SecurityGroup are superseded by the ones from the backend securityGroup,
+		// they're only revoked once the backend securityGroup is allowed so that traffic isn't interrupted.
+		if err := c.instanceAttachmentController.Delete(ctx, ingKey); err != nil {
+			return errors.Wrap(err, "failed to delete instance securityGroup attachment")
+		}
+		return nil
+	}
+	if err := c.instanceAttachmentController.Reconcile(ctx, ingKey, ingressAnnos, attachmentInfo.ManagedSGID, tgGroups); err != nil {
 		return errors.Wrap(err, "failed to reconcile instance securityGroup attachment")
 	}
 	return nil
@@ -176,39 +234,35 @@ func (c *associationController) ensureLBManagedSG(ctx context.Context, ingKey ty
 		sgTags[k] = v
 	}
 
+	// the sources of each port are granted in a single permission, the way EC2 describes them, so that they're only modified on changes.
 	var inboundPermissions []*ec2.IpPermission
 	for _, port := range cfg.LbPorts {
-		ipRanges := make([]*ec2.IpRange, 0, len(cfg.LbInboundCIDRs))
+		permission := &ec2.IpPermission{
+			IpProtocol: aws.String("tcp"),
+			FromPort:   aws.Int64(port),
+			ToPort:     aws.Int64(port),
+		}
 		for _, cidr := range cfg.LbInboundCIDRs {
-			ipRanges = append(ipRanges, &ec2.IpRange{
+			permission.IpRanges = append(permission.IpRanges, &ec2.IpRange{
 				CidrIp:      aws.String(cidr),
 				Description: aws.String(fmt.Sprintf("Allow ingress on port %v from %v", port, cidr)),
 			})
 		}
-		if len(ipRanges) > 0 {
-			inboundPermissions = append(inboundPermissions, &ec2.IpPermission{
-				IpProtocol: aws.String("tcp"),
-				FromPort:   aws.Int64(port),
-				ToPort:     aws.Int64(port),
-				IpRanges:   ipRanges,
-			})
-		}
-
-		ipv6Ranges := make([]*ec2.Ipv6Range, 0, len(cfg.LbInboundV6CIDRs))
 		for _, cidr := range cfg.LbInboundV6CIDRs {
-			ipv6Ranges = append(ipv6Ranges, &ec2.Ipv6Range{
+			permission.Ipv6Ranges = append(permission.Ipv6Ranges, &ec2.Ipv6Range{
 				CidrIpv6:    aws.String(cidr),
 				Description: aws.String(fmt.Sprintf("Allow ingress on port %v from %v", port, cidr)),
 			})
 		}
-		if len(ipv6Ranges) > 0 {
-			inboundPermissions = append(inboundPermissions, &ec2.IpPermission{
-				IpProtocol: aws.String("tcp"),
-				FromPort:   aws.Int64(port),
-				ToPort:     aws.Int64(port),
-				Ipv6Ranges: ipv6Ranges,
+		for _, prefixList := range cfg.LbInboundPrefixLists {
+			permission.PrefixListIds = append(permission.PrefixListIds, &ec2.PrefixListId{
+				PrefixListId: aws.String(prefixList),
+				Description:  aws.String(fmt.Sprintf("Allow ingress on port %v from %v", port, prefixList)),
 			})
 		}
+		if len(permission.IpRanges) > 0 || len(permission.Ipv6Ranges) > 0 || len(permission.PrefixListIds) > 0 {
+			inboundPermissions = append(inboundPermissions, permission)
+		}
 	}
 	if err := c.sgController.Reconcile(ctx, sgInstance, inboundPermissions, sgTags); err != nil {
 		return "", fmt.Errorf("failed to reconcile managed LoadBalancer securityGroup due to %v", err)
@@ -231,12 +285,7 @@ func (c *associationController) deleteLBManagedSG(ctx context.Context, ingKey ty
 	return c.cloud.DeleteSecurityGroupByID(ctx, aws.StringValue(sgInstance.GroupId))
 }
 
-func (c *associationController) buildAssociationConfig(ctx context.Context, ingKey types.NamespacedName) (associationConfig, error) {
-	ingressAnnos, err := c.store.GetIngressAnnotations(ingKey.String())
-	if err != nil {
-		return associationConfig{}, err
-	}
-
+func (c *associationController) buildAssociationConfig(ctx context.Context, ingressAnnos *annotations.Ingress) (associationConfig, error) {
 	lbPorts := make([]int64, 0, len(ingressAnnos.LoadBalancer.Ports))
 	for _, port := range ingressAnnos.LoadBalancer.Ports {
 		lbPorts = append(lbPorts, port.Port)
@@ -246,11 +295,13 @@ func (c *associationController) buildAssociationConfig(ctx context.Context, ingK
 		return associationConfig{}, err
 	}
 	return associationConfig{
-		LbPorts:          lbPorts,
-		LbInboundCIDRs:   ingressAnnos.LoadBalancer.InboundCidrs,
-		LbInboundV6CIDRs: ingressAnnos.LoadBalancer.InboundV6CIDRs,
-		LbExternalSGs:    lbExternalSGs,
-		AdditionalTags:   ingressAnnos.Tags.LoadBalancer,
+		LbPorts:              lbPorts,
+		LbInboundCIDRs:       ingressAnnos.LoadBalancer.InboundCidrs,
+		LbInboundV6CIDRs:     ingressAnnos.LoadBalancer.InboundV6CIDRs,
+		LbInboundPrefixLists: ingressAnnos.LoadBalancer.InboundPrefixLists,
+		LbExternalSGs:        lbExternalSGs,
+		ManageBackendSGRules: ingressAnnos.LoadBalancer.ManageBackendSGRules,
+		AdditionalTags:       ingressAnnos.Tags.LoadBalancer,
 	}, nil
 }
 
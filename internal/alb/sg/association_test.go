@@ -10,6 +10,7 @@ import (
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/mocks"
 	"github.com/magiconair/properties/assert"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func Test_resolveSecurityGroupIDs(t *testing.T) {
@@ -92,3 +93,70 @@ func Test_resolveSecurityGroupIDs(t *testing.T) {
 		})
 	}
 }
+
+// recordingSGController records the inboundPermissions reconciled on securityGroups.
+type recordingSGController struct {
+	inboundPermissions []*ec2.IpPermission
+}
+
+func (c *recordingSGController) EnsureSGInstanceByName(ctx context.Context, name string, description string) (*ec2.SecurityGroup, error) {
+	return &ec2.SecurityGroup{GroupId: aws.String("sg-lb"), GroupName: aws.String(name)}, nil
+}
+
+func (c *recordingSGController) Reconcile(ctx context.Context, instance *ec2.SecurityGroup, inboundPermissions []*ec2.IpPermission, tags map[string]string) error {
+	c.inboundPermissions = inboundPermissions
+	return nil
+}
+
+type stubNameTagGenerator struct{}
+
+func (stubNameTagGenerator) NameLBSG(namespace string, ingressName string) string {
+	return namespace + "-" + ingressName
+}
+
+func (stubNameTagGenerator) NameInstanceSG(namespace string, ingressName string) string {
+	return "instance-" + namespace + "-" + ingressName
+}
+
+func (stubNameTagGenerator) NameBackendSG() string {
+	return "backend"
+}
+
+func (stubNameTagGenerator) TagLBSG(namespace string, ingressName string) map[string]string {
+	return map[string]string{}
+}
+
+func (stubNameTagGenerator) TagInstanceSG(namespace string, ingressName string) map[string]string {
+	return map[string]string{}
+}
+
+func (stubNameTagGenerator) TagBackendSG() map[string]string {
+	return map[string]string{}
+}
+
+func Test_ensureLBManagedSG_ipv6(t *testing.T) {
+	sgController := &recordingSGController{}
+	controller := &associationController{sgController: sgController, nameTagGen: stubNameTagGenerator{}}
+
+	sgID, err := controller.ensureLBManagedSG(context.Background(), types.NamespacedName{Namespace: "namespace", Name: "ingress"}, associationConfig{
+		LbPorts:          []int64{443},
+		LbInboundCIDRs:   []string{"10.0.0.0/8"},
+		LbInboundV6CIDRs: []string{"2001:db8::/32"},
+	})
+	assert.Equal(t, err, nil)
+	assert.Equal(t, sgID, "sg-lb")
+	// IPv4 and IPv6 ranges share the permission of the port, as described by EC2.
+	assert.Equal(t, sgController.inboundPermissions, []*ec2.IpPermission{{
+		IpProtocol: aws.String("tcp"),
+		FromPort:   aws.Int64(443),
+		ToPort:     aws.Int64(443),
+		IpRanges: []*ec2.IpRange{{
+			CidrIp:      aws.String("10.0.0.0/8"),
+			Description: aws.String("Allow ingress on port 443 from 10.0.0.0/8"),
+		}},
+		Ipv6Ranges: []*ec2.Ipv6Range{{
+			CidrIpv6:    aws.String("2001:db8::/32"),
+			Description: aws.String("Allow ingress on port 443 from 2001:db8::/32"),
+		}},
+	}})
+}
@@ -0,0 +1,80 @@
+package sg
+
+import (
+	"context"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/tg"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/store"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// BackendSGController manages the backend securityGroup, which is shared by the LoadBalancers with a managed securityGroup,
+// so that worker node securityGroups need a single rule for all of them instead of one rule per LoadBalancer.
+type BackendSGController interface {
+	// Setup ensures the backend securityGroup exists, and returns its ID.
+	Setup(ctx context.Context) (string, error)
+
+	// Reconcile grants inbound traffic from the backend securityGroup on the worker node securityGroups of targets in tgGroups.
+	// Permissions are never revoked, since the worker nodes may still be targets of other LoadBalancers.
+	Reconcile(ctx context.Context, backendSGID string, tgGroups []tg.TargetGroupGroup) error
+}
+
+// NewBackendSGController constructs a new backend securityGroup controller
+func NewBackendSGController(
+	sgController SecurityGroupController,
+	targetENIsResolver TargetENIsResolver,
+	nameTagGen NameTagGenerator,
+	store store.Storer,
+	cloud aws.CloudAPI) BackendSGController {
+
+	return &backendSGController{
+		sgController: sgController,
+		instanceAttachmentController: &instanceAttachmentControllerV2{
+			sgController:       sgController,
+			targetENIsResolver: targetENIsResolver,
+			nameTagGen:         nameTagGen,
+			store:              store,
+			cloud:              cloud,
+		},
+		nameTagGen: nameTagGen,
+	}
+}
+
+type backendSGController struct {
+	sgController SecurityGroupController
+	// instanceAttachmentController finds the worker node securityGroups and the permissions on them.
+	instanceAttachmentController *instanceAttachmentControllerV2
+	nameTagGen                   NameTagGenerator
+}
+
+func (c *backendSGController) Setup(ctx context.Context) (string, error) {
+	sgInstance, err := c.sgController.EnsureSGInstanceByName(ctx, c.nameTagGen.NameBackendSG(), "backend securityGroup shared by LoadBalancers of ALB Ingress Controller")
+	if err != nil {
+		return "", errors.Wrap(err, "failed to reconcile backend securityGroup")
+	}
+	if err := c.sgController.Reconcile(ctx, sgInstance, nil, c.nameTagGen.TagBackendSG()); err != nil {
+		return "", errors.Wrap(err, "failed to reconcile backend securityGroup")
+	}
+	return aws.StringValue(sgInstance.GroupId), nil
+}
+
+func (c *backendSGController) Reconcile(ctx context.Context, backendSGID string, tgGroups []tg.TargetGroupGroup) error {
+	targetInstanceSGs, err := c.instanceAttachmentController.findInstanceSGsForTgGroups(ctx, tgGroups)
+	if err != nil {
+		return err
+	}
+	attachedInstanceSGs, err := c.instanceAttachmentController.findInstanceSGsAttachedWithLBSG(ctx, backendSGID)
+	if err != nil {
+		return err
+	}
+
+	shouldAttachInstanceSGs := sets.StringKeySet(targetInstanceSGs).Difference(sets.StringKeySet(attachedInstanceSGs))
+	for _, instanceSGID := range shouldAttachInstanceSGs.List() {
+		if err := c.instanceAttachmentController.ensureLBSGAttachedToInstanceSG(ctx, backendSGID, targetInstanceSGs[instanceSGID]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package sg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/tg"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type stubTargetENIsResolver map[string]ENIInfo
+
+func (r stubTargetENIsResolver) Resolve(ctx context.Context, tgGroups []tg.TargetGroupGroup) (map[string]ENIInfo, error) {
+	return r, nil
+}
+
+func Test_backendSGController_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	cloud := &mocks.CloudAPI{}
+	cloud.On("GetClusterName").Return("cluster")
+	cloud.On("DescribeSecurityGroups", ctx, &ec2.DescribeSecurityGroupsInput{
+		GroupIds: aws.StringSlice([]string{"sg-node-1", "sg-node-2"}),
+	}).Return([]*ec2.SecurityGroup{{GroupId: aws.String("sg-node-1")}, {GroupId: aws.String("sg-node-2")}}, nil)
+	cloud.On("DescribeSecurityGroups", ctx, &ec2.DescribeSecurityGroupsInput{
+		Filters: []*ec2.Filter{{Name: aws.String("ip-permission.group-id"), Values: aws.StringSlice([]string{"sg-backend"})}},
+	}).Return([]*ec2.SecurityGroup{{GroupId: aws.String("sg-node-1")}, {GroupId: aws.String("sg-other-node")}}, nil)
+	cloud.On("AuthorizeSecurityGroupIngressWithContext", ctx, &ec2.AuthorizeSecurityGroupIngressInput{
+		GroupId: aws.String("sg-node-2"),
+		IpPermissions: []*ec2.IpPermission{{
+			IpProtocol:       aws.String("tcp"),
+			FromPort:         aws.Int64(0),
+			ToPort:           aws.Int64(65535),
+			UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-backend")}},
+		}},
+	}).Return(nil, nil)
+
+	resolver := stubTargetENIsResolver{
+		"eni-1": NewENIInfoViaENI(&ec2.NetworkInterface{Groups: []*ec2.GroupIdentifier{{GroupId: aws.String("sg-node-1")}}}),
+		"eni-2": NewENIInfoViaENI(&ec2.NetworkInterface{Groups: []*ec2.GroupIdentifier{{GroupId: aws.String("sg-node-2")}}}),
+	}
+	controller := NewBackendSGController(nil, resolver, nil, nil, cloud)
+
+	// the permissions on sg-other-node are kept, it may be a target of other LoadBalancers.
+	assert.NoError(t, controller.Reconcile(ctx, "sg-backend", nil))
+	cloud.AssertExpectations(t)
+	cloud.AssertNotCalled(t, "RevokeSecurityGroupIngressWithContext", mock.Anything, mock.Anything)
+}
+
+func Test_LbAttachmentInfo_SGIDs(t *testing.T) {
+	info := LbAttachmentInfo{ManagedSGID: "sg-managed", BackendSGID: "sg-backend"}
+	assert.Equal(t, []string{"sg-managed", "sg-backend"}, info.SGIDs())
+
+	info = LbAttachmentInfo{ExternalSGIDs: []string{"sg-external"}}
+	assert.Equal(t, []string{"sg-external"}, info.SGIDs())
+
+	info = LbAttachmentInfo{ExternalSGIDs: []string{"sg-external-1", "sg-external-2"}, BackendSGID: "sg-backend"}
+	assert.Equal(t, []string{"sg-external-1", "sg-external-2", "sg-backend"}, info.SGIDs())
+}
@@ -5,14 +5,15 @@ import (
 
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/tg"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/store"
 	"k8s.io/apimachinery/pkg/types"
 )
 
 // InstanceAttachment manages SecurityGroups on worker nodes.
 type InstanceAttachmentController interface {
-	// Reconcile will setup SecurityGroup on worker nodes to allow inbound traffic from LoadBalancer(with lbSGID) to targets in tgGroup.
-	Reconcile(ctx context.Context, ingKey types.NamespacedName, lbSGID string, tgGroup tg.TargetGroupGroup) error
+	// Reconcile will setup SecurityGroup on worker nodes to allow inbound traffic from LoadBalancer(with lbSGID) to targets in tgGroups.
+	Reconcile(ctx context.Context, ingKey types.NamespacedName, ingressAnnos *annotations.Ingress, lbSGID string, tgGroups []tg.TargetGroupGroup) error
 
 	// Delete will cleanup resources setup in Reconcile.
 	Delete(ctx context.Context, ingKey types.NamespacedName) error
@@ -40,13 +41,13 @@ type migratableInstanceAttachmentController struct {
 	deprecated []InstanceAttachmentController
 }
 
-func (c *migratableInstanceAttachmentController) Reconcile(ctx context.Context, ingKey types.NamespacedName, lbSGID string, tgGroup tg.TargetGroupGroup) error {
+func (c *migratableInstanceAttachmentController) Reconcile(ctx context.Context, ingKey types.NamespacedName, ingressAnnos *annotations.Ingress, lbSGID string, tgGroups []tg.TargetGroupGroup) error {
 	for _, deprecatedC := range c.deprecated {
 		if err := deprecatedC.Delete(ctx, ingKey); err != nil {
 			return err
 		}
 	}
-	return c.activated.Reconcile(ctx, ingKey, lbSGID, tgGroup)
+	return c.activated.Reconcile(ctx, ingKey, ingressAnnos, lbSGID, tgGroups)
 }
 
 func (c *migratableInstanceAttachmentController) Delete(ctx context.Context, ingKey types.NamespacedName) error {
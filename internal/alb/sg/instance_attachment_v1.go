@@ -8,6 +8,7 @@ import (
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/tg"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/store"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/types"
@@ -43,14 +44,10 @@ type instanceAttachmentControllerV1 struct {
 
 var clusterInstanceENILock = &sync.Mutex{}
 
-func (c *instanceAttachmentControllerV1) Reconcile(ctx context.Context, ingKey types.NamespacedName, lbSGID string,
-	tgGroup tg.TargetGroupGroup) error {
+func (c *instanceAttachmentControllerV1) Reconcile(ctx context.Context, ingKey types.NamespacedName, ingressAnnos *annotations.Ingress, lbSGID string,
+	tgGroups []tg.TargetGroupGroup) error {
 
-	ingAnnos, err := c.store.GetIngressAnnotations(ingKey.String())
-	if err != nil {
-		return err
-	}
-	instanceSGID, err := c.ensureInstanceSG(ctx, ingKey, lbSGID, ingAnnos.Tags.LoadBalancer)
+	instanceSGID, err := c.ensureInstanceSG(ctx, ingKey, lbSGID, ingressAnnos.Tags.LoadBalancer)
 	if err != nil {
 		return errors.Wrap(err, "failed to reconcile instance securityGroup")
 	}
@@ -60,7 +57,7 @@ func (c *instanceAttachmentControllerV1) Reconcile(ctx context.Context, ingKey t
 	// lock is cheaper than aws api calls, but we are assuming that no-other external component is modifying ENI sg at same time :D
 	clusterInstanceENILock.Lock()
 	defer clusterInstanceENILock.Unlock()
-	targetENIs, err := c.targetENIsResolver.Resolve(ctx, tgGroup)
+	targetENIs, err := c.targetENIsResolver.Resolve(ctx, tgGroups)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/tg"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/store"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/errors"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/util/log"
@@ -42,8 +43,8 @@ type instanceAttachmentControllerV2 struct {
 	cloud aws.CloudAPI
 }
 
-func (c *instanceAttachmentControllerV2) Reconcile(ctx context.Context, ingKey types.NamespacedName, lbSGID string, tgGroup tg.TargetGroupGroup) error {
-	targetInstanceSGs, err := c.findInstanceSGsForTgGroup(ctx, tgGroup)
+func (c *instanceAttachmentControllerV2) Reconcile(ctx context.Context, ingKey types.NamespacedName, ingressAnnos *annotations.Ingress, lbSGID string, tgGroups []tg.TargetGroupGroup) error {
+	targetInstanceSGs, err := c.findInstanceSGsForTgGroups(ctx, tgGroups)
 	if err != nil {
 		return err
 	}
@@ -94,8 +95,8 @@ func (c *instanceAttachmentControllerV2) Delete(ctx context.Context, ingKey type
 	return nil
 }
 
-func (c *instanceAttachmentControllerV2) findInstanceSGsForTgGroup(ctx context.Context, tgGroup tg.TargetGroupGroup) (map[string]*ec2.SecurityGroup, error) {
-	targetENIs, err := c.targetENIsResolver.Resolve(ctx, tgGroup)
+func (c *instanceAttachmentControllerV2) findInstanceSGsForTgGroups(ctx context.Context, tgGroups []tg.TargetGroupGroup) (map[string]*ec2.SecurityGroup, error) {
+	targetENIs, err := c.targetENIsResolver.Resolve(ctx, tgGroups)
 	if err != nil {
 		return nil, err
 	}
@@ -136,6 +136,12 @@ func ipPermissionEquals(source *ec2.IpPermission, target *ec2.IpPermission) bool
 	if len(diffIPv6Ranges(target.Ipv6Ranges, source.Ipv6Ranges)) != 0 {
 		return false
 	}
+	if len(diffPrefixListIDs(source.PrefixListIds, target.PrefixListIds)) != 0 {
+		return false
+	}
+	if len(diffPrefixListIDs(target.PrefixListIds, source.PrefixListIds)) != 0 {
+		return false
+	}
 	if len(diffUserIDGroupPairs(source.UserIdGroupPairs, target.UserIdGroupPairs)) != 0 {
 		return false
 	}
@@ -180,6 +186,23 @@ func diffIPRanges(source []*ec2.IpRange, target []*ec2.IpRange) (diffs []*ec2.Ip
 	return diffs
 }
 
+// diffPrefixListIDs calculates set_difference as source - target
+func diffPrefixListIDs(source []*ec2.PrefixListId, target []*ec2.PrefixListId) (diffs []*ec2.PrefixListId) {
+	for _, sPrefixList := range source {
+		containsInTarget := false
+		for _, tPrefixList := range target {
+			if aws.StringValue(sPrefixList.PrefixListId) == aws.StringValue(tPrefixList.PrefixListId) {
+				containsInTarget = true
+				break
+			}
+		}
+		if !containsInTarget {
+			diffs = append(diffs, sPrefixList)
+		}
+	}
+	return diffs
+}
+
 // ipRangeEquals test whether two IPRange instance are equals
 func ipRangeEquals(source *string, target *string) bool {
 	return aws.StringValue(source) == aws.StringValue(target)
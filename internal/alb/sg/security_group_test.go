@@ -851,3 +851,22 @@ func TestDiffIPPermissions(t *testing.T) {
 		}
 	}
 }
+
+func TestDiffIPPermissions_prefixLists(t *testing.T) {
+	permission := func(prefixLists ...string) *ec2.IpPermission {
+		p := &ec2.IpPermission{
+			IpProtocol: aws.String("tcp"),
+			FromPort:   aws.Int64(443),
+			ToPort:     aws.Int64(443),
+			IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("10.0.0.0/8")}},
+		}
+		for _, prefixList := range prefixLists {
+			p.PrefixListIds = append(p.PrefixListIds, &ec2.PrefixListId{PrefixListId: aws.String(prefixList)})
+		}
+		return p
+	}
+
+	assert.Equal(t, diffIPPermissions([]*ec2.IpPermission{permission("pl-a", "pl-b")}, []*ec2.IpPermission{permission("pl-b", "pl-a")}), []*ec2.IpPermission(nil))
+	assert.Equal(t, diffIPPermissions([]*ec2.IpPermission{permission("pl-a")}, []*ec2.IpPermission{permission("pl-b")}), []*ec2.IpPermission{permission("pl-a")})
+	assert.Equal(t, diffIPPermissions([]*ec2.IpPermission{permission()}, []*ec2.IpPermission{permission("pl-a")}), []*ec2.IpPermission{permission()})
+}
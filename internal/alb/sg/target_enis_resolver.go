@@ -51,7 +51,7 @@ func (e *ENIInfo) SecurityGroups() []string {
 // TargetENIsResolver resolves the ENIs that supports targets for target groups.
 type TargetENIsResolver interface {
 	// Resolve returns ENIs that supports targets for target groups.
-	Resolve(ctx context.Context, tgGroup tg.TargetGroupGroup) (map[string]ENIInfo, error)
+	Resolve(ctx context.Context, tgGroups []tg.TargetGroupGroup) (map[string]ENIInfo, error)
 }
 
 func NewTargetENIsResolver(store store.Storer, cloud aws.CloudAPI) TargetENIsResolver {
@@ -66,17 +66,19 @@ type defaultTargetENIsResolver struct {
 	cloud aws.CloudAPI
 }
 
-func (r *defaultTargetENIsResolver) Resolve(ctx context.Context, tgGroup tg.TargetGroupGroup) (map[string]ENIInfo, error) {
+func (r *defaultTargetENIsResolver) Resolve(ctx context.Context, tgGroups []tg.TargetGroupGroup) (map[string]ENIInfo, error) {
 	targetInstances := sets.NewString()
 	targetIPs := sets.NewString()
-	for _, tg := range tgGroup.TGByBackend {
-		if tg.TargetType == elbv2.TargetTypeEnumInstance {
-			for _, endpoint := range tg.Targets {
-				targetInstances.Insert(aws.StringValue(endpoint.Id))
-			}
-		} else {
-			for _, endpoint := range tg.Targets {
-				targetIPs.Insert(aws.StringValue(endpoint.Id))
+	for _, tgGroup := range tgGroups {
+		for _, tg := range tgGroup.TGByBackend {
+			if tg.TargetType == elbv2.TargetTypeEnumInstance {
+				for _, endpoint := range tg.Targets {
+					targetInstances.Insert(aws.StringValue(endpoint.Id))
+				}
+			} else {
+				for _, endpoint := range tg.Targets {
+					targetIPs.Insert(aws.StringValue(endpoint.Id))
+				}
 			}
 		}
 	}
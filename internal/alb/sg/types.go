@@ -7,13 +7,23 @@ type LbAttachmentInfo struct {
 
 	// The external provided securityGroupID.
 	ExternalSGIDs []string
+
+	// The backend securityGroupID attached alongside the managed or external securityGroups.
+	// It will be empty unless the backend securityGroup is enabled, or annotation `alb.ingress.kubernetes.io/manage-backend-security-group-rules` is true.
+	BackendSGID string
 }
 
 func (i *LbAttachmentInfo) SGIDs() []string {
+	var sgIDs []string
 	if i.ManagedSGID != "" {
-		return []string{i.ManagedSGID}
+		sgIDs = []string{i.ManagedSGID}
+	} else {
+		sgIDs = append(sgIDs, i.ExternalSGIDs...)
+	}
+	if i.BackendSGID != "" {
+		sgIDs = append(sgIDs, i.BackendSGID)
 	}
-	return i.ExternalSGIDs
+	return sgIDs
 }
 
 // NameGenerator provides name generation functionality for sg package.
@@ -23,6 +33,9 @@ type NameGenerator interface {
 
 	// NameLBSG generates name for managed securityGroup that will be attached to EC2 instances.
 	NameInstanceSG(namespace string, ingressName string) string
+
+	// NameBackendSG generates name for the backend securityGroup shared by all LoadBalancers.
+	NameBackendSG() string
 }
 
 // TagGenerator provides tag generation functionality for sg package.
@@ -32,6 +45,9 @@ type TagGenerator interface {
 
 	// TagInstanceSG generates tags for managed securityGroup that will be attached to EC2 instances.
 	TagInstanceSG(namespace string, ingressName string) map[string]string
+
+	// TagBackendSG generates tags for the backend securityGroup shared by all LoadBalancers.
+	TagBackendSG() map[string]string
 }
 
 // NameTagGenerator is combination of NameGenerator and TagGenerator
@@ -13,19 +13,30 @@ import (
 )
 
 const (
-	DeregistrationDelayTimeoutSecondsKey = "deregistration_delay.timeout_seconds"
-	SlowStartDurationSecondsKey          = "slow_start.duration_seconds"
-	StickinessEnabledKey                 = "stickiness.enabled"
-	StickinessTypeKey                    = "stickiness.type"
-	StickinessLbCookieDurationSecondsKey = "stickiness.lb_cookie.duration_seconds"
-	LoadBalancingAlgorithmTypeKey        = "load_balancing.algorithm.type"
-
-	DeregistrationDelayTimeoutSeconds = 300
-	SlowStartDurationSeconds          = 0
-	StickinessEnabled                 = false
-	StickinessType                    = "lb_cookie"
-	StickinessLbCookieDurationSeconds = 86400
-	LoadBalancingAlgorithmType        = "round_robin"
+	DeregistrationDelayTimeoutSecondsKey  = "deregistration_delay.timeout_seconds"
+	SlowStartDurationSecondsKey           = "slow_start.duration_seconds"
+	StickinessEnabledKey                  = "stickiness.enabled"
+	StickinessTypeKey                     = "stickiness.type"
+	StickinessLbCookieDurationSecondsKey  = "stickiness.lb_cookie.duration_seconds"
+	LoadBalancingAlgorithmTypeKey         = "load_balancing.algorithm.type"
+	StickinessAppCookieCookieNameKey      = "stickiness.app_cookie.cookie_name"
+	StickinessAppCookieDurationSecondsKey = "stickiness.app_cookie.duration_seconds"
+	ProxyProtocolV2EnabledKey             = "proxy_protocol_v2.enabled"
+	PreserveClientIPEnabledKey            = "preserve_client_ip.enabled"
+
+	DeregistrationDelayTimeoutSeconds  = 300
+	SlowStartDurationSeconds           = 0
+	StickinessEnabled                  = false
+	StickinessType                     = "lb_cookie"
+	StickinessLbCookieDurationSeconds  = 86400
+	LoadBalancingAlgorithmType         = "round_robin"
+	StickinessAppCookieCookieName      = ""
+	StickinessAppCookieDurationSeconds = 86400
+)
+
+const (
+	StickinessTypeLBCookie  = "lb_cookie"
+	StickinessTypeAppCookie = "app_cookie"
 )
 
 // Attributes represents the desired state of attributes for a target group.
@@ -47,8 +58,8 @@ type Attributes struct {
 	// The value is true or false. The default is false.
 	StickinessEnabled bool
 
-	// StickinessType: stickiness.type - The type of sticky sessions. The possible value is
-	// lb_cookie.
+	// StickinessType: stickiness.type - The type of sticky sessions. The possible values are
+	// lb_cookie and app_cookie.
 	StickinessType string
 
 	// StickinessLbCookieDurationSeconds: stickiness.lb_cookie.duration_seconds - The time period, in seconds,
@@ -62,16 +73,38 @@ type Attributes struct {
 	// how the load balancer selects targets when routing requests. The value is round_robin or
 	// least_outstanding_requests. The default is round_robin.
 	LoadBalancingAlgorithmType string
+
+	// StickinessAppCookieCookieName: stickiness.app_cookie.cookie_name - The name of the application cookie
+	// sticky sessions follow when StickinessType is app_cookie. Names starting with AWSALB are reserved for
+	// the load balancer.
+	StickinessAppCookieCookieName string
+
+	// StickinessAppCookieDurationSeconds: stickiness.app_cookie.duration_seconds - The time period, in seconds,
+	// during which requests from a client should be routed to the same target when StickinessType is app_cookie.
+	// The range is 1 second to 1 week (604800 seconds). The default value is 1 day (86400 seconds).
+	StickinessAppCookieDurationSeconds int64
+
+	// ProxyProtocolV2Enabled: proxy_protocol_v2.enabled - Indicates whether Proxy Protocol version 2 is enabled,
+	// passing the client connection info to TCP and TLS targets. Only the targetGroups of network LoadBalancers
+	// have it, so it's nil unless specified.
+	ProxyProtocolV2Enabled *bool
+
+	// PreserveClientIPEnabled: preserve_client_ip.enabled - Indicates whether the client IP is the source of the
+	// connections to the targets, rather than the private IP of the network LoadBalancer. It defaults to true for
+	// instance targets and false for ip targets, and is nil unless specified.
+	PreserveClientIPEnabled *bool
 }
 
 func NewAttributes(attrs []*elbv2.TargetGroupAttribute) (a *Attributes, err error) {
 	a = &Attributes{
-		DeregistrationDelayTimeoutSeconds: DeregistrationDelayTimeoutSeconds,
-		SlowStartDurationSeconds:          SlowStartDurationSeconds,
-		StickinessEnabled:                 StickinessEnabled,
-		StickinessType:                    StickinessType,
-		StickinessLbCookieDurationSeconds: StickinessLbCookieDurationSeconds,
-		LoadBalancingAlgorithmType:        LoadBalancingAlgorithmType,
+		DeregistrationDelayTimeoutSeconds:  DeregistrationDelayTimeoutSeconds,
+		SlowStartDurationSeconds:           SlowStartDurationSeconds,
+		StickinessEnabled:                  StickinessEnabled,
+		StickinessType:                     StickinessType,
+		StickinessLbCookieDurationSeconds:  StickinessLbCookieDurationSeconds,
+		LoadBalancingAlgorithmType:         LoadBalancingAlgorithmType,
+		StickinessAppCookieCookieName:      StickinessAppCookieCookieName,
+		StickinessAppCookieDurationSeconds: StickinessAppCookieDurationSeconds,
 	}
 	var e error
 	for _, attr := range attrs {
@@ -100,7 +133,7 @@ func NewAttributes(attrs []*elbv2.TargetGroupAttribute) (a *Attributes, err erro
 			}
 		case StickinessTypeKey:
 			a.StickinessType = attrValue
-			if attrValue != "lb_cookie" {
+			if attrValue != StickinessTypeLBCookie && attrValue != StickinessTypeAppCookie {
 				return a, fmt.Errorf("invalid target group attribute value %s=%s", attrKey, attrValue)
 			}
 		case StickinessLbCookieDurationSecondsKey:
@@ -116,6 +149,28 @@ func NewAttributes(attrs []*elbv2.TargetGroupAttribute) (a *Attributes, err erro
 			if attrValue != "round_robin" && attrValue != "least_outstanding_requests" {
 				return a, fmt.Errorf("invalid target group attribute value %s=%s", attrKey, attrValue)
 			}
+		case StickinessAppCookieCookieNameKey:
+			a.StickinessAppCookieCookieName = attrValue
+		case StickinessAppCookieDurationSecondsKey:
+			a.StickinessAppCookieDurationSeconds, err = strconv.ParseInt(attrValue, 10, 64)
+			if err != nil {
+				return a, fmt.Errorf("invalid target group attribute value %s=%s", attrKey, attrValue)
+			}
+			if a.StickinessAppCookieDurationSeconds < 1 || a.StickinessAppCookieDurationSeconds > 604800 {
+				return a, fmt.Errorf("%s must be within 1-604800 seconds, not %v", attrKey, attrValue)
+			}
+		case ProxyProtocolV2EnabledKey:
+			enabled, err := strconv.ParseBool(attrValue)
+			if err != nil {
+				return a, fmt.Errorf("invalid target group attribute value %s=%s", attrKey, attrValue)
+			}
+			a.ProxyProtocolV2Enabled = aws.Bool(enabled)
+		case PreserveClientIPEnabledKey:
+			enabled, err := strconv.ParseBool(attrValue)
+			if err != nil {
+				return a, fmt.Errorf("invalid target group attribute value %s=%s", attrKey, attrValue)
+			}
+			a.PreserveClientIPEnabled = aws.Bool(enabled)
 		default:
 			e = NewInvalidAttribute(attrKey)
 		}
@@ -197,6 +252,25 @@ func attributesChangeSet(a, b *Attributes) (changeSet []*elbv2.TargetGroupAttrib
 		changeSet = append(changeSet, tgAttribute(LoadBalancingAlgorithmTypeKey, b.LoadBalancingAlgorithmType))
 	}
 
+	// ELBV2 rejects an empty cookie name, so the application cookie is only changed along with app_cookie stickiness.
+	if b.StickinessType == StickinessTypeAppCookie {
+		if a.StickinessAppCookieCookieName != b.StickinessAppCookieCookieName {
+			changeSet = append(changeSet, tgAttribute(StickinessAppCookieCookieNameKey, b.StickinessAppCookieCookieName))
+		}
+
+		if a.StickinessAppCookieDurationSeconds != b.StickinessAppCookieDurationSeconds {
+			changeSet = append(changeSet, tgAttribute(StickinessAppCookieDurationSecondsKey, fmt.Sprintf("%v", b.StickinessAppCookieDurationSeconds)))
+		}
+	}
+
+	if b.ProxyProtocolV2Enabled != nil && aws.BoolValue(a.ProxyProtocolV2Enabled) != aws.BoolValue(b.ProxyProtocolV2Enabled) {
+		changeSet = append(changeSet, tgAttribute(ProxyProtocolV2EnabledKey, fmt.Sprintf("%v", aws.BoolValue(b.ProxyProtocolV2Enabled))))
+	}
+
+	if b.PreserveClientIPEnabled != nil && aws.BoolValue(a.PreserveClientIPEnabled) != aws.BoolValue(b.PreserveClientIPEnabled) {
+		changeSet = append(changeSet, tgAttribute(PreserveClientIPEnabledKey, fmt.Sprintf("%v", aws.BoolValue(b.PreserveClientIPEnabled))))
+	}
+
 	return
 }
 
@@ -109,6 +109,30 @@ func Test_NewAttributes(t *testing.T) {
 			ok:         false,
 			attributes: []*elbv2.TargetGroupAttribute{tgAttribute(StickinessTypeKey, "not lb_cookie")},
 		},
+		{
+			name: "StickinessTypeKey is app_cookie",
+			ok:   true,
+			attributes: []*elbv2.TargetGroupAttribute{
+				tgAttribute(StickinessTypeKey, "app_cookie"),
+				tgAttribute(StickinessAppCookieCookieNameKey, "session"),
+				tgAttribute(StickinessAppCookieDurationSecondsKey, "3600"),
+			},
+			output: &Attributes{
+				DeregistrationDelayTimeoutSeconds:  DeregistrationDelayTimeoutSeconds,
+				SlowStartDurationSeconds:           SlowStartDurationSeconds,
+				StickinessEnabled:                  StickinessEnabled,
+				StickinessType:                     "app_cookie",
+				StickinessLbCookieDurationSeconds:  StickinessLbCookieDurationSeconds,
+				LoadBalancingAlgorithmType:         LoadBalancingAlgorithmType,
+				StickinessAppCookieCookieName:      "session",
+				StickinessAppCookieDurationSeconds: 3600,
+			},
+		},
+		{
+			name:       "StickinessAppCookieDurationSecondsKey is > 604800",
+			ok:         false,
+			attributes: []*elbv2.TargetGroupAttribute{tgAttribute(StickinessAppCookieDurationSecondsKey, "604801")},
+		},
 
 		{
 			name:       "StickinessLbCookieDurationSecondsKey is default",
@@ -314,6 +338,50 @@ func Test_attributesChangeSet(t *testing.T) {
 			b:         MustNewAttributes([]*elbv2.TargetGroupAttribute{tgAttribute(LoadBalancingAlgorithmTypeKey, "least_outstanding_requests")}),
 			changeSet: []*elbv2.TargetGroupAttribute{tgAttribute(LoadBalancingAlgorithmTypeKey, "least_outstanding_requests")},
 		},
+
+		{
+			name: "StickinessAppCookie: a=lb_cookie b=app_cookie",
+			a:    MustNewAttributes(defaultAttributes()),
+			b: MustNewAttributes([]*elbv2.TargetGroupAttribute{
+				tgAttribute(StickinessEnabledKey, "true"),
+				tgAttribute(StickinessTypeKey, "app_cookie"),
+				tgAttribute(StickinessAppCookieCookieNameKey, "session"),
+				tgAttribute(StickinessAppCookieDurationSecondsKey, "3600"),
+			}),
+			changeSet: []*elbv2.TargetGroupAttribute{
+				tgAttribute(StickinessEnabledKey, "true"),
+				tgAttribute(StickinessTypeKey, "app_cookie"),
+				tgAttribute(StickinessAppCookieCookieNameKey, "session"),
+				tgAttribute(StickinessAppCookieDurationSecondsKey, "3600"),
+			},
+		},
+		{
+			name: "StickinessAppCookie: a=app_cookie b=lb_cookie, cookie name is kept",
+			a: MustNewAttributes([]*elbv2.TargetGroupAttribute{
+				tgAttribute(StickinessTypeKey, "app_cookie"),
+				tgAttribute(StickinessAppCookieCookieNameKey, "session"),
+			}),
+			b:         MustNewAttributes(defaultAttributes()),
+			changeSet: []*elbv2.TargetGroupAttribute{tgAttribute(StickinessTypeKey, "lb_cookie")},
+		},
+
+		{
+			name:      "ProxyProtocolV2Enabled: a=default b=enabled",
+			a:         MustNewAttributes([]*elbv2.TargetGroupAttribute{tgAttribute(ProxyProtocolV2EnabledKey, "false")}),
+			b:         MustNewAttributes([]*elbv2.TargetGroupAttribute{tgAttribute(ProxyProtocolV2EnabledKey, "true")}),
+			changeSet: []*elbv2.TargetGroupAttribute{tgAttribute(ProxyProtocolV2EnabledKey, "true")},
+		},
+		{
+			name:      "PreserveClientIPEnabled: a=enabled b=disabled",
+			a:         MustNewAttributes([]*elbv2.TargetGroupAttribute{tgAttribute(PreserveClientIPEnabledKey, "true")}),
+			b:         MustNewAttributes([]*elbv2.TargetGroupAttribute{tgAttribute(PreserveClientIPEnabledKey, "false")}),
+			changeSet: []*elbv2.TargetGroupAttribute{tgAttribute(PreserveClientIPEnabledKey, "false")},
+		},
+		{
+			name: "ProxyProtocolV2Enabled: a=enabled b=unspecified",
+			a:    MustNewAttributes([]*elbv2.TargetGroupAttribute{tgAttribute(ProxyProtocolV2EnabledKey, "true")}),
+			b:    MustNewAttributes(nil),
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			changeSet := attributesChangeSet(tc.a, tc.b)
@@ -11,11 +11,13 @@ import (
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/healthcheck"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/targetgroup"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/backend"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/store"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/k8s"
 	util "github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/util/types"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	extensions "k8s.io/api/extensions/v1beta1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -39,7 +41,8 @@ type Controller interface {
 func NewController(cloud aws.CloudAPI, store store.Storer, nameTagGen NameTagGenerator, tagsController tags.Controller, endpointResolver backend.EndpointResolver, client client.Client) Controller {
 	attrsController := NewAttributesController(cloud)
 	targetHealthController := NewTargetHealthController(cloud, store, endpointResolver, client)
-	targetsController := NewTargetsController(cloud, endpointResolver, targetHealthController)
+	cfg := store.GetConfig()
+	targetsController := NewTargetsController(cloud, endpointResolver, targetHealthController, cfg.TargetsBatchSize, cfg.TargetDrainingWait)
 	return &defaultController{
 		cloud:             cloud,
 		store:             store,
@@ -73,8 +76,12 @@ func (controller *defaultController) Reconcile(ctx context.Context, ingress *ext
 		return TargetGroup{}, fmt.Errorf("failed to load serviceAnnotation due to %v", err)
 	}
 
-	protocol := aws.StringValue(serviceAnnos.TargetGroup.BackendProtocol)
+	protocol := BackendProtocol(ingressAnnos, serviceAnnos)
+	protocolVersion := ProtocolVersion(ingressAnnos, serviceAnnos)
 	targetType := aws.StringValue(serviceAnnos.TargetGroup.TargetType)
+	if err := validateNetworkAttributes(protocol, serviceAnnos.TargetGroup.Attributes); err != nil {
+		return TargetGroup{}, err
+	}
 
 	healthCheckPort, err := controller.resolveServiceHealthCheckPort(ingress.Namespace, backend.ServiceName, intstr.Parse(*serviceAnnos.HealthCheck.Port), targetType)
 
@@ -82,17 +89,23 @@ func (controller *defaultController) Reconcile(ctx context.Context, ingress *ext
 		return TargetGroup{}, fmt.Errorf("failed to resolve healthcheck port due to %v", err)
 	}
 
-	tgName := controller.nameTagGen.NameTG(ingress.Namespace, ingress.Name, backend.ServiceName, backend.ServicePort.String(), targetType, protocol)
+	// The protocol version of a targetGroup cannot be modified, HTTP2 and gRPC targetGroups are named apart from HTTP1 ones
+	// and replace them instead, while the names of HTTP1 targetGroups are left unchanged.
+	nameProtocol := protocol
+	if protocolVersion != targetgroup.ProtocolVersionHTTP1 {
+		nameProtocol = protocol + ":" + protocolVersion
+	}
+	tgName := controller.nameTagGen.NameTG(ingress.Namespace, ingress.Name, backend.ServiceName, backend.ServicePort.String(), targetType, nameProtocol)
 	tgInstance, err := controller.findExistingTGInstance(ctx, tgName)
 	if err != nil {
 		return TargetGroup{}, fmt.Errorf("failed to find existing targetGroup due to %v", err)
 	}
 	if tgInstance == nil {
-		if tgInstance, err = controller.newTGInstance(ctx, tgName, serviceAnnos, healthCheckPort); err != nil {
+		if tgInstance, err = controller.newTGInstance(ctx, tgName, protocol, protocolVersion, serviceAnnos, healthCheckPort); err != nil {
 			return TargetGroup{}, fmt.Errorf("failed to create targetGroup due to %v", err)
 		}
 	} else {
-		if tgInstance, err = controller.reconcileTGInstance(ctx, tgInstance, serviceAnnos, healthCheckPort); err != nil {
+		if tgInstance, err = controller.reconcileTGInstance(ctx, tgInstance, protocolVersion, serviceAnnos, healthCheckPort); err != nil {
 			return TargetGroup{}, fmt.Errorf("failed to modify targetGroup due to %v", err)
 		}
 	}
@@ -107,6 +120,7 @@ func (controller *defaultController) Reconcile(ctx context.Context, ingress *ext
 	}
 	tgTargets := NewTargets(targetType, ingress, &backend)
 	tgTargets.TgArn = tgArn
+	tgTargets.NodeSelector = serviceAnnos.TargetGroup.TargetNodeLabels
 	if err = controller.targetsController.Reconcile(ctx, tgTargets); err != nil {
 		return TargetGroup{}, fmt.Errorf("failed to reconcile targetGroup targets due to %v", err)
 	}
@@ -122,18 +136,88 @@ func (controller *defaultController) StopReconcilingPodConditionStatus(tgArn str
 	controller.targetsController.StopReconcilingPodConditionStatus(tgArn)
 }
 
-func (controller *defaultController) newTGInstance(ctx context.Context, name string, serviceAnnos *annotations.Service, healthCheckPort string) (*elbv2.TargetGroup, error) {
+// BackendProtocol returns the protocol of the targetGroup of a backend of ingress: its backend-protocol for application LoadBalancers,
+// TCP or TLS for network LoadBalancers, which forward connections rather than requests.
+func BackendProtocol(ingressAnnos *annotations.Ingress, serviceAnnos *annotations.Service) string {
+	protocol := aws.StringValue(serviceAnnos.TargetGroup.BackendProtocol)
+	if ingressAnnos.LoadBalancer == nil || aws.StringValue(ingressAnnos.LoadBalancer.Type) != elbv2.LoadBalancerTypeEnumNetwork {
+		return protocol
+	}
+	if protocol == elbv2.ProtocolEnumHttps {
+		return elbv2.ProtocolEnumTls
+	}
+	return elbv2.ProtocolEnumTcp
+}
+
+// ProtocolVersion returns the protocol version of the targetGroup of a backend of ingress: its backend-protocol-version for
+// application LoadBalancers, HTTP1 for network LoadBalancers, which don't support the other versions.
+func ProtocolVersion(ingressAnnos *annotations.Ingress, serviceAnnos *annotations.Service) string {
+	if ingressAnnos.LoadBalancer != nil && aws.StringValue(ingressAnnos.LoadBalancer.Type) == elbv2.LoadBalancerTypeEnumNetwork {
+		return targetgroup.ProtocolVersionHTTP1
+	}
+	if serviceAnnos.TargetGroup.ProtocolVersion == nil {
+		return targetgroup.ProtocolVersionHTTP1
+	}
+	return aws.StringValue(serviceAnnos.TargetGroup.ProtocolVersion)
+}
+
+// validateNetworkAttributes rejects proxy protocol v2 and client IP preservation on the HTTP and HTTPS targetGroups of
+// application LoadBalancers, which pass the client connection info in the X-Forwarded headers instead.
+func validateNetworkAttributes(protocol string, attributes []*elbv2.TargetGroupAttribute) error {
+	if protocol == elbv2.ProtocolEnumTcp || protocol == elbv2.ProtocolEnumTls {
+		return nil
+	}
+	for _, attr := range attributes {
+		switch key := aws.StringValue(attr.Key); key {
+		case ProxyProtocolV2EnabledKey, PreserveClientIPEnabledKey:
+			return fmt.Errorf("%v is only supported by the targetGroups of network LoadBalancers, %v targetGroups get the client connection info from the X-Forwarded headers", key, protocol)
+		}
+	}
+	return nil
+}
+
+// healthCheckPath returns the path of HTTP and HTTPS health checks, TCP health checks have none.
+func healthCheckPath(serviceAnnos *annotations.Service) *string {
+	if aws.StringValue(serviceAnnos.HealthCheck.Protocol) == elbv2.ProtocolEnumTcp {
+		return nil
+	}
+	return serviceAnnos.HealthCheck.Path
+}
+
+// healthCheckMatcher returns the success codes of HTTP and HTTPS health checks, TCP health checks have none.
+// The success codes of gRPC health checks are grpc-status codes rather than HTTP ones.
+func healthCheckMatcher(protocolVersion string, serviceAnnos *annotations.Service) *elbv2.Matcher {
+	if aws.StringValue(serviceAnnos.HealthCheck.Protocol) == elbv2.ProtocolEnumTcp {
+		return nil
+	}
+	if protocolVersion == targetgroup.ProtocolVersionGRPC {
+		return &elbv2.Matcher{GrpcCode: serviceAnnos.TargetGroup.GRPCSuccessCodes()}
+	}
+	return &elbv2.Matcher{HttpCode: serviceAnnos.TargetGroup.SuccessCodes}
+}
+
+// targetGroupProtocolVersion returns the ProtocolVersion of a new targetGroup, nil for HTTP1, which is the default and
+// the only protocol version of the TCP and TLS targetGroups of network LoadBalancers.
+func targetGroupProtocolVersion(protocolVersion string) *string {
+	if protocolVersion == targetgroup.ProtocolVersionHTTP1 {
+		return nil
+	}
+	return aws.String(protocolVersion)
+}
+
+func (controller *defaultController) newTGInstance(ctx context.Context, name string, protocol string, protocolVersion string, serviceAnnos *annotations.Service, healthCheckPort string) (*elbv2.TargetGroup, error) {
 	albctx.GetLogger(ctx).Infof("creating target group %v", name)
 	resp, err := controller.cloud.CreateTargetGroupWithContext(ctx, &elbv2.CreateTargetGroupInput{
 		Name:                       aws.String(name),
-		HealthCheckPath:            serviceAnnos.HealthCheck.Path,
+		HealthCheckPath:            healthCheckPath(serviceAnnos),
 		HealthCheckIntervalSeconds: serviceAnnos.HealthCheck.IntervalSeconds,
 		HealthCheckPort:            aws.String(healthCheckPort),
 		HealthCheckProtocol:        serviceAnnos.HealthCheck.Protocol,
 		HealthCheckTimeoutSeconds:  serviceAnnos.HealthCheck.TimeoutSeconds,
 		TargetType:                 serviceAnnos.TargetGroup.TargetType,
-		Protocol:                   serviceAnnos.TargetGroup.BackendProtocol,
-		Matcher:                    &elbv2.Matcher{HttpCode: serviceAnnos.TargetGroup.SuccessCodes},
+		Protocol:                   aws.String(protocol),
+		ProtocolVersion:            targetGroupProtocolVersion(protocolVersion),
+		Matcher:                    healthCheckMatcher(protocolVersion, serviceAnnos),
 		HealthyThresholdCount:      serviceAnnos.TargetGroup.HealthyThresholdCount,
 		UnhealthyThresholdCount:    serviceAnnos.TargetGroup.UnhealthyThresholdCount,
 		Port:                       aws.Int64(targetGroupDefaultPort),
@@ -146,18 +230,18 @@ func (controller *defaultController) newTGInstance(ctx context.Context, name str
 	return tgInstance, nil
 }
 
-func (controller *defaultController) reconcileTGInstance(ctx context.Context, instance *elbv2.TargetGroup, serviceAnnos *annotations.Service, healthCheckPort string) (*elbv2.TargetGroup, error) {
-	if controller.TGInstanceNeedsModification(ctx, instance, serviceAnnos) {
+func (controller *defaultController) reconcileTGInstance(ctx context.Context, instance *elbv2.TargetGroup, protocolVersion string, serviceAnnos *annotations.Service, healthCheckPort string) (*elbv2.TargetGroup, error) {
+	if controller.TGInstanceNeedsModification(ctx, instance, protocolVersion, serviceAnnos, healthCheckPort) {
 		albctx.GetLogger(ctx).Infof("modify target group %v", aws.StringValue(instance.TargetGroupArn))
 
 		output, err := controller.cloud.ModifyTargetGroupWithContext(ctx, &elbv2.ModifyTargetGroupInput{
 			TargetGroupArn:             instance.TargetGroupArn,
-			HealthCheckPath:            serviceAnnos.HealthCheck.Path,
+			HealthCheckPath:            healthCheckPath(serviceAnnos),
 			HealthCheckIntervalSeconds: serviceAnnos.HealthCheck.IntervalSeconds,
 			HealthCheckPort:            aws.String(healthCheckPort),
 			HealthCheckProtocol:        serviceAnnos.HealthCheck.Protocol,
 			HealthCheckTimeoutSeconds:  serviceAnnos.HealthCheck.TimeoutSeconds,
-			Matcher:                    &elbv2.Matcher{HttpCode: serviceAnnos.TargetGroup.SuccessCodes},
+			Matcher:                    healthCheckMatcher(protocolVersion, serviceAnnos),
 			HealthyThresholdCount:      serviceAnnos.TargetGroup.HealthyThresholdCount,
 			UnhealthyThresholdCount:    serviceAnnos.TargetGroup.UnhealthyThresholdCount,
 		})
@@ -180,12 +264,23 @@ func (controller *defaultController) resolveServiceHealthCheckPort(namespace str
 
 	servicePort := servicePortAnnotation.String()
 
+	serviceKey := namespace + "/" + serviceName
 	//If the annotation uses the default port ("traffic-port"), do not try to look up a port by that name.
 	if servicePort == healthcheck.DefaultPort {
+		if targetType != elbv2.TargetTypeEnumInstance {
+			return servicePort, nil
+		}
+		// kube-proxy reports whether nodes host ready pods of services with externalTrafficPolicy Local on their healthCheckNodePort
+		service, err := controller.store.GetService(serviceKey)
+		if err != nil {
+			return servicePort, errors.Wrap(err, "failed to resolve healthcheck service name")
+		}
+		if service.Spec.ExternalTrafficPolicy == corev1.ServiceExternalTrafficPolicyTypeLocal && service.Spec.HealthCheckNodePort != 0 {
+			return strconv.Itoa(int(service.Spec.HealthCheckNodePort)), nil
+		}
 		return servicePort, nil
 	}
 
-	serviceKey := namespace + "/" + serviceName
 	service, err := controller.store.GetService(serviceKey)
 
 	if err != nil {
@@ -206,12 +301,12 @@ func (controller *defaultController) resolveServiceHealthCheckPort(namespace str
 
 }
 
-func (controller *defaultController) TGInstanceNeedsModification(ctx context.Context, instance *elbv2.TargetGroup, serviceAnnos *annotations.Service) bool {
+func (controller *defaultController) TGInstanceNeedsModification(ctx context.Context, instance *elbv2.TargetGroup, protocolVersion string, serviceAnnos *annotations.Service, healthCheckPort string) bool {
 	needsChange := false
-	if !util.DeepEqual(instance.HealthCheckPath, serviceAnnos.HealthCheck.Path) {
+	if !util.DeepEqual(instance.HealthCheckPath, healthCheckPath(serviceAnnos)) {
 		needsChange = true
 	}
-	if !util.DeepEqual(instance.HealthCheckPort, serviceAnnos.HealthCheck.Port) {
+	if aws.StringValue(instance.HealthCheckPort) != healthCheckPort {
 		needsChange = true
 	}
 	if !util.DeepEqual(instance.HealthCheckProtocol, serviceAnnos.HealthCheck.Protocol) {
@@ -223,7 +318,9 @@ func (controller *defaultController) TGInstanceNeedsModification(ctx context.Con
 	if !util.DeepEqual(instance.HealthCheckTimeoutSeconds, serviceAnnos.HealthCheck.TimeoutSeconds) {
 		needsChange = true
 	}
-	if !util.DeepEqual(instance.Matcher.HttpCode, serviceAnnos.TargetGroup.SuccessCodes) {
+	// TCP health checks have no Matcher.
+	if matcher := healthCheckMatcher(protocolVersion, serviceAnnos); matcher != nil && (instance.Matcher == nil ||
+		!util.DeepEqual(instance.Matcher.HttpCode, matcher.HttpCode) || !util.DeepEqual(instance.Matcher.GrpcCode, matcher.GrpcCode)) {
 		needsChange = true
 	}
 	if !util.DeepEqual(instance.HealthyThresholdCount, serviceAnnos.TargetGroup.HealthyThresholdCount) {
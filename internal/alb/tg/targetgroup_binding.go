@@ -0,0 +1,208 @@
+package tg
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/elbv2/v1alpha1"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/util/log"
+	corev1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// TargetGroupBindingFinalizer blocks the deletion of TargetGroupBindings until their targets are deregistered.
+const TargetGroupBindingFinalizer = "elbv2.k8s.aws/targets"
+
+// NewBindingReconciler constructs a reconciler registering the endpoints of the service of each TargetGroupBinding into its target group.
+// The target groups are managed outside of the controller, only their targets are reconciled.
+func NewBindingReconciler(client client.Client, recorder record.EventRecorder, cloud aws.CloudAPI, targetsController TargetsController) reconcile.Reconciler {
+	return &bindingReconciler{
+		client:            client,
+		recorder:          recorder,
+		cloud:             cloud,
+		targetsController: targetsController,
+	}
+}
+
+type bindingReconciler struct {
+	client            client.Client
+	recorder          record.EventRecorder
+	cloud             aws.CloudAPI
+	targetsController TargetsController
+}
+
+func (r *bindingReconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	ctx := albctx.SetLogger(context.Background(), log.New(request.NamespacedName.String()))
+	binding := &v1alpha1.TargetGroupBinding{}
+	if err := r.client.Get(ctx, request.NamespacedName, binding); err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+	ctx = albctx.SetEventf(ctx, func(eventType string, reason string, messageFmt string, args ...interface{}) {
+		r.recorder.Eventf(binding, eventType, reason, messageFmt, args...)
+	})
+
+	if binding.DeletionTimestamp != nil {
+		if err := r.finalize(ctx, binding); err != nil {
+			albctx.GetEventf(ctx)(corev1.EventTypeWarning, "ERROR", "failed to deregister targets due to %v", err)
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+	if !hasBindingFinalizer(binding) {
+		binding.Finalizers = append(binding.Finalizers, TargetGroupBindingFinalizer)
+		if err := r.client.Update(ctx, binding); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	targets, err := r.reconcileTargets(ctx, binding)
+	if err != nil {
+		albctx.GetEventf(ctx)(corev1.EventTypeWarning, "ERROR", "failed to register targets due to %v", err)
+		return reconcile.Result{}, err
+	}
+	var ownedTargets []string
+	if binding.Spec.MultiCluster {
+		ownedTargets = targetKeys(targets.Targets)
+	}
+	if binding.Status.ObservedGeneration != binding.Generation || !reflect.DeepEqual(binding.Status.Targets, ownedTargets) {
+		binding.Status.ObservedGeneration = binding.Generation
+		binding.Status.Targets = ownedTargets
+		if err := r.client.Status().Update(ctx, binding); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	return reconcile.Result{}, nil
+}
+
+// reconcileTargets registers the endpoints of the service of binding into its target group, and deregisters the other targets.
+// MultiCluster bindings only deregister the targets they registered.
+func (r *bindingReconciler) reconcileTargets(ctx context.Context, binding *v1alpha1.TargetGroupBinding) (*Targets, error) {
+	targetType, err := r.targetType(ctx, binding)
+	if err != nil {
+		return nil, err
+	}
+	backend := &extensions.IngressBackend{
+		ServiceName: binding.Spec.ServiceRef.Name,
+		ServicePort: binding.Spec.ServiceRef.Port,
+	}
+	targets := NewTargets(targetType, bindingIngress(binding), backend)
+	targets.TgArn = binding.Spec.TargetGroupARN
+	if binding.Spec.MultiCluster {
+		targets.OwnedTargets = sets.NewString(binding.Status.Targets...)
+	}
+	if err := r.targetsController.Reconcile(ctx, targets); err != nil {
+		return nil, err
+	}
+	return targets, nil
+}
+
+// targetType returns the target type of the target group of binding, which must match the targetType of binding if specified.
+func (r *bindingReconciler) targetType(ctx context.Context, binding *v1alpha1.TargetGroupBinding) (string, error) {
+	tgArn := binding.Spec.TargetGroupARN
+	tgInstance, err := r.cloud.GetTargetGroupByArn(ctx, tgArn)
+	if err != nil {
+		return "", err
+	}
+	if tgInstance == nil {
+		return "", fmt.Errorf("target group %v not found", tgArn)
+	}
+	targetType := aws.StringValue(tgInstance.TargetType)
+	if targetType != elbv2.TargetTypeEnumInstance && targetType != elbv2.TargetTypeEnumIp {
+		return "", fmt.Errorf("target group %v has target type %v, only target groups of %v or %v targets can be bound",
+			tgArn, targetType, elbv2.TargetTypeEnumInstance, elbv2.TargetTypeEnumIp)
+	}
+	if binding.Spec.TargetType != nil && string(*binding.Spec.TargetType) != targetType {
+		return "", fmt.Errorf("targetType %v doesn't match target type %v of target group %v", *binding.Spec.TargetType, targetType, tgArn)
+	}
+	return targetType, nil
+}
+
+// finalize deregisters the targets of the target group of binding, only the targets it registered if MultiCluster, then removes TargetGroupBindingFinalizer.
+// Target groups already deleted have nothing to deregister.
+func (r *bindingReconciler) finalize(ctx context.Context, binding *v1alpha1.TargetGroupBinding) error {
+	if !hasBindingFinalizer(binding) {
+		return nil
+	}
+	tgArn := binding.Spec.TargetGroupARN
+	r.targetsController.StopReconcilingPodConditionStatus(tgArn)
+	resp, err := r.cloud.DescribeTargetHealthWithContext(ctx, &elbv2.DescribeTargetHealthInput{TargetGroupArn: aws.String(tgArn)})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != elbv2.ErrCodeTargetGroupNotFoundException {
+			return err
+		}
+	} else {
+		owned := sets.NewString(binding.Status.Targets...)
+		var targets []*elbv2.TargetDescription
+		for _, thd := range resp.TargetHealthDescriptions {
+			if aws.StringValue(thd.TargetHealth.State) == elbv2.TargetHealthStateEnumDraining {
+				continue
+			}
+			if binding.Spec.MultiCluster && !owned.Has(tdString(thd.Target)) {
+				continue
+			}
+			targets = append(targets, thd.Target)
+		}
+		if len(targets) != 0 {
+			albctx.GetLogger(ctx).Infof("Removing targets from %v: %v", tgArn, tdsString(targets))
+			if _, err := r.cloud.DeregisterTargetsWithContext(ctx, &elbv2.DeregisterTargetsInput{
+				TargetGroupArn: aws.String(tgArn),
+				Targets:        targets,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	var finalizers []string
+	for _, finalizer := range binding.Finalizers {
+		if finalizer != TargetGroupBindingFinalizer {
+			finalizers = append(finalizers, finalizer)
+		}
+	}
+	binding.Finalizers = finalizers
+	return r.client.Update(ctx, binding)
+}
+
+// bindingIngress is the ingress the targets of binding are resolved for, the endpoints of services are resolved in its namespace.
+func bindingIngress(binding *v1alpha1.TargetGroupBinding) *extensions.Ingress {
+	return &extensions.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: binding.Namespace,
+			Name:      binding.Name,
+		},
+	}
+}
+
+// targetKeys returns the sorted id:port of targets, nil if there isn't any.
+func targetKeys(targets []*elbv2.TargetDescription) []string {
+	if len(targets) == 0 {
+		return nil
+	}
+	keys := sets.NewString()
+	for _, td := range targets {
+		keys.Insert(tdString(td))
+	}
+	return keys.List()
+}
+
+func hasBindingFinalizer(binding *v1alpha1.TargetGroupBinding) bool {
+	for _, finalizer := range binding.Finalizers {
+		if finalizer == TargetGroupBindingFinalizer {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,165 @@
+package tg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/mocks"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/elbv2/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func newTestBinding(targetType *v1alpha1.TargetType) *v1alpha1.TargetGroupBinding {
+	return &v1alpha1.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "binding", Generation: 2},
+		Spec: v1alpha1.TargetGroupBindingSpec{
+			TargetGroupARN: "tgArn",
+			TargetType:     targetType,
+			ServiceRef:     v1alpha1.ServiceReference{Name: "service", Port: intstr.FromString("http")},
+		},
+	}
+}
+
+func newTestBindingReconciler(t *testing.T, binding *v1alpha1.TargetGroupBinding, cloud aws.CloudAPI, targetsController TargetsController) *bindingReconciler {
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return &bindingReconciler{
+		client:            fake.NewFakeClientWithScheme(scheme, binding),
+		recorder:          record.NewFakeRecorder(10),
+		cloud:             cloud,
+		targetsController: targetsController,
+	}
+}
+
+func TestBindingReconciler_Reconcile(t *testing.T) {
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "namespace", Name: "binding"}}
+	cloud := &mocks.CloudAPI{}
+	cloud.On("GetTargetGroupByArn", mock.Anything, "tgArn").Return(&elbv2.TargetGroup{TargetType: aws.String(elbv2.TargetTypeEnumIp)}, nil)
+	targetsController := &MockTargetsController{}
+	targetsController.On("Reconcile", mock.Anything, &Targets{
+		TgArn:      "tgArn",
+		TargetType: elbv2.TargetTypeEnumIp,
+		Ingress:    &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "binding"}},
+		Backend:    &extensions.IngressBackend{ServiceName: "service", ServicePort: intstr.FromString("http")},
+	}).Return(nil)
+	r := newTestBindingReconciler(t, newTestBinding(nil), cloud, targetsController)
+
+	_, err := r.Reconcile(request)
+	assert.NoError(t, err)
+	binding := &v1alpha1.TargetGroupBinding{}
+	assert.NoError(t, r.client.Get(context.Background(), request.NamespacedName, binding))
+	assert.Equal(t, []string{TargetGroupBindingFinalizer}, binding.Finalizers)
+	assert.Equal(t, int64(2), binding.Status.ObservedGeneration)
+	targetsController.AssertExpectations(t)
+
+	instance := v1alpha1.TargetTypeInstance
+	r = newTestBindingReconciler(t, newTestBinding(&instance), cloud, targetsController)
+	_, err = r.Reconcile(request)
+	assert.EqualError(t, err, "targetType instance doesn't match target type ip of target group tgArn")
+}
+
+func TestBindingReconciler_Reconcile_multiCluster(t *testing.T) {
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "namespace", Name: "binding"}}
+	binding := newTestBinding(nil)
+	binding.Spec.MultiCluster = true
+	binding.Status.Targets = []string{"10.0.0.1:8080"}
+	cloud := &mocks.CloudAPI{}
+	cloud.On("GetTargetGroupByArn", mock.Anything, "tgArn").Return(&elbv2.TargetGroup{TargetType: aws.String(elbv2.TargetTypeEnumIp)}, nil)
+	targetsController := &MockTargetsController{}
+	targetsController.On("Reconcile", mock.Anything, &Targets{
+		TgArn:        "tgArn",
+		TargetType:   elbv2.TargetTypeEnumIp,
+		Ingress:      &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "binding"}},
+		Backend:      &extensions.IngressBackend{ServiceName: "service", ServicePort: intstr.FromString("http")},
+		OwnedTargets: sets.NewString("10.0.0.1:8080"),
+	}).Run(func(args mock.Arguments) {
+		args.Get(1).(*Targets).Targets = []*elbv2.TargetDescription{
+			{Id: aws.String("10.0.0.3"), Port: aws.Int64(8080)},
+			{Id: aws.String("10.0.0.2"), Port: aws.Int64(8080)},
+		}
+	}).Return(nil)
+	r := newTestBindingReconciler(t, binding, cloud, targetsController)
+
+	_, err := r.Reconcile(request)
+	assert.NoError(t, err)
+	targetsController.AssertExpectations(t)
+	actual := &v1alpha1.TargetGroupBinding{}
+	assert.NoError(t, r.client.Get(context.Background(), request.NamespacedName, actual))
+	assert.Equal(t, []string{"10.0.0.2:8080", "10.0.0.3:8080"}, actual.Status.Targets)
+}
+
+func TestBindingReconciler_Reconcile_deleted(t *testing.T) {
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "namespace", Name: "binding"}}
+	binding := newTestBinding(nil)
+	binding.Finalizers = []string{"other", TargetGroupBindingFinalizer}
+	now := metav1.Now()
+	binding.DeletionTimestamp = &now
+	healthy := &elbv2.TargetDescription{Id: aws.String("10.0.0.1"), Port: aws.Int64(8080)}
+	draining := &elbv2.TargetDescription{Id: aws.String("10.0.0.2"), Port: aws.Int64(8080)}
+	cloud := &mocks.CloudAPI{}
+	cloud.On("DescribeTargetHealthWithContext", mock.Anything, &elbv2.DescribeTargetHealthInput{TargetGroupArn: aws.String("tgArn")}).Return(&elbv2.DescribeTargetHealthOutput{
+		TargetHealthDescriptions: []*elbv2.TargetHealthDescription{
+			{Target: healthy, TargetHealth: &elbv2.TargetHealth{State: aws.String(elbv2.TargetHealthStateEnumHealthy)}},
+			{Target: draining, TargetHealth: &elbv2.TargetHealth{State: aws.String(elbv2.TargetHealthStateEnumDraining)}},
+		},
+	}, nil)
+	cloud.On("DeregisterTargetsWithContext", mock.Anything, &elbv2.DeregisterTargetsInput{
+		TargetGroupArn: aws.String("tgArn"),
+		Targets:        []*elbv2.TargetDescription{healthy},
+	}).Return(&elbv2.DeregisterTargetsOutput{}, nil)
+	targetsController := &MockTargetsController{}
+	targetsController.On("StopReconcilingPodConditionStatus", "tgArn").Return()
+	r := newTestBindingReconciler(t, binding, cloud, targetsController)
+
+	_, err := r.Reconcile(request)
+	assert.NoError(t, err)
+	cloud.AssertExpectations(t)
+	targetsController.AssertExpectations(t)
+	actual := &v1alpha1.TargetGroupBinding{}
+	assert.NoError(t, r.client.Get(context.Background(), request.NamespacedName, actual))
+	assert.Equal(t, []string{"other"}, actual.Finalizers)
+}
+
+func TestBindingReconciler_Reconcile_deletedMultiCluster(t *testing.T) {
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "namespace", Name: "binding"}}
+	binding := newTestBinding(nil)
+	binding.Spec.MultiCluster = true
+	binding.Status.Targets = []string{"10.0.0.1:8080"}
+	binding.Finalizers = []string{TargetGroupBindingFinalizer}
+	now := metav1.Now()
+	binding.DeletionTimestamp = &now
+	owned := &elbv2.TargetDescription{Id: aws.String("10.0.0.1"), Port: aws.Int64(8080)}
+	other := &elbv2.TargetDescription{Id: aws.String("10.1.0.1"), Port: aws.Int64(8080)}
+	cloud := &mocks.CloudAPI{}
+	cloud.On("DescribeTargetHealthWithContext", mock.Anything, &elbv2.DescribeTargetHealthInput{TargetGroupArn: aws.String("tgArn")}).Return(&elbv2.DescribeTargetHealthOutput{
+		TargetHealthDescriptions: []*elbv2.TargetHealthDescription{
+			{Target: owned, TargetHealth: &elbv2.TargetHealth{State: aws.String(elbv2.TargetHealthStateEnumHealthy)}},
+			{Target: other, TargetHealth: &elbv2.TargetHealth{State: aws.String(elbv2.TargetHealthStateEnumHealthy)}},
+		},
+	}, nil)
+	cloud.On("DeregisterTargetsWithContext", mock.Anything, &elbv2.DeregisterTargetsInput{
+		TargetGroupArn: aws.String("tgArn"),
+		Targets:        []*elbv2.TargetDescription{owned},
+	}).Return(&elbv2.DeregisterTargetsOutput{}, nil)
+	targetsController := &MockTargetsController{}
+	targetsController.On("StopReconcilingPodConditionStatus", "tgArn").Return()
+	r := newTestBindingReconciler(t, binding, cloud, targetsController)
+
+	_, err := r.Reconcile(request)
+	assert.NoError(t, err)
+	cloud.AssertExpectations(t)
+}
@@ -11,8 +11,11 @@ import (
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/action"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/bluegreen"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/canary"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/backend"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/store"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/tracing"
 	extensions "k8s.io/api/extensions/v1beta1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -60,6 +63,9 @@ type defaultGroupController struct {
 }
 
 func (controller *defaultGroupController) Reconcile(ctx context.Context, ingress *extensions.Ingress) (TargetGroupGroup, error) {
+	ctx, span := tracing.StartSpan(ctx, "tg.Reconcile")
+	defer span.End()
+
 	tgByBackend := make(map[extensions.IngressBackend]TargetGroup)
 
 	serviceBackends, externalTGARNs, err := ExtractTargetGroupBackends(ingress)
@@ -83,6 +89,9 @@ func (controller *defaultGroupController) Reconcile(ctx context.Context, ingress
 }
 
 func (controller *defaultGroupController) GC(ctx context.Context, tgGroup TargetGroupGroup) error {
+	ctx, span := tracing.StartSpan(ctx, "tg.GC")
+	defer span.End()
+
 	tagFilters := make(map[string][]string)
 	for k, v := range tgGroup.selector {
 		tagFilters[k] = []string{v}
@@ -115,6 +124,9 @@ func (controller *defaultGroupController) GC(ctx context.Context, tgGroup Target
 }
 
 func (controller *defaultGroupController) Delete(ctx context.Context, ingressKey types.NamespacedName) error {
+	ctx, span := tracing.StartSpan(ctx, "tg.Delete")
+	defer span.End()
+
 	selector := controller.nameTagGen.TagTGGroup(ingressKey.Namespace, ingressKey.Name)
 	tgGroup := TargetGroupGroup{
 		selector: selector,
@@ -122,12 +134,21 @@ func (controller *defaultGroupController) Delete(ctx context.Context, ingressKey
 	return controller.GC(ctx, tgGroup)
 }
 
+// DefaultBackend returns the default backend of ingress, or nil if it has none.
+// Default backends without service, e.g. the resource backends of networking.k8s.io/v1 ingresses, are none either: the listeners respond 404 instead.
+func DefaultBackend(ingress *extensions.Ingress) *extensions.IngressBackend {
+	if ingress.Spec.Backend == nil || ingress.Spec.Backend.ServiceName == "" {
+		return nil
+	}
+	return ingress.Spec.Backend
+}
+
 // ExtractTargetGroupBackends returns backends for Ingress.
 // Backends can be either k8s service based or targetGroupArns referencing targetGroups created out side of k8s.
 func ExtractTargetGroupBackends(ingress *extensions.Ingress) ([]extensions.IngressBackend, []string, error) {
 	var rawIngBackends []extensions.IngressBackend
-	if ingress.Spec.Backend != nil {
-		rawIngBackends = append(rawIngBackends, *ingress.Spec.Backend)
+	if backend := DefaultBackend(ingress); backend != nil {
+		rawIngBackends = append(rawIngBackends, *backend)
 	}
 	for _, rule := range ingress.Spec.Rules {
 		if rule.HTTP == nil {
@@ -138,12 +159,37 @@ func ExtractTargetGroupBackends(ingress *extensions.Ingress) ([]extensions.Ingre
 		}
 	}
 
+	rawCanaries, err := canary.NewParser().Parse(ingress)
+	if err != nil {
+		return nil, nil, err
+	}
+	canaries := rawCanaries.(*canary.Config)
+
+	rawBlueGreen, err := bluegreen.NewParser().Parse(ingress)
+	if err != nil {
+		return nil, nil, err
+	}
+	blueGreen := rawBlueGreen.(*bluegreen.Config)
+
 	var serviceBackends []extensions.IngressBackend
 	for _, ingBackend := range rawIngBackends {
 		if action.Use(ingBackend.ServicePort.String()) {
 			continue
 		}
 		serviceBackends = append(serviceBackends, ingBackend)
+		// the target group of the inactive service is kept with its targets, for an instant rollback
+		if activeServiceName := blueGreen.GetActiveService(ingBackend.ServiceName); activeServiceName != ingBackend.ServiceName {
+			serviceBackends = append(serviceBackends, extensions.IngressBackend{
+				ServiceName: activeServiceName,
+				ServicePort: ingBackend.ServicePort,
+			})
+		}
+		if c, ok := canaries.GetCanary(ingBackend.ServiceName); ok {
+			serviceBackends = append(serviceBackends, extensions.IngressBackend{
+				ServiceName: c.ServiceName,
+				ServicePort: ingBackend.ServicePort,
+			})
+		}
 	}
 
 	raw, err := action.NewParser().Parse(ingress)
@@ -10,6 +10,7 @@ import (
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/tags"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/healthcheck"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/loadbalancer"
 	annoTags "github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/tags"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/targetgroup"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/store"
@@ -1323,3 +1324,177 @@ func TestDefaultController_Reconcile(t *testing.T) {
 		})
 	}
 }
+
+func TestBackendProtocol(t *testing.T) {
+	network := &annotations.Ingress{LoadBalancer: &loadbalancer.Config{Type: aws.String(elbv2.LoadBalancerTypeEnumNetwork)}}
+	application := &annotations.Ingress{LoadBalancer: &loadbalancer.Config{Type: aws.String(elbv2.LoadBalancerTypeEnumApplication)}}
+	https := &annotations.Service{TargetGroup: &targetgroup.Config{BackendProtocol: aws.String(elbv2.ProtocolEnumHttps)}}
+	http := &annotations.Service{TargetGroup: &targetgroup.Config{BackendProtocol: aws.String(elbv2.ProtocolEnumHttp)}}
+
+	assert.Equal(t, elbv2.ProtocolEnumHttps, BackendProtocol(application, https))
+	assert.Equal(t, elbv2.ProtocolEnumHttp, BackendProtocol(&annotations.Ingress{}, http))
+	assert.Equal(t, elbv2.ProtocolEnumTls, BackendProtocol(network, https))
+	assert.Equal(t, elbv2.ProtocolEnumTcp, BackendProtocol(network, http))
+}
+
+func Test_validateNetworkAttributes(t *testing.T) {
+	proxyProtocolV2 := []*elbv2.TargetGroupAttribute{tgAttribute(ProxyProtocolV2EnabledKey, "true")}
+	preserveClientIP := []*elbv2.TargetGroupAttribute{tgAttribute(DeregistrationDelayTimeoutSecondsKey, "30"), tgAttribute(PreserveClientIPEnabledKey, "true")}
+	assert.NoError(t, validateNetworkAttributes(elbv2.ProtocolEnumTcp, proxyProtocolV2))
+	assert.NoError(t, validateNetworkAttributes(elbv2.ProtocolEnumTls, preserveClientIP))
+	assert.NoError(t, validateNetworkAttributes(elbv2.ProtocolEnumHttp, nil))
+	assert.EqualError(t, validateNetworkAttributes(elbv2.ProtocolEnumHttp, proxyProtocolV2),
+		"proxy_protocol_v2.enabled is only supported by the targetGroups of network LoadBalancers, HTTP targetGroups get the client connection info from the X-Forwarded headers")
+	assert.EqualError(t, validateNetworkAttributes(elbv2.ProtocolEnumHttps, preserveClientIP),
+		"preserve_client_ip.enabled is only supported by the targetGroups of network LoadBalancers, HTTPS targetGroups get the client connection info from the X-Forwarded headers")
+}
+
+func TestDefaultController_TGInstanceNeedsModification_tcpHealthCheck(t *testing.T) {
+	controller := &defaultController{}
+	serviceAnnos := &annotations.Service{
+		HealthCheck: &healthcheck.Config{
+			Path:            aws.String("/"),
+			Port:            aws.String("traffic-port"),
+			Protocol:        aws.String(elbv2.ProtocolEnumTcp),
+			IntervalSeconds: aws.Int64(10),
+			TimeoutSeconds:  aws.Int64(5),
+		},
+		TargetGroup: &targetgroup.Config{
+			SuccessCodes:            aws.String("200"),
+			HealthyThresholdCount:   aws.Int64(3),
+			UnhealthyThresholdCount: aws.Int64(3),
+		},
+	}
+	instance := &elbv2.TargetGroup{
+		HealthCheckPort:            aws.String("traffic-port"),
+		HealthCheckProtocol:        aws.String(elbv2.ProtocolEnumTcp),
+		HealthCheckIntervalSeconds: aws.Int64(10),
+		HealthCheckTimeoutSeconds:  aws.Int64(5),
+		HealthyThresholdCount:      aws.Int64(3),
+		UnhealthyThresholdCount:    aws.Int64(3),
+	}
+	assert.False(t, controller.TGInstanceNeedsModification(context.Background(), instance, targetgroup.ProtocolVersionHTTP1, serviceAnnos, "traffic-port"), "TCP health checks have neither path nor matcher")
+
+	assert.True(t, controller.TGInstanceNeedsModification(context.Background(), instance, targetgroup.ProtocolVersionHTTP1, serviceAnnos, "32000"), "the resolved health check port differs")
+
+	serviceAnnos.HealthCheck.Protocol = aws.String(elbv2.ProtocolEnumHttp)
+	assert.True(t, controller.TGInstanceNeedsModification(context.Background(), instance, targetgroup.ProtocolVersionHTTP1, serviceAnnos, "traffic-port"))
+}
+
+func TestDefaultController_resolveServiceHealthCheckPort_externalTrafficPolicyLocal(t *testing.T) {
+	s := store.NewDummy()
+	s.GetServiceFunc = func(string) (*corev1.Service, error) {
+		return &corev1.Service{Spec: corev1.ServiceSpec{
+			Type:                  corev1.ServiceTypeNodePort,
+			ExternalTrafficPolicy: corev1.ServiceExternalTrafficPolicyTypeLocal,
+			HealthCheckNodePort:   32000,
+		}}, nil
+	}
+	controller := &defaultController{store: s}
+
+	port, err := controller.resolveServiceHealthCheckPort("namespace", "service", intstr.FromString(healthcheck.DefaultPort), elbv2.TargetTypeEnumInstance)
+	assert.NoError(t, err)
+	assert.Equal(t, "32000", port)
+
+	port, err = controller.resolveServiceHealthCheckPort("namespace", "service", intstr.FromString(healthcheck.DefaultPort), elbv2.TargetTypeEnumIp)
+	assert.NoError(t, err)
+	assert.Equal(t, healthcheck.DefaultPort, port, "ip targets are health checked directly")
+
+	port, err = controller.resolveServiceHealthCheckPort("namespace", "service", intstr.FromInt(8080), elbv2.TargetTypeEnumInstance)
+	assert.NoError(t, err)
+	assert.Equal(t, "8080", port, "explicit health check ports are kept")
+}
+
+func TestProtocolVersion(t *testing.T) {
+	network := &annotations.Ingress{LoadBalancer: &loadbalancer.Config{Type: aws.String(elbv2.LoadBalancerTypeEnumNetwork)}}
+	application := &annotations.Ingress{LoadBalancer: &loadbalancer.Config{Type: aws.String(elbv2.LoadBalancerTypeEnumApplication)}}
+	grpc := &annotations.Service{TargetGroup: &targetgroup.Config{ProtocolVersion: aws.String(targetgroup.ProtocolVersionGRPC)}}
+
+	assert.Equal(t, targetgroup.ProtocolVersionGRPC, ProtocolVersion(application, grpc))
+	assert.Equal(t, targetgroup.ProtocolVersionHTTP1, ProtocolVersion(application, &annotations.Service{TargetGroup: &targetgroup.Config{}}))
+	assert.Equal(t, targetgroup.ProtocolVersionHTTP1, ProtocolVersion(network, grpc))
+}
+
+func TestDefaultController_newTGInstance_grpc(t *testing.T) {
+	ctx := context.Background()
+	serviceAnnos := &annotations.Service{
+		HealthCheck: &healthcheck.Config{
+			Path:            aws.String("/grpc.health.v1.Health/Check"),
+			Protocol:        aws.String(elbv2.ProtocolEnumHttp),
+			IntervalSeconds: aws.Int64(10),
+			TimeoutSeconds:  aws.Int64(5),
+		},
+		TargetGroup: &targetgroup.Config{
+			TargetType:              aws.String(elbv2.TargetTypeEnumIp),
+			ProtocolVersion:         aws.String(targetgroup.ProtocolVersionGRPC),
+			SuccessCodes:            aws.String(targetgroup.DefaultSuccessCodes),
+			HealthyThresholdCount:   aws.Int64(2),
+			UnhealthyThresholdCount: aws.Int64(2),
+		},
+	}
+	instance := &elbv2.TargetGroup{TargetGroupArn: aws.String("MyTargetGroupArn")}
+	cloud := &mocks.CloudAPI{}
+	cloud.On("CreateTargetGroupWithContext", ctx, &elbv2.CreateTargetGroupInput{
+		Name:                       aws.String("k8s-tgName"),
+		HealthCheckPath:            aws.String("/grpc.health.v1.Health/Check"),
+		HealthCheckIntervalSeconds: aws.Int64(10),
+		HealthCheckPort:            aws.String("traffic-port"),
+		HealthCheckProtocol:        aws.String(elbv2.ProtocolEnumHttp),
+		HealthCheckTimeoutSeconds:  aws.Int64(5),
+		TargetType:                 aws.String(elbv2.TargetTypeEnumIp),
+		Protocol:                   aws.String(elbv2.ProtocolEnumHttps),
+		ProtocolVersion:            aws.String(targetgroup.ProtocolVersionGRPC),
+		Matcher:                    &elbv2.Matcher{GrpcCode: aws.String(targetgroup.DefaultGRPCSuccessCodes)},
+		HealthyThresholdCount:      aws.Int64(2),
+		UnhealthyThresholdCount:    aws.Int64(2),
+		Port:                       aws.Int64(targetGroupDefaultPort),
+	}).Return(&elbv2.CreateTargetGroupOutput{TargetGroups: []*elbv2.TargetGroup{instance}}, nil)
+	controller := &defaultController{cloud: cloud}
+
+	tgInstance, err := controller.newTGInstance(ctx, "k8s-tgName", elbv2.ProtocolEnumHttps, targetgroup.ProtocolVersionGRPC, serviceAnnos, "traffic-port")
+	assert.NoError(t, err)
+	assert.Equal(t, instance, tgInstance)
+	cloud.AssertExpectations(t)
+}
+
+func TestDefaultController_TGInstanceNeedsModification_grpc(t *testing.T) {
+	serviceAnnos := &annotations.Service{
+		HealthCheck: &healthcheck.Config{
+			Path:            aws.String("/grpc.health.v1.Health/Check"),
+			Port:            aws.String("traffic-port"),
+			Protocol:        aws.String(elbv2.ProtocolEnumHttp),
+			IntervalSeconds: aws.Int64(10),
+			TimeoutSeconds:  aws.Int64(5),
+		},
+		TargetGroup: &targetgroup.Config{
+			SuccessCodes:            aws.String("0,12"),
+			HealthyThresholdCount:   aws.Int64(2),
+			UnhealthyThresholdCount: aws.Int64(2),
+		},
+	}
+	for _, tc := range []struct {
+		Name           string
+		CurrentCode    string
+		ExpectedModify bool
+	}{
+		{Name: "success codes are unchanged", CurrentCode: "0,12"},
+		{Name: "success codes changed", CurrentCode: "12", ExpectedModify: true},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			instance := &elbv2.TargetGroup{
+				HealthCheckPath:            aws.String("/grpc.health.v1.Health/Check"),
+				HealthCheckPort:            aws.String("traffic-port"),
+				HealthCheckProtocol:        aws.String(elbv2.ProtocolEnumHttp),
+				HealthCheckIntervalSeconds: aws.Int64(10),
+				HealthCheckTimeoutSeconds:  aws.Int64(5),
+				HealthyThresholdCount:      aws.Int64(2),
+				UnhealthyThresholdCount:    aws.Int64(2),
+				Matcher:                    &elbv2.Matcher{GrpcCode: aws.String(tc.CurrentCode)},
+				ProtocolVersion:            aws.String(targetgroup.ProtocolVersionGRPC),
+			}
+			controller := &defaultController{}
+
+			assert.Equal(t, tc.ExpectedModify, controller.TGInstanceNeedsModification(context.Background(), instance, targetgroup.ProtocolVersionGRPC, serviceAnnos, "traffic-port"))
+		})
+	}
+}
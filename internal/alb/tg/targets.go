@@ -5,13 +5,18 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/backend"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/tracing"
 	api "k8s.io/api/core/v1"
 	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 // Targets contains the targets for a target group.
@@ -30,6 +35,13 @@ type Targets struct {
 
 	// Backend is the ingress backend for the targets
 	Backend *extensions.IngressBackend
+
+	// NodeSelector selects the nodes registered as instance targets, nil selects all of them
+	NodeSelector labels.Selector
+
+	// OwnedTargets are the targets deregistered when they aren't desired anymore, as id:port, for target groups shared with other clusters.
+	// nil means all the targets of the target group are owned.
+	OwnedTargets sets.String
 }
 
 // NewTargets returns a new Targets pointer
@@ -48,12 +60,18 @@ type TargetsController interface {
 	StopReconcilingPodConditionStatus(tgArn string)
 }
 
-// NewTargetsController constructs a new target group targets controller
-func NewTargetsController(cloud aws.CloudAPI, endpointResolver backend.EndpointResolver, healthController TargetHealthController) TargetsController {
+// drainingPollInterval is the period of checking whether deregistered targets are draining.
+var drainingPollInterval = time.Second
+
+// NewTargetsController constructs a new target group targets controller, which registers and deregisters targets in batches of at most batchSize targets.
+// 0 means unlimited. Reconciles wait up to drainingWait for deregistered ip targets to be draining, 0 means they don't wait.
+func NewTargetsController(cloud aws.CloudAPI, endpointResolver backend.EndpointResolver, healthController TargetHealthController, batchSize int, drainingWait time.Duration) TargetsController {
 	return &targetsController{
 		cloud:            cloud,
 		endpointResolver: endpointResolver,
 		healthController: healthController,
+		batchSize:        batchSize,
+		drainingWait:     drainingWait,
 	}
 }
 
@@ -61,10 +79,15 @@ type targetsController struct {
 	cloud            aws.CloudAPI
 	endpointResolver backend.EndpointResolver
 	healthController TargetHealthController
+	batchSize        int
+	drainingWait     time.Duration
 }
 
 func (c *targetsController) Reconcile(ctx context.Context, t *Targets) error {
-	desired, err := c.endpointResolver.Resolve(t.Ingress, t.Backend, t.TargetType)
+	ctx, span := tracing.StartSpan(ctx, "tg.ReconcileTargets")
+	defer span.End()
+
+	desired, err := c.endpointResolver.Resolve(t.Ingress, t.Backend, t.TargetType, t.NodeSelector)
 	if err != nil {
 		return err
 	}
@@ -78,6 +101,9 @@ func (c *targetsController) Reconcile(ctx context.Context, t *Targets) error {
 	if err != nil {
 		return err
 	}
+	if t.OwnedTargets != nil {
+		current = ownedTargets(current, desired, t.OwnedTargets)
+	}
 	if t.TargetType == elbv2.TargetTypeEnumIp {
 		// pods conditions reconciling is only implemented for target type == IP;
 		// with target type == node, a 1:1 mapping between ALB target and pod is only possible if hostPort is used, which is discouraged
@@ -91,12 +117,7 @@ func (c *targetsController) Reconcile(ctx context.Context, t *Targets) error {
 	additions, removals := targetChangeSets(current, desired)
 	if len(additions) > 0 {
 		albctx.GetLogger(ctx).Infof("Adding targets to %v: %v", t.TgArn, tdsString(additions))
-		in := &elbv2.RegisterTargetsInput{
-			TargetGroupArn: aws.String(t.TgArn),
-			Targets:        additions,
-		}
-
-		if _, err := c.cloud.RegisterTargetsWithContext(ctx, in); err != nil {
+		if err := c.registerTargets(ctx, t.TgArn, additions); err != nil {
 			albctx.GetLogger(ctx).Errorf("Error adding targets to %v: %v", t.TgArn, err.Error())
 			albctx.GetEventf(ctx)(api.EventTypeWarning, "ERROR", "Error adding targets to target group %s: %s", t.TgArn, err.Error())
 			return err
@@ -112,16 +133,16 @@ func (c *targetsController) Reconcile(ctx context.Context, t *Targets) error {
 			}
 		}
 
-		in := &elbv2.DeregisterTargetsInput{
-			TargetGroupArn: aws.String(t.TgArn),
-			Targets:        removals,
-		}
-
-		if _, err := c.cloud.DeregisterTargetsWithContext(ctx, in); err != nil {
+		if err := c.deregisterTargets(ctx, t.TgArn, removals); err != nil {
 			albctx.GetLogger(ctx).Errorf("Error removing targets from %v: %v", t.TgArn, err.Error())
 			albctx.GetEventf(ctx)(api.EventTypeWarning, "ERROR", "Error removing targets from target group %s: %s", t.TgArn, err.Error())
 			return err
 		}
+		if t.TargetType == elbv2.TargetTypeEnumIp && c.drainingWait > 0 {
+			if err := c.waitForDraining(ctx, t.TgArn, removals); err != nil {
+				return err
+			}
+		}
 		// TODO add Delete events ?
 	}
 	t.Targets = desired
@@ -132,6 +153,75 @@ func (c *targetsController) StopReconcilingPodConditionStatus(tgArn string) {
 	c.healthController.StopReconcilingPodConditionStatus(tgArn)
 }
 
+// registerTargets registers targets into the target group tgArn, in batches of at most batchSize targets.
+// Throttled batches are retried by the AWS SDK, then by the retried reconcile, which only registers the targets left.
+func (c *targetsController) registerTargets(ctx context.Context, tgArn string, targets []*elbv2.TargetDescription) error {
+	for _, batch := range batchTargets(targets, c.batchSize) {
+		in := &elbv2.RegisterTargetsInput{
+			TargetGroupArn: aws.String(tgArn),
+			Targets:        batch,
+		}
+		if _, err := c.cloud.RegisterTargetsWithContext(ctx, in); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deregisterTargets deregisters targets from the target group tgArn, in batches of at most batchSize targets.
+func (c *targetsController) deregisterTargets(ctx context.Context, tgArn string, targets []*elbv2.TargetDescription) error {
+	for _, batch := range batchTargets(targets, c.batchSize) {
+		in := &elbv2.DeregisterTargetsInput{
+			TargetGroupArn: aws.String(tgArn),
+			Targets:        batch,
+		}
+		if _, err := c.cloud.DeregisterTargetsWithContext(ctx, in); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitForDraining waits up to drainingWait for the deregistered targets of tgArn to be draining, i.e. for the ALB to stop routing new requests to them.
+// Timing out isn't an error, as the targets are deregistered regardless.
+func (c *targetsController) waitForDraining(ctx context.Context, tgArn string, targets []*elbv2.TargetDescription) error {
+	in := &elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: aws.String(tgArn),
+		Targets:        targets,
+	}
+	err := wait.Poll(drainingPollInterval, c.drainingWait, func() (bool, error) {
+		resp, err := c.cloud.DescribeTargetHealthWithContext(ctx, in)
+		if err != nil {
+			return false, err
+		}
+		for _, thd := range resp.TargetHealthDescriptions {
+			state := aws.StringValue(thd.TargetHealth.State)
+			if state != elbv2.TargetHealthStateEnumDraining && state != elbv2.TargetHealthStateEnumUnused {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		albctx.GetLogger(ctx).Warnf("Targets removed from %v aren't draining after %v: %v", tgArn, c.drainingWait, tdsString(targets))
+		return nil
+	}
+	return err
+}
+
+// batchTargets splits targets in batches of at most batchSize targets, 0 means a single batch.
+func batchTargets(targets []*elbv2.TargetDescription, batchSize int) [][]*elbv2.TargetDescription {
+	if batchSize <= 0 {
+		return [][]*elbv2.TargetDescription{targets}
+	}
+	var batches [][]*elbv2.TargetDescription
+	for len(targets) > batchSize {
+		batches = append(batches, targets[:batchSize])
+		targets = targets[batchSize:]
+	}
+	return append(batches, targets)
+}
+
 func (c *targetsController) getCurrentTargets(ctx context.Context, TgArn string) ([]*elbv2.TargetDescription, error) {
 	opts := &elbv2.DescribeTargetHealthInput{TargetGroupArn: aws.String(TgArn)}
 	resp, err := c.cloud.DescribeTargetHealthWithContext(ctx, opts)
@@ -177,6 +267,21 @@ func (c *targetsController) populateTargetAZ(ctx context.Context, a []*elbv2.Tar
 	return nil
 }
 
+// ownedTargets returns the current targets which are either owned or desired, the targets of other clusters are left alone.
+func ownedTargets(current, desired []*elbv2.TargetDescription, owned sets.String) []*elbv2.TargetDescription {
+	desiredSet := sets.NewString()
+	for _, td := range desired {
+		desiredSet.Insert(tdString(td))
+	}
+	var result []*elbv2.TargetDescription
+	for _, td := range current {
+		if owned.Has(tdString(td)) || desiredSet.Has(tdString(td)) {
+			result = append(result, td)
+		}
+	}
+	return result
+}
+
 // targetChangeSets compares b to a, returning a list of targets to add and remove from a to match b
 func targetChangeSets(current, desired []*elbv2.TargetDescription) (add []*elbv2.TargetDescription, remove []*elbv2.TargetDescription) {
 	currentMap := map[string]bool{}
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/dummy"
@@ -16,7 +18,9 @@ import (
 	"github.com/stretchr/testify/mock"
 	corev1 "k8s.io/api/core/v1"
 	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -89,11 +93,12 @@ type RegisterTargetsCall struct {
 }
 
 type ResolveCall struct {
-	InputIngress    *extensions.Ingress
-	InputBackend    *extensions.IngressBackend
-	InputTargetType string
-	Output          []*elbv2.TargetDescription
-	Err             error
+	InputIngress      *extensions.Ingress
+	InputBackend      *extensions.IngressBackend
+	InputTargetType   string
+	InputNodeSelector labels.Selector
+	Output            []*elbv2.TargetDescription
+	Err               error
 }
 
 func Test_TargetsReconcile(t *testing.T) {
@@ -267,7 +272,7 @@ func Test_TargetsReconcile(t *testing.T) {
 			ctx := context.Background()
 			endpointResolver := &mocks.EndpointResolver{}
 			if tc.ResolveCall != nil {
-				endpointResolver.On("Resolve", tc.ResolveCall.InputIngress, tc.ResolveCall.InputBackend, tc.ResolveCall.InputTargetType).Return(tc.ResolveCall.Output, tc.ResolveCall.Err)
+				endpointResolver.On("Resolve", tc.ResolveCall.InputIngress, tc.ResolveCall.InputBackend, tc.ResolveCall.InputTargetType, tc.ResolveCall.InputNodeSelector).Return(tc.ResolveCall.Output, tc.ResolveCall.Err)
 				if tc.ResolveCall.InputTargetType == elbv2.TargetTypeEnumIp {
 					endpointResolver.On("ReverseResolve", tc.ResolveCall.InputIngress, tc.ResolveCall.InputBackend, mock.Anything).Return(make([]*corev1.Pod, len(tc.ResolveCall.Output)), nil)
 				}
@@ -291,7 +296,7 @@ func Test_TargetsReconcile(t *testing.T) {
 			client := testclient.NewFakeClient()
 			healthController := NewTargetHealthController(cloud, store, endpointResolver, client)
 
-			controller := NewTargetsController(cloud, endpointResolver, healthController)
+			controller := NewTargetsController(cloud, endpointResolver, healthController, 0, 0)
 			err := controller.Reconcile(context.Background(), tc.Targets)
 
 			if tc.ExpectedError != nil {
@@ -334,6 +339,13 @@ func Test_targetChangeSets(t *testing.T) {
 	}
 }
 
+func Test_ownedTargets(t *testing.T) {
+	current := []*elbv2.TargetDescription{newTd("owned", 1), newTd("desired", 1), newTd("other", 1)}
+	desired := []*elbv2.TargetDescription{newTd("desired", 1), newTd("new", 1)}
+	assert.Equal(t, []*elbv2.TargetDescription{newTd("owned", 1), newTd("desired", 1)},
+		ownedTargets(current, desired, sets.NewString("owned:1", "new:1")))
+}
+
 func Test_tdsString(t *testing.T) {
 	for _, tc := range []struct {
 		name     string
@@ -354,3 +366,61 @@ func Test_tdsString(t *testing.T) {
 
 	}
 }
+
+func Test_batchTargets(t *testing.T) {
+	targets := []*elbv2.TargetDescription{newTd("id1", 1), newTd("id2", 1), newTd("id3", 1)}
+	assert.Equal(t, [][]*elbv2.TargetDescription{targets}, batchTargets(targets, 0))
+	assert.Equal(t, [][]*elbv2.TargetDescription{targets}, batchTargets(targets, 3))
+	assert.Equal(t, [][]*elbv2.TargetDescription{targets[:2], targets[2:]}, batchTargets(targets, 2))
+}
+
+func Test_targetsController_registerTargets(t *testing.T) {
+	ctx := context.Background()
+	targets := []*elbv2.TargetDescription{newTd("id1", 1), newTd("id2", 1), newTd("id3", 1)}
+	throttled := awserr.New("Throttling", "Rate exceeded", nil)
+
+	cloud := &mocks.CloudAPI{}
+	cloud.On("RegisterTargetsWithContext", ctx, &elbv2.RegisterTargetsInput{TargetGroupArn: aws.String("arn"), Targets: targets[:2]}).Return(nil, nil).Once()
+	cloud.On("RegisterTargetsWithContext", ctx, &elbv2.RegisterTargetsInput{TargetGroupArn: aws.String("arn"), Targets: targets[2:]}).Return(nil, nil).Once()
+	controller := &targetsController{cloud: cloud, batchSize: 2}
+	assert.NoError(t, controller.registerTargets(ctx, "arn", targets))
+	cloud.AssertExpectations(t)
+
+	// a throttled batch fails the reconcile, past the retries of the AWS SDK, without sending the next batches.
+	cloud = &mocks.CloudAPI{}
+	cloud.On("DeregisterTargetsWithContext", ctx, &elbv2.DeregisterTargetsInput{TargetGroupArn: aws.String("arn"), Targets: targets[:2]}).Return(nil, throttled).Once()
+	controller = &targetsController{cloud: cloud, batchSize: 2}
+	assert.Equal(t, throttled, controller.deregisterTargets(ctx, "arn", targets))
+	cloud.AssertExpectations(t)
+}
+
+func Test_targetsController_waitForDraining(t *testing.T) {
+	defer func(interval time.Duration) { drainingPollInterval = interval }(drainingPollInterval)
+	drainingPollInterval = time.Millisecond
+
+	ctx := context.Background()
+	targets := []*elbv2.TargetDescription{newTd("10.0.0.1", 8080)}
+	in := &elbv2.DescribeTargetHealthInput{TargetGroupArn: aws.String("arn"), Targets: targets}
+	health := func(state string) *elbv2.DescribeTargetHealthOutput {
+		return &elbv2.DescribeTargetHealthOutput{TargetHealthDescriptions: []*elbv2.TargetHealthDescription{
+			{Target: targets[0], TargetHealth: &elbv2.TargetHealth{State: aws.String(state)}},
+		}}
+	}
+
+	cloud := &mocks.CloudAPI{}
+	cloud.On("DescribeTargetHealthWithContext", ctx, in).Return(health(elbv2.TargetHealthStateEnumHealthy), nil).Once()
+	cloud.On("DescribeTargetHealthWithContext", ctx, in).Return(health(elbv2.TargetHealthStateEnumDraining), nil).Once()
+	controller := &targetsController{cloud: cloud, drainingWait: time.Second}
+	assert.NoError(t, controller.waitForDraining(ctx, "arn", targets))
+	cloud.AssertExpectations(t)
+
+	cloud = &mocks.CloudAPI{}
+	cloud.On("DescribeTargetHealthWithContext", ctx, in).Return(health(elbv2.TargetHealthStateEnumHealthy), nil)
+	controller = &targetsController{cloud: cloud, drainingWait: 10 * time.Millisecond}
+	assert.NoError(t, controller.waitForDraining(ctx, "arn", targets))
+
+	cloud = &mocks.CloudAPI{}
+	cloud.On("DescribeTargetHealthWithContext", ctx, in).Return(nil, errors.New("access denied")).Once()
+	controller = &targetsController{cloud: cloud, drainingWait: time.Second}
+	assert.EqualError(t, controller.waitForDraining(ctx, "arn", targets), "access denied")
+}
@@ -0,0 +1,136 @@
+package albctx
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+// EventLimiter deduplicates and rate limits events, so a flapping ingress doesn't flood the API server with identical events.
+// An event is dropped when an event with the same object, reason and message was emitted within dedupWindow,
+// or when burst events were already emitted for the object within the current interval.
+type EventLimiter struct {
+	dedupWindow time.Duration
+	burst       int
+	interval    time.Duration
+
+	// now returns the current time, it's replaceable for tests.
+	now func() time.Time
+
+	mu         sync.Mutex
+	emitted    map[string]*emittedEvent
+	budgets    map[string]*eventBudget
+	lastPruned time.Time
+}
+
+type emittedEvent struct {
+	at         time.Time
+	suppressed int
+}
+
+type eventBudget struct {
+	start time.Time
+	count int
+}
+
+// NewEventLimiter constructs new EventLimiter. A zero dedupWindow disables deduplication, a zero burst disables rate limiting.
+func NewEventLimiter(dedupWindow time.Duration, burst int, interval time.Duration) *EventLimiter {
+	return &EventLimiter{
+		dedupWindow: dedupWindow,
+		burst:       burst,
+		interval:    interval,
+		now:         time.Now,
+		emitted:     make(map[string]*emittedEvent),
+		budgets:     make(map[string]*eventBudget),
+	}
+}
+
+// Wrap returns an Eventf emitting events of object through eventf, subject to the limits.
+// When an event is emitted again after being deduplicated, its message mentions how often it was suppressed.
+// A nil EventLimiter returns eventf as is.
+func (l *EventLimiter) Wrap(object string, eventf Eventf) Eventf {
+	if l == nil {
+		return eventf
+	}
+	return func(eventType string, reason string, messageFmt string, args ...interface{}) {
+		message := fmt.Sprintf(messageFmt, args...)
+		suppressed, ok := l.allow(object, reason, message)
+		if !ok {
+			return
+		}
+		if suppressed > 0 {
+			message = fmt.Sprintf("%s (repeated %d times)", message, suppressed)
+		}
+		eventf(eventType, reason, "%s", message)
+	}
+}
+
+// allow returns whether the event may be emitted, along with the number of times it was deduplicated since last emitted.
+func (l *EventLimiter) allow(object string, reason string, message string) (int, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	l.prune(now)
+
+	key := fmt.Sprintf("%s/%s/%x", object, reason, messageHash(message))
+	previous, seen := l.emitted[key]
+	if seen && now.Sub(previous.at) < l.dedupWindow {
+		previous.suppressed++
+		return 0, false
+	}
+
+	if l.burst > 0 {
+		budget, ok := l.budgets[object]
+		if !ok || now.Sub(budget.start) >= l.interval {
+			budget = &eventBudget{start: now}
+			l.budgets[object] = budget
+		}
+		if budget.count >= l.burst {
+			glog.V(4).Infof("dropping event %v of %v as the event rate limit is exceeded: %v", reason, object, message)
+			return 0, false
+		}
+		budget.count++
+	}
+
+	suppressed := 0
+	if seen {
+		suppressed = previous.suppressed
+	}
+	if l.dedupWindow > 0 {
+		l.emitted[key] = &emittedEvent{at: now}
+	}
+	return suppressed, true
+}
+
+// prune forgets expired events and budgets, at most once per dedupWindow or interval.
+// Suppressed events are kept for another dedupWindow, so the count is reported when they recur.
+func (l *EventLimiter) prune(now time.Time) {
+	period := l.dedupWindow
+	if l.interval > period {
+		period = l.interval
+	}
+	if now.Sub(l.lastPruned) < period {
+		return
+	}
+	for key, event := range l.emitted {
+		age := now.Sub(event.at)
+		if age >= 2*l.dedupWindow || (age >= l.dedupWindow && event.suppressed == 0) {
+			delete(l.emitted, key)
+		}
+	}
+	for object, budget := range l.budgets {
+		if now.Sub(budget.start) >= l.interval {
+			delete(l.budgets, object)
+		}
+	}
+	l.lastPruned = now
+}
+
+func messageHash(message string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(message))
+	return h.Sum64()
+}
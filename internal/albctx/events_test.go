@@ -0,0 +1,60 @@
+package albctx
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordedEvents []string
+
+func (r *recordedEvents) eventf(eventType, reason, messageFmt string, args ...interface{}) {
+	*r = append(*r, fmt.Sprintf("%s %s %s", eventType, reason, fmt.Sprintf(messageFmt, args...)))
+}
+
+func TestEventLimiter_Wrap(t *testing.T) {
+	now := time.Unix(0, 0)
+	limiter := NewEventLimiter(time.Minute, 3, time.Minute)
+	limiter.now = func() time.Time { return now }
+
+	var events recordedEvents
+	eventf := limiter.Wrap("default/ingress", events.eventf)
+	for i := 0; i < 5; i++ {
+		eventf("Warning", "ERROR", "failed to reconcile ingress due to %v", "throttled")
+	}
+	assert.Equal(t, recordedEvents{"Warning ERROR failed to reconcile ingress due to throttled"}, events)
+
+	eventf("Normal", "CREATE", "LoadBalancer %v created", "lb")
+	eventf("Normal", "CREATE", "LoadBalancer %v created", "lb-2")
+	eventf("Normal", "CREATE", "LoadBalancer %v created", "lb-3")
+	assert.Len(t, events, 3, "events beyond the rate limit should be dropped")
+
+	var otherEvents recordedEvents
+	limiter.Wrap("default/other", otherEvents.eventf)("Warning", "ERROR", "failed to reconcile ingress due to %v", "throttled")
+	assert.Len(t, otherEvents, 1, "limits should apply per object")
+
+	now = now.Add(time.Minute)
+	eventf("Warning", "ERROR", "failed to reconcile ingress due to %v", "throttled")
+	assert.Equal(t, "Warning ERROR failed to reconcile ingress due to throttled (repeated 4 times)", events[3])
+
+	now = now.Add(2 * time.Minute)
+	eventf("Warning", "ERROR", "failed to reconcile ingress due to %v", "throttled")
+	assert.Equal(t, "Warning ERROR failed to reconcile ingress due to throttled", events[4])
+}
+
+func TestEventLimiter_disabled(t *testing.T) {
+	limiter := NewEventLimiter(0, 0, time.Minute)
+	var events recordedEvents
+	eventf := limiter.Wrap("default/ingress", events.eventf)
+	for i := 0; i < 20; i++ {
+		eventf("Warning", "ERROR", "%v failed", "100%")
+	}
+	assert.Len(t, events, 20)
+	assert.Equal(t, "Warning ERROR 100% failed", events[0])
+
+	var nilLimiter *EventLimiter
+	nilLimiter.Wrap("default/ingress", events.eventf)("Normal", "CREATE", "created")
+	assert.Len(t, events, 21)
+}
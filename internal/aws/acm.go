@@ -23,6 +23,9 @@ type ACMAPI interface {
 
 	// DescribeCertificate is an wrapper around acm.DescribeCertificate
 	DescribeCertificate(ctx context.Context, certArn string) (*acm.CertificateDetail, error)
+
+	// ListTagsForCertificate returns the tags of an ACM certificate
+	ListTagsForCertificate(ctx context.Context, certArn string) (map[string]string, error)
 }
 
 // Status validates ACM connectivity
@@ -68,3 +71,17 @@ func (c *Cloud) DescribeCertificate(ctx context.Context, certArn string) (*acm.C
 	}
 	return resp.Certificate, nil
 }
+
+func (c *Cloud) ListTagsForCertificate(ctx context.Context, certArn string) (map[string]string, error) {
+	resp, err := c.acm.ListTagsForCertificateWithContext(ctx, &acm.ListTagsForCertificateInput{
+		CertificateArn: aws.String(certArn),
+	})
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]string, len(resp.Tags))
+	for _, tag := range resp.Tags {
+		tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return tags, nil
+}
@@ -10,6 +10,10 @@ import (
 	"github.com/aws/aws-sdk-go/aws/ec2metadata"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acm/acmiface"
+	"github.com/aws/aws-sdk-go/service/arczonalshift"
+	"github.com/aws/aws-sdk-go/service/arczonalshift/arczonalshiftiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/elbv2"
@@ -18,25 +22,40 @@ import (
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/aws/aws-sdk-go/service/servicequotas/servicequotasiface"
 	"github.com/aws/aws-sdk-go/service/shield"
 	"github.com/aws/aws-sdk-go/service/shield/shieldiface"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 	"github.com/aws/aws-sdk-go/service/wafregional"
 	"github.com/aws/aws-sdk-go/service/wafregional/wafregionaliface"
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
+	"github.com/golang/glog"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/metric"
 	"github.com/ticketmaster/aws-sdk-go-cache/cache"
 )
 
 type CloudAPI interface {
 	ACMAPI
+	CloudWatchAPI
 	EC2API
 	ELBV2API
 	IAMAPI
 	ResourceGroupsTaggingAPIAPI
+	Route53API
+	S3API
+	ServiceQuotasAPI
 	ShieldAPI
+	STSAPI
 	WAFRegionalAPI
 	WAFV2API
+	ZonalShiftAPI
 
 	GetClusterName() string
 	GetVpcID() string
@@ -45,16 +64,23 @@ type CloudAPI interface {
 type Cloud struct {
 	vpcID       string
 	region      string
+	partition   string
 	clusterName string
 
-	acm         acmiface.ACMAPI
-	ec2         ec2iface.EC2API
-	elbv2       elbv2iface.ELBV2API
-	iam         iamiface.IAMAPI
-	shield      shieldiface.ShieldAPI
-	rgt         resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI
-	wafregional wafregionaliface.WAFRegionalAPI
-	wafv2       wafv2iface.WAFV2API
+	acm           acmiface.ACMAPI
+	cloudwatch    cloudwatchiface.CloudWatchAPI
+	ec2           ec2iface.EC2API
+	elbv2         elbv2iface.ELBV2API
+	iam           iamiface.IAMAPI
+	shield        shieldiface.ShieldAPI
+	rgt           resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI
+	route53       route53iface.Route53API
+	s3            s3iface.S3API
+	servicequotas servicequotasiface.ServiceQuotasAPI
+	sts           stsiface.STSAPI
+	wafregional   wafregionaliface.WAFRegionalAPI
+	wafv2         wafv2iface.WAFV2API
+	zonalshift    arczonalshiftiface.ARCZonalShiftAPI
 }
 
 // Initialize the global AWS clients.
@@ -62,7 +88,7 @@ type Cloud struct {
 // TODO: remove clusterName dependency
 // TODO: remove mc dependency like https://github.com/kubernetes/kubernetes/blob/master/pkg/cloudprovider/providers/aws/aws_metrics.go
 func New(cfg CloudConfig, clusterName string, mc metric.Collector, ce bool, cc *cache.Config) (CloudAPI, error) {
-	metadataSession := session.Must(session.NewSession(aws.NewConfig()))
+	metadataSession := session.Must(session.NewSession(aws.NewConfig().WithHTTPClient(newMetadataHTTPClient())))
 	metadata := ec2metadata.New(metadataSession)
 	if len(cfg.VpcID) == 0 {
 		vpcID, err := GetVpcIDFromEC2Metadata(metadata)
@@ -79,20 +105,68 @@ func New(cfg CloudConfig, clusterName string, mc metric.Collector, ce bool, cc *
 		cfg.Region = region
 	}
 
-	awsCfg := aws.NewConfig().WithRegion(cfg.Region).WithSTSRegionalEndpoint(endpoints.RegionalSTSEndpoint).WithMaxRetries(cfg.APIMaxRetries)
+	if len(cfg.Partition) == 0 {
+		cfg.Partition = PartitionForRegion(cfg.Region)
+	}
+
+	httpClient, err := NewHTTPClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	awsCfg := aws.NewConfig().WithRegion(cfg.Region).WithHTTPClient(httpClient).WithSTSRegionalEndpoint(endpoints.RegionalSTSEndpoint).
+		WithMaxRetries(cfg.APIMaxRetries)
+	if len(cfg.Endpoint) != 0 {
+		awsCfg = awsCfg.WithEndpoint(cfg.Endpoint)
+	}
 	awsSession := NewSession(awsCfg, cfg.APIDebug, mc, ce, cc)
+	if len(cfg.APIFaults) != 0 {
+		faultRules, err := ParseFaultRules(cfg.APIFaults)
+		if err != nil {
+			return nil, err
+		}
+		glog.Warningf("Injecting faults into AWS API calls: %v", cfg.APIFaults)
+		InjectFaults(awsSession, faultRules)
+	}
+	if cfg.ReadOnly {
+		BlockMutations(awsSession)
+	}
+	if len(cfg.APIRateLimits) != 0 {
+		rateLimits, err := ParseRateLimits(cfg.APIRateLimits)
+		if err != nil {
+			return nil, err
+		}
+		LimitRate(awsSession, rateLimits)
+	}
+	// the ELBV2 describe calls are already cached with the other AWS API calls when the AWS SDK cache is enabled.
+	elbv2Session := awsSession
+	if cfg.ELBV2CacheDuration > 0 && !ce {
+		elbv2Session = NewELBV2CachingSession(awsSession, cfg.ELBV2CacheDuration)
+	}
+	// Shield Advanced is only available in the aws partition.
+	var shieldClient shieldiface.ShieldAPI
+	if cfg.Partition == endpoints.AwsPartitionID {
+		shieldClient = shield.New(awsSession, &aws.Config{Region: aws.String(shieldRegion)})
+	}
 	return &Cloud{
 		cfg.VpcID,
 		cfg.Region,
+		cfg.Partition,
 		clusterName,
 		acm.New(awsSession),
+		cloudwatch.New(awsSession),
 		ec2.New(awsSession),
-		elbv2.New(awsSession),
+		elbv2.New(elbv2Session),
 		iam.New(awsSession),
-		shield.New(awsSession, &aws.Config{Region: aws.String("us-east-1")}),
+		shieldClient,
 		resourcegroupstaggingapi.New(awsSession),
+		route53.New(awsSession),
+		s3.New(awsSession),
+		servicequotas.New(awsSession),
+		sts.New(awsSession),
 		wafregional.New(awsSession),
 		wafv2.New(awsSession),
+		arczonalshift.New(awsSession),
 	}, nil
 }
 
@@ -0,0 +1,75 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+const (
+	// cloudWatchDeleteAlarmsBatchSize is the maximum number of alarms of a single DeleteAlarms call.
+	cloudWatchDeleteAlarmsBatchSize = 100
+	// cloudWatchPutMetricDataBatchSize is the maximum number of metric data of a single PutMetricData call.
+	cloudWatchPutMetricDataBatchSize = 20
+)
+
+type CloudWatchAPI interface {
+	// GetMetricAlarmsByPrefix returns the metric alarms whose name starts with prefix.
+	GetMetricAlarmsByPrefix(ctx context.Context, prefix string) ([]*cloudwatch.MetricAlarm, error)
+
+	// PutMetricAlarm creates or updates a metric alarm.
+	PutMetricAlarm(ctx context.Context, input *cloudwatch.PutMetricAlarmInput) error
+
+	// DeleteMetricAlarms deletes the metric alarms by name.
+	DeleteMetricAlarms(ctx context.Context, names []string) error
+
+	// PutMetricData publishes custom metric data into namespace.
+	PutMetricData(ctx context.Context, namespace string, data []*cloudwatch.MetricDatum) error
+}
+
+func (c *Cloud) GetMetricAlarmsByPrefix(ctx context.Context, prefix string) ([]*cloudwatch.MetricAlarm, error) {
+	var result []*cloudwatch.MetricAlarm
+	err := c.cloudwatch.DescribeAlarmsPagesWithContext(ctx, &cloudwatch.DescribeAlarmsInput{
+		AlarmNamePrefix: String(prefix),
+	}, func(output *cloudwatch.DescribeAlarmsOutput, _ bool) bool {
+		result = append(result, output.MetricAlarms...)
+		return true
+	})
+	return result, err
+}
+
+func (c *Cloud) PutMetricAlarm(ctx context.Context, input *cloudwatch.PutMetricAlarmInput) error {
+	_, err := c.cloudwatch.PutMetricAlarmWithContext(ctx, input)
+	return err
+}
+
+func (c *Cloud) DeleteMetricAlarms(ctx context.Context, names []string) error {
+	for start := 0; start < len(names); start += cloudWatchDeleteAlarmsBatchSize {
+		end := start + cloudWatchDeleteAlarmsBatchSize
+		if end > len(names) {
+			end = len(names)
+		}
+		if _, err := c.cloudwatch.DeleteAlarmsWithContext(ctx, &cloudwatch.DeleteAlarmsInput{
+			AlarmNames: StringSlice(names[start:end]),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Cloud) PutMetricData(ctx context.Context, namespace string, data []*cloudwatch.MetricDatum) error {
+	for start := 0; start < len(data); start += cloudWatchPutMetricDataBatchSize {
+		end := start + cloudWatchPutMetricDataBatchSize
+		if end > len(data) {
+			end = len(data)
+		}
+		if _, err := c.cloudwatch.PutMetricDataWithContext(ctx, &cloudwatch.PutMetricDataInput{
+			Namespace:  String(namespace),
+			MetricData: data[start:end],
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloud_DeleteMetricAlarms(t *testing.T) {
+	ctx := context.Background()
+	var names []string
+	for i := 0; i < 150; i++ {
+		names = append(names, fmt.Sprintf("lb/alarm-%03d", i))
+	}
+
+	cloudwatchsvc := &mocks.CloudWatchAPI{}
+	cloudwatchsvc.On("DeleteAlarmsWithContext", ctx, &cloudwatch.DeleteAlarmsInput{AlarmNames: StringSlice(names[:100])}).Return(&cloudwatch.DeleteAlarmsOutput{}, nil)
+	cloudwatchsvc.On("DeleteAlarmsWithContext", ctx, &cloudwatch.DeleteAlarmsInput{AlarmNames: StringSlice(names[100:])}).Return(&cloudwatch.DeleteAlarmsOutput{}, nil)
+	cloud := &Cloud{cloudwatch: cloudwatchsvc}
+
+	assert.NoError(t, cloud.DeleteMetricAlarms(ctx, names))
+	cloudwatchsvc.AssertExpectations(t)
+}
+
+func TestCloud_PutMetricData(t *testing.T) {
+	ctx := context.Background()
+	var data []*cloudwatch.MetricDatum
+	for i := 0; i < 25; i++ {
+		data = append(data, &cloudwatch.MetricDatum{MetricName: String(fmt.Sprintf("metric-%02d", i))})
+	}
+
+	cloudwatchsvc := &mocks.CloudWatchAPI{}
+	cloudwatchsvc.On("PutMetricDataWithContext", ctx, &cloudwatch.PutMetricDataInput{Namespace: String("namespace"), MetricData: data[:20]}).Return(&cloudwatch.PutMetricDataOutput{}, nil)
+	cloudwatchsvc.On("PutMetricDataWithContext", ctx, &cloudwatch.PutMetricDataInput{Namespace: String("namespace"), MetricData: data[20:]}).Return(&cloudwatch.PutMetricDataOutput{}, nil)
+	cloud := &Cloud{cloudwatch: cloudwatchsvc}
+
+	assert.NoError(t, cloud.PutMetricData(ctx, "namespace", data))
+	cloudwatchsvc.AssertExpectations(t)
+}
@@ -2,11 +2,15 @@ package aws
 
 import (
 	"fmt"
+	"net/url"
 	"os"
+	"regexp"
 	"strconv"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/spf13/pflag"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 )
 
 const (
@@ -16,13 +20,43 @@ const (
 	defaultAPIDebug      = false
 )
 
+var (
+	vpcIDPattern     = regexp.MustCompile(`^vpc-[0-9a-f]+$`)
+	regionPattern    = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-[0-9]+$`)
+	partitionPattern = regexp.MustCompile(`^aws(-[a-z]+)*$`)
+)
+
 // configuration for cloud
 type CloudConfig struct {
 	VpcID  string
 	Region string
+	// Partition overrides the partition detected from Region, e.g. aws-cn or aws-us-gov.
+	Partition string
 
 	APIMaxRetries int
 	APIDebug      bool
+
+	// HTTPProxy is the proxy URL for AWS API calls, overriding the HTTPS_PROXY and HTTP_PROXY environment variables.
+	HTTPProxy string
+	// NoProxy lists hosts excluded from HTTPProxy, in the NO_PROXY format.
+	NoProxy string
+	// CABundle is the path of a PEM file with certificates to trust for AWS API calls, in addition to the system roots.
+	CABundle string
+
+	// APIFaults are fault rules injected into AWS API calls, see ParseFaultRules.
+	APIFaults string
+
+	// Endpoint overrides the endpoint of all AWS APIs, e.g. to run against LocalStack.
+	Endpoint string
+
+	// APIRateLimits are the <qps>[:<burst>] rate limits of the calls to each AWS service, see ParseRateLimits.
+	APIRateLimits map[string]string
+
+	// ELBV2CacheDuration caches the ELBV2 describe calls of reconciles for this long, 0 disables the cache.
+	ELBV2CacheDuration time.Duration
+
+	// ReadOnly blocks the calls modifying AWS resources, see BlockMutations. It's set by the controller's --observe-only flag.
+	ReadOnly bool
 }
 
 func (cfg *CloudConfig) BindFlags(fs *pflag.FlagSet) {
@@ -30,10 +64,28 @@ func (cfg *CloudConfig) BindFlags(fs *pflag.FlagSet) {
 		`AWS VPC ID for the kubernetes cluster`)
 	fs.StringVar(&cfg.Region, "aws-region", defaultRegion,
 		`AWS Region for the kubernetes cluster`)
+	fs.StringVar(&cfg.Partition, "aws-partition", "",
+		`AWS partition of the kubernetes cluster, e.g. aws-cn or aws-us-gov. Detected from the region if unspecified`)
 	fs.IntVar(&cfg.APIMaxRetries, "aws-max-retries", defaultAPIMaxRetries,
 		`Maximum number of times to retry the AWS API.`)
 	fs.BoolVar(&cfg.APIDebug, "aws-api-debug", defaultAPIDebug,
 		`Enable debug logging of AWS API`)
+	fs.StringVar(&cfg.HTTPProxy, "aws-http-proxy", "",
+		`Proxy URL for AWS API calls. Defaults to the HTTPS_PROXY and HTTP_PROXY environment variables`)
+	fs.StringVar(&cfg.NoProxy, "aws-no-proxy", "",
+		`Comma separated hosts, domains and CIDRs to exclude from --aws-http-proxy. Defaults to the NO_PROXY environment variable`)
+	fs.StringVar(&cfg.CABundle, "aws-ca-bundle", "",
+		`Path of a PEM encoded CA bundle to trust for AWS API calls, in addition to the system roots`)
+	fs.StringVar(&cfg.APIFaults, "aws-api-faults", "",
+		`Inject faults into AWS API calls for resilience testing, as semicolon separated <operation>=<fault>[@<probability>] rules. `+
+			`fault is one of latency:<duration>, throttle or error:<code>[:<statusCode>]. Never use in production.`)
+	fs.StringToStringVar(&cfg.APIRateLimits, "aws-api-rate-limits", nil,
+		`Client-side rate limits of AWS API calls, as comma separated <service>=<qps>[:<burst>] entries, e.g. elasticloadbalancing=10:20,ec2=20. `+
+			`The burst defaults to the qps, calls to other services aren't limited`)
+	fs.DurationVar(&cfg.ELBV2CacheDuration, "aws-elbv2-cache-duration", 0,
+		`Duration to cache the load balancers, target groups, listeners and tags described on ELBV2 for. The cache is flushed whenever the controller modifies ELBV2 resources, 0 disables the cache`)
+	fs.StringVar(&cfg.Endpoint, "aws-endpoint", "",
+		`URL of an endpoint serving all AWS APIs instead of the AWS endpoints of the region, e.g. a LocalStack endpoint for testing`)
 }
 
 func (cfg *CloudConfig) BindEnv() error {
@@ -55,5 +107,60 @@ func (cfg *CloudConfig) BindEnv() error {
 		}
 		cfg.APIMaxRetries = int(v)
 	}
+
+	if s, ok := os.LookupEnv("AWS_API_FAULTS"); ok {
+		cfg.APIFaults = s
+	}
 	return nil
 }
+
+// Validate checks the configuration without calling AWS, all the errors found are returned together.
+// Regions unknown to the SDK are accepted as long as they're well-formed, so new regions can be used.
+func (cfg *CloudConfig) Validate() error {
+	var errs []error
+	if len(cfg.VpcID) != 0 && !vpcIDPattern.MatchString(cfg.VpcID) {
+		errs = append(errs, fmt.Errorf("--aws-vpc-id %v is not a VPC ID, e.g. vpc-0123456789abcdef0", cfg.VpcID))
+	}
+	if len(cfg.Region) != 0 && !regionPattern.MatchString(cfg.Region) {
+		errs = append(errs, fmt.Errorf("--aws-region %v is not a region, e.g. us-west-2", cfg.Region))
+	}
+	if len(cfg.Partition) != 0 && !partitionPattern.MatchString(cfg.Partition) {
+		errs = append(errs, fmt.Errorf("--aws-partition %v is not a partition, e.g. aws or aws-cn", cfg.Partition))
+	}
+	if cfg.ELBV2CacheDuration < 0 {
+		errs = append(errs, fmt.Errorf("--aws-elbv2-cache-duration must not be negative, got %v", cfg.ELBV2CacheDuration))
+	}
+	if cfg.APIMaxRetries < 0 {
+		errs = append(errs, fmt.Errorf("--aws-max-retries must not be negative, got %d", cfg.APIMaxRetries))
+	}
+	if len(cfg.HTTPProxy) != 0 {
+		if u, err := url.Parse(cfg.HTTPProxy); err != nil {
+			errs = append(errs, fmt.Errorf("invalid --aws-http-proxy %v due to %v", cfg.HTTPProxy, err))
+		} else if len(u.Scheme) == 0 || len(u.Host) == 0 {
+			errs = append(errs, fmt.Errorf("--aws-http-proxy %v must be an absolute URL, e.g. http://proxy:3128", cfg.HTTPProxy))
+		}
+	} else if len(cfg.NoProxy) != 0 {
+		errs = append(errs, fmt.Errorf("--aws-no-proxy is only respected along with --aws-http-proxy, use the NO_PROXY environment variable instead"))
+	}
+	if len(cfg.Endpoint) != 0 {
+		if u, err := url.Parse(cfg.Endpoint); err != nil || len(u.Scheme) == 0 || len(u.Host) == 0 {
+			errs = append(errs, fmt.Errorf("--aws-endpoint %v must be an absolute URL, e.g. http://localhost:4566", cfg.Endpoint))
+		}
+	}
+	if len(cfg.CABundle) != 0 {
+		if _, err := loadCABundle(cfg.CABundle); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(cfg.APIFaults) != 0 {
+		if _, err := ParseFaultRules(cfg.APIFaults); err != nil {
+			errs = append(errs, fmt.Errorf("invalid --aws-api-faults due to %v", err))
+		}
+	}
+	if len(cfg.APIRateLimits) != 0 {
+		if _, err := ParseRateLimits(cfg.APIRateLimits); err != nil {
+			errs = append(errs, fmt.Errorf("invalid --aws-api-rate-limits due to %v", err))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
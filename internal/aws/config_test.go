@@ -0,0 +1,80 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloudConfig_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		Name          string
+		Cfg           CloudConfig
+		ExpectedError string
+	}{
+		{
+			Name: "introspected from ec2Metadata",
+			Cfg:  CloudConfig{APIMaxRetries: 10},
+		},
+		{
+			Name: "valid configuration",
+			Cfg: CloudConfig{
+				VpcID:     "vpc-0123456789abcdef0",
+				Region:    "us-gov-west-1",
+				Partition: "aws-us-gov",
+				HTTPProxy: "http://proxy:3128",
+				NoProxy:   "internal.example.com",
+				APIFaults: "DescribeTags=throttle@0.5",
+				Endpoint:  "http://localhost:4566",
+			},
+		},
+		{
+			Name:          "availability zone as region",
+			Cfg:           CloudConfig{Region: "us-west-2a"},
+			ExpectedError: "--aws-region us-west-2a is not a region, e.g. us-west-2",
+		},
+		{
+			Name:          "no proxy without proxy",
+			Cfg:           CloudConfig{NoProxy: "internal.example.com"},
+			ExpectedError: "--aws-no-proxy is only respected along with --aws-http-proxy, use the NO_PROXY environment variable instead",
+		},
+		{
+			Name:          "relative proxy URL",
+			Cfg:           CloudConfig{HTTPProxy: "proxy:3128"},
+			ExpectedError: "--aws-http-proxy proxy:3128 must be an absolute URL, e.g. http://proxy:3128",
+		},
+		{
+			Name:          "endpoint without scheme",
+			Cfg:           CloudConfig{Endpoint: "localhost:4566"},
+			ExpectedError: "--aws-endpoint localhost:4566 must be an absolute URL, e.g. http://localhost:4566",
+		},
+		{
+			Name:          "negative elbv2 cache duration",
+			Cfg:           CloudConfig{ELBV2CacheDuration: -time.Minute},
+			ExpectedError: "--aws-elbv2-cache-duration must not be negative, got -1m0s",
+		},
+		{
+			Name:          "invalid rate limit",
+			Cfg:           CloudConfig{APIRateLimits: map[string]string{"elasticloadbalancing": "fast"}},
+			ExpectedError: "invalid --aws-api-rate-limits due to invalid rate limit elasticloadbalancing=fast: qps must be a positive number",
+		},
+		{
+			Name: "multiple errors",
+			Cfg:  CloudConfig{VpcID: "subnet-1", Partition: "china", APIMaxRetries: -1, APIFaults: "DescribeTags"},
+			ExpectedError: "[--aws-vpc-id subnet-1 is not a VPC ID, e.g. vpc-0123456789abcdef0, " +
+				"--aws-partition china is not a partition, e.g. aws or aws-cn, " +
+				"--aws-max-retries must not be negative, got -1, " +
+				`invalid --aws-api-faults due to invalid fault rule "DescribeTags": expected <operation>=<fault>]`,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := tc.Cfg.Validate()
+			if tc.ExpectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.ExpectedError)
+			}
+		})
+	}
+}
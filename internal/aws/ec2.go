@@ -3,6 +3,7 @@ package aws
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -41,8 +42,8 @@ type EC2API interface {
 	// GetSecurityGroupsByName retrieves securityGroups by securityGroupName(SecurityGroup names within vpc are unique)
 	GetSecurityGroupsByName(context.Context, []string) ([]*ec2.SecurityGroup, error)
 
-	// GetClusterSubnets retrieves the subnets associated with the cluster, by matching tags
-	GetClusterSubnets(string) ([]*ec2.Subnet, error)
+	// GetClusterSubnets retrieves the subnets associated with the cluster, which carry all tags with one of their values, or any value if none
+	GetClusterSubnets(tags map[string][]string) ([]*ec2.Subnet, error)
 
 	// DeleteSecurityGroupByID delete securityGroup by securityGroupID
 	DeleteSecurityGroupByID(context.Context, string) error
@@ -164,17 +165,31 @@ func (c *Cloud) GetSubnetsByNameOrID(ctx context.Context, nameOrIDs []string) (s
 	return
 }
 
-func (c *Cloud) GetClusterSubnets(tagSubnetType string) ([]*ec2.Subnet, error) {
+func (c *Cloud) GetClusterSubnets(tags map[string][]string) ([]*ec2.Subnet, error) {
 	in := &ec2.DescribeSubnetsInput{Filters: []*ec2.Filter{
 		{
 			Name:   aws.String("tag:kubernetes.io/cluster/" + c.clusterName),
 			Values: aws.StringSlice([]string{"owned", "shared"}),
 		},
-		{
-			Name:   aws.String("tag:" + tagSubnetType),
-			Values: aws.StringSlice([]string{"", "1"}),
-		},
 	}}
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if len(tags[key]) == 0 {
+			in.Filters = append(in.Filters, &ec2.Filter{
+				Name:   aws.String("tag-key"),
+				Values: aws.StringSlice([]string{key}),
+			})
+			continue
+		}
+		in.Filters = append(in.Filters, &ec2.Filter{
+			Name:   aws.String("tag:" + key),
+			Values: aws.StringSlice(tags[key]),
+		})
+	}
 
 	result, err := c.describeSubnetsHelper(in)
 	if err != nil {
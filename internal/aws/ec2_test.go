@@ -196,21 +196,30 @@ func TestCloud_GetClusterSubnets(t *testing.T) {
 		Name                  string
 		DescribeSubnetsOutput *ec2.DescribeSubnetsOutput
 		DescribeSubnetsError  error
-		TagSubnetType         string
+		Tags                  map[string][]string
+		ExpectedTagFilters    []*ec2.Filter
 		ExpectedResult        []*ec2.Subnet
 		ExpectedError         error
 	}{
 		{
-			Name:          "No subnets returned",
-			TagSubnetType: TagNameSubnetInternalELB,
+			Name: "No subnets returned",
+			Tags: map[string][]string{TagNameSubnetInternalELB: {"", "1"}},
+			ExpectedTagFilters: []*ec2.Filter{{
+				Name:   aws.String("tag:" + TagNameSubnetInternalELB),
+				Values: aws.StringSlice([]string{"", "1"}),
+			}},
 			DescribeSubnetsOutput: &ec2.DescribeSubnetsOutput{
 				NextToken: nil,
 				Subnets:   []*ec2.Subnet{},
 			},
 		},
 		{
-			Name:          "Two internal subnets returned",
-			TagSubnetType: TagNameSubnetInternalELB,
+			Name: "Two internal subnets returned",
+			Tags: map[string][]string{TagNameSubnetInternalELB: {"", "1"}},
+			ExpectedTagFilters: []*ec2.Filter{{
+				Name:   aws.String("tag:" + TagNameSubnetInternalELB),
+				Values: aws.StringSlice([]string{"", "1"}),
+			}},
 			DescribeSubnetsOutput: &ec2.DescribeSubnetsOutput{
 				NextToken: nil,
 				Subnets:   []*ec2.Subnet{internalSubnet1, internalSubnet2},
@@ -218,8 +227,12 @@ func TestCloud_GetClusterSubnets(t *testing.T) {
 			ExpectedResult: []*ec2.Subnet{internalSubnet1, internalSubnet2},
 		},
 		{
-			Name:          "One public subnet returned",
-			TagSubnetType: TagNameSubnetPublicELB,
+			Name: "One public subnet returned",
+			Tags: map[string][]string{TagNameSubnetPublicELB: {"", "1"}},
+			ExpectedTagFilters: []*ec2.Filter{{
+				Name:   aws.String("tag:" + TagNameSubnetPublicELB),
+				Values: aws.StringSlice([]string{"", "1"}),
+			}},
 			DescribeSubnetsOutput: &ec2.DescribeSubnetsOutput{
 				NextToken: nil,
 				Subnets:   []*ec2.Subnet{publicSubnet},
@@ -227,8 +240,31 @@ func TestCloud_GetClusterSubnets(t *testing.T) {
 			ExpectedResult: []*ec2.Subnet{publicSubnet},
 		},
 		{
-			Name:          "Error from API call",
-			TagSubnetType: TagNameSubnetPublicELB,
+			Name: "Configured tags",
+			Tags: map[string][]string{"network": {"public"}, "alb": nil},
+			ExpectedTagFilters: []*ec2.Filter{
+				{
+					Name:   aws.String("tag-key"),
+					Values: aws.StringSlice([]string{"alb"}),
+				},
+				{
+					Name:   aws.String("tag:network"),
+					Values: aws.StringSlice([]string{"public"}),
+				},
+			},
+			DescribeSubnetsOutput: &ec2.DescribeSubnetsOutput{
+				NextToken: nil,
+				Subnets:   []*ec2.Subnet{publicSubnet},
+			},
+			ExpectedResult: []*ec2.Subnet{publicSubnet},
+		},
+		{
+			Name: "Error from API call",
+			Tags: map[string][]string{TagNameSubnetPublicELB: {"", "1"}},
+			ExpectedTagFilters: []*ec2.Filter{{
+				Name:   aws.String("tag:" + TagNameSubnetPublicELB),
+				Values: aws.StringSlice([]string{"", "1"}),
+			}},
 			DescribeSubnetsOutput: &ec2.DescribeSubnetsOutput{
 				NextToken: nil,
 				Subnets:   []*ec2.Subnet{},
@@ -241,15 +277,11 @@ func TestCloud_GetClusterSubnets(t *testing.T) {
 			svc := &mocks.EC2API{}
 
 			svc.On("DescribeSubnetsPages",
-				&ec2.DescribeSubnetsInput{Filters: []*ec2.Filter{
+				&ec2.DescribeSubnetsInput{Filters: append([]*ec2.Filter{
 					{
 						Name:   aws.String("tag:kubernetes.io/cluster/" + clusterName),
 						Values: aws.StringSlice([]string{"owned", "shared"}),
-					},
-					{
-						Name:   aws.String("tag:" + tc.TagSubnetType),
-						Values: aws.StringSlice([]string{"", "1"}),
-					}},
+					}}, tc.ExpectedTagFilters...),
 				},
 				mock.AnythingOfType("func(*ec2.DescribeSubnetsOutput, bool) bool"),
 			).Return(tc.DescribeSubnetsError).Run(func(args mock.Arguments) {
@@ -261,7 +293,7 @@ func TestCloud_GetClusterSubnets(t *testing.T) {
 				clusterName: clusterName,
 				ec2:         svc,
 			}
-			subnets, err := cloud.GetClusterSubnets(tc.TagSubnetType)
+			subnets, err := cloud.GetClusterSubnets(tc.Tags)
 			assert.Equal(t, tc.ExpectedResult, subnets)
 			assert.Equal(t, tc.ExpectedError, err)
 			svc.AssertExpectations(t)
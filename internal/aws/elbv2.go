@@ -3,6 +3,7 @@ package aws
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -43,6 +44,7 @@ type ELBV2API interface {
 	ModifyTargetGroupAttributesWithContext(context.Context, *elbv2.ModifyTargetGroupAttributesInput) (*elbv2.ModifyTargetGroupAttributesOutput, error)
 	CreateTargetGroupWithContext(context.Context, *elbv2.CreateTargetGroupInput) (*elbv2.CreateTargetGroupOutput, error)
 	ModifyTargetGroupWithContext(context.Context, *elbv2.ModifyTargetGroupInput) (*elbv2.ModifyTargetGroupOutput, error)
+
 	RegisterTargetsWithContext(context.Context, *elbv2.RegisterTargetsInput) (*elbv2.RegisterTargetsOutput, error)
 	DeregisterTargetsWithContext(context.Context, *elbv2.DeregisterTargetsInput) (*elbv2.DeregisterTargetsOutput, error)
 	DescribeTargetHealthWithContext(context.Context, *elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error)
@@ -53,6 +55,7 @@ type ELBV2API interface {
 	CreateListenerWithContext(context.Context, *elbv2.CreateListenerInput) (*elbv2.CreateListenerOutput, error)
 	ModifyListenerWithContext(context.Context, *elbv2.ModifyListenerInput) (*elbv2.ModifyListenerOutput, error)
 	DescribeLoadBalancerAttributesWithContext(context.Context, *elbv2.DescribeLoadBalancerAttributesInput) (*elbv2.DescribeLoadBalancerAttributesOutput, error)
+	DescribeSSLPoliciesWithContext(context.Context, *elbv2.DescribeSSLPoliciesInput) (*elbv2.DescribeSSLPoliciesOutput, error)
 	ModifyLoadBalancerAttributesWithContext(context.Context, *elbv2.ModifyLoadBalancerAttributesInput) (*elbv2.ModifyLoadBalancerAttributesOutput, error)
 	CreateLoadBalancerWithContext(context.Context, *elbv2.CreateLoadBalancerInput) (*elbv2.CreateLoadBalancerOutput, error)
 	SetIpAddressTypeWithContext(context.Context, *elbv2.SetIpAddressTypeInput) (*elbv2.SetIpAddressTypeOutput, error)
@@ -65,6 +68,12 @@ type ELBV2API interface {
 	DescribeListenerCertificates(context.Context, string) ([]*elbv2.Certificate, error)
 	AddListenerCertificates(context.Context, *elbv2.AddListenerCertificatesInput) (*elbv2.AddListenerCertificatesOutput, error)
 	RemoveListenerCertificates(context.Context, *elbv2.RemoveListenerCertificatesInput) (*elbv2.RemoveListenerCertificatesOutput, error)
+
+	// GetAccountLimits returns the ELBV2 limits of the account by name, e.g. application-load-balancers.
+	GetAccountLimits(context.Context) (map[string]int64, error)
+
+	// GetAccountUsage returns the number of ELBV2 resources in the region, keyed by the name of the limit on them.
+	GetAccountUsage(context.Context) (map[string]int64, error)
 }
 
 func (c *Cloud) DescribeTargetGroupAttributesWithContext(ctx context.Context, i *elbv2.DescribeTargetGroupAttributesInput) (*elbv2.DescribeTargetGroupAttributesOutput, error) {
@@ -112,6 +121,10 @@ func (c *Cloud) CreateListenerWithContext(ctx context.Context, i *elbv2.CreateLi
 func (c *Cloud) ModifyListenerWithContext(ctx context.Context, i *elbv2.ModifyListenerInput) (*elbv2.ModifyListenerOutput, error) {
 	return c.elbv2.ModifyListenerWithContext(ctx, i)
 }
+func (c *Cloud) DescribeSSLPoliciesWithContext(ctx context.Context, i *elbv2.DescribeSSLPoliciesInput) (*elbv2.DescribeSSLPoliciesOutput, error) {
+	return c.elbv2.DescribeSSLPoliciesWithContext(ctx, i)
+}
+
 func (c *Cloud) DescribeLoadBalancerAttributesWithContext(ctx context.Context, i *elbv2.DescribeLoadBalancerAttributesInput) (*elbv2.DescribeLoadBalancerAttributesOutput, error) {
 	return c.elbv2.DescribeLoadBalancerAttributesWithContext(ctx, i)
 }
@@ -184,6 +197,56 @@ func (c *Cloud) GetRules(ctx context.Context, listenerArn string) ([]*elbv2.Rule
 	return rules, p.Err()
 }
 
+func (c *Cloud) GetAccountLimits(ctx context.Context) (map[string]int64, error) {
+	limits := make(map[string]int64)
+	input := &elbv2.DescribeAccountLimitsInput{}
+	for {
+		output, err := c.elbv2.DescribeAccountLimitsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, limit := range output.Limits {
+			max, err := strconv.ParseInt(aws.StringValue(limit.Max), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse limit %v: %v", aws.StringValue(limit.Name), aws.StringValue(limit.Max))
+			}
+			limits[aws.StringValue(limit.Name)] = max
+		}
+		if output.NextMarker == nil {
+			return limits, nil
+		}
+		input.Marker = output.NextMarker
+	}
+}
+
+func (c *Cloud) GetAccountUsage(ctx context.Context) (map[string]int64, error) {
+	usage := map[string]int64{
+		"application-load-balancers": 0,
+		"network-load-balancers":     0,
+		"target-groups":              0,
+	}
+	if err := c.elbv2.DescribeLoadBalancersPagesWithContext(ctx, &elbv2.DescribeLoadBalancersInput{}, func(output *elbv2.DescribeLoadBalancersOutput, _ bool) bool {
+		for _, lb := range output.LoadBalancers {
+			switch aws.StringValue(lb.Type) {
+			case elbv2.LoadBalancerTypeEnumApplication:
+				usage["application-load-balancers"]++
+			case elbv2.LoadBalancerTypeEnumNetwork:
+				usage["network-load-balancers"]++
+			}
+		}
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	if err := c.elbv2.DescribeTargetGroupsPagesWithContext(ctx, &elbv2.DescribeTargetGroupsInput{}, func(output *elbv2.DescribeTargetGroupsOutput, _ bool) bool {
+		usage["target-groups"] += int64(len(output.TargetGroups))
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
 // StatusELBV2 validates ELBV2 connectivity
 func (c *Cloud) StatusELBV2() func() error {
 	return func() error {
@@ -0,0 +1,25 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/ticketmaster/aws-sdk-go-cache/cache"
+)
+
+// elbv2CachedOperations are the ELBV2 describe calls made for every ingress on each reconcile.
+var elbv2CachedOperations = []string{"DescribeLoadBalancers", "DescribeTargetGroups", "DescribeListeners", "DescribeTags"}
+
+// NewELBV2CachingSession returns a copy of sess caching the responses of elbv2CachedOperations for ttl.
+// The other ELBV2 responses expire right away. Any ELBV2 call modifying resources flushes the cache,
+// so the clients of all ELBV2 operations must share the returned session for the cache to stay consistent.
+func NewELBV2CachingSession(sess *session.Session, ttl time.Duration) *session.Session {
+	cc := cache.NewConfig(0)
+	for _, operation := range elbv2CachedOperations {
+		cc.SetCacheTTL(elbv2.ServiceName, operation, ttl)
+	}
+	cachingSession := sess.Copy()
+	cache.AddCaching(cachingSession, cc)
+	return cachingSession
+}
@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewELBV2CachingSession(t *testing.T) {
+	var actions []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		action := r.Form.Get("Action")
+		actions = append(actions, action)
+		w.Write([]byte("<" + action + "Response><" + action + "Result/></" + action + "Response>"))
+	}))
+	defer server.Close()
+
+	sess := session.Must(session.NewSession(aws.NewConfig().
+		WithRegion("us-west-2").
+		WithEndpoint(server.URL).
+		WithCredentials(credentials.NewStaticCredentials("id", "secret", "")).
+		WithMaxRetries(0)))
+	cachingSession := NewELBV2CachingSession(sess, time.Minute)
+	client := elbv2.New(cachingSession)
+	ctx := context.Background()
+
+	describe := func(name string) {
+		_, err := client.DescribeTargetGroupsWithContext(ctx, &elbv2.DescribeTargetGroupsInput{Names: aws.StringSlice([]string{name})})
+		assert.NoError(t, err)
+	}
+	describe("tg")
+	describe("tg")
+	describe("other")
+	for i := 0; i < 2; i++ {
+		_, err := client.DescribeTargetHealthWithContext(ctx, &elbv2.DescribeTargetHealthInput{TargetGroupArn: aws.String("tgArn")})
+		assert.NoError(t, err)
+	}
+	_, err := client.CreateTargetGroupWithContext(ctx, &elbv2.CreateTargetGroupInput{Name: aws.String("tg")})
+	assert.NoError(t, err)
+	describe("tg")
+
+	assert.Equal(t, []string{
+		"DescribeTargetGroups",
+		"DescribeTargetGroups",
+		"DescribeTargetHealth",
+		"DescribeTargetHealth",
+		"CreateTargetGroup",
+		"DescribeTargetGroups",
+	}, actions)
+
+	_, err = elbv2.New(sess).DescribeTargetGroupsWithContext(ctx, &elbv2.DescribeTargetGroupsInput{Names: aws.StringSlice([]string{"other"})})
+	assert.NoError(t, err)
+	assert.Len(t, actions, 7, "the original session shouldn't cache")
+}
@@ -0,0 +1,44 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// APIError is a failed AWS API call, identified by its service, operation and request ID.
+// It embeds the original awserr.RequestFailure, so that error codes can still be inspected.
+type APIError struct {
+	awserr.RequestFailure
+
+	Service   string
+	Operation string
+}
+
+var _ awserr.RequestFailure = (*APIError)(nil)
+
+// Error returns a single line message, so that it remains readable when included in Kubernetes events.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s.%s failed: %s: %s (status code: %d, request id: %s)",
+		e.Service, e.Operation, e.Code(), e.Message(), e.StatusCode(), e.RequestID())
+}
+
+// annotateAPIError replaces a final request failure with an APIError.
+// It's an AfterRetry handler, as it's the last place where the error returned by request.Send can be amended.
+func annotateAPIError(r *request.Request) {
+	// r.Error is reset by the core AfterRetry handler when the request will be retried.
+	if r.Error == nil {
+		return
+	}
+	if _, ok := r.Error.(*APIError); ok {
+		return
+	}
+	if reqErr, ok := r.Error.(awserr.RequestFailure); ok {
+		r.Error = &APIError{
+			RequestFailure: reqErr,
+			Service:        r.ClientInfo.ServiceName,
+			Operation:      r.Operation.Name,
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package aws
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_annotateAPIError(t *testing.T) {
+	reqFailure := awserr.NewRequestFailure(awserr.New("TooManyTargetGroups", "too many target groups", nil), 400, "req-1")
+	for _, tc := range []struct {
+		Name          string
+		Err           error
+		ExpectedError error
+	}{
+		{
+			Name:          "request succeeded or will be retried",
+			Err:           nil,
+			ExpectedError: nil,
+		},
+		{
+			Name: "request failure",
+			Err:  reqFailure,
+			ExpectedError: &APIError{
+				RequestFailure: reqFailure,
+				Service:        "elasticloadbalancing",
+				Operation:      "CreateTargetGroup",
+			},
+		},
+		{
+			Name:          "non-request failure",
+			Err:           errors.New("connection reset"),
+			ExpectedError: errors.New("connection reset"),
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			r := &request.Request{
+				ClientInfo: metadata.ClientInfo{ServiceName: "elasticloadbalancing"},
+				Operation:  &request.Operation{Name: "CreateTargetGroup"},
+				Error:      tc.Err,
+			}
+			annotateAPIError(r)
+			assert.Equal(t, tc.ExpectedError, r.Error)
+		})
+	}
+}
+
+func TestAPIError_Error(t *testing.T) {
+	err := &APIError{
+		RequestFailure: awserr.NewRequestFailure(awserr.New("TooManyTargetGroups", "too many target groups", nil), 400, "req-1"),
+		Service:        "elasticloadbalancing",
+		Operation:      "CreateTargetGroup",
+	}
+	assert.Equal(t, "elasticloadbalancing.CreateTargetGroup failed: TooManyTargetGroups: too many target groups (status code: 400, request id: req-1)", err.Error())
+	assert.Equal(t, "TooManyTargetGroups", err.Code())
+}
@@ -0,0 +1,176 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/corehandlers"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+const (
+	faultKindLatency  = "latency"
+	faultKindThrottle = "throttle"
+	faultKindError    = "error"
+
+	faultThrottleCode      = "Throttling"
+	faultDefaultStatusCode = http.StatusBadRequest
+	faultRequestID         = "injected-fault"
+)
+
+// FaultRule injects a fault into AWS API calls whose operation matches Operation.
+type FaultRule struct {
+	// Operation is a path.Match pattern of either the operation name (e.g. Describe*) or service.operation (e.g. ec2.Describe*).
+	Operation string
+	// Kind is one of latency, throttle or error.
+	Kind string
+	// Latency is the delay added before the call is sent, for latency faults.
+	Latency time.Duration
+	// Code and StatusCode are the error returned instead of sending the call, for error faults.
+	Code       string
+	StatusCode int
+	// Probability is the chance between 0 and 1 that the fault is injected into a matching call.
+	Probability float64
+}
+
+// ParseFaultRules parses fault rules of the form <operation>=<fault>[@<probability>], separated by semicolons.
+// The fault is one of latency:<duration>, throttle or error:<code>[:<statusCode>].
+func ParseFaultRules(s string) ([]FaultRule, error) {
+	var rules []FaultRule
+	for _, entry := range strings.Split(s, ";") {
+		entry = strings.TrimSpace(entry)
+		if len(entry) == 0 {
+			continue
+		}
+		rule, err := parseFaultRule(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fault rule %q: %v", entry, err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func parseFaultRule(entry string) (FaultRule, error) {
+	parts := strings.SplitN(entry, "=", 2)
+	if len(parts) != 2 || len(parts[0]) == 0 {
+		return FaultRule{}, fmt.Errorf("expected <operation>=<fault>")
+	}
+	rule := FaultRule{Operation: parts[0], Probability: 1}
+	if _, err := path.Match(rule.Operation, ""); err != nil {
+		return FaultRule{}, err
+	}
+
+	fault := parts[1]
+	if i := strings.LastIndex(fault, "@"); i >= 0 {
+		probability, err := strconv.ParseFloat(fault[i+1:], 64)
+		if err != nil || probability < 0 || probability > 1 {
+			return FaultRule{}, fmt.Errorf("probability must be between 0 and 1")
+		}
+		rule.Probability = probability
+		fault = fault[:i]
+	}
+
+	args := strings.Split(fault, ":")
+	rule.Kind = args[0]
+	switch rule.Kind {
+	case faultKindLatency:
+		if len(args) != 2 {
+			return FaultRule{}, fmt.Errorf("expected latency:<duration>")
+		}
+		latency, err := time.ParseDuration(args[1])
+		if err != nil {
+			return FaultRule{}, err
+		}
+		rule.Latency = latency
+	case faultKindThrottle:
+		if len(args) != 1 {
+			return FaultRule{}, fmt.Errorf("expected throttle")
+		}
+		rule.Code = faultThrottleCode
+		rule.StatusCode = faultDefaultStatusCode
+	case faultKindError:
+		if len(args) < 2 || len(args) > 3 || len(args[1]) == 0 {
+			return FaultRule{}, fmt.Errorf("expected error:<code>[:<statusCode>]")
+		}
+		rule.Code = args[1]
+		rule.StatusCode = faultDefaultStatusCode
+		if len(args) == 3 {
+			statusCode, err := strconv.Atoi(args[2])
+			if err != nil {
+				return FaultRule{}, fmt.Errorf("invalid statusCode %v", args[2])
+			}
+			rule.StatusCode = statusCode
+		}
+	default:
+		return FaultRule{}, fmt.Errorf("unknown fault %v", rule.Kind)
+	}
+	return rule, nil
+}
+
+func (rule *FaultRule) matches(service string, operation string) bool {
+	if ok, _ := path.Match(rule.Operation, operation); ok {
+		return true
+	}
+	ok, _ := path.Match(rule.Operation, service+"."+operation)
+	return ok
+}
+
+// faultInjector replaces the core send handler, so that injected errors go through the SDK's regular retry logic
+// without the call reaching AWS.
+type faultInjector struct {
+	rules []FaultRule
+	// roll returns a random number in [0, 1), it's replaceable for tests.
+	roll func() float64
+	// next sends calls which weren't failed by a fault.
+	next func(*request.Request)
+}
+
+// InjectFaults makes calls of sess subject to the fault rules.
+// It's meant for exercising the controller's resilience in e2e tests, never for production use.
+func InjectFaults(sess *session.Session, rules []FaultRule) {
+	injector := &faultInjector{rules: rules, roll: rand.Float64, next: corehandlers.SendHandler.Fn}
+	sess.Handlers.Send.Swap(corehandlers.SendHandler.Name, request.NamedHandler{
+		Name: corehandlers.SendHandler.Name,
+		Fn:   injector.send,
+	})
+}
+
+func (f *faultInjector) send(r *request.Request) {
+	for i := range f.rules {
+		rule := &f.rules[i]
+		if !rule.matches(r.ClientInfo.ServiceName, r.Operation.Name) || f.roll() >= rule.Probability {
+			continue
+		}
+		if rule.Kind == faultKindLatency {
+			select {
+			case <-time.After(rule.Latency):
+			case <-r.Context().Done():
+				r.Error = awserr.New(request.CanceledErrorCode, "request context canceled", r.Context().Err())
+				return
+			}
+			continue
+		}
+
+		r.HTTPResponse = &http.Response{
+			StatusCode: rule.StatusCode,
+			Status:     http.StatusText(rule.StatusCode),
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		}
+		r.Error = awserr.NewRequestFailure(
+			awserr.New(rule.Code, fmt.Sprintf("fault injected by rule %v", rule.Operation), nil),
+			rule.StatusCode, faultRequestID)
+		return
+	}
+	f.next(r)
+}
@@ -0,0 +1,143 @@
+package aws
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFaultRules(t *testing.T) {
+	for _, tc := range []struct {
+		Name          string
+		Input         string
+		ExpectedRules []FaultRule
+		ExpectedErr   error
+	}{
+		{
+			Name:  "empty",
+			Input: "",
+		},
+		{
+			Name:  "all kinds of faults",
+			Input: "elasticloadbalancing.Describe*=latency:500ms; CreateTargetGroup=throttle@0.5;AuthorizeSecurityGroupIngress=error:InvalidPermission.Duplicate;*=error:InternalFailure:500@0.1",
+			ExpectedRules: []FaultRule{
+				{Operation: "elasticloadbalancing.Describe*", Kind: "latency", Latency: 500 * time.Millisecond, Probability: 1},
+				{Operation: "CreateTargetGroup", Kind: "throttle", Code: "Throttling", StatusCode: 400, Probability: 0.5},
+				{Operation: "AuthorizeSecurityGroupIngress", Kind: "error", Code: "InvalidPermission.Duplicate", StatusCode: 400, Probability: 1},
+				{Operation: "*", Kind: "error", Code: "InternalFailure", StatusCode: 500, Probability: 0.1},
+			},
+		},
+		{
+			Name:        "missing fault",
+			Input:       "CreateTargetGroup",
+			ExpectedErr: errors.New(`invalid fault rule "CreateTargetGroup": expected <operation>=<fault>`),
+		},
+		{
+			Name:        "unknown fault",
+			Input:       "CreateTargetGroup=crash",
+			ExpectedErr: errors.New(`invalid fault rule "CreateTargetGroup=crash": unknown fault crash`),
+		},
+		{
+			Name:        "invalid probability",
+			Input:       "CreateTargetGroup=throttle@2",
+			ExpectedErr: errors.New(`invalid fault rule "CreateTargetGroup=throttle@2": probability must be between 0 and 1`),
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			rules, err := ParseFaultRules(tc.Input)
+			assert.Equal(t, tc.ExpectedRules, rules)
+			assert.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestInjectFaults(t *testing.T) {
+	rules, err := ParseFaultRules("CreateTargetGroup=throttle;elasticloadbalancing.DeleteTargetGroup=error:ResourceInUse")
+	assert.NoError(t, err)
+
+	sess := session.Must(session.NewSession(aws.NewConfig().
+		WithRegion("us-west-2").
+		WithCredentials(credentials.NewStaticCredentials("id", "secret", "")).
+		WithMaxRetries(2).
+		WithSleepDelay(func(time.Duration) {})))
+	sess.Handlers.AfterRetry.PushBack(annotateAPIError)
+	InjectFaults(sess, rules)
+	client := elbv2.New(sess)
+
+	req, _ := client.CreateTargetGroupRequest(&elbv2.CreateTargetGroupInput{Name: aws.String("tg")})
+	err = req.Send()
+	assert.Equal(t, "Throttling", err.(awserr.Error).Code())
+	assert.Equal(t, "elasticloadbalancing.CreateTargetGroup failed: Throttling: fault injected by rule CreateTargetGroup (status code: 400, request id: injected-fault)", err.Error())
+	assert.Equal(t, 2, req.RetryCount, "throttled calls should be retried")
+
+	req, _ = client.DeleteTargetGroupRequest(&elbv2.DeleteTargetGroupInput{TargetGroupArn: aws.String("tgArn")})
+	err = req.Send()
+	assert.Equal(t, "ResourceInUse", err.(awserr.Error).Code())
+	assert.Equal(t, 0, req.RetryCount)
+}
+
+func Test_faultInjector_send(t *testing.T) {
+	rules := []FaultRule{
+		{Operation: "Describe*", Kind: "latency", Latency: time.Millisecond, Probability: 1},
+		{Operation: "ec2.*", Kind: "error", Code: "UnauthorizedOperation", StatusCode: 403, Probability: 0.5},
+	}
+	for _, tc := range []struct {
+		Name         string
+		Roll         float64
+		Service      string
+		Operation    string
+		ExpectedSent bool
+		ExpectedCode string
+	}{
+		{
+			Name:         "latency only",
+			Roll:         0.9,
+			Service:      "elasticloadbalancing",
+			Operation:    "DescribeTargetGroups",
+			ExpectedSent: true,
+		},
+		{
+			Name:         "error within probability",
+			Roll:         0.4,
+			Service:      "ec2",
+			Operation:    "DescribeInstances",
+			ExpectedCode: "UnauthorizedOperation",
+		},
+		{
+			Name:         "error beyond probability",
+			Roll:         0.6,
+			Service:      "ec2",
+			Operation:    "DescribeInstances",
+			ExpectedSent: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			sent := false
+			injector := &faultInjector{
+				rules: rules,
+				roll:  func() float64 { return tc.Roll },
+				next:  func(*request.Request) { sent = true },
+			}
+			r := newReq(nil, nil)
+			r.ClientInfo.ServiceName = tc.Service
+			r.Operation.Name = tc.Operation
+
+			injector.send(r)
+			assert.Equal(t, tc.ExpectedSent, sent)
+			if len(tc.ExpectedCode) != 0 {
+				assert.Equal(t, tc.ExpectedCode, r.Error.(awserr.Error).Code())
+				assert.Equal(t, 403, r.HTTPResponse.StatusCode)
+			} else {
+				assert.NoError(t, r.Error)
+			}
+		})
+	}
+}
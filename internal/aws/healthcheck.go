@@ -2,6 +2,8 @@ package aws
 
 import (
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/golang/glog"
 	"k8s.io/apiserver/pkg/server/healthz"
@@ -9,17 +11,25 @@ import (
 
 type HealthChecker struct {
 	healthCheckFuncs []func() error
+
+	// period is the duration a check result is reused for, so that frequent probes don't translate into AWS API calls.
+	period time.Duration
+
+	mutex       sync.Mutex
+	lastChecked time.Time
+	lastErr     error
 }
 
-// Constructs a new healthChecker
-func NewHealthChecker(cloud CloudAPI) *HealthChecker {
-	healthCheckFuncs := []func() error{cloud.StatusEC2(), cloud.StatusIAM()}
+// Constructs a new healthChecker, which calls AWS APIs at most once per period.
+func NewHealthChecker(cloud CloudAPI, period time.Duration) *HealthChecker {
+	healthCheckFuncs := []func() error{cloud.StatusEC2(), cloud.StatusELBV2(), cloud.StatusIAM()}
 	if cloud.ACMAvailable() {
 		healthCheckFuncs = append(healthCheckFuncs, cloud.StatusACM())
 	}
 
 	return &HealthChecker{
 		healthCheckFuncs: healthCheckFuncs,
+		period:           period,
 	}
 }
 
@@ -30,8 +40,19 @@ func (c *HealthChecker) Name() string {
 	return "aws-alb-ingress-controller"
 }
 
-// TODO, validate the call health check frequency
 func (c *HealthChecker) Check(_ *http.Request) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if !c.lastChecked.IsZero() && time.Since(c.lastChecked) < c.period {
+		return c.lastErr
+	}
+	c.lastErr = c.check()
+	c.lastChecked = time.Now()
+	return c.lastErr
+}
+
+func (c *HealthChecker) check() error {
 	for _, fn := range c.healthCheckFuncs {
 		err := fn()
 		if err != nil {
@@ -0,0 +1,29 @@
+package aws
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthChecker_Check(t *testing.T) {
+	calls := 0
+	checkErr := errors.New("[ec2.DescribeTagsWithContext]: AccessDenied")
+	checker := &HealthChecker{
+		healthCheckFuncs: []func() error{func() error {
+			calls++
+			return checkErr
+		}},
+		period: time.Hour,
+	}
+
+	assert.Equal(t, checkErr, checker.Check(nil))
+	assert.Equal(t, checkErr, checker.Check(nil))
+	assert.Equal(t, 1, calls)
+
+	checker.lastChecked = time.Now().Add(-2 * time.Hour)
+	assert.Equal(t, checkErr, checker.Check(nil))
+	assert.Equal(t, 2, calls)
+}
@@ -0,0 +1,95 @@
+package aws
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// NewHTTPClient constructs the HTTP client used for AWS API calls.
+// Requests are sent through cfg.HTTPProxy when specified, otherwise through the proxy from the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables.
+// Certificates in cfg.CABundle are trusted in addition to the system roots.
+func NewHTTPClient(cfg CloudConfig) (*http.Client, error) {
+	proxy, err := proxyFunc(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{}
+	if len(cfg.CABundle) != 0 {
+		rootCAs, err := loadCABundle(cfg.CABundle)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy: proxy,
+			DialContext: (&net.Dialer{
+				Timeout:   30 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+			TLSClientConfig:       tlsConfig,
+		},
+	}, nil
+}
+
+// newMetadataHTTPClient constructs the HTTP client for the instance metadata service, which must never be reached through a proxy.
+func newMetadataHTTPClient() *http.Client {
+	return &http.Client{
+		Timeout: 1 * time.Second,
+		Transport: &http.Transport{
+			Proxy: nil,
+			DialContext: (&net.Dialer{
+				Timeout:   1 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+		},
+	}
+}
+
+func proxyFunc(cfg CloudConfig) (func(*http.Request) (*url.URL, error), error) {
+	if len(cfg.HTTPProxy) == 0 {
+		return http.ProxyFromEnvironment, nil
+	}
+	if _, err := url.Parse(cfg.HTTPProxy); err != nil {
+		return nil, fmt.Errorf("invalid --aws-http-proxy %v due to %v", cfg.HTTPProxy, err)
+	}
+	proxyConfig := &httpproxy.Config{
+		HTTPProxy:  cfg.HTTPProxy,
+		HTTPSProxy: cfg.HTTPProxy,
+		NoProxy:    cfg.NoProxy,
+	}
+	proxy := proxyConfig.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxy(req.URL)
+	}, nil
+}
+
+func loadCABundle(path string) (*x509.CertPool, error) {
+	pem, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA bundle %v due to %v", path, err)
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("failed to load CA bundle %v: no PEM encoded certificates found", path)
+	}
+	return pool, nil
+}
@@ -0,0 +1,69 @@
+package aws
+
+import (
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHTTPClient_proxy(t *testing.T) {
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = append(proxied, r.URL.Host)
+	}))
+	defer proxy.Close()
+
+	client, err := NewHTTPClient(CloudConfig{HTTPProxy: proxy.URL, NoProxy: "internal.example.com"})
+	assert.NoError(t, err)
+	transport := client.Transport.(*http.Transport)
+
+	req, _ := http.NewRequest(http.MethodGet, "http://elasticloadbalancing.us-west-2.amazonaws.com/", nil)
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, []string{"elasticloadbalancing.us-west-2.amazonaws.com"}, proxied)
+
+	req, _ = http.NewRequest(http.MethodGet, "https://internal.example.com/", nil)
+	proxyURL, err := transport.Proxy(req)
+	assert.NoError(t, err)
+	assert.Nil(t, proxyURL)
+
+	_, err = NewHTTPClient(CloudConfig{HTTPProxy: "http://%zz"})
+	assert.Error(t, err)
+}
+
+func TestNewHTTPClient_caBundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "ca-bundle")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	bundle := filepath.Join(dir, "ca.pem")
+	assert.NoError(t, ioutil.WriteFile(bundle, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600))
+
+	client, err := NewHTTPClient(CloudConfig{})
+	assert.NoError(t, err)
+	_, err = client.Get(server.URL)
+	assert.Error(t, err, "the test server certificate shouldn't be trusted without the bundle")
+
+	client, err = NewHTTPClient(CloudConfig{CABundle: bundle})
+	assert.NoError(t, err)
+	resp, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	resp.Body.Close()
+
+	invalid := filepath.Join(dir, "invalid.pem")
+	assert.NoError(t, ioutil.WriteFile(invalid, []byte("not a certificate"), 0600))
+	_, err = NewHTTPClient(CloudConfig{CABundle: invalid})
+	assert.EqualError(t, err, "failed to load CA bundle "+invalid+": no PEM encoded certificates found")
+
+	_, err = NewHTTPClient(CloudConfig{CABundle: filepath.Join(dir, "missing.pem")})
+	assert.Error(t, err)
+}
@@ -12,6 +12,15 @@ import (
 type IAMAPI interface {
 	// StatusIAM validates IAM  connectivity
 	StatusIAM() func() error
+
+	// GetRoleArn returns the ARN of the role named roleName, including its path.
+	GetRoleArn(ctx context.Context, roleName string) (string, error)
+
+	// GetDeniedActions simulates the policies of the principal, returning the actions it isn't allowed to call on all resources.
+	GetDeniedActions(ctx context.Context, principalArn string, actions []string) ([]string, error)
+
+	// GetServerCertificateMetadata returns the metadata of the IAM server certificate named certName.
+	GetServerCertificateMetadata(ctx context.Context, certName string) (*iam.ServerCertificateMetadata, error)
 }
 
 // Status validates IAM connectivity
@@ -25,3 +34,35 @@ func (c *Cloud) StatusIAM() func() error {
 		return nil
 	}
 }
+
+func (c *Cloud) GetRoleArn(ctx context.Context, roleName string) (string, error) {
+	output, err := c.iam.GetRoleWithContext(ctx, &iam.GetRoleInput{RoleName: aws.String(roleName)})
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(output.Role.Arn), nil
+}
+
+func (c *Cloud) GetDeniedActions(ctx context.Context, principalArn string, actions []string) ([]string, error) {
+	var denied []string
+	err := c.iam.SimulatePrincipalPolicyPagesWithContext(ctx, &iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: aws.String(principalArn),
+		ActionNames:     aws.StringSlice(actions),
+	}, func(output *iam.SimulatePolicyResponse, _ bool) bool {
+		for _, result := range output.EvaluationResults {
+			if aws.StringValue(result.EvalDecision) != iam.PolicyEvaluationDecisionTypeAllowed {
+				denied = append(denied, aws.StringValue(result.EvalActionName))
+			}
+		}
+		return true
+	})
+	return denied, err
+}
+
+func (c *Cloud) GetServerCertificateMetadata(ctx context.Context, certName string) (*iam.ServerCertificateMetadata, error) {
+	output, err := c.iam.GetServerCertificateWithContext(ctx, &iam.GetServerCertificateInput{ServerCertificateName: aws.String(certName)})
+	if err != nil {
+		return nil, err
+	}
+	return output.ServerCertificate.ServerCertificateMetadata, nil
+}
@@ -0,0 +1,71 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+// shieldRegion is the region of the global Shield Advanced endpoint, which only exists in the aws partition.
+const shieldRegion = "us-east-1"
+
+// regionPrefixPartitions maps region prefixes to their partition, for regions unknown to the SDK.
+var regionPrefixPartitions = []struct {
+	prefix    string
+	partition string
+}{
+	{"cn-", endpoints.AwsCnPartitionID},
+	{"us-gov-", endpoints.AwsUsGovPartitionID},
+	{"us-isob-", endpoints.AwsIsoBPartitionID},
+	{"us-iso-", endpoints.AwsIsoPartitionID},
+}
+
+// PartitionForRegion returns the partition of region, e.g. aws-cn for cn-north-1.
+func PartitionForRegion(region string) string {
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+		return p.ID()
+	}
+	for _, entry := range regionPrefixPartitions {
+		if strings.HasPrefix(region, entry.prefix) {
+			return entry.partition
+		}
+	}
+	return endpoints.AwsPartitionID
+}
+
+// BuildARN constructs the ARN of resource in partition.
+func BuildARN(partition string, service string, region string, accountID string, resource string) string {
+	return arn.ARN{
+		Partition: partition,
+		Service:   service,
+		Region:    region,
+		AccountID: accountID,
+		Resource:  resource,
+	}.String()
+}
+
+// ARNPartition returns the partition of an ARN, or "" if s isn't an ARN.
+func ARNPartition(s string) string {
+	a, err := arn.Parse(s)
+	if err != nil {
+		return ""
+	}
+	return a.Partition
+}
+
+// ValidateARNPartition returns an error when s isn't an ARN in partition. Nothing is validated when partition is unknown, i.e. "".
+func ValidateARNPartition(s string, partition string) error {
+	if len(partition) == 0 {
+		return nil
+	}
+	a, err := arn.Parse(s)
+	if err != nil {
+		return fmt.Errorf("%v is not a valid ARN: %v", s, err)
+	}
+	if a.Partition != partition {
+		return fmt.Errorf("%v is in partition %v, expected partition %v", s, a.Partition, partition)
+	}
+	return nil
+}
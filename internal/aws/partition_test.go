@@ -0,0 +1,67 @@
+package aws
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartitionForRegion(t *testing.T) {
+	for _, tc := range []struct {
+		Region   string
+		Expected string
+	}{
+		{Region: "us-west-2", Expected: "aws"},
+		{Region: "cn-northwest-1", Expected: "aws-cn"},
+		{Region: "us-gov-west-1", Expected: "aws-us-gov"},
+		{Region: "cn-south-9", Expected: "aws-cn"},
+		{Region: "us-isob-east-1", Expected: "aws-iso-b"},
+		{Region: "mars-north-1", Expected: "aws"},
+	} {
+		t.Run(tc.Region, func(t *testing.T) {
+			assert.Equal(t, tc.Expected, PartitionForRegion(tc.Region))
+		})
+	}
+}
+
+func TestBuildARN(t *testing.T) {
+	assert.Equal(t, "arn:aws-cn:ec2:cn-north-1:123456789012:security-group/sg-1",
+		BuildARN("aws-cn", "ec2", "cn-north-1", "123456789012", "security-group/sg-1"))
+}
+
+func TestValidateARNPartition(t *testing.T) {
+	for _, tc := range []struct {
+		Name          string
+		ARN           string
+		Partition     string
+		ExpectedError error
+	}{
+		{
+			Name:      "same partition",
+			ARN:       "arn:aws-us-gov:acm:us-gov-west-1:123456789012:certificate/cert",
+			Partition: "aws-us-gov",
+		},
+		{
+			Name:          "different partition",
+			ARN:           "arn:aws:acm:us-west-2:123456789012:certificate/cert",
+			Partition:     "aws-cn",
+			ExpectedError: errors.New("arn:aws:acm:us-west-2:123456789012:certificate/cert is in partition aws, expected partition aws-cn"),
+		},
+		{
+			Name:          "invalid ARN",
+			ARN:           "cert",
+			Partition:     "aws",
+			ExpectedError: errors.New("cert is not a valid ARN: arn: invalid prefix"),
+		},
+		{
+			Name: "unknown partition",
+			ARN:  "cert",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := ValidateARNPartition(tc.ARN, tc.Partition)
+			assert.Equal(t, tc.ExpectedError, err)
+		})
+	}
+}
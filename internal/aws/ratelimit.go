@@ -0,0 +1,66 @@
+package aws
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/ticketmaster/aws-sdk-go-cache/cache"
+	"golang.org/x/time/rate"
+)
+
+// RateLimit is the token bucket limiting the calls to an AWS service.
+type RateLimit struct {
+	QPS   float64
+	Burst int
+}
+
+// ParseRateLimits parses rate limits of the form <qps>[:<burst>] by the name of the AWS service they apply to, e.g. elasticloadbalancing or ec2.
+// The burst defaults to the QPS rounded up.
+func ParseRateLimits(limits map[string]string) (map[string]RateLimit, error) {
+	parsed := make(map[string]RateLimit, len(limits))
+	for service, limit := range limits {
+		if len(service) == 0 {
+			return nil, fmt.Errorf("invalid rate limit %q: expected <service>=<qps>[:<burst>]", limit)
+		}
+		args := strings.Split(limit, ":")
+		if len(args) > 2 {
+			return nil, fmt.Errorf("invalid rate limit %v=%v: expected <qps>[:<burst>]", service, limit)
+		}
+		qps, err := strconv.ParseFloat(args[0], 64)
+		if err != nil || qps <= 0 {
+			return nil, fmt.Errorf("invalid rate limit %v=%v: qps must be a positive number", service, limit)
+		}
+		burst := int(math.Ceil(qps))
+		if len(args) == 2 {
+			burst, err = strconv.Atoi(args[1])
+			if err != nil || burst < 1 {
+				return nil, fmt.Errorf("invalid rate limit %v=%v: burst must be a positive integer", service, limit)
+			}
+		}
+		parsed[service] = RateLimit{QPS: qps, Burst: burst}
+	}
+	return parsed, nil
+}
+
+// LimitRate makes each attempt of the calls of sess to the services of limits wait for a token of the service's bucket,
+// so that retries are limited as well. Responses served from the AWS SDK cache don't consume tokens.
+func LimitRate(sess *session.Session, limits map[string]RateLimit) {
+	limiters := make(map[string]*rate.Limiter, len(limits))
+	for service, limit := range limits {
+		limiters[service] = rate.NewLimiter(rate.Limit(limit.QPS), limit.Burst)
+	}
+	sess.Handlers.Sign.PushFront(func(r *request.Request) {
+		limiter, ok := limiters[r.ClientInfo.ServiceName]
+		if !ok || cache.IsCacheHit(r.HTTPRequest.Context()) {
+			return
+		}
+		if err := limiter.Wait(r.Context()); err != nil {
+			r.Error = awserr.New(request.CanceledErrorCode, "request context canceled", err)
+		}
+	})
+}
@@ -0,0 +1,86 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRateLimits(t *testing.T) {
+	for _, tc := range []struct {
+		Name           string
+		Limits         map[string]string
+		ExpectedLimits map[string]RateLimit
+		ExpectedError  string
+	}{
+		{
+			Name:   "qps and burst",
+			Limits: map[string]string{"elasticloadbalancing": "10:20", "ec2": "2.5"},
+			ExpectedLimits: map[string]RateLimit{
+				"elasticloadbalancing": {QPS: 10, Burst: 20},
+				"ec2":                  {QPS: 2.5, Burst: 3},
+			},
+		},
+		{
+			Name:          "non-positive qps",
+			Limits:        map[string]string{"ec2": "0"},
+			ExpectedError: "invalid rate limit ec2=0: qps must be a positive number",
+		},
+		{
+			Name:          "invalid burst",
+			Limits:        map[string]string{"ec2": "10:many"},
+			ExpectedError: "invalid rate limit ec2=10:many: burst must be a positive integer",
+		},
+		{
+			Name:          "too many arguments",
+			Limits:        map[string]string{"ec2": "10:20:30"},
+			ExpectedError: "invalid rate limit ec2=10:20:30: expected <qps>[:<burst>]",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			limits, err := ParseRateLimits(tc.Limits)
+			if len(tc.ExpectedError) != 0 {
+				assert.EqualError(t, err, tc.ExpectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedLimits, limits)
+		})
+	}
+}
+
+func TestLimitRate(t *testing.T) {
+	sess := session.Must(session.NewSession(aws.NewConfig().
+		WithRegion("us-west-2").
+		WithCredentials(credentials.NewStaticCredentials("id", "secret", "")).
+		WithMaxRetries(0)))
+	sent := 0
+	sess.Handlers.Send.Clear()
+	sess.Handlers.Send.PushBack(func(r *request.Request) {
+		sent++
+		r.Error = awserr.New("Sent", "call was sent", nil)
+	})
+	LimitRate(sess, map[string]RateLimit{"elasticloadbalancing": {QPS: 0.001, Burst: 1}})
+	client := elbv2.New(sess)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := client.DescribeTargetGroupsWithContext(ctx, &elbv2.DescribeTargetGroupsInput{})
+	assert.Equal(t, "Sent", err.(awserr.Error).Code(), "the burst should allow the first call")
+	_, err = client.DescribeTargetGroupsWithContext(ctx, &elbv2.DescribeTargetGroupsInput{})
+	assert.Equal(t, request.CanceledErrorCode, err.(awserr.Error).Code(), "the second call should wait for a token beyond its deadline")
+	assert.Equal(t, 1, sent)
+
+	_, err = ec2.New(sess).DescribeVpcsWithContext(ctx, &ec2.DescribeVpcsInput{})
+	assert.Equal(t, "Sent", err.(awserr.Error).Code(), "calls to other services shouldn't be limited")
+	assert.Equal(t, 2, sent)
+}
@@ -0,0 +1,84 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// ReadOnlyErrorCode is the code of the errors returned for mutating calls blocked by BlockMutations.
+const ReadOnlyErrorCode = "ReadOnlyMode"
+
+// readOnlyOperationPrefixes are the prefixes of operations which never modify AWS resources.
+var readOnlyOperationPrefixes = []string{"Describe", "Get", "List", "Simulate"}
+
+// BlockMutations makes calls of sess which could modify AWS resources fail with ReadOnlyErrorCode, without sending them.
+// STS calls are let through as they're needed for credentials, and so is PutMetricData as it only publishes the controller's metrics.
+// Blocked calls are recorded into the MutationRecorder of their context, if any.
+func BlockMutations(sess *session.Session) {
+	sess.Handlers.Validate.PushFrontNamed(request.NamedHandler{
+		Name: "alb.BlockMutations",
+		Fn:   blockMutation,
+	})
+}
+
+func blockMutation(r *request.Request) {
+	if isReadOnlyCall(r.ClientInfo.ServiceName, r.Operation.Name) {
+		return
+	}
+	call := fmt.Sprintf("%v.%v", r.ClientInfo.ServiceName, r.Operation.Name)
+	if recorder := GetMutationRecorder(r.Context()); recorder != nil {
+		recorder.record(call)
+	}
+	r.Error = awserr.New(ReadOnlyErrorCode, fmt.Sprintf("%v is blocked in read-only mode", call), nil)
+}
+
+func isReadOnlyCall(serviceName string, operationName string) bool {
+	if serviceName == "sts" || operationName == "PutMetricData" {
+		return true
+	}
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(operationName, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// MutationRecorder records the mutating calls blocked by BlockMutations.
+type MutationRecorder struct {
+	mutex sync.Mutex
+	calls []string
+}
+
+// Calls returns the blocked calls, as service.operation, in the order they were made.
+func (m *MutationRecorder) Calls() []string {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return append([]string(nil), m.calls...)
+}
+
+func (m *MutationRecorder) record(call string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.calls = append(m.calls, call)
+}
+
+type mutationRecorderKey struct{}
+
+// WithMutationRecorder returns a context recording the mutating calls blocked by BlockMutations into the returned MutationRecorder.
+func WithMutationRecorder(ctx context.Context) (context.Context, *MutationRecorder) {
+	recorder := &MutationRecorder{}
+	return context.WithValue(ctx, mutationRecorderKey{}, recorder), recorder
+}
+
+// GetMutationRecorder returns the MutationRecorder of ctx, nil if none.
+func GetMutationRecorder(ctx context.Context) *MutationRecorder {
+	recorder, _ := ctx.Value(mutationRecorderKey{}).(*MutationRecorder)
+	return recorder
+}
@@ -0,0 +1,61 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockMutations(t *testing.T) {
+	sess := session.Must(session.NewSession(aws.NewConfig().
+		WithRegion("us-west-2").
+		WithCredentials(credentials.NewStaticCredentials("id", "secret", "")).
+		WithMaxRetries(0)))
+	sess.Handlers.Send.Clear()
+	sess.Handlers.Send.PushBack(func(r *request.Request) {
+		r.Error = awserr.New("Sent", "call was sent", nil)
+	})
+	BlockMutations(sess)
+	client := elbv2.New(sess)
+
+	ctx, recorder := WithMutationRecorder(context.Background())
+	_, err := client.CreateTargetGroupWithContext(ctx, &elbv2.CreateTargetGroupInput{Name: aws.String("tg")})
+	assert.Equal(t, ReadOnlyErrorCode, err.(awserr.Error).Code())
+	assert.Equal(t, "ReadOnlyMode: elasticloadbalancing.CreateTargetGroup is blocked in read-only mode", err.Error())
+	_, err = client.DescribeTargetGroupsWithContext(ctx, &elbv2.DescribeTargetGroupsInput{})
+	assert.Equal(t, "Sent", err.(awserr.Error).Code())
+	_, err = client.DeleteTargetGroupWithContext(ctx, &elbv2.DeleteTargetGroupInput{TargetGroupArn: aws.String("tgArn")})
+	assert.Equal(t, ReadOnlyErrorCode, err.(awserr.Error).Code())
+	assert.Equal(t, []string{"elasticloadbalancing.CreateTargetGroup", "elasticloadbalancing.DeleteTargetGroup"}, recorder.Calls())
+
+	_, err = client.ModifyListener(&elbv2.ModifyListenerInput{ListenerArn: aws.String("lsArn")})
+	assert.Equal(t, ReadOnlyErrorCode, err.(awserr.Error).Code(), "calls without recorder should be blocked as well")
+	assert.Len(t, recorder.Calls(), 2)
+}
+
+func Test_isReadOnlyCall(t *testing.T) {
+	for _, tc := range []struct {
+		serviceName   string
+		operationName string
+		expected      bool
+	}{
+		{"elasticloadbalancing", "DescribeLoadBalancers", true},
+		{"acm", "ListCertificates", true},
+		{"iam", "SimulatePrincipalPolicy", true},
+		{"waf-regional", "GetWebACLForResource", true},
+		{"sts", "AssumeRoleWithWebIdentity", true},
+		{"monitoring", "PutMetricData", true},
+		{"elasticloadbalancing", "ModifyRule", false},
+		{"ec2", "AuthorizeSecurityGroupIngress", false},
+		{"tagging", "TagResources", false},
+	} {
+		assert.Equal(t, tc.expected, isReadOnlyCall(tc.serviceName, tc.operationName), "%v.%v", tc.serviceName, tc.operationName)
+	}
+}
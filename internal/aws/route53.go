@@ -0,0 +1,114 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+// route53ListTagsBatchSize is the maximum number of resources of a single ListTagsForResources call.
+const route53ListTagsBatchSize = 10
+
+type Route53API interface {
+	// GetHealthChecksByTags returns the Route 53 health checks tagged with all of tags.
+	GetHealthChecksByTags(ctx context.Context, tags map[string]string) ([]*route53.HealthCheck, error)
+
+	// CreateHealthCheck creates a Route 53 health check with config and tags.
+	CreateHealthCheck(ctx context.Context, config *route53.HealthCheckConfig, tags map[string]string) (*route53.HealthCheck, error)
+
+	// DeleteHealthCheck deletes the Route 53 health check, it's a no-op if the health check doesn't exist.
+	DeleteHealthCheck(ctx context.Context, healthCheckID string) error
+}
+
+func (c *Cloud) GetHealthChecksByTags(ctx context.Context, tags map[string]string) ([]*route53.HealthCheck, error) {
+	var healthChecks []*route53.HealthCheck
+	err := c.route53.ListHealthChecksPagesWithContext(ctx, &route53.ListHealthChecksInput{}, func(output *route53.ListHealthChecksOutput, _ bool) bool {
+		healthChecks = append(healthChecks, output.HealthChecks...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*route53.HealthCheck
+	for start := 0; start < len(healthChecks); start += route53ListTagsBatchSize {
+		end := start + route53ListTagsBatchSize
+		if end > len(healthChecks) {
+			end = len(healthChecks)
+		}
+		byID := make(map[string]*route53.HealthCheck)
+		var ids []*string
+		for _, healthCheck := range healthChecks[start:end] {
+			byID[StringValue(healthCheck.Id)] = healthCheck
+			ids = append(ids, healthCheck.Id)
+		}
+		output, err := c.route53.ListTagsForResourcesWithContext(ctx, &route53.ListTagsForResourcesInput{
+			ResourceType: String(route53.TagResourceTypeHealthcheck),
+			ResourceIds:  ids,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, tagSet := range output.ResourceTagSets {
+			if route53TagsMatch(tagSet.Tags, tags) {
+				result = append(result, byID[StringValue(tagSet.ResourceId)])
+			}
+		}
+	}
+	return result, nil
+}
+
+func (c *Cloud) CreateHealthCheck(ctx context.Context, config *route53.HealthCheckConfig, tags map[string]string) (*route53.HealthCheck, error) {
+	output, err := c.route53.CreateHealthCheckWithContext(ctx, &route53.CreateHealthCheckInput{
+		CallerReference:   String("alb-" + strconv.FormatInt(time.Now().UnixNano(), 36)),
+		HealthCheckConfig: config,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var addTags []*route53.Tag
+	for k, v := range tags {
+		addTags = append(addTags, &route53.Tag{Key: String(k), Value: String(v)})
+	}
+	_, err = c.route53.ChangeTagsForResourceWithContext(ctx, &route53.ChangeTagsForResourceInput{
+		ResourceType: String(route53.TagResourceTypeHealthcheck),
+		ResourceId:   output.HealthCheck.Id,
+		AddTags:      addTags,
+	})
+	if err != nil {
+		// an untagged health check would never be found again, so it's deleted rather than leaked.
+		if deleteErr := c.DeleteHealthCheck(ctx, StringValue(output.HealthCheck.Id)); deleteErr != nil {
+			return nil, fmt.Errorf("failed to tag health check %v due to %v, and failed to delete it due to %v", StringValue(output.HealthCheck.Id), err, deleteErr)
+		}
+		return nil, err
+	}
+	return output.HealthCheck, nil
+}
+
+func (c *Cloud) DeleteHealthCheck(ctx context.Context, healthCheckID string) error {
+	_, err := c.route53.DeleteHealthCheckWithContext(ctx, &route53.DeleteHealthCheckInput{
+		HealthCheckId: String(healthCheckID),
+	})
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == route53.ErrCodeNoSuchHealthCheck {
+		return nil
+	}
+	return err
+}
+
+func route53TagsMatch(tags []*route53.Tag, expected map[string]string) bool {
+	actual := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		actual[StringValue(tag.Key)] = StringValue(tag.Value)
+	}
+	for k, v := range expected {
+		if value, ok := actual[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
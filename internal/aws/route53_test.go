@@ -0,0 +1,84 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCloud_GetHealthChecksByTags(t *testing.T) {
+	ctx := context.Background()
+	var healthChecks []*route53.HealthCheck
+	for i := 0; i < 12; i++ {
+		healthChecks = append(healthChecks, &route53.HealthCheck{Id: String(fmt.Sprintf("hc-%02d", i))})
+	}
+	tagsOf := func(ids []*string) []*route53.ResourceTagSet {
+		var tagSets []*route53.ResourceTagSet
+		for _, id := range ids {
+			owner := "other"
+			if StringValue(id) == "hc-03" || StringValue(id) == "hc-11" {
+				owner = "ingress"
+			}
+			tagSets = append(tagSets, &route53.ResourceTagSet{
+				ResourceId: id,
+				Tags:       []*route53.Tag{{Key: String("kubernetes.io/ingress-name"), Value: String(owner)}},
+			})
+		}
+		return tagSets
+	}
+
+	route53svc := &mocks.Route53API{}
+	route53svc.On("ListHealthChecksPagesWithContext", ctx, &route53.ListHealthChecksInput{}, mock.Anything).Run(func(args mock.Arguments) {
+		fn := args.Get(2).(func(*route53.ListHealthChecksOutput, bool) bool)
+		fn(&route53.ListHealthChecksOutput{HealthChecks: healthChecks[:5]}, false)
+		fn(&route53.ListHealthChecksOutput{HealthChecks: healthChecks[5:]}, true)
+	}).Return(nil)
+	route53svc.On("ListTagsForResourcesWithContext", ctx, mock.Anything).Return(func(_ context.Context, input *route53.ListTagsForResourcesInput, _ ...request.Option) *route53.ListTagsForResourcesOutput {
+		return &route53.ListTagsForResourcesOutput{ResourceTagSets: tagsOf(input.ResourceIds)}
+	}, nil)
+	cloud := &Cloud{route53: route53svc}
+
+	result, err := cloud.GetHealthChecksByTags(ctx, map[string]string{"kubernetes.io/ingress-name": "ingress"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*route53.HealthCheck{healthChecks[3], healthChecks[11]}, result)
+	route53svc.AssertNumberOfCalls(t, "ListTagsForResourcesWithContext", 2)
+}
+
+func TestCloud_DeleteHealthCheck(t *testing.T) {
+	for _, tc := range []struct {
+		Name          string
+		DeleteError   error
+		ExpectedError error
+	}{
+		{
+			Name: "deleted",
+		},
+		{
+			Name:        "already deleted",
+			DeleteError: awserr.New(route53.ErrCodeNoSuchHealthCheck, "", nil),
+		},
+		{
+			Name:          "in use",
+			DeleteError:   errors.New(route53.ErrCodeHealthCheckInUse),
+			ExpectedError: errors.New(route53.ErrCodeHealthCheckInUse),
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctx := context.Background()
+			route53svc := &mocks.Route53API{}
+			route53svc.On("DeleteHealthCheckWithContext", ctx, &route53.DeleteHealthCheckInput{HealthCheckId: String("hc")}).Return(&route53.DeleteHealthCheckOutput{}, tc.DeleteError)
+			cloud := &Cloud{route53: route53svc}
+
+			assert.Equal(t, tc.ExpectedError, cloud.DeleteHealthCheck(ctx, "hc"))
+			route53svc.AssertExpectations(t)
+		})
+	}
+}
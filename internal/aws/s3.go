@@ -0,0 +1,23 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+type S3API interface {
+	// GetBucketRegion returns the region of a bucket, it fails with NoSuchBucket if the bucket doesn't exist.
+	GetBucketRegion(ctx context.Context, bucket string) (string, error)
+}
+
+func (c *Cloud) GetBucketRegion(ctx context.Context, bucket string) (string, error) {
+	output, err := c.s3.GetBucketLocationWithContext(ctx, &s3.GetBucketLocationInput{
+		Bucket: String(bucket),
+	})
+	if err != nil {
+		return "", err
+	}
+	// buckets of us-east-1 have no location constraint, and the legacy EU one is eu-west-1.
+	return s3.NormalizeBucketLocation(StringValue(output.LocationConstraint)), nil
+}
@@ -0,0 +1,53 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloud_GetBucketRegion(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []struct {
+		Name               string
+		LocationConstraint *string
+		Err                error
+		ExpectedRegion     string
+		ExpectedError      error
+	}{
+		{
+			Name:               "regional bucket",
+			LocationConstraint: String("us-west-2"),
+			ExpectedRegion:     "us-west-2",
+		},
+		{
+			Name:           "us-east-1 bucket",
+			ExpectedRegion: "us-east-1",
+		},
+		{
+			Name:               "legacy EU bucket",
+			LocationConstraint: String("EU"),
+			ExpectedRegion:     "eu-west-1",
+		},
+		{
+			Name:          "missing bucket",
+			Err:           errors.New("NoSuchBucket"),
+			ExpectedError: errors.New("NoSuchBucket"),
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			s3svc := &mocks.S3API{}
+			s3svc.On("GetBucketLocationWithContext", ctx, &s3.GetBucketLocationInput{Bucket: String("access-logs")}).Return(
+				&s3.GetBucketLocationOutput{LocationConstraint: tc.LocationConstraint}, tc.Err)
+			cloud := &Cloud{s3: s3svc}
+
+			region, err := cloud.GetBucketRegion(ctx, "access-logs")
+			assert.Equal(t, tc.ExpectedError, err)
+			assert.Equal(t, tc.ExpectedRegion, region)
+		})
+	}
+}
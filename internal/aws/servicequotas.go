@@ -0,0 +1,35 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+)
+
+type ServiceQuotasAPI interface {
+	// GetServiceQuotaValue returns the value of a quota applied to the account, which is its AWS default value unless it was increased.
+	GetServiceQuotaValue(ctx context.Context, serviceCode string, quotaCode string) (float64, error)
+}
+
+func (c *Cloud) GetServiceQuotaValue(ctx context.Context, serviceCode string, quotaCode string) (float64, error) {
+	output, err := c.servicequotas.GetServiceQuotaWithContext(ctx, &servicequotas.GetServiceQuotaInput{
+		ServiceCode: String(serviceCode),
+		QuotaCode:   String(quotaCode),
+	})
+	if err == nil {
+		return Float64Value(output.Quota.Value), nil
+	}
+	// quotas that were never increased have no applied value.
+	if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != servicequotas.ErrCodeNoSuchResourceException {
+		return 0, err
+	}
+	defaultOutput, err := c.servicequotas.GetAWSDefaultServiceQuotaWithContext(ctx, &servicequotas.GetAWSDefaultServiceQuotaInput{
+		ServiceCode: String(serviceCode),
+		QuotaCode:   String(quotaCode),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return Float64Value(defaultOutput.Quota.Value), nil
+}
@@ -2,12 +2,14 @@ package aws
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/golang/glog"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/metric"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/tracing"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/util/log"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/ticketmaster/aws-sdk-go-cache/cache"
@@ -25,10 +27,16 @@ func NewSession(awsconfig *aws.Config, AWSDebug bool, mc metric.Collector, ce bo
 		// Adds caching to session if cache is enabled
 		cache.AddCaching(session, cc)
 	}
+	tracing.InstrumentAWSHandlers(&session.Handlers)
 	session.Handlers.Retry.PushFront(func(r *request.Request) {
 		mc.IncAPIRetryCount(prometheus.Labels{"service": r.ClientInfo.ServiceName, "operation": r.Operation.Name})
+		if request.IsErrorThrottle(r.Error) {
+			mc.IncAPIThrottleCount(prometheus.Labels{"service": r.ClientInfo.ServiceName, "operation": r.Operation.Name})
+		}
 	})
 
+	session.Handlers.AfterRetry.PushBack(annotateAPIError)
+
 	session.Handlers.Send.PushFront(func(r *request.Request) {
 		mc.IncAPIRequestCount(prometheus.Labels{"service": r.ClientInfo.ServiceName, "operation": r.Operation.Name})
 		if AWSDebug {
@@ -37,6 +45,7 @@ func NewSession(awsconfig *aws.Config, AWSDebug bool, mc metric.Collector, ce bo
 	})
 
 	session.Handlers.Complete.PushFront(func(r *request.Request) {
+		mc.ObserveAPIRequestDuration(prometheus.Labels{"service": r.ClientInfo.ServiceName, "operation": r.Operation.Name}, time.Since(r.Time))
 		if r.Error != nil {
 			mc.IncAPIErrorCount(prometheus.Labels{"service": r.ClientInfo.ServiceName, "operation": r.Operation.Name})
 			if AWSDebug {
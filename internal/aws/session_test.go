@@ -0,0 +1,88 @@
+package aws
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/metric"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingCollector struct {
+	metric.DummyCollector
+
+	mu        sync.Mutex
+	requests  []string
+	errors    []string
+	throttles []string
+	durations []string
+}
+
+func labelsKey(l prometheus.Labels) string {
+	return l["service"] + "." + l["operation"]
+}
+
+func (c *recordingCollector) IncAPIRequestCount(l prometheus.Labels) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.requests = append(c.requests, labelsKey(l))
+}
+
+func (c *recordingCollector) IncAPIErrorCount(l prometheus.Labels) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.errors = append(c.errors, labelsKey(l))
+}
+
+func (c *recordingCollector) IncAPIThrottleCount(l prometheus.Labels) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.throttles = append(c.throttles, labelsKey(l))
+}
+
+func (c *recordingCollector) ObserveAPIRequestDuration(l prometheus.Labels, d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.durations = append(c.durations, labelsKey(l))
+}
+
+func TestNewSession_metrics(t *testing.T) {
+	throttled := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if throttled {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`<ErrorResponse><Error><Type>Sender</Type><Code>Throttling</Code><Message>Rate exceeded</Message></Error></ErrorResponse>`))
+			return
+		}
+		w.Write([]byte(`<DescribeLoadBalancersResponse><DescribeLoadBalancersResult><LoadBalancers/></DescribeLoadBalancersResult></DescribeLoadBalancersResponse>`))
+	}))
+	defer server.Close()
+
+	mc := &recordingCollector{}
+	sess := NewSession(aws.NewConfig().
+		WithRegion("us-west-2").
+		WithEndpoint(server.URL).
+		WithCredentials(credentials.NewStaticCredentials("id", "secret", "")).
+		WithMaxRetries(0), false, mc, false, nil)
+	client := elbv2.New(sess)
+
+	_, err := client.DescribeLoadBalancersWithContext(context.Background(), &elbv2.DescribeLoadBalancersInput{})
+	assert.Error(t, err)
+	throttled = false
+	_, err = client.DescribeLoadBalancersWithContext(context.Background(), &elbv2.DescribeLoadBalancersInput{})
+	assert.NoError(t, err)
+
+	operation := "elasticloadbalancing.DescribeLoadBalancers"
+	assert.Equal(t, []string{operation, operation}, mc.requests)
+	assert.Equal(t, []string{operation}, mc.errors)
+	assert.Equal(t, []string{operation}, mc.throttles)
+	assert.Equal(t, []string{operation, operation}, mc.durations)
+}
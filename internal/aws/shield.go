@@ -2,6 +2,7 @@ package aws
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/shield"
@@ -23,11 +24,18 @@ func (c *Cloud) ShieldAvailable(ctx context.Context) (bool, error) {
 	return *status.SubscriptionState == "ACTIVE", nil
 }
 
+// GetSubscriptionStatus reports an inactive subscription in partitions without Shield Advanced.
 func (c *Cloud) GetSubscriptionStatus(ctx context.Context) (*shield.GetSubscriptionStateOutput, error) {
+	if c.shield == nil {
+		return &shield.GetSubscriptionStateOutput{SubscriptionState: String(shield.SubscriptionStateInactive)}, nil
+	}
 	return c.shield.GetSubscriptionStateWithContext(ctx, &shield.GetSubscriptionStateInput{})
 }
 
 func (c *Cloud) GetProtection(ctx context.Context, resourceArn *string) (*shield.Protection, error) {
+	if c.shield == nil {
+		return nil, nil
+	}
 	result, err := c.shield.DescribeProtectionWithContext(ctx, &shield.DescribeProtectionInput{
 		ResourceArn: resourceArn,
 	})
@@ -44,6 +52,9 @@ func (c *Cloud) GetProtection(ctx context.Context, resourceArn *string) (*shield
 }
 
 func (c *Cloud) CreateProtection(ctx context.Context, resourceArn *string, protectionName *string) (*shield.CreateProtectionOutput, error) {
+	if c.shield == nil {
+		return nil, fmt.Errorf("shield advanced is not available in partition %v", c.partition)
+	}
 	return c.shield.CreateProtectionWithContext(ctx, &shield.CreateProtectionInput{
 		Name:        protectionName,
 		ResourceArn: resourceArn,
@@ -51,6 +62,9 @@ func (c *Cloud) CreateProtection(ctx context.Context, resourceArn *string, prote
 }
 
 func (c *Cloud) DeleteProtection(ctx context.Context, protectionID *string) (*shield.DeleteProtectionOutput, error) {
+	if c.shield == nil {
+		return nil, fmt.Errorf("shield advanced is not available in partition %v", c.partition)
+	}
 	return c.shield.DeleteProtectionWithContext(ctx, &shield.DeleteProtectionInput{
 		ProtectionId: protectionID,
 	})
@@ -228,3 +228,19 @@ func TestCloud_DeleteProtection(t *testing.T) {
 		})
 	}
 }
+
+func TestCloud_ShieldAvailable_unavailablePartition(t *testing.T) {
+	ctx := context.Background()
+	cloud := &Cloud{partition: "aws-cn"}
+
+	available, err := cloud.ShieldAvailable(ctx)
+	assert.NoError(t, err)
+	assert.False(t, available)
+
+	protection, err := cloud.GetProtection(ctx, aws.String("arn:aws-cn:elasticloadbalancing:cn-north-1:000000000000:loadbalancer/app/lb/0123456789abcdef"))
+	assert.NoError(t, err)
+	assert.Nil(t, protection)
+
+	_, err = cloud.CreateProtection(ctx, aws.String("arn"), aws.String("name"))
+	assert.EqualError(t, err, "shield advanced is not available in partition aws-cn")
+}
@@ -0,0 +1,37 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+type STSAPI interface {
+	// GetCallerPrincipalArn returns the ARN of the IAM user or role making the AWS API calls, assumed-role sessions are resolved to their role.
+	GetCallerPrincipalArn(ctx context.Context) (string, error)
+}
+
+func (c *Cloud) GetCallerPrincipalArn(ctx context.Context) (string, error) {
+	identity, err := c.sts.GetCallerIdentityWithContext(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", err
+	}
+	callerArn := StringValue(identity.Arn)
+	parsed, err := arn.Parse(callerArn)
+	if err != nil {
+		return "", err
+	}
+	// assumed-role/<role-name>/<session-name>
+	parts := strings.Split(parsed.Resource, "/")
+	if parts[0] != "assumed-role" || len(parts) < 3 {
+		return callerArn, nil
+	}
+	roleArn, err := c.GetRoleArn(ctx, parts[1])
+	if err != nil {
+		// without iam:GetRole, assume the role has no path.
+		return BuildARN(parsed.Partition, "iam", "", parsed.AccountID, "role/"+parts[1]), nil
+	}
+	return roleArn, nil
+}
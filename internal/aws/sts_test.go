@@ -0,0 +1,52 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloud_GetCallerPrincipalArn(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []struct {
+		Name        string
+		CallerArn   string
+		GetRoleErr  error
+		ExpectedArn string
+	}{
+		{
+			Name:        "user",
+			CallerArn:   "arn:aws:iam::123456789012:user/admin",
+			ExpectedArn: "arn:aws:iam::123456789012:user/admin",
+		},
+		{
+			Name:        "assumed role",
+			CallerArn:   "arn:aws:sts::123456789012:assumed-role/alb-ingress-controller/i-0123456789abcdef0",
+			ExpectedArn: "arn:aws:iam::123456789012:role/kube/alb-ingress-controller",
+		},
+		{
+			Name:        "assumed role without iam:GetRole",
+			CallerArn:   "arn:aws-cn:sts::123456789012:assumed-role/alb-ingress-controller/session",
+			GetRoleErr:  errors.New("AccessDenied"),
+			ExpectedArn: "arn:aws-cn:iam::123456789012:role/alb-ingress-controller",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			stssvc := &mocks.STSAPI{}
+			stssvc.On("GetCallerIdentityWithContext", ctx, &sts.GetCallerIdentityInput{}).Return(&sts.GetCallerIdentityOutput{Arn: String(tc.CallerArn)}, nil)
+			iamsvc := &mocks.IAMAPI{}
+			iamsvc.On("GetRoleWithContext", ctx, &iam.GetRoleInput{RoleName: String("alb-ingress-controller")}).Return(
+				&iam.GetRoleOutput{Role: &iam.Role{Arn: String("arn:aws:iam::123456789012:role/kube/alb-ingress-controller")}}, tc.GetRoleErr)
+			cloud := &Cloud{sts: stssvc, iam: iamsvc}
+
+			principalArn, err := cloud.GetCallerPrincipalArn(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedArn, principalArn)
+		})
+	}
+}
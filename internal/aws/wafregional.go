@@ -11,6 +11,7 @@ import (
 
 type WAFRegionalAPI interface {
 	WebACLExists(ctx context.Context, webACLId *string) (bool, error)
+	GetWebACLIdByName(ctx context.Context, name string) (*string, error)
 	GetWebACLSummary(ctx context.Context, resourceArn *string) (*waf.WebACLSummary, error)
 	AssociateWAF(ctx context.Context, resourceArn *string, webACLId *string) (*wafregional.AssociateWebACLOutput, error)
 	DisassociateWAF(ctx context.Context, resourceArn *string) (*wafregional.DisassociateWebACLOutput, error)
@@ -32,6 +33,26 @@ func (c *Cloud) WebACLExists(ctx context.Context, webACLId *string) (bool, error
 	return true, nil
 }
 
+// GetWebACLIdByName returns the ID of the webACL named name, or nil if there is none.
+func (c *Cloud) GetWebACLIdByName(ctx context.Context, name string) (*string, error) {
+	input := &waf.ListWebACLsInput{Limit: Int64(100)}
+	for {
+		output, err := c.wafregional.ListWebACLsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, webACL := range output.WebACLs {
+			if StringValue(webACL.Name) == name {
+				return webACL.WebACLId, nil
+			}
+		}
+		if StringValue(output.NextMarker) == "" {
+			return nil, nil
+		}
+		input.NextMarker = output.NextMarker
+	}
+}
+
 // GetWebACLSummary return associated summary for resource.
 func (c *Cloud) GetWebACLSummary(ctx context.Context, resourceArn *string) (*waf.WebACLSummary, error) {
 	result, err := c.wafregional.GetWebACLForResourceWithContext(ctx, &wafregional.GetWebACLForResourceInput{
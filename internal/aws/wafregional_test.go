@@ -53,6 +53,30 @@ func TestCloud_WebACLExists(t *testing.T) {
 	}
 }
 
+func TestCloud_GetWebACLIdByName(t *testing.T) {
+	ctx := context.Background()
+	wafsvc := &mocks.WAFRegionalAPI{}
+	wafsvc.On("ListWebACLsWithContext", ctx, &waf.ListWebACLsInput{Limit: aws.Int64(100)}).Return(&waf.ListWebACLsOutput{
+		WebACLs:    []*waf.WebACLSummary{{Name: aws.String("other"), WebACLId: aws.String("other_id")}},
+		NextMarker: aws.String("marker"),
+	}, nil)
+	wafsvc.On("ListWebACLsWithContext", ctx, &waf.ListWebACLsInput{Limit: aws.Int64(100), NextMarker: aws.String("marker")}).Return(&waf.ListWebACLsOutput{
+		WebACLs: []*waf.WebACLSummary{{Name: aws.String("name"), WebACLId: aws.String("web_acl_id")}},
+	}, nil)
+
+	cloud := &Cloud{
+		wafregional: wafsvc,
+	}
+
+	webACLId, err := cloud.GetWebACLIdByName(ctx, "name")
+	assert.NoError(t, err)
+	assert.Equal(t, aws.String("web_acl_id"), webACLId)
+
+	webACLId, err = cloud.GetWebACLIdByName(ctx, "missing")
+	assert.NoError(t, err)
+	assert.Nil(t, webACLId)
+}
+
 func TestCloud_GetWebACLSummary(t *testing.T) {
 	resourceArn := aws.String("arn")
 
@@ -8,6 +8,7 @@ import (
 
 type WAFV2API interface {
 	GetWAFV2WebACLSummary(ctx context.Context, webACLId *string) (*wafv2.WebACL, error)
+	GetWAFV2WebACLARNByName(ctx context.Context, name string) (*string, error)
 	AssociateWAFV2(ctx context.Context, resourceArn *string, webACLId *string) (*wafv2.AssociateWebACLOutput, error)
 	DisassociateWAFV2(ctx context.Context, resourceArn *string) (*wafv2.DisassociateWebACLOutput, error)
 }
@@ -25,6 +26,26 @@ func (c *Cloud) GetWAFV2WebACLSummary(ctx context.Context, resourceArn *string)
 	return result.WebACL, nil
 }
 
+// GetWAFV2WebACLARNByName returns the ARN of the regional WAFv2 webACL named name, or nil if there is none.
+func (c *Cloud) GetWAFV2WebACLARNByName(ctx context.Context, name string) (*string, error) {
+	input := &wafv2.ListWebACLsInput{Scope: String(wafv2.ScopeRegional), Limit: Int64(100)}
+	for {
+		output, err := c.wafv2.ListWebACLsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, webACL := range output.WebACLs {
+			if StringValue(webACL.Name) == name {
+				return webACL.ARN, nil
+			}
+		}
+		if StringValue(output.NextMarker) == "" {
+			return nil, nil
+		}
+		input.NextMarker = output.NextMarker
+	}
+}
+
 // AssociateWAFV2 WAF ACL to resource.
 func (c *Cloud) AssociateWAFV2(ctx context.Context, resourceArn *string, webACLARN *string) (*wafv2.AssociateWebACLOutput, error) {
 	result, err := c.wafv2.AssociateWebACLWithContext(ctx, &wafv2.AssociateWebACLInput{
@@ -135,3 +135,23 @@ func TestCloud_DisassociateWAFV2(t *testing.T) {
 		})
 	}
 }
+
+func TestCloud_GetWAFV2WebACLARNByName(t *testing.T) {
+	ctx := context.Background()
+	wafv2svc := &mocks.WAFV2API{}
+	wafv2svc.On("ListWebACLsWithContext", ctx, &wafv2.ListWebACLsInput{Scope: aws.String(wafv2.ScopeRegional), Limit: aws.Int64(100)}).Return(&wafv2.ListWebACLsOutput{
+		WebACLs: []*wafv2.WebACLSummary{{Name: aws.String("name"), ARN: aws.String("web_acl_arn")}},
+	}, nil)
+
+	cloud := &Cloud{
+		wafv2: wafv2svc,
+	}
+
+	webACLARN, err := cloud.GetWAFV2WebACLARNByName(ctx, "name")
+	assert.NoError(t, err)
+	assert.Equal(t, aws.String("web_acl_arn"), webACLARN)
+
+	webACLARN, err = cloud.GetWAFV2WebACLARNByName(ctx, "missing")
+	assert.NoError(t, err)
+	assert.Nil(t, webACLARN)
+}
@@ -0,0 +1,78 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/arczonalshift"
+)
+
+type ZonalShiftAPI interface {
+	// GetZonalShiftManagedResource returns the zonal shift state of a resource, or nil if it isn't registered for zonal shifts.
+	GetZonalShiftManagedResource(ctx context.Context, resourceArn string) (*arczonalshift.GetManagedResourceOutput, error)
+
+	// CreatePracticeRunConfiguration configures practice runs of a resource registered for zonal shifts.
+	CreatePracticeRunConfiguration(ctx context.Context, resourceArn string, config *arczonalshift.PracticeRunConfiguration) error
+
+	// UpdatePracticeRunConfiguration updates the practice runs of a resource, it can't remove its blocking alarm.
+	UpdatePracticeRunConfiguration(ctx context.Context, resourceArn string, config *arczonalshift.PracticeRunConfiguration) error
+
+	// DeletePracticeRunConfiguration deletes the practice runs of a resource, its zonal autoshift must be disabled.
+	DeletePracticeRunConfiguration(ctx context.Context, resourceArn string) error
+
+	// SetZonalAutoshiftStatus enables or disables zonal autoshift of a resource, which needs practice runs to be enabled.
+	SetZonalAutoshiftStatus(ctx context.Context, resourceArn string, status string) error
+}
+
+func (c *Cloud) GetZonalShiftManagedResource(ctx context.Context, resourceArn string) (*arczonalshift.GetManagedResourceOutput, error) {
+	output, err := c.zonalshift.GetManagedResourceWithContext(ctx, &arczonalshift.GetManagedResourceInput{
+		ResourceIdentifier: String(resourceArn),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == arczonalshift.ErrCodeResourceNotFoundException {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return output, nil
+}
+
+func (c *Cloud) CreatePracticeRunConfiguration(ctx context.Context, resourceArn string, config *arczonalshift.PracticeRunConfiguration) error {
+	_, err := c.zonalshift.CreatePracticeRunConfigurationWithContext(ctx, &arczonalshift.CreatePracticeRunConfigurationInput{
+		ResourceIdentifier: String(resourceArn),
+		OutcomeAlarms:      config.OutcomeAlarms,
+		BlockingAlarms:     config.BlockingAlarms,
+		BlockedDates:       config.BlockedDates,
+		BlockedWindows:     config.BlockedWindows,
+	})
+	return err
+}
+
+func (c *Cloud) UpdatePracticeRunConfiguration(ctx context.Context, resourceArn string, config *arczonalshift.PracticeRunConfiguration) error {
+	// blocked dates and windows are sent even if empty, to clear them.
+	blockedDates := append([]*string{}, config.BlockedDates...)
+	blockedWindows := append([]*string{}, config.BlockedWindows...)
+	_, err := c.zonalshift.UpdatePracticeRunConfigurationWithContext(ctx, &arczonalshift.UpdatePracticeRunConfigurationInput{
+		ResourceIdentifier: String(resourceArn),
+		OutcomeAlarms:      config.OutcomeAlarms,
+		BlockingAlarms:     config.BlockingAlarms,
+		BlockedDates:       blockedDates,
+		BlockedWindows:     blockedWindows,
+	})
+	return err
+}
+
+func (c *Cloud) DeletePracticeRunConfiguration(ctx context.Context, resourceArn string) error {
+	_, err := c.zonalshift.DeletePracticeRunConfigurationWithContext(ctx, &arczonalshift.DeletePracticeRunConfigurationInput{
+		ResourceIdentifier: String(resourceArn),
+	})
+	return err
+}
+
+func (c *Cloud) SetZonalAutoshiftStatus(ctx context.Context, resourceArn string, status string) error {
+	_, err := c.zonalshift.UpdateZonalAutoshiftConfigurationWithContext(ctx, &arczonalshift.UpdateZonalAutoshiftConfigurationInput{
+		ResourceIdentifier:   String(resourceArn),
+		ZonalAutoshiftStatus: String(status),
+	})
+	return err
+}
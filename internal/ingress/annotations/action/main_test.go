@@ -1,6 +1,7 @@
 package action
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -115,11 +116,36 @@ func TestInvalidIngressActions(t *testing.T) {
 		actionJSON  string
 		expectedErr string
 	}{
+		{
+			name:        "should error if Type is absent",
+			actionJSON:  `{"FixedResponseConfig": {"StatusCode": "503"}}`,
+			expectedErr: "Type is required",
+		},
+		{
+			name:        "should error for authenticate actions",
+			actionJSON:  `{"Type": "authenticate-oidc", "AuthenticateOidcConfig": {"Issuer": "https://idp"}}`,
+			expectedErr: "authenticate-oidc actions aren't supported, use the auth-type annotation to authenticate requests before this action",
+		},
 		{
 			name:        "should error if FixedResponseConfig absent for fixed-response action",
 			actionJSON:  `{"Type": "fixed-response"}`,
 			expectedErr: "missing FixedResponseConfig",
 		},
+		{
+			name:        "should error if StatusCode absent for FixedResponseConfig",
+			actionJSON:  `{"Type": "fixed-response", "FixedResponseConfig": {"ContentType": "text/plain"}}`,
+			expectedErr: "invalid FixedResponseConfig: StatusCode is required",
+		},
+		{
+			name:        "should error if StatusCode of FixedResponseConfig isn't 2XX, 4XX or 5XX",
+			actionJSON:  `{"Type": "fixed-response", "FixedResponseConfig": {"StatusCode": "301"}}`,
+			expectedErr: "invalid FixedResponseConfig: StatusCode must be 2XX, 4XX or 5XX, got 301",
+		},
+		{
+			name:        "should error if MessageBody of FixedResponseConfig is too long",
+			actionJSON:  `{"Type": "fixed-response", "FixedResponseConfig": {"StatusCode": "503", "MessageBody": "` + strings.Repeat("x", 1025) + `"}}`,
+			expectedErr: "invalid FixedResponseConfig: MessageBody must be at most 1024 characters",
+		},
 		{
 			name:        "should error if RedirectConfig absent for redirect action",
 			actionJSON:  `{"Type": "redirect"}`,
@@ -130,6 +156,21 @@ func TestInvalidIngressActions(t *testing.T) {
 			actionJSON:  `{"Type": "redirect", "RedirectConfig": {"Host": "#{host}"}}`,
 			expectedErr: "invalid RedirectConfig: StatusCode is required",
 		},
+		{
+			name:        "should error if StatusCode of RedirectConfig isn't HTTP_301 or HTTP_302",
+			actionJSON:  `{"Type": "redirect", "RedirectConfig": {"StatusCode": "HTTP_307"}}`,
+			expectedErr: "invalid RedirectConfig: StatusCode must be HTTP_301 or HTTP_302, got HTTP_307",
+		},
+		{
+			name:        "should error if Protocol of RedirectConfig is invalid",
+			actionJSON:  `{"Type": "redirect", "RedirectConfig": {"Protocol": "TCP", "StatusCode": "HTTP_301"}}`,
+			expectedErr: "invalid RedirectConfig: Protocol must be HTTP, HTTPS or #{protocol}, got TCP",
+		},
+		{
+			name:        "should error if Path of RedirectConfig is relative",
+			actionJSON:  `{"Type": "redirect", "RedirectConfig": {"Path": "#{path}", "StatusCode": "HTTP_301"}}`,
+			expectedErr: "invalid RedirectConfig: Path must start with /, got #{path}",
+		},
 		{
 			name:        "should error if both TargetGroupArn and ForwardConfig absent for for forward action",
 			actionJSON:  `{"Type": "forward"}`,
@@ -140,6 +181,26 @@ func TestInvalidIngressActions(t *testing.T) {
 			actionJSON:  `{"Type": "forward", "TargetGroupArn": "tg-1", "ForwardConfig": {"TargetGroups": [{"TargetGroupArn": "tg-2", "weight": 10}]}}`,
 			expectedErr: "precisely one of TargetGroupArn and ForwardConfig can be specified",
 		},
+		{
+			name:        "should error if ForwardConfig has no target groups",
+			actionJSON:  `{"Type": "forward", "ForwardConfig": {"TargetGroups": []}}`,
+			expectedErr: "invalid ForwardConfig: TargetGroups is required",
+		},
+		{
+			name:        "should error if ForwardConfig has more than 5 target groups",
+			actionJSON:  `{"Type": "forward", "ForwardConfig": {"TargetGroups": [{"TargetGroupArn": "tg-1", "Weight": 1}, {"TargetGroupArn": "tg-2", "Weight": 1}, {"TargetGroupArn": "tg-3", "Weight": 1}, {"TargetGroupArn": "tg-4", "Weight": 1}, {"TargetGroupArn": "tg-5", "Weight": 1}, {"TargetGroupArn": "tg-6", "Weight": 1}]}}`,
+			expectedErr: "invalid ForwardConfig: TargetGroups must contain at most 5 target groups, got 6",
+		},
+		{
+			name:        "should error if Weight of a target group is above 999",
+			actionJSON:  `{"Type": "forward", "ForwardConfig": {"TargetGroups": [{"TargetGroupArn": "tg-1", "Weight": 1000}, {"ServiceName": "svc", "ServicePort": "80", "Weight": 0}]}}`,
+			expectedErr: "invalid ForwardConfig: invalid TargetGroupTuple: Weight must be between 0 and 999, got 1000",
+		},
+		{
+			name:        "should error if DurationSeconds of TargetGroupStickinessConfig is out of range",
+			actionJSON:  `{"Type": "forward", "ForwardConfig": {"TargetGroups": [{"TargetGroupArn": "tg-1"}], "TargetGroupStickinessConfig": {"Enabled": true, "DurationSeconds": 0}}}`,
+			expectedErr: "invalid ForwardConfig: invalid TargetGroupStickinessConfig: DurationSeconds must be between 1 and 604800, got 0",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			ing := dummy.NewIngress()
@@ -1,6 +1,9 @@
 package action
 
 import (
+	"regexp"
+	"strings"
+
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
 	"github.com/pkg/errors"
@@ -23,6 +26,24 @@ type FixedResponseActionConfig struct {
 	StatusCode *string
 }
 
+var fixedResponseStatusCodePattern = regexp.MustCompile(`^[245][0-9][0-9]$`)
+
+// maxFixedResponseMessageBodyLength is the maximum length of the message of fixed-response actions.
+const maxFixedResponseMessageBodyLength = 1024
+
+func (c *FixedResponseActionConfig) validate() error {
+	if c.StatusCode == nil {
+		return errors.New("StatusCode is required")
+	}
+	if !fixedResponseStatusCodePattern.MatchString(*c.StatusCode) {
+		return errors.Errorf("StatusCode must be 2XX, 4XX or 5XX, got %v", *c.StatusCode)
+	}
+	if len(aws.StringValue(c.MessageBody)) > maxFixedResponseMessageBodyLength {
+		return errors.Errorf("MessageBody must be at most %v characters", maxFixedResponseMessageBodyLength)
+	}
+	return nil
+}
+
 // Information about an redirect action
 type RedirectActionConfig struct {
 	// The hostname. This component is not percent-encoded. The hostname can contain
@@ -57,6 +78,22 @@ func (c *RedirectActionConfig) validate() error {
 	if c.StatusCode == nil {
 		return errors.New("StatusCode is required")
 	}
+	switch *c.StatusCode {
+	case elbv2.RedirectActionStatusCodeEnumHttp301, elbv2.RedirectActionStatusCodeEnumHttp302:
+	default:
+		return errors.Errorf("StatusCode must be %v or %v, got %v",
+			elbv2.RedirectActionStatusCodeEnumHttp301, elbv2.RedirectActionStatusCodeEnumHttp302, *c.StatusCode)
+	}
+	if c.Protocol != nil {
+		switch *c.Protocol {
+		case elbv2.ProtocolEnumHttp, elbv2.ProtocolEnumHttps, "#{protocol}":
+		default:
+			return errors.Errorf("Protocol must be %v, %v or #{protocol}, got %v", elbv2.ProtocolEnumHttp, elbv2.ProtocolEnumHttps, *c.Protocol)
+		}
+	}
+	if c.Path != nil && !strings.HasPrefix(*c.Path, "/") {
+		return errors.Errorf("Path must start with /, got %v", *c.Path)
+	}
 	return nil
 }
 
@@ -88,6 +125,16 @@ type TargetGroupStickinessConfig struct {
 	Enabled *bool
 }
 
+// maxTargetGroupStickinessDurationSeconds is the maximum duration of target group stickiness, 7 days.
+const maxTargetGroupStickinessDurationSeconds = 604800
+
+func (c *TargetGroupStickinessConfig) validate() error {
+	if c.DurationSeconds != nil && (*c.DurationSeconds < 1 || *c.DurationSeconds > maxTargetGroupStickinessDurationSeconds) {
+		return errors.Errorf("DurationSeconds must be between 1 and %v, got %v", maxTargetGroupStickinessDurationSeconds, *c.DurationSeconds)
+	}
+	return nil
+}
+
 // Information about how traffic will be distributed between multiple target
 // groups in a forward rule.
 type TargetGroupTuple struct {
@@ -104,6 +151,9 @@ type TargetGroupTuple struct {
 	Weight *int64
 }
 
+// maxTargetGroupWeight is the maximum weight of a target group in forward actions.
+const maxTargetGroupWeight = 999
+
 func (t *TargetGroupTuple) validate() error {
 	if (t.TargetGroupArn != nil) == (t.ServiceName != nil) {
 		return errors.New("precisely one of TargetGroupArn and ServiceName can be specified")
@@ -112,6 +162,9 @@ func (t *TargetGroupTuple) validate() error {
 	if t.ServiceName != nil && t.ServicePort == nil {
 		return errors.New("missing ServicePort")
 	}
+	if t.Weight != nil && (*t.Weight < 0 || *t.Weight > maxTargetGroupWeight) {
+		return errors.Errorf("Weight must be between 0 and %v, got %v", maxTargetGroupWeight, *t.Weight)
+	}
 	return nil
 }
 
@@ -125,7 +178,16 @@ type ForwardActionConfig struct {
 	TargetGroups []*TargetGroupTuple
 }
 
+// maxForwardTargetGroups is the maximum number of target groups of forward actions.
+const maxForwardTargetGroups = 5
+
 func (c *ForwardActionConfig) validate() error {
+	if len(c.TargetGroups) == 0 {
+		return errors.New("TargetGroups is required")
+	}
+	if len(c.TargetGroups) > maxForwardTargetGroups {
+		return errors.Errorf("TargetGroups must contain at most %v target groups, got %v", maxForwardTargetGroups, len(c.TargetGroups))
+	}
 	for _, t := range c.TargetGroups {
 		if err := t.validate(); err != nil {
 			return errors.Wrap(err, "invalid TargetGroupTuple")
@@ -138,6 +200,11 @@ func (c *ForwardActionConfig) validate() error {
 			}
 		}
 	}
+	if c.TargetGroupStickinessConfig != nil {
+		if err := c.TargetGroupStickinessConfig.validate(); err != nil {
+			return errors.Wrap(err, "invalid TargetGroupStickinessConfig")
+		}
+	}
 	return nil
 }
 
@@ -175,6 +242,9 @@ func (a *Action) validate() error {
 		if a.FixedResponseConfig == nil {
 			return errors.New("missing FixedResponseConfig")
 		}
+		if err := a.FixedResponseConfig.validate(); err != nil {
+			return errors.Wrap(err, "invalid FixedResponseConfig")
+		}
 	case elbv2.ActionTypeEnumRedirect:
 		if a.RedirectConfig == nil {
 			return errors.New("missing RedirectConfig")
@@ -191,8 +261,14 @@ func (a *Action) validate() error {
 				return errors.Wrap(err, "invalid ForwardConfig")
 			}
 		}
+	case elbv2.ActionTypeEnumAuthenticateOidc, elbv2.ActionTypeEnumAuthenticateCognito:
+		// authenticate actions must be followed by another action, they're built from the auth annotations instead,
+		// which apply to `use-annotation` backends as well.
+		return errors.Errorf("%v actions aren't supported, use the auth-type annotation to authenticate requests before this action", aws.StringValue(a.Type))
+	case "":
+		return errors.New("Type is required")
 	default:
-		return errors.Errorf("unknown action type: %v", *a.Type)
+		return errors.Errorf("unknown action type: %v", aws.StringValue(a.Type))
 	}
 	return nil
 }
@@ -31,6 +31,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/action"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/bluegreen"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/canary"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/conditions"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/healthcheck"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/loadbalancer"
@@ -47,6 +49,8 @@ type Ingress struct {
 	// TODO: found out why the ObjectMeta is needed?
 	metav1.ObjectMeta
 	Action       *action.Config
+	BlueGreen    *bluegreen.Config
+	Canary       *canary.Config
 	Conditions   *conditions.Config
 	HealthCheck  *healthcheck.Config
 	TargetGroup  *targetgroup.Config
@@ -58,6 +62,8 @@ type Ingress struct {
 func NewIngressDummy() *Ingress {
 	return &Ingress{
 		Action:       action.Dummy(),
+		BlueGreen:    &bluegreen.Config{},
+		Canary:       &canary.Config{},
 		HealthCheck:  &healthcheck.Config{},
 		TargetGroup:  targetgroup.Dummy(),
 		LoadBalancer: loadbalancer.Dummy(),
@@ -73,6 +79,8 @@ func (s *Service) Merge(b *Ingress, cfg *config.Configuration) *Service {
 	return &Service{
 		ObjectMeta:   s.ObjectMeta,
 		Action:       s.Action,
+		BlueGreen:    s.BlueGreen,
+		Canary:       s.Canary,
 		Conditions:   s.Conditions,
 		LoadBalancer: s.LoadBalancer,
 		Tags:         s.Tags,
@@ -100,6 +108,8 @@ func NewIngressAnnotationExtractor(cfg resolver.Resolver) Extractor {
 	return Extractor{
 		map[string]parser.IngressAnnotation{
 			"Action":       action.NewParser(),
+			"BlueGreen":    bluegreen.NewParser(),
+			"Canary":       canary.NewParser(),
 			"Conditions":   conditions.NewParser(),
 			"HealthCheck":  healthcheck.NewParser(cfg),
 			"TargetGroup":  targetgroup.NewParser(cfg),
@@ -229,6 +239,21 @@ func LoadInt64Annotation(annotation string, value *int64, annotations ...map[str
 	return true, nil
 }
 
+// LoadFloat64Annotation loads annotation into value of type float64 from list of annotations by priority.
+func LoadFloat64Annotation(annotation string, value *float64, annotations ...map[string]string) (bool, error) {
+	key := parser.GetAnnotationWithPrefix(annotation)
+	raw, ok := utils.MapFindFirst(key, annotations...)
+	if !ok {
+		return false, nil
+	}
+	f, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return true, pkgerrors.Wrapf(err, "failed to parse annotation, %v: %v", key, raw)
+	}
+	*value = f
+	return true, nil
+}
+
 // LoadInt64Annotation loads annotation into value of type JSON from list of annotations by priority.
 func LoadJSONAnnotation(annotation string, value interface{}, annotations ...map[string]string) (bool, error) {
 	key := parser.GetAnnotationWithPrefix(annotation)
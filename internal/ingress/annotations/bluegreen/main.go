@@ -0,0 +1,55 @@
+package bluegreen
+
+import (
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/parser"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/errors"
+	pkgerrors "github.com/pkg/errors"
+)
+
+// AnnotationActiveService names the service receiving the requests to a backend service, as annotation suffix.
+const AnnotationActiveService = "active-service"
+
+type Config struct {
+	// ActiveServices maps backend services to the service their rules forward to.
+	ActiveServices map[string]string
+}
+
+// NewParser creates a new blue/green annotation parser
+func NewParser() parser.IngressAnnotation {
+	return &blueGreenParser{}
+}
+
+type blueGreenParser struct {
+}
+
+// Parse parses the annotations contained in the resource
+func (p *blueGreenParser) Parse(ing parser.AnnotationInterface) (interface{}, error) {
+	activeServices, err := parser.GetStringAnnotations(AnnotationActiveService, ing)
+	if err != nil && !errors.IsMissingAnnotations(err) {
+		return nil, err
+	}
+
+	activeServiceByName := make(map[string]string, len(activeServices))
+	for serviceName, activeServiceName := range activeServices {
+		if activeServiceName == "" {
+			return nil, pkgerrors.Errorf("annotation %v.%v must name a service",
+				parser.GetAnnotationWithPrefix(AnnotationActiveService), serviceName)
+		}
+		activeServiceByName[serviceName] = activeServiceName
+	}
+
+	return &Config{
+		ActiveServices: activeServiceByName,
+	}, nil
+}
+
+// GetActiveService returns the service receiving the requests to serviceName, serviceName itself unless swapped by annotations.
+func (c *Config) GetActiveService(serviceName string) string {
+	if c == nil {
+		return serviceName
+	}
+	if activeServiceName, ok := c.ActiveServices[serviceName]; ok {
+		return activeServiceName
+	}
+	return serviceName
+}
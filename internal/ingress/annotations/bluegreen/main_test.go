@@ -0,0 +1,63 @@
+package bluegreen
+
+import (
+	"testing"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/parser"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/dummy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlueGreenParse(t *testing.T) {
+	for _, tc := range []struct {
+		name                   string
+		annotations            map[string]string
+		expectedActiveServices map[string]string
+		expectedErr            string
+	}{
+		{
+			name:                   "no active service",
+			annotations:            map[string]string{},
+			expectedActiveServices: map[string]string{},
+		},
+		{
+			name: "swapped and rolled back services",
+			annotations: map[string]string{
+				"active-service.web": "web-green",
+				"active-service.api": "api",
+			},
+			expectedActiveServices: map[string]string{
+				"web": "web-green",
+				"api": "api",
+			},
+		},
+		{
+			name:        "empty active service",
+			annotations: map[string]string{"active-service.web": ""},
+			expectedErr: "annotation alb.ingress.kubernetes.io/active-service.web must name a service",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ing := dummy.NewIngress()
+			data := map[string]string{}
+			for k, v := range tc.annotations {
+				data[parser.GetAnnotationWithPrefix(k)] = v
+			}
+			ing.SetAnnotations(data)
+			cfg, err := NewParser().Parse(ing)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedActiveServices, cfg.(*Config).ActiveServices)
+		})
+	}
+}
+
+func TestConfig_GetActiveService(t *testing.T) {
+	cfg := &Config{ActiveServices: map[string]string{"web": "web-green"}}
+	assert.Equal(t, "web-green", cfg.GetActiveService("web"))
+	assert.Equal(t, "api", cfg.GetActiveService("api"))
+	assert.Equal(t, "web", (*Config)(nil).GetActiveService("web"))
+}
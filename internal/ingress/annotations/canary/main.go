@@ -0,0 +1,82 @@
+package canary
+
+import (
+	"strconv"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/parser"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/errors"
+	pkgerrors "github.com/pkg/errors"
+)
+
+const (
+	// AnnotationCanary names the canary service of a backend service, as annotation suffix.
+	AnnotationCanary = "canary"
+	// AnnotationCanaryWeight is the percentage of the requests to a backend service forwarded to its canary service, as annotation suffix.
+	AnnotationCanaryWeight = "canary-weight"
+)
+
+// Canary is the canary service of a backend service, receiving Weight percent of its requests.
+type Canary struct {
+	ServiceName string
+	Weight      int64
+}
+
+type Config struct {
+	Canaries map[string]Canary
+}
+
+// NewParser creates a new canary annotation parser
+func NewParser() parser.IngressAnnotation {
+	return &canaryParser{}
+}
+
+type canaryParser struct {
+}
+
+// Parse parses the annotations contained in the resource
+func (p *canaryParser) Parse(ing parser.AnnotationInterface) (interface{}, error) {
+	canaries, err := parser.GetStringAnnotations(AnnotationCanary, ing)
+	if err != nil && !errors.IsMissingAnnotations(err) {
+		return nil, err
+	}
+	weights, err := parser.GetStringAnnotations(AnnotationCanaryWeight, ing)
+	if err != nil && !errors.IsMissingAnnotations(err) {
+		return nil, err
+	}
+
+	canaryByName := make(map[string]Canary, len(canaries))
+	for serviceName, canaryServiceName := range canaries {
+		if canaryServiceName == "" || canaryServiceName == serviceName {
+			return nil, pkgerrors.Errorf("annotation %v.%v must name another service, got %q",
+				parser.GetAnnotationWithPrefix(AnnotationCanary), serviceName, canaryServiceName)
+		}
+		canaryByName[serviceName] = Canary{ServiceName: canaryServiceName}
+	}
+	for serviceName, rawWeight := range weights {
+		canary, ok := canaryByName[serviceName]
+		if !ok {
+			return nil, pkgerrors.Errorf("annotation %v.%v requires annotation %v.%v",
+				parser.GetAnnotationWithPrefix(AnnotationCanaryWeight), serviceName, parser.GetAnnotationWithPrefix(AnnotationCanary), serviceName)
+		}
+		weight, err := strconv.ParseInt(rawWeight, 10, 64)
+		if err != nil || weight < 0 || weight > 100 {
+			return nil, pkgerrors.Errorf("annotation %v.%v must be a percentage between 0 and 100, got %v",
+				parser.GetAnnotationWithPrefix(AnnotationCanaryWeight), serviceName, rawWeight)
+		}
+		canary.Weight = weight
+		canaryByName[serviceName] = canary
+	}
+
+	return &Config{
+		Canaries: canaryByName,
+	}, nil
+}
+
+// GetCanary returns the canary service of serviceName configured by annotations, if any
+func (c *Config) GetCanary(serviceName string) (Canary, bool) {
+	if c == nil {
+		return Canary{}, false
+	}
+	canary, ok := c.Canaries[serviceName]
+	return canary, ok
+}
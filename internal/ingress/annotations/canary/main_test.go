@@ -0,0 +1,67 @@
+package canary
+
+import (
+	"testing"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/parser"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/dummy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanaryParse(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		annotations      map[string]string
+		expectedCanaries map[string]Canary
+		expectedErr      string
+	}{
+		{
+			name:             "no canary",
+			annotations:      map[string]string{},
+			expectedCanaries: map[string]Canary{},
+		},
+		{
+			name: "canaries with and without weight",
+			annotations: map[string]string{
+				"canary.web":        "web-canary",
+				"canary-weight.web": "20",
+				"canary.api":        "api-v2",
+			},
+			expectedCanaries: map[string]Canary{
+				"web": {ServiceName: "web-canary", Weight: 20},
+				"api": {ServiceName: "api-v2", Weight: 0},
+			},
+		},
+		{
+			name:        "canary of itself",
+			annotations: map[string]string{"canary.web": "web"},
+			expectedErr: `annotation alb.ingress.kubernetes.io/canary.web must name another service, got "web"`,
+		},
+		{
+			name:        "weight without canary",
+			annotations: map[string]string{"canary-weight.web": "20"},
+			expectedErr: "annotation alb.ingress.kubernetes.io/canary-weight.web requires annotation alb.ingress.kubernetes.io/canary.web",
+		},
+		{
+			name:        "weight above 100",
+			annotations: map[string]string{"canary.web": "web-canary", "canary-weight.web": "101"},
+			expectedErr: "annotation alb.ingress.kubernetes.io/canary-weight.web must be a percentage between 0 and 100, got 101",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ing := dummy.NewIngress()
+			data := map[string]string{}
+			for k, v := range tc.annotations {
+				data[parser.GetAnnotationWithPrefix(k)] = v
+			}
+			ing.SetAnnotations(data)
+			cfg, err := NewParser().Parse(ing)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCanaries, cfg.(*Config).Canaries)
+		})
+	}
+}
@@ -17,7 +17,9 @@ limitations under the License.
 package class
 
 import (
+	networking "github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/networking/v1"
 	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/client-go/tools/cache"
 )
 
 const (
@@ -25,14 +27,69 @@ const (
 	annotationKubernetesIngressClass = "kubernetes.io/ingress.class"
 
 	defaultIngressClass = "alb"
+
+	// IngressClassController is the controller of the IngressClass resources implemented by this controller.
+	IngressClassController = "ingress.k8s.aws/alb"
 )
 
+// ingressClasses is the store of IngressClass resources, nil unless they're watched.
+var ingressClasses cache.Store
+
+// SetIngressClassStore makes IsValidIngress match ingresses by the IngressClass resources of store.
+func SetIngressClassStore(store cache.Store) {
+	ingressClasses = store
+}
+
 // If watchIngressClass is empty, then both ingress without class annotation or with class annotation specified as `alb` will be matched.
 // If watchIngressClass is not empty, then only ingress with class annotation specified as watchIngressClass will be matched
+// Once IngressClass resources are watched, an ingress whose class is an IngressClass is only matched if IngressClassController implements it,
+// and an ingress without class annotation takes the class of the default IngressClass if there's one.
 func IsValidIngress(ingressClass string, ingress *extensions.Ingress) bool {
 	actualIngressClass := ingress.GetAnnotations()[annotationKubernetesIngressClass]
+	if ingressClasses != nil {
+		resource := findIngressClass(actualIngressClass)
+		if resource != nil {
+			if resource.Spec.Controller != IngressClassController {
+				return false
+			}
+			return ingressClass == "" || resource.Name == ingressClass
+		}
+	}
 	if ingressClass == "" {
 		return actualIngressClass == "" || actualIngressClass == defaultIngressClass
 	}
 	return actualIngressClass == ingressClass
 }
+
+// ReferencesIngressClass returns whether resource is the class of ingress, explicitly or as default IngressClass.
+func ReferencesIngressClass(ingress *extensions.Ingress, resource *networking.IngressClass) bool {
+	actualIngressClass := ingress.GetAnnotations()[annotationKubernetesIngressClass]
+	if actualIngressClass == "" {
+		return resource.IsDefault()
+	}
+	return actualIngressClass == resource.Name
+}
+
+// findIngressClass returns the IngressClass named name, or the default IngressClass if name is empty.
+// It returns nil if there's no such IngressClass, or several default ones.
+func findIngressClass(name string) *networking.IngressClass {
+	if name != "" {
+		obj, exists, err := ingressClasses.GetByKey(name)
+		if err != nil || !exists {
+			return nil
+		}
+		return obj.(*networking.IngressClass)
+	}
+	var defaultClass *networking.IngressClass
+	for _, obj := range ingressClasses.List() {
+		resource := obj.(*networking.IngressClass)
+		if !resource.IsDefault() {
+			continue
+		}
+		if defaultClass != nil {
+			return nil
+		}
+		defaultClass = resource
+	}
+	return defaultClass
+}
@@ -19,10 +19,12 @@ package class
 import (
 	"testing"
 
+	networking "github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/networking/v1"
 	"github.com/stretchr/testify/assert"
 
 	extensions "k8s.io/api/extensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
 )
 
 func TestIsValidIngress(t *testing.T) {
@@ -161,3 +163,108 @@ func TestIsValidIngress(t *testing.T) {
 		})
 	}
 }
+
+func ingressWithClass(ingressClass string) *extensions.Ingress {
+	ingress := &extensions.Ingress{}
+	if ingressClass != "" {
+		ingress.Annotations = map[string]string{annotationKubernetesIngressClass: ingressClass}
+	}
+	return ingress
+}
+
+func TestIsValidIngress_ingressClassResources(t *testing.T) {
+	newIngressClass := func(name string, controller string, isDefault bool) *networking.IngressClass {
+		ingressClass := &networking.IngressClass{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       networking.IngressClassSpec{Controller: controller},
+		}
+		if isDefault {
+			ingressClass.Annotations = map[string]string{networking.AnnotationIsDefaultIngressClass: "true"}
+		}
+		return ingressClass
+	}
+	for _, tc := range []struct {
+		Name           string
+		IngressClass   string
+		IngressClasses []*networking.IngressClass
+		Ingress        *extensions.Ingress
+		ExpectedValid  bool
+	}{
+		{
+			Name:           "IngressClass not set, matches ingressClass of the controller",
+			IngressClasses: []*networking.IngressClass{newIngressClass("internal", IngressClassController, false)},
+			Ingress:        ingressWithClass("internal"),
+			ExpectedValid:  true,
+		},
+		{
+			Name:           "IngressClass not set, don't match ingressClass of another controller",
+			IngressClasses: []*networking.IngressClass{newIngressClass("alb", "k8s.io/ingress-nginx", false)},
+			Ingress:        ingressWithClass("alb"),
+			ExpectedValid:  false,
+		},
+		{
+			Name:           "IngressClass not set, matches default ingressClass of the controller",
+			IngressClasses: []*networking.IngressClass{newIngressClass("internal", IngressClassController, true)},
+			Ingress:        ingressWithClass(""),
+			ExpectedValid:  true,
+		},
+		{
+			Name:           "IngressClass not set, don't match ingress without ingressClass if default ingressClass is of another controller",
+			IngressClasses: []*networking.IngressClass{newIngressClass("nginx", "k8s.io/ingress-nginx", true)},
+			Ingress:        ingressWithClass(""),
+			ExpectedValid:  false,
+		},
+		{
+			Name:           "IngressClass set to custom, matches ingress without ingressClass if custom is the default ingressClass",
+			IngressClass:   "custom",
+			IngressClasses: []*networking.IngressClass{newIngressClass("custom", IngressClassController, true)},
+			Ingress:        ingressWithClass(""),
+			ExpectedValid:  true,
+		},
+		{
+			Name:         "IngressClass set to custom, don't match ingress without ingressClass if several ingressClasses are default",
+			IngressClass: "custom",
+			IngressClasses: []*networking.IngressClass{
+				newIngressClass("custom", IngressClassController, true),
+				newIngressClass("nginx", "k8s.io/ingress-nginx", true),
+			},
+			Ingress:       ingressWithClass(""),
+			ExpectedValid: false,
+		},
+		{
+			Name:           "IngressClass set to custom, don't match other ingressClass of the controller",
+			IngressClass:   "custom",
+			IngressClasses: []*networking.IngressClass{newIngressClass("internal", IngressClassController, false)},
+			Ingress:        ingressWithClass("internal"),
+			ExpectedValid:  false,
+		},
+		{
+			Name:          "IngressClass set to custom, matches ingressClass of custom without IngressClass resource",
+			IngressClass:  "custom",
+			Ingress:       ingressWithClass("custom"),
+			ExpectedValid: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+			for _, ingressClass := range tc.IngressClasses {
+				assert.NoError(t, store.Add(ingressClass))
+			}
+			SetIngressClassStore(store)
+			defer SetIngressClassStore(nil)
+
+			actualValid := IsValidIngress(tc.IngressClass, tc.Ingress)
+			assert.Equal(t, tc.ExpectedValid, actualValid)
+		})
+	}
+}
+
+func TestReferencesIngressClass(t *testing.T) {
+	ingressClass := &networking.IngressClass{ObjectMeta: metav1.ObjectMeta{Name: "internal"}}
+	assert.True(t, ReferencesIngressClass(ingressWithClass("internal"), ingressClass))
+	assert.False(t, ReferencesIngressClass(ingressWithClass("alb"), ingressClass))
+	assert.False(t, ReferencesIngressClass(ingressWithClass(""), ingressClass))
+
+	ingressClass.Annotations = map[string]string{networking.AnnotationIsDefaultIngressClass: "true"}
+	assert.True(t, ReferencesIngressClass(ingressWithClass(""), ingressClass))
+}
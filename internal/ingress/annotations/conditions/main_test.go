@@ -153,6 +153,21 @@ func TestConditionsParse_Invalid(t *testing.T) {
 			conditionsJSON: `[{"Field": "source-ip"}]`,
 			expectedErr:    "missing SourceIpConfig",
 		},
+		{
+			name:           "should error if HttpHeaderName absent for http-header condition",
+			conditionsJSON: `[{"Field": "http-header", "HttpHeaderConfig": {"Values": ["value"]}}]`,
+			expectedErr:    "invalid HttpHeaderConfig: HttpHeaderName cannot be empty",
+		},
+		{
+			name:           "should error if source-ip condition values aren't CIDRs",
+			conditionsJSON: `[{"Field": "source-ip", "SourceIpConfig": {"Values": ["192.168.0.1"]}}]`,
+			expectedErr:    "invalid SourceIpConfig: Values must be CIDRs, got 192.168.0.1",
+		},
+		{
+			name:           "should error for unknown field",
+			conditionsJSON: `[{"Field": "http-method", "HttpRequestMethodConfig": {"Values": ["GET"]}}]`,
+			expectedErr:    "unknown Field http-method, expected one of host-header, path-pattern, http-header, http-request-method, query-string, source-ip",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			ing := dummy.NewIngress()
@@ -1,6 +1,8 @@
 package conditions
 
 import (
+	"net"
+
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
 	"github.com/pkg/errors"
 )
@@ -60,6 +62,9 @@ type HttpHeaderConditionConfig struct {
 }
 
 func (c *HttpHeaderConditionConfig) validate() error {
+	if aws.StringValue(c.HttpHeaderName) == "" {
+		return errors.New("HttpHeaderName cannot be empty")
+	}
 	if len(c.Values) == 0 {
 		return errors.New("Values cannot be empty")
 	}
@@ -167,6 +172,11 @@ func (c *SourceIpConditionConfig) validate() error {
 	if len(c.Values) == 0 {
 		return errors.New("Values cannot be empty")
 	}
+	for _, value := range c.Values {
+		if _, _, err := net.ParseCIDR(aws.StringValue(value)); err != nil {
+			return errors.Errorf("Values must be CIDRs, got %v", aws.StringValue(value))
+		}
+	}
 	return nil
 }
 
@@ -250,6 +260,9 @@ func (c *RuleCondition) validate() error {
 		if err := c.SourceIpConfig.validate(); err != nil {
 			return errors.Wrap(err, "invalid SourceIpConfig")
 		}
+	default:
+		return errors.Errorf("unknown Field %v, expected one of %v, %v, %v, %v, %v, %v", aws.StringValue(c.Field),
+			FieldHostHeader, FieldPathPattern, FieldHTTPHeader, FieldHTTPRequestMethod, FieldQueryString, FieldSourceIP)
 	}
 	return nil
 }
@@ -20,6 +20,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/golang/glog"
@@ -37,6 +39,8 @@ type PortData struct {
 }
 
 type Config struct {
+	// Type is the type of the LoadBalancer, application or network.
+	Type           *string
 	Scheme         *string
 	IPAddressType  *string
 	WebACLId       *string
@@ -48,6 +52,16 @@ type Config struct {
 	SecurityGroups []string
 	Subnets        []string
 	Attributes     []*elbv2.LoadBalancerAttribute
+
+	// EIPAllocations are the Elastic IPs of an internet-facing network LoadBalancer, one per subnet.
+	EIPAllocations []string
+
+	// ManageBackendSGRules allows traffic from the LoadBalancer on worker nodes when SecurityGroups are specified,
+	// through the backend securityGroup attached alongside them.
+	ManageBackendSGRules bool
+
+	// InboundPrefixLists are the IDs of managed prefix lists allowed to access the LoadBalancer alongside InboundCidrs.
+	InboundPrefixLists []string
 }
 
 type loadBalancer struct {
@@ -57,6 +71,7 @@ type loadBalancer struct {
 const (
 	DefaultIPAddressType = elbv2.IpAddressTypeIpv4
 	DefaultScheme        = elbv2.LoadBalancerSchemeEnumInternal
+	DefaultType          = elbv2.LoadBalancerTypeEnumApplication
 )
 
 // NewParser creates a new target group annotation parser
@@ -84,41 +99,76 @@ func (lb loadBalancer) Parse(ing parser.AnnotationInterface) (interface{}, error
 		return nil, errors.NewInvalidAnnotationContentReason(fmt.Sprintf("ALB scheme must be either `%v` or `%v`", elbv2.LoadBalancerSchemeEnumInternal, elbv2.LoadBalancerSchemeEnumInternetFacing))
 	}
 
-	ports, err := parsePorts(ing)
+	lbType, err := parser.GetStringAnnotation("load-balancer-type", ing)
+	if err != nil {
+		lbType = aws.String(DefaultType)
+	}
+
+	if *lbType != elbv2.LoadBalancerTypeEnumApplication && *lbType != elbv2.LoadBalancerTypeEnumNetwork {
+		return nil, errors.NewInvalidAnnotationContentReason(fmt.Sprintf("LoadBalancer type must be either `%v` or `%v`", elbv2.LoadBalancerTypeEnumApplication, elbv2.LoadBalancerTypeEnumNetwork))
+	}
+
+	ports, err := parsePorts(ing, *lbType)
 	if err != nil {
 		return nil, err
 	}
 
-	attributes, err := parseAttributes(ing)
+	attributes, err := parseAttributes(ing, *lbType)
 	if err != nil {
 		return nil, err
 	}
 
 	securityGroups := parser.GetStringSliceAnnotation("security-groups", ing)
+	manageBackendSGRules, err := parseBoolean(ing, aws.String("manage-backend-security-group-rules"))
+	if err != nil {
+		return nil, err
+	}
 	subnets := parser.GetStringSliceAnnotation("subnets", ing)
 
+	eipAllocations := parser.GetStringSliceAnnotation("eip-allocations", ing)
+	if len(eipAllocations) != 0 {
+		if *lbType != elbv2.LoadBalancerTypeEnumNetwork {
+			return nil, errors.NewInvalidAnnotationContentReason("Elastic IPs are only supported by network LoadBalancers")
+		}
+		if *scheme != elbv2.LoadBalancerSchemeEnumInternetFacing {
+			return nil, errors.NewInvalidAnnotationContentReason("Elastic IPs are only supported by internet-facing LoadBalancers")
+		}
+	}
+
 	shieldAdvanced, err := parseBoolean(ing, aws.String("shield-advanced-protection"))
 	if err != nil {
 		return nil, err
 	}
 
-	v4CIDRs, v6CIDRs, err := parseCidrs(ing)
+	prefixLists := parser.GetStringSliceAnnotation("security-group-prefix-lists", ing)
+	for _, prefixList := range prefixLists {
+		if !strings.HasPrefix(prefixList, "pl-") {
+			return nil, errors.NewInvalidAnnotationContentReason(fmt.Sprintf("invalid prefix list %v, expected a prefix list ID starting with pl-", prefixList))
+		}
+	}
+
+	// all sources are allowed by default, unless prefix lists are specified.
+	v4CIDRs, v6CIDRs, err := parseCidrs(ing, *ipAddressType, len(prefixLists) == 0)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Config{
+		Type:          lbType,
 		Scheme:        scheme,
 		IPAddressType: ipAddressType,
 
-		Attributes:     attributes,
-		InboundCidrs:   v4CIDRs,
-		InboundV6CIDRs: v6CIDRs,
-		Ports:          ports,
-		ShieldAdvanced: shieldAdvanced,
-
-		Subnets:        subnets,
-		SecurityGroups: securityGroups,
+		Attributes:         attributes,
+		InboundCidrs:       v4CIDRs,
+		InboundV6CIDRs:     v6CIDRs,
+		InboundPrefixLists: prefixLists,
+		Ports:              ports,
+		ShieldAdvanced:     shieldAdvanced,
+
+		Subnets:              subnets,
+		SecurityGroups:       securityGroups,
+		ManageBackendSGRules: aws.BoolValue(manageBackendSGRules),
+		EIPAllocations:       eipAllocations,
 	}, nil
 }
 
@@ -133,7 +183,26 @@ func parseBoolean(ing parser.AnnotationInterface, key *string) (*bool, error) {
 	return value, nil
 }
 
-func parseAttributes(ing parser.AnnotationInterface) ([]*elbv2.LoadBalancerAttribute, error) {
+const (
+	deletionProtectionEnabledKey      = "deletion_protection.enabled"
+	idleTimeoutTimeoutSecondsKey      = "idle_timeout.timeout_seconds"
+	dropInvalidHeaderFieldsEnabledKey = "routing.http.drop_invalid_header_fields.enabled"
+	accessLogsS3EnabledKey            = "access_logs.s3.enabled"
+	accessLogsS3BucketKey             = "access_logs.s3.bucket"
+	accessLogsS3PrefixKey             = "access_logs.s3.prefix"
+	loadBalancingCrossZoneEnabledKey  = "load_balancing.cross_zone.enabled"
+	wafFailOpenEnabledKey             = "waf.fail_open.enabled"
+)
+
+const (
+	minIdleTimeoutSeconds = 1
+	maxIdleTimeoutSeconds = 4000
+)
+
+// bucketNameRegexp matches the names of S3 buckets ELBV2 can deliver access logs to.
+var bucketNameRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+
+func parseAttributes(ing parser.AnnotationInterface, lbType string) ([]*elbv2.LoadBalancerAttribute, error) {
 	var badAttrs []string
 	var lbattrs []*elbv2.LoadBalancerAttribute
 
@@ -143,10 +212,6 @@ func parseAttributes(ing parser.AnnotationInterface) ([]*elbv2.LoadBalancerAttri
 		attrs = oldattrs
 	}
 
-	if attrs == nil {
-		return nil, nil
-	}
-
 	for _, attr := range attrs {
 		parts := strings.Split(attr, "=")
 		switch {
@@ -165,22 +230,178 @@ func parseAttributes(ing parser.AnnotationInterface) ([]*elbv2.LoadBalancerAttri
 	if len(badAttrs) > 0 {
 		return nil, fmt.Errorf("unable to parse `%s` into Key=Value pair(s)", strings.Join(badAttrs, ", "))
 	}
+	for _, attr := range lbattrs {
+		if err := validateAttribute(aws.StringValue(attr.Key), aws.StringValue(attr.Value), lbType); err != nil {
+			return nil, err
+		}
+	}
+
+	accessLogsAttrs, err := parseAccessLogs(ing)
+	if err != nil {
+		return nil, err
+	}
+	if len(accessLogsAttrs) != 0 {
+		for _, attr := range lbattrs {
+			switch aws.StringValue(attr.Key) {
+			case accessLogsS3EnabledKey, accessLogsS3BucketKey, accessLogsS3PrefixKey:
+				return nil, errors.NewInvalidAnnotationContentReason(fmt.Sprintf("load balancer attribute %v cannot be combined with the access-logs annotations", aws.StringValue(attr.Key)))
+			}
+		}
+		lbattrs = append(lbattrs, accessLogsAttrs...)
+	}
+
+	deletionProtection, err := parseBoolean(ing, aws.String("deletion-protection-enabled"))
+	if err != nil {
+		return nil, err
+	}
+	if deletionProtection != nil {
+		if lbattrs, err = appendAnnotationAttribute(lbattrs, "deletion-protection-enabled", deletionProtectionEnabledKey, strconv.FormatBool(*deletionProtection)); err != nil {
+			return nil, err
+		}
+	}
+
+	crossZone, err := parseBoolean(ing, aws.String("cross-zone-load-balancing-enabled"))
+	if err != nil {
+		return nil, err
+	}
+	if crossZone != nil {
+		if lbType != elbv2.LoadBalancerTypeEnumNetwork {
+			return nil, errors.NewInvalidAnnotationContentReason("cross-zone-load-balancing-enabled is only supported by network LoadBalancers, application LoadBalancers always balance across zones")
+		}
+		if lbattrs, err = appendAnnotationAttribute(lbattrs, "cross-zone-load-balancing-enabled", loadBalancingCrossZoneEnabledKey, strconv.FormatBool(*crossZone)); err != nil {
+			return nil, err
+		}
+	}
+
+	wafFailOpen, err := parseBoolean(ing, aws.String("wafv2-fail-open-enabled"))
+	if err != nil {
+		return nil, err
+	}
+	if wafFailOpen != nil {
+		if lbType != elbv2.LoadBalancerTypeEnumApplication {
+			return nil, errors.NewInvalidAnnotationContentReason("wafv2-fail-open-enabled is only supported by application LoadBalancers")
+		}
+		if lbattrs, err = appendAnnotationAttribute(lbattrs, "wafv2-fail-open-enabled", wafFailOpenEnabledKey, strconv.FormatBool(*wafFailOpen)); err != nil {
+			return nil, err
+		}
+	}
+
+	idleTimeout, err := parser.GetInt64Annotation("idle-timeout-seconds", ing)
+	if err == nil {
+		if lbType != elbv2.LoadBalancerTypeEnumApplication {
+			return nil, errors.NewInvalidAnnotationContentReason("idle-timeout-seconds is only supported by application LoadBalancers")
+		}
+		if *idleTimeout < minIdleTimeoutSeconds || *idleTimeout > maxIdleTimeoutSeconds {
+			return nil, errors.NewInvalidAnnotationContentReason(fmt.Sprintf("idle-timeout-seconds must be within %v-%v seconds, got %v", minIdleTimeoutSeconds, maxIdleTimeoutSeconds, *idleTimeout))
+		}
+		if lbattrs, err = appendAnnotationAttribute(lbattrs, "idle-timeout-seconds", idleTimeoutTimeoutSecondsKey, strconv.FormatInt(*idleTimeout, 10)); err != nil {
+			return nil, err
+		}
+	} else if err != errors.ErrMissingAnnotations {
+		return nil, err
+	}
 	return lbattrs, nil
 }
 
+// appendAnnotationAttribute appends the attribute set by a structured annotation to attrs,
+// which must not set the same attribute through the load-balancer-attributes annotation.
+func appendAnnotationAttribute(attrs []*elbv2.LoadBalancerAttribute, annotation string, key string, value string) ([]*elbv2.LoadBalancerAttribute, error) {
+	for _, attr := range attrs {
+		if aws.StringValue(attr.Key) == key {
+			return nil, errors.NewInvalidAnnotationContentReason(fmt.Sprintf("load balancer attribute %v cannot be combined with the %v annotation", key, annotation))
+		}
+	}
+	return append(attrs, &elbv2.LoadBalancerAttribute{Key: aws.String(key), Value: aws.String(value)}), nil
+}
+
+// parseAccessLogs returns the access_logs.s3 attributes of the access-logs-enabled, access-logs-s3-bucket and access-logs-s3-prefix
+// annotations, nil when none of them is set. Access logs are enabled by default once a bucket is set.
+func parseAccessLogs(ing parser.AnnotationInterface) ([]*elbv2.LoadBalancerAttribute, error) {
+	enabled, err := parseBoolean(ing, aws.String("access-logs-enabled"))
+	if err != nil {
+		return nil, err
+	}
+	bucket, _ := parser.GetStringAnnotation("access-logs-s3-bucket", ing)
+	prefix, _ := parser.GetStringAnnotation("access-logs-s3-prefix", ing)
+	if enabled == nil && bucket == nil && prefix == nil {
+		return nil, nil
+	}
+	if enabled == nil {
+		enabled = aws.Bool(bucket != nil)
+	}
+
+	if aws.BoolValue(enabled) && bucket == nil {
+		return nil, errors.NewInvalidAnnotationContentReason("access-logs-s3-bucket is required when access logs are enabled")
+	}
+	if bucket != nil && !bucketNameRegexp.MatchString(*bucket) {
+		return nil, errors.NewInvalidAnnotationContent("access-logs-s3-bucket", *bucket)
+	}
+	if prefix != nil && strings.Contains(*prefix, "AWSLogs") {
+		return nil, errors.NewInvalidAnnotationContentReason("access-logs-s3-prefix cannot contain AWSLogs, which is appended to it by ELBV2")
+	}
+
+	attrs := []*elbv2.LoadBalancerAttribute{
+		{Key: aws.String(accessLogsS3EnabledKey), Value: aws.String(strconv.FormatBool(*enabled))},
+	}
+	if bucket != nil {
+		attrs = append(attrs, &elbv2.LoadBalancerAttribute{Key: aws.String(accessLogsS3BucketKey), Value: bucket})
+	}
+	if prefix != nil {
+		attrs = append(attrs, &elbv2.LoadBalancerAttribute{Key: aws.String(accessLogsS3PrefixKey), Value: prefix})
+	}
+	return attrs, nil
+}
+
+// validateAttribute rejects the values of attributes the AttributesController would fail to apply,
+// so that they're reported on the ingress rather than on each reconciliation.
+func validateAttribute(key string, value string, lbType string) error {
+	switch key {
+	case deletionProtectionEnabledKey:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return errors.NewInvalidAnnotationContentReason(fmt.Sprintf("load balancer attribute %v must be true or false, got %v", key, value))
+		}
+	case idleTimeoutTimeoutSecondsKey:
+		seconds, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || seconds < minIdleTimeoutSeconds || seconds > maxIdleTimeoutSeconds {
+			return errors.NewInvalidAnnotationContentReason(fmt.Sprintf("load balancer attribute %v must be within %v-%v seconds, got %v", key, minIdleTimeoutSeconds, maxIdleTimeoutSeconds, value))
+		}
+	case loadBalancingCrossZoneEnabledKey:
+		if lbType != elbv2.LoadBalancerTypeEnumNetwork {
+			return errors.NewInvalidAnnotationContentReason(fmt.Sprintf("load balancer attribute %v is only supported by network LoadBalancers", key))
+		}
+		if _, err := strconv.ParseBool(value); err != nil {
+			return errors.NewInvalidAnnotationContentReason(fmt.Sprintf("load balancer attribute %v must be true or false, got %v", key, value))
+		}
+	case dropInvalidHeaderFieldsEnabledKey, wafFailOpenEnabledKey:
+		if lbType != elbv2.LoadBalancerTypeEnumApplication {
+			return errors.NewInvalidAnnotationContentReason(fmt.Sprintf("load balancer attribute %v is only supported by application LoadBalancers", key))
+		}
+		if _, err := strconv.ParseBool(value); err != nil {
+			return errors.NewInvalidAnnotationContentReason(fmt.Sprintf("load balancer attribute %v must be true or false, got %v", key, value))
+		}
+	}
+	return nil
+}
+
 // parsePorts takes a JSON array describing what ports and protocols should be used. When the JSON
 // is empty, implying the annotation was not present, desired ports are set to the default. The
 // default port value is 80 when a certArn is not present and 443 when it is.
-func parsePorts(ing parser.AnnotationInterface) ([]PortData, error) {
+// Network LoadBalancers listen over TCP, or TLS in place of HTTPS.
+func parsePorts(ing parser.AnnotationInterface, lbType string) ([]PortData, error) {
+	plainProtocol, secureProtocol := elbv2.ProtocolEnumHttp, elbv2.ProtocolEnumHttps
+	if lbType == elbv2.LoadBalancerTypeEnumNetwork {
+		plainProtocol, secureProtocol = elbv2.ProtocolEnumTcp, elbv2.ProtocolEnumTls
+	}
+
 	lps := []PortData{}
 	p, err := parser.GetStringAnnotation("listen-ports", ing)
 	if err != nil {
 		// If port data is empty, default to port 80 or 443 contingent on whether a certArn was specified.
 		_, err = parser.GetStringAnnotation("certificate-arn", ing)
 		if err != nil {
-			lps = append(lps, PortData{int64(80), elbv2.ProtocolEnumHttp})
+			lps = append(lps, PortData{int64(80), plainProtocol})
 		} else {
-			lps = append(lps, PortData{int64(443), elbv2.ProtocolEnumHttps})
+			lps = append(lps, PortData{int64(443), secureProtocol})
 		}
 		return lps, nil
 	}
@@ -202,12 +423,12 @@ func parsePorts(ing parser.AnnotationInterface) ([]PortData, error) {
 				return nil, fmt.Errorf("Invalid port provided. Must be between 1 and 65535. It was %d", v)
 			}
 			switch {
-			case k == elbv2.ProtocolEnumHttp:
+			case k == plainProtocol:
 				lps = append(lps, PortData{v, k})
-			case k == elbv2.ProtocolEnumHttps:
+			case k == secureProtocol:
 				lps = append(lps, PortData{v, k})
 			default:
-				return nil, fmt.Errorf("Invalid protocol provided. Must be HTTP or HTTPS and in order to use HTTPS you must have specified a certificate ARN")
+				return nil, fmt.Errorf("Invalid protocol provided. Must be %v or %v and in order to use %v you must have specified a certificate ARN", plainProtocol, secureProtocol, secureProtocol)
 			}
 		}
 	}
@@ -215,7 +436,8 @@ func parsePorts(ing parser.AnnotationInterface) ([]PortData, error) {
 	return lps, nil
 }
 
-func parseCidrs(ing parser.AnnotationInterface) (v4CIDRs, v6CIDRs []string, err error) {
+// parseCidrs parses the inbound CIDRs, which default to all addresses of ipAddressType when defaultAll is true.
+func parseCidrs(ing parser.AnnotationInterface, ipAddressType string, defaultAll bool) (v4CIDRs, v6CIDRs []string, err error) {
 	cidrConfig := parser.GetStringSliceAnnotation("security-group-inbound-cidrs", ing)
 	if len(cidrConfig) != 0 {
 		glog.Warningf("`security-group-inbound-cidrs` annotation is deprecated, use `inbound-cidrs` instead")
@@ -236,11 +458,25 @@ func parseCidrs(ing parser.AnnotationInterface) (v4CIDRs, v6CIDRs []string, err
 		}
 	}
 
-	if len(v4CIDRs) == 0 && len(v6CIDRs) == 0 {
+	ipv6CIDRs := parser.GetStringSliceAnnotation("inbound-ipv6-cidrs", ing)
+	if len(ipv6CIDRs) != 0 && ipAddressType != elbv2.IpAddressTypeDualstack {
+		return v4CIDRs, v6CIDRs, errors.NewInvalidAnnotationContentReason(fmt.Sprintf("IPv6 CIDRs are only supported by `%v` LoadBalancers", elbv2.IpAddressTypeDualstack))
+	}
+	for _, inboundCidr := range ipv6CIDRs {
+		ip, _, err := net.ParseCIDR(inboundCidr)
+		if err != nil {
+			return v4CIDRs, v6CIDRs, err
+		}
+		if ip.To4() != nil {
+			return v4CIDRs, v6CIDRs, errors.NewInvalidAnnotationContentReason(fmt.Sprintf("%v isn't an IPv6 CIDR, specify it in inbound-cidrs", inboundCidr))
+		}
+		v6CIDRs = append(v6CIDRs, inboundCidr)
+	}
+
+	if defaultAll && len(v4CIDRs) == 0 && len(v6CIDRs) == 0 {
 		v4CIDRs = append(v4CIDRs, "0.0.0.0/0")
 
-		addrType, _ := parser.GetStringAnnotation("ip-address-type", ing)
-		if addrType != nil && *addrType == elbv2.IpAddressTypeDualstack {
+		if ipAddressType == elbv2.IpAddressTypeDualstack {
 			v6CIDRs = append(v6CIDRs, "::/0")
 		}
 	}
@@ -250,6 +486,7 @@ func parseCidrs(ing parser.AnnotationInterface) (v4CIDRs, v6CIDRs []string, err
 
 func Dummy() *Config {
 	return &Config{
+		Type:          aws.String(elbv2.LoadBalancerTypeEnumApplication),
 		Scheme:        aws.String(elbv2.LoadBalancerSchemeEnumInternal),
 		IPAddressType: aws.String(elbv2.IpAddressTypeIpv4),
 		Ports: []PortData{
@@ -1 +1,487 @@
 package loadbalancer
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/resolver"
+	"github.com/stretchr/testify/assert"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParse_loadBalancerType(t *testing.T) {
+	for _, tc := range []struct {
+		Name          string
+		Annotations   map[string]string
+		ExpectedType  string
+		ExpectedPorts []PortData
+		ExpectedError string
+	}{
+		{
+			Name:          "application by default",
+			Annotations:   map[string]string{},
+			ExpectedType:  elbv2.LoadBalancerTypeEnumApplication,
+			ExpectedPorts: []PortData{{Port: 80, Scheme: elbv2.ProtocolEnumHttp}},
+		},
+		{
+			Name:          "network listens over TCP by default",
+			Annotations:   map[string]string{"alb.ingress.kubernetes.io/load-balancer-type": "network"},
+			ExpectedType:  elbv2.LoadBalancerTypeEnumNetwork,
+			ExpectedPorts: []PortData{{Port: 80, Scheme: elbv2.ProtocolEnumTcp}},
+		},
+		{
+			Name: "network listens over TLS with certificates",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/load-balancer-type": "network",
+				"alb.ingress.kubernetes.io/certificate-arn":    "arn:aws:acm:us-west-2:123456789012:certificate/abc",
+			},
+			ExpectedType:  elbv2.LoadBalancerTypeEnumNetwork,
+			ExpectedPorts: []PortData{{Port: 443, Scheme: elbv2.ProtocolEnumTls}},
+		},
+		{
+			Name: "network listen-ports",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/load-balancer-type": "network",
+				"alb.ingress.kubernetes.io/listen-ports":       `[{"TCP": 5432}, {"TLS": 443}]`,
+			},
+			ExpectedType:  elbv2.LoadBalancerTypeEnumNetwork,
+			ExpectedPorts: []PortData{{Port: 5432, Scheme: elbv2.ProtocolEnumTcp}, {Port: 443, Scheme: elbv2.ProtocolEnumTls}},
+		},
+		{
+			Name: "network doesn't listen over HTTP",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/load-balancer-type": "network",
+				"alb.ingress.kubernetes.io/listen-ports":       `[{"HTTP": 80}]`,
+			},
+			ExpectedError: "Invalid protocol provided. Must be TCP or TLS and in order to use TLS you must have specified a certificate ARN",
+		},
+		{
+			Name:          "application doesn't listen over TCP",
+			Annotations:   map[string]string{"alb.ingress.kubernetes.io/listen-ports": `[{"TCP": 80}]`},
+			ExpectedError: "Invalid protocol provided. Must be HTTP or HTTPS and in order to use HTTPS you must have specified a certificate ARN",
+		},
+		{
+			Name:          "unknown type",
+			Annotations:   map[string]string{"alb.ingress.kubernetes.io/load-balancer-type": "gateway"},
+			ExpectedError: "LoadBalancer type must be either `application` or `network`",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			ing := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ingress", Annotations: tc.Annotations}}
+			cfg, err := NewParser(resolver.Mock{}).Parse(ing)
+			if tc.ExpectedError != "" {
+				assert.EqualError(t, err, tc.ExpectedError)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.ExpectedType, aws.StringValue(cfg.(*Config).Type))
+				assert.Equal(t, tc.ExpectedPorts, cfg.(*Config).Ports)
+			}
+		})
+	}
+}
+
+func TestParse_attributes(t *testing.T) {
+	for _, tc := range []struct {
+		Name               string
+		Annotations        map[string]string
+		ExpectedAttributes []*elbv2.LoadBalancerAttribute
+		ExpectedError      string
+	}{
+		{
+			Name:        "drop invalid header fields",
+			Annotations: map[string]string{"alb.ingress.kubernetes.io/load-balancer-attributes": "routing.http.drop_invalid_header_fields.enabled=true"},
+			ExpectedAttributes: []*elbv2.LoadBalancerAttribute{
+				{Key: aws.String("routing.http.drop_invalid_header_fields.enabled"), Value: aws.String("true")},
+			},
+		},
+		{
+			Name:          "drop invalid header fields isn't a boolean",
+			Annotations:   map[string]string{"alb.ingress.kubernetes.io/load-balancer-attributes": "routing.http.drop_invalid_header_fields.enabled=yes please"},
+			ExpectedError: "load balancer attribute routing.http.drop_invalid_header_fields.enabled must be true or false, got yes please",
+		},
+		{
+			Name: "network LoadBalancers don't drop invalid header fields",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/load-balancer-type":       "network",
+				"alb.ingress.kubernetes.io/load-balancer-attributes": "routing.http.drop_invalid_header_fields.enabled=true",
+			},
+			ExpectedError: "load balancer attribute routing.http.drop_invalid_header_fields.enabled is only supported by application LoadBalancers",
+		},
+		{
+			Name: "access logs",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/load-balancer-attributes": "idle_timeout.timeout_seconds=600",
+				"alb.ingress.kubernetes.io/access-logs-s3-bucket":    "my-access-logs",
+				"alb.ingress.kubernetes.io/access-logs-s3-prefix":    "my-app",
+			},
+			ExpectedAttributes: []*elbv2.LoadBalancerAttribute{
+				{Key: aws.String("idle_timeout.timeout_seconds"), Value: aws.String("600")},
+				{Key: aws.String("access_logs.s3.enabled"), Value: aws.String("true")},
+				{Key: aws.String("access_logs.s3.bucket"), Value: aws.String("my-access-logs")},
+				{Key: aws.String("access_logs.s3.prefix"), Value: aws.String("my-app")},
+			},
+		},
+		{
+			Name: "access logs disabled",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/access-logs-enabled":   "false",
+				"alb.ingress.kubernetes.io/access-logs-s3-bucket": "my-access-logs",
+			},
+			ExpectedAttributes: []*elbv2.LoadBalancerAttribute{
+				{Key: aws.String("access_logs.s3.enabled"), Value: aws.String("false")},
+				{Key: aws.String("access_logs.s3.bucket"), Value: aws.String("my-access-logs")},
+			},
+		},
+		{
+			Name:          "access logs without bucket",
+			Annotations:   map[string]string{"alb.ingress.kubernetes.io/access-logs-enabled": "true"},
+			ExpectedError: "access-logs-s3-bucket is required when access logs are enabled",
+		},
+		{
+			Name:          "invalid bucket name",
+			Annotations:   map[string]string{"alb.ingress.kubernetes.io/access-logs-s3-bucket": "My_Bucket"},
+			ExpectedError: "the annotation access-logs-s3-bucket does not contain a valid value (My_Bucket)",
+		},
+		{
+			Name: "AWSLogs prefix",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/access-logs-s3-bucket": "my-access-logs",
+				"alb.ingress.kubernetes.io/access-logs-s3-prefix": "AWSLogs/my-app",
+			},
+			ExpectedError: "access-logs-s3-prefix cannot contain AWSLogs, which is appended to it by ELBV2",
+		},
+		{
+			Name: "access logs set by both attributes and annotations",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/load-balancer-attributes": "access_logs.s3.enabled=true,access_logs.s3.bucket=other",
+				"alb.ingress.kubernetes.io/access-logs-s3-bucket":    "my-access-logs",
+			},
+			ExpectedError: "load balancer attribute access_logs.s3.enabled cannot be combined with the access-logs annotations",
+		},
+		{
+			Name: "deletion protection",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/load-balancer-type":          "network",
+				"alb.ingress.kubernetes.io/deletion-protection-enabled": "true",
+			},
+			ExpectedAttributes: []*elbv2.LoadBalancerAttribute{
+				{Key: aws.String("deletion_protection.enabled"), Value: aws.String("true")},
+			},
+		},
+		{
+			Name:          "deletion protection isn't a boolean",
+			Annotations:   map[string]string{"alb.ingress.kubernetes.io/deletion-protection-enabled": "on"},
+			ExpectedError: "the annotation alb.ingress.kubernetes.io/deletion-protection-enabled does not contain a valid value (on)",
+		},
+		{
+			Name: "deletion protection set by both attributes and annotations",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/load-balancer-attributes":    "deletion_protection.enabled=false",
+				"alb.ingress.kubernetes.io/deletion-protection-enabled": "true",
+			},
+			ExpectedError: "load balancer attribute deletion_protection.enabled cannot be combined with the deletion-protection-enabled annotation",
+		},
+		{
+			Name: "cross-zone load balancing",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/load-balancer-type":                "network",
+				"alb.ingress.kubernetes.io/cross-zone-load-balancing-enabled": "true",
+			},
+			ExpectedAttributes: []*elbv2.LoadBalancerAttribute{
+				{Key: aws.String("load_balancing.cross_zone.enabled"), Value: aws.String("true")},
+			},
+		},
+		{
+			Name:          "application LoadBalancers always balance across zones",
+			Annotations:   map[string]string{"alb.ingress.kubernetes.io/cross-zone-load-balancing-enabled": "false"},
+			ExpectedError: "cross-zone-load-balancing-enabled is only supported by network LoadBalancers, application LoadBalancers always balance across zones",
+		},
+		{
+			Name:          "cross-zone load balancing attribute of an application LoadBalancer",
+			Annotations:   map[string]string{"alb.ingress.kubernetes.io/load-balancer-attributes": "load_balancing.cross_zone.enabled=false"},
+			ExpectedError: "load balancer attribute load_balancing.cross_zone.enabled is only supported by network LoadBalancers",
+		},
+		{
+			Name: "cross-zone load balancing set by both attributes and annotations",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/load-balancer-type":                "network",
+				"alb.ingress.kubernetes.io/load-balancer-attributes":          "load_balancing.cross_zone.enabled=false",
+				"alb.ingress.kubernetes.io/cross-zone-load-balancing-enabled": "true",
+			},
+			ExpectedError: "load balancer attribute load_balancing.cross_zone.enabled cannot be combined with the cross-zone-load-balancing-enabled annotation",
+		},
+		{
+			Name:        "WAFv2 fail open",
+			Annotations: map[string]string{"alb.ingress.kubernetes.io/wafv2-fail-open-enabled": "true"},
+			ExpectedAttributes: []*elbv2.LoadBalancerAttribute{
+				{Key: aws.String("waf.fail_open.enabled"), Value: aws.String("true")},
+			},
+		},
+		{
+			Name: "WAFv2 fail open of a network LoadBalancer",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/load-balancer-type":      "network",
+				"alb.ingress.kubernetes.io/wafv2-fail-open-enabled": "true",
+			},
+			ExpectedError: "wafv2-fail-open-enabled is only supported by application LoadBalancers",
+		},
+		{
+			Name: "WAFv2 fail open set by both attributes and annotations",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/load-balancer-attributes": "waf.fail_open.enabled=false",
+				"alb.ingress.kubernetes.io/wafv2-fail-open-enabled":  "true",
+			},
+			ExpectedError: "load balancer attribute waf.fail_open.enabled cannot be combined with the wafv2-fail-open-enabled annotation",
+		},
+		{
+			Name:        "idle timeout",
+			Annotations: map[string]string{"alb.ingress.kubernetes.io/idle-timeout-seconds": "300"},
+			ExpectedAttributes: []*elbv2.LoadBalancerAttribute{
+				{Key: aws.String("idle_timeout.timeout_seconds"), Value: aws.String("300")},
+			},
+		},
+		{
+			Name:          "idle timeout out of range",
+			Annotations:   map[string]string{"alb.ingress.kubernetes.io/idle-timeout-seconds": "4001"},
+			ExpectedError: "idle-timeout-seconds must be within 1-4000 seconds, got 4001",
+		},
+		{
+			Name:          "idle timeout attribute out of range",
+			Annotations:   map[string]string{"alb.ingress.kubernetes.io/load-balancer-attributes": "idle_timeout.timeout_seconds=0"},
+			ExpectedError: "load balancer attribute idle_timeout.timeout_seconds must be within 1-4000 seconds, got 0",
+		},
+		{
+			Name: "network LoadBalancers have no idle timeout",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/load-balancer-type":   "network",
+				"alb.ingress.kubernetes.io/idle-timeout-seconds": "300",
+			},
+			ExpectedError: "idle-timeout-seconds is only supported by application LoadBalancers",
+		},
+		{
+			Name: "idle timeout set by both attributes and annotations",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/load-balancer-attributes": "idle_timeout.timeout_seconds=600",
+				"alb.ingress.kubernetes.io/idle-timeout-seconds":     "300",
+			},
+			ExpectedError: "load balancer attribute idle_timeout.timeout_seconds cannot be combined with the idle-timeout-seconds annotation",
+		},
+		{
+			Name:          "not a Key=Value pair",
+			Annotations:   map[string]string{"alb.ingress.kubernetes.io/load-balancer-attributes": "routing.http2.enabled"},
+			ExpectedError: "unable to parse `routing.http2.enabled` into Key=Value pair(s)",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			ing := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ingress", Annotations: tc.Annotations}}
+			cfg, err := NewParser(resolver.Mock{}).Parse(ing)
+			if tc.ExpectedError != "" {
+				assert.EqualError(t, err, tc.ExpectedError)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.ExpectedAttributes, cfg.(*Config).Attributes)
+			}
+		})
+	}
+}
+
+func TestParse_eipAllocations(t *testing.T) {
+	for _, tc := range []struct {
+		Name                   string
+		Annotations            map[string]string
+		ExpectedEIPAllocations []string
+		ExpectedError          string
+	}{
+		{
+			Name: "internet-facing network",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/load-balancer-type": "network",
+				"alb.ingress.kubernetes.io/scheme":             "internet-facing",
+				"alb.ingress.kubernetes.io/eip-allocations":    "eipalloc-a, eipalloc-b",
+			},
+			ExpectedEIPAllocations: []string{"eipalloc-a", "eipalloc-b"},
+		},
+		{
+			Name: "internal network",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/load-balancer-type": "network",
+				"alb.ingress.kubernetes.io/eip-allocations":    "eipalloc-a,eipalloc-b",
+			},
+			ExpectedError: "Elastic IPs are only supported by internet-facing LoadBalancers",
+		},
+		{
+			Name: "application",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/scheme":          "internet-facing",
+				"alb.ingress.kubernetes.io/eip-allocations": "eipalloc-a,eipalloc-b",
+			},
+			ExpectedError: "Elastic IPs are only supported by network LoadBalancers",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			ing := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ingress", Annotations: tc.Annotations}}
+			cfg, err := NewParser(resolver.Mock{}).Parse(ing)
+			if tc.ExpectedError != "" {
+				assert.EqualError(t, err, tc.ExpectedError)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.ExpectedEIPAllocations, cfg.(*Config).EIPAllocations)
+			}
+		})
+	}
+}
+
+func TestParse_manageBackendSGRules(t *testing.T) {
+	for _, tc := range []struct {
+		Name                         string
+		Annotations                  map[string]string
+		ExpectedManageBackendSGRules bool
+		ExpectedError                bool
+	}{
+		{
+			Name:        "unspecified",
+			Annotations: map[string]string{"alb.ingress.kubernetes.io/security-groups": "sg-a"},
+		},
+		{
+			Name: "enabled",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/security-groups":                     "sg-a",
+				"alb.ingress.kubernetes.io/manage-backend-security-group-rules": "true",
+			},
+			ExpectedManageBackendSGRules: true,
+		},
+		{
+			Name: "invalid",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/security-groups":                     "sg-a",
+				"alb.ingress.kubernetes.io/manage-backend-security-group-rules": "yes please",
+			},
+			ExpectedError: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			ing := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ingress", Annotations: tc.Annotations}}
+			cfg, err := NewParser(resolver.Mock{}).Parse(ing)
+			if tc.ExpectedError {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.ExpectedManageBackendSGRules, cfg.(*Config).ManageBackendSGRules)
+			}
+		})
+	}
+}
+
+func TestParse_prefixLists(t *testing.T) {
+	for _, tc := range []struct {
+		Name                string
+		Annotations         map[string]string
+		ExpectedPrefixLists []string
+		ExpectedCIDRs       []string
+		ExpectedError       string
+	}{
+		{
+			Name:          "unspecified",
+			ExpectedCIDRs: []string{"0.0.0.0/0"},
+		},
+		{
+			Name:                "prefix lists only",
+			Annotations:         map[string]string{"alb.ingress.kubernetes.io/security-group-prefix-lists": "pl-a, pl-b"},
+			ExpectedPrefixLists: []string{"pl-a", "pl-b"},
+		},
+		{
+			Name: "prefix lists and CIDRs",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/security-group-prefix-lists": "pl-a",
+				"alb.ingress.kubernetes.io/inbound-cidrs":               "10.0.0.0/8",
+			},
+			ExpectedPrefixLists: []string{"pl-a"},
+			ExpectedCIDRs:       []string{"10.0.0.0/8"},
+		},
+		{
+			Name:          "invalid prefix list",
+			Annotations:   map[string]string{"alb.ingress.kubernetes.io/security-group-prefix-lists": "corporate"},
+			ExpectedError: "invalid prefix list corporate, expected a prefix list ID starting with pl-",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			ing := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ingress", Annotations: tc.Annotations}}
+			cfg, err := NewParser(resolver.Mock{}).Parse(ing)
+			if tc.ExpectedError != "" {
+				assert.EqualError(t, err, tc.ExpectedError)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.ExpectedPrefixLists, cfg.(*Config).InboundPrefixLists)
+				assert.Equal(t, tc.ExpectedCIDRs, cfg.(*Config).InboundCidrs)
+			}
+		})
+	}
+}
+
+func TestParse_ipv6CIDRs(t *testing.T) {
+	for _, tc := range []struct {
+		Name          string
+		Annotations   map[string]string
+		ExpectedCIDRs []string
+		ExpectedV6    []string
+		ExpectedError string
+	}{
+		{
+			Name:          "dualstack defaults",
+			Annotations:   map[string]string{"alb.ingress.kubernetes.io/ip-address-type": "dualstack"},
+			ExpectedCIDRs: []string{"0.0.0.0/0"},
+			ExpectedV6:    []string{"::/0"},
+		},
+		{
+			Name: "restricted IPv6",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/ip-address-type":    "dualstack",
+				"alb.ingress.kubernetes.io/inbound-cidrs":      "10.0.0.0/8",
+				"alb.ingress.kubernetes.io/inbound-ipv6-cidrs": "2001:db8::/32, 2001:db9::/32",
+			},
+			ExpectedCIDRs: []string{"10.0.0.0/8"},
+			ExpectedV6:    []string{"2001:db8::/32", "2001:db9::/32"},
+		},
+		{
+			Name: "IPv6 only",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/ip-address-type":    "dualstack",
+				"alb.ingress.kubernetes.io/inbound-ipv6-cidrs": "2001:db8::/32",
+			},
+			ExpectedV6: []string{"2001:db8::/32"},
+		},
+		{
+			Name: "IPv4 CIDR",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/ip-address-type":    "dualstack",
+				"alb.ingress.kubernetes.io/inbound-ipv6-cidrs": "10.0.0.0/8",
+			},
+			ExpectedError: "10.0.0.0/8 isn't an IPv6 CIDR, specify it in inbound-cidrs",
+		},
+		{
+			Name:          "ipv4 LoadBalancer",
+			Annotations:   map[string]string{"alb.ingress.kubernetes.io/inbound-ipv6-cidrs": "2001:db8::/32"},
+			ExpectedError: "IPv6 CIDRs are only supported by `dualstack` LoadBalancers",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			ing := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ingress", Annotations: tc.Annotations}}
+			cfg, err := NewParser(resolver.Mock{}).Parse(ing)
+			if tc.ExpectedError != "" {
+				assert.EqualError(t, err, tc.ExpectedError)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.ExpectedCIDRs, cfg.(*Config).InboundCidrs)
+				assert.Equal(t, tc.ExpectedV6, cfg.(*Config).InboundV6CIDRs)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package targetgroup
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/config"
@@ -27,15 +28,19 @@ import (
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/parser"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/errors"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/resolver"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 type Config struct {
 	Attributes              []*elbv2.TargetGroupAttribute
 	BackendProtocol         *string
 	HealthyThresholdCount   *int64
+	ProtocolVersion         *string
 	SuccessCodes            *string
 	TargetType              *string
 	UnhealthyThresholdCount *int64
+	// TargetNodeLabels selects the nodes registered as instance targets, nil selects all of them.
+	TargetNodeLabels labels.Selector
 }
 
 type targetGroup struct {
@@ -47,6 +52,23 @@ const (
 	DefaultHealthyThresholdCount   = 2
 	DefaultUnhealthyThresholdCount = 2
 	DefaultSuccessCodes            = "200"
+	DefaultProtocolVersion         = ProtocolVersionHTTP1
+	// DefaultGRPCSuccessCodes replaces DefaultSuccessCodes for gRPC targets, which health checks expect to answer UNIMPLEMENTED.
+	DefaultGRPCSuccessCodes = "12"
+)
+
+// The protocol versions of targetGroups, elbv2 has no enum for them.
+const (
+	ProtocolVersionHTTP1 = "HTTP1"
+	ProtocolVersionHTTP2 = "HTTP2"
+	ProtocolVersionGRPC  = "GRPC"
+)
+
+const (
+	// minSuccessCode and maxSuccessCode bound the success codes of all targetGroups, from the grpc-status codes of gRPC
+	// health checks up to the HTTP codes of the HTTP health checks of network LoadBalancers.
+	minSuccessCode = 0
+	maxSuccessCode = 599
 )
 
 // NewParser creates a new target group annotation parser
@@ -62,14 +84,27 @@ func (tg targetGroup) Parse(ing parser.AnnotationInterface) (interface{}, error)
 	if err != nil {
 		targetType = aws.String(cfg.DefaultTargetType)
 	}
+	targetType = aws.String(cfg.ResolveTargetType(*targetType))
 
 	if *targetType != elbv2.TargetTypeEnumInstance && *targetType != elbv2.TargetTypeEnumIp {
 		return "", errors.NewInvalidAnnotationContent("target-type", *targetType)
 	}
 
+	// backendProtocol is left nil when unspecified, so that the HTTP backend-protocol of a service overrides the HTTPS one of its ingress.
 	backendProtocol, err := parser.GetStringAnnotation("backend-protocol", ing)
+	if err == nil && *backendProtocol != elbv2.ProtocolEnumHttp && *backendProtocol != elbv2.ProtocolEnumHttps {
+		return nil, errors.NewInvalidAnnotationContent("backend-protocol", *backendProtocol)
+	}
+
+	protocolVersion, err := parser.GetStringAnnotation("backend-protocol-version", ing)
 	if err != nil {
-		backendProtocol = aws.String(DefaultBackendProtocol)
+		protocolVersion = aws.String(DefaultProtocolVersion)
+	}
+
+	switch *protocolVersion {
+	case ProtocolVersionHTTP1, ProtocolVersionHTTP2, ProtocolVersionGRPC:
+	default:
+		return nil, errors.NewInvalidAnnotationContent("backend-protocol-version", *protocolVersion)
 	}
 
 	healthyThresholdCount, err := parser.GetInt64Annotation("healthy-threshold-count", ing)
@@ -92,19 +127,31 @@ func (tg targetGroup) Parse(ing parser.AnnotationInterface) (interface{}, error)
 	if err == nil {
 		successCodes = s
 	}
+	if successCodes, err = normalizeSuccessCodes(successCodes); err != nil {
+		return nil, err
+	}
 
 	attributes, err := parseAttributes(ing)
 	if err != nil {
 		return nil, err
 	}
 
+	var targetNodeLabels labels.Selector
+	if rawTargetNodeLabels, err := parser.GetStringAnnotation("target-node-labels", ing); err == nil {
+		if targetNodeLabels, err = labels.Parse(*rawTargetNodeLabels); err != nil {
+			return nil, errors.NewInvalidAnnotationContentReason(fmt.Sprintf("invalid target-node-labels %v: %v", *rawTargetNodeLabels, err))
+		}
+	}
+
 	return &Config{
 		TargetType:              targetType,
 		BackendProtocol:         backendProtocol,
+		ProtocolVersion:         protocolVersion,
 		HealthyThresholdCount:   healthyThresholdCount,
 		UnhealthyThresholdCount: unhealthyThresholdCount,
 		SuccessCodes:            successCodes,
 		Attributes:              attributes,
+		TargetNodeLabels:        targetNodeLabels,
 	}, nil
 }
 
@@ -114,15 +161,32 @@ func (a *Config) Merge(b *Config, cfg *config.Configuration) *Config {
 	if attributes == nil {
 		attributes = b.Attributes
 	}
+	targetNodeLabels := a.TargetNodeLabels
+	if targetNodeLabels == nil {
+		targetNodeLabels = b.TargetNodeLabels
+	}
 
 	return &Config{
 		Attributes:              attributes,
-		BackendProtocol:         parser.MergeString(a.BackendProtocol, b.BackendProtocol, DefaultBackendProtocol),
-		TargetType:              parser.MergeString(a.TargetType, b.TargetType, cfg.DefaultTargetType),
+		BackendProtocol:         mergeBackendProtocol(a.BackendProtocol, b.BackendProtocol),
+		ProtocolVersion:         parser.MergeString(a.ProtocolVersion, b.ProtocolVersion, DefaultProtocolVersion),
+		TargetType:              parser.MergeString(a.TargetType, b.TargetType, cfg.ResolveTargetType(cfg.DefaultTargetType)),
 		SuccessCodes:            parser.MergeString(a.SuccessCodes, b.SuccessCodes, DefaultSuccessCodes),
 		HealthyThresholdCount:   parser.MergeInt64(a.HealthyThresholdCount, b.HealthyThresholdCount, DefaultHealthyThresholdCount),
 		UnhealthyThresholdCount: parser.MergeInt64(a.UnhealthyThresholdCount, b.UnhealthyThresholdCount, DefaultUnhealthyThresholdCount),
+		TargetNodeLabels:        targetNodeLabels,
+	}
+}
+
+// mergeBackendProtocol returns a if it is defined, even with the default value, then b, and defaults to DefaultBackendProtocol.
+func mergeBackendProtocol(a, b *string) *string {
+	if a != nil {
+		return a
 	}
+	if b != nil {
+		return b
+	}
+	return aws.String(DefaultBackendProtocol)
 }
 
 func parseAttributes(ing parser.AnnotationInterface) ([]*elbv2.TargetGroupAttribute, error) {
@@ -148,13 +212,150 @@ func parseAttributes(ing parser.AnnotationInterface) ([]*elbv2.TargetGroupAttrib
 	if len(invalid) > 0 {
 		return nil, fmt.Errorf("unable to parse `%s` into Key=Value pair(s)", strings.Join(invalid, ", "))
 	}
+	for _, annotation := range []struct{ name, key string }{
+		{"proxy-protocol-v2-enabled", proxyProtocolV2EnabledKey},
+		{"preserve-client-ip", preserveClientIPEnabledKey},
+	} {
+		enabled, err := parser.GetBoolAnnotation(annotation.name, ing)
+		if err == errors.ErrMissingAnnotations {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		for _, attribute := range output {
+			if aws.StringValue(attribute.Key) == annotation.key {
+				return nil, errors.NewInvalidAnnotationContentReason(fmt.Sprintf("target group attribute %v cannot be combined with the %v annotation", annotation.key, annotation.name))
+			}
+		}
+		output = append(output, &elbv2.TargetGroupAttribute{
+			Key:   aws.String(annotation.key),
+			Value: aws.String(strconv.FormatBool(*enabled)),
+		})
+	}
+
+	values := make(map[string]string, len(output))
+	for _, attribute := range output {
+		if err := validateAttribute(aws.StringValue(attribute.Key), aws.StringValue(attribute.Value)); err != nil {
+			return nil, err
+		}
+		values[aws.StringValue(attribute.Key)] = aws.StringValue(attribute.Value)
+	}
+	if values[stickinessTypeKey] == stickinessTypeAppCookie && values[stickinessAppCookieCookieNameKey] == "" {
+		return nil, errors.NewInvalidAnnotationContentReason(fmt.Sprintf("target group attribute %v is required by %v stickiness", stickinessAppCookieCookieNameKey, stickinessTypeAppCookie))
+	}
 	return output, nil
 }
 
+const (
+	deregistrationDelayTimeoutSecondsKey  = "deregistration_delay.timeout_seconds"
+	slowStartDurationSecondsKey           = "slow_start.duration_seconds"
+	stickinessEnabledKey                  = "stickiness.enabled"
+	stickinessTypeKey                     = "stickiness.type"
+	stickinessLbCookieDurationSecondsKey  = "stickiness.lb_cookie.duration_seconds"
+	loadBalancingAlgorithmTypeKey         = "load_balancing.algorithm.type"
+	stickinessAppCookieCookieNameKey      = "stickiness.app_cookie.cookie_name"
+	stickinessAppCookieDurationSecondsKey = "stickiness.app_cookie.duration_seconds"
+	proxyProtocolV2EnabledKey             = "proxy_protocol_v2.enabled"
+	preserveClientIPEnabledKey            = "preserve_client_ip.enabled"
+
+	stickinessTypeLBCookie  = "lb_cookie"
+	stickinessTypeAppCookie = "app_cookie"
+
+	// reservedCookiePrefix is the prefix of the cookies of the load balancer, AWSALB, AWSALBAPP and AWSALBTG.
+	reservedCookiePrefix = "AWSALB"
+)
+
+// validateAttribute rejects the target group attributes the targetGroup AttributesController would fail to apply,
+// so that they're reported on the ingress or service rather than on each reconciliation.
+func validateAttribute(key string, value string) error {
+	switch key {
+	case deregistrationDelayTimeoutSecondsKey:
+		return validateSecondsAttribute(key, value, 0, 3600)
+	case slowStartDurationSecondsKey:
+		if value == "0" {
+			return nil
+		}
+		return validateSecondsAttribute(key, value, 30, 900)
+	case stickinessEnabledKey, proxyProtocolV2EnabledKey, preserveClientIPEnabledKey:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return errors.NewInvalidAnnotationContentReason(fmt.Sprintf("target group attribute %v must be true or false, got %v", key, value))
+		}
+	case stickinessTypeKey:
+		if value != stickinessTypeLBCookie && value != stickinessTypeAppCookie {
+			return errors.NewInvalidAnnotationContentReason(fmt.Sprintf("target group attribute %v must be %v or %v, got %v", key, stickinessTypeLBCookie, stickinessTypeAppCookie, value))
+		}
+	case stickinessLbCookieDurationSecondsKey:
+		return validateSecondsAttribute(key, value, 1, 604800)
+	case stickinessAppCookieCookieNameKey:
+		if strings.HasPrefix(value, reservedCookiePrefix) {
+			return errors.NewInvalidAnnotationContentReason(fmt.Sprintf("target group attribute %v cannot start with %v, which is reserved for the cookies of the load balancer", key, reservedCookiePrefix))
+		}
+	case stickinessAppCookieDurationSecondsKey:
+		return validateSecondsAttribute(key, value, 1, 604800)
+	case loadBalancingAlgorithmTypeKey:
+		if value != "round_robin" && value != "least_outstanding_requests" {
+			return errors.NewInvalidAnnotationContentReason(fmt.Sprintf("target group attribute %v must be round_robin or least_outstanding_requests, got %v", key, value))
+		}
+	default:
+		return errors.NewInvalidAnnotationContentReason(fmt.Sprintf("the target group attribute %v is not valid", key))
+	}
+	return nil
+}
+
+func validateSecondsAttribute(key string, value string, min int64, max int64) error {
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || seconds < min || seconds > max {
+		return errors.NewInvalidAnnotationContentReason(fmt.Sprintf("target group attribute %v must be within %v-%v seconds, got %v", key, min, max, value))
+	}
+	return nil
+}
+
+// normalizeSuccessCodes validates success codes, a comma-separated list of codes and ranges of codes such as 200-299,301,
+// and removes the whitespace ELBV2 doesn't accept.
+func normalizeSuccessCodes(successCodes *string) (*string, error) {
+	var entries []string
+	for _, entry := range strings.Split(aws.StringValue(successCodes), ",") {
+		entry = strings.TrimSpace(entry)
+		if err := validateSuccessCode(entry); err != nil {
+			return nil, errors.NewInvalidAnnotationContentReason(fmt.Sprintf("invalid success-codes %v: %v", aws.StringValue(successCodes), err))
+		}
+		entries = append(entries, entry)
+	}
+	return aws.String(strings.Join(entries, ",")), nil
+}
+
+// validateSuccessCode checks that entry is a code or a range of codes within minSuccessCode-maxSuccessCode.
+func validateSuccessCode(entry string) error {
+	bounds := strings.SplitN(entry, "-", 2)
+	from, err := strconv.ParseInt(bounds[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("%q isn't a code or a range of codes", entry)
+	}
+	to := from
+	if len(bounds) == 2 {
+		if to, err = strconv.ParseInt(bounds[1], 10, 64); err != nil || to < from {
+			return fmt.Errorf("%q isn't a code or a range of codes", entry)
+		}
+	}
+	if from < minSuccessCode || to > maxSuccessCode {
+		return fmt.Errorf("%v must be within %v-%v", entry, minSuccessCode, maxSuccessCode)
+	}
+	return nil
+}
+
+// GRPCSuccessCodes returns the gRPC status codes of successful health checks, SuccessCodes unless left to its HTTP default.
+func (a *Config) GRPCSuccessCodes() *string {
+	if a.SuccessCodes == nil || aws.StringValue(a.SuccessCodes) == DefaultSuccessCodes {
+		return aws.String(DefaultGRPCSuccessCodes)
+	}
+	return a.SuccessCodes
+}
+
 func Dummy() *Config {
 	return &Config{
 		BackendProtocol:         aws.String(elbv2.ProtocolEnumHttp),
 		HealthyThresholdCount:   aws.Int64(2),
+		ProtocolVersion:         aws.String(ProtocolVersionHTTP1),
 		SuccessCodes:            aws.String("200"),
 		TargetType:              aws.String(elbv2.TargetTypeEnumInstance),
 		UnhealthyThresholdCount: aws.Int64(2),
@@ -6,7 +6,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/config"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/resolver"
 	"github.com/stretchr/testify/assert"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 func TestMerge(t *testing.T) {
@@ -104,3 +108,303 @@ func TestMerge(t *testing.T) {
 		assert.Equal(t, tc.ExpectedResult, actualResult)
 	}
 }
+
+func TestMerge_backendProtocol(t *testing.T) {
+	for _, tc := range []struct {
+		Name             string
+		Service          *string
+		Ingress          *string
+		ExpectedProtocol string
+	}{
+		{Name: "default", ExpectedProtocol: DefaultBackendProtocol},
+		{Name: "ingress", Ingress: aws.String(elbv2.ProtocolEnumHttps), ExpectedProtocol: elbv2.ProtocolEnumHttps},
+		{Name: "service", Service: aws.String(elbv2.ProtocolEnumHttps), Ingress: aws.String(elbv2.ProtocolEnumHttp), ExpectedProtocol: elbv2.ProtocolEnumHttps},
+		{Name: "service with the default protocol", Service: aws.String(elbv2.ProtocolEnumHttp), Ingress: aws.String(elbv2.ProtocolEnumHttps), ExpectedProtocol: elbv2.ProtocolEnumHttp},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			merged := (&Config{BackendProtocol: tc.Service}).Merge(&Config{BackendProtocol: tc.Ingress}, &config.Configuration{})
+			assert.Equal(t, tc.ExpectedProtocol, aws.StringValue(merged.BackendProtocol))
+		})
+	}
+}
+
+func TestParse_backendProtocol(t *testing.T) {
+	for _, tc := range []struct {
+		Name             string
+		Annotation       string
+		ExpectedProtocol *string
+		ExpectedError    string
+	}{
+		{Name: "unspecified"},
+		{Name: "HTTPS", Annotation: "HTTPS", ExpectedProtocol: aws.String(elbv2.ProtocolEnumHttps)},
+		{Name: "lowercase", Annotation: "https", ExpectedError: "the annotation backend-protocol does not contain a valid value (https)"},
+		{Name: "TCP", Annotation: "TCP", ExpectedError: "the annotation backend-protocol does not contain a valid value (TCP)"},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			annos := map[string]string{"alb.ingress.kubernetes.io/target-type": "ip"}
+			if len(tc.Annotation) != 0 {
+				annos["alb.ingress.kubernetes.io/backend-protocol"] = tc.Annotation
+			}
+			ing := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ingress", Annotations: annos}}
+			cfg, err := NewParser(resolver.Mock{}).Parse(ing)
+			if tc.ExpectedError != "" {
+				assert.EqualError(t, err, tc.ExpectedError)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.ExpectedProtocol, cfg.(*Config).BackendProtocol)
+			}
+		})
+	}
+}
+
+func TestParse_targetNodeLabels(t *testing.T) {
+	for _, tc := range []struct {
+		Name             string
+		Annotation       string
+		ExpectedSelector labels.Selector
+		ExpectedError    string
+	}{
+		{Name: "unspecified"},
+		{Name: "equality", Annotation: "node-group=ingress", ExpectedSelector: labels.SelectorFromSet(labels.Set{"node-group": "ingress"})},
+		{Name: "invalid", Annotation: "node-group in ingress", ExpectedError: "invalid target-node-labels node-group in ingress: unable to parse requirement: found 'ingress' expected: '('"},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			annos := map[string]string{"alb.ingress.kubernetes.io/target-type": "instance"}
+			if len(tc.Annotation) != 0 {
+				annos["alb.ingress.kubernetes.io/target-node-labels"] = tc.Annotation
+			}
+			ing := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ingress", Annotations: annos}}
+			cfg, err := NewParser(resolver.Mock{}).Parse(ing)
+			if tc.ExpectedError != "" {
+				assert.EqualError(t, err, tc.ExpectedError)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.ExpectedSelector, cfg.(*Config).TargetNodeLabels)
+			}
+		})
+	}
+}
+
+func TestParse_autoTargetType(t *testing.T) {
+	annos := map[string]string{"alb.ingress.kubernetes.io/target-type": "auto"}
+	ing := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ingress", Annotations: annos}}
+	cfg, err := NewParser(resolver.Mock{}).Parse(ing)
+	if assert.NoError(t, err) {
+		assert.Equal(t, aws.String("instance"), cfg.(*Config).TargetType, "auto is instance when the VPC CNI plugin isn't detected")
+	}
+}
+
+func TestMerge_targetNodeLabels(t *testing.T) {
+	serviceSelector := labels.SelectorFromSet(labels.Set{"node-group": "service"})
+	ingressSelector := labels.SelectorFromSet(labels.Set{"node-group": "ingress"})
+	assert.Equal(t, serviceSelector, (&Config{TargetNodeLabels: serviceSelector}).Merge(&Config{TargetNodeLabels: ingressSelector}, &config.Configuration{}).TargetNodeLabels)
+	assert.Equal(t, ingressSelector, (&Config{}).Merge(&Config{TargetNodeLabels: ingressSelector}, &config.Configuration{}).TargetNodeLabels)
+}
+
+func TestParse_protocolVersion(t *testing.T) {
+	for _, tc := range []struct {
+		Name            string
+		Annotations     map[string]string
+		ExpectedVersion string
+		ExpectedError   string
+	}{
+		{
+			Name:            "defaults to HTTP1",
+			ExpectedVersion: ProtocolVersionHTTP1,
+		},
+		{
+			Name:            "gRPC",
+			Annotations:     map[string]string{"alb.ingress.kubernetes.io/backend-protocol-version": "GRPC"},
+			ExpectedVersion: ProtocolVersionGRPC,
+		},
+		{
+			Name:          "unknown version",
+			Annotations:   map[string]string{"alb.ingress.kubernetes.io/backend-protocol-version": "HTTP3"},
+			ExpectedError: "the annotation backend-protocol-version does not contain a valid value (HTTP3)",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			annos := map[string]string{"alb.ingress.kubernetes.io/target-type": "ip"}
+			for k, v := range tc.Annotations {
+				annos[k] = v
+			}
+			ing := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ingress", Annotations: annos}}
+			cfg, err := NewParser(resolver.Mock{}).Parse(ing)
+			if tc.ExpectedError != "" {
+				assert.EqualError(t, err, tc.ExpectedError)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.ExpectedVersion, aws.StringValue(cfg.(*Config).ProtocolVersion))
+			}
+		})
+	}
+}
+
+func TestParse_successCodes(t *testing.T) {
+	for _, tc := range []struct {
+		Name                 string
+		Annotation           string
+		ExpectedSuccessCodes string
+		ExpectedError        string
+	}{
+		{Name: "default", ExpectedSuccessCodes: DefaultSuccessCodes},
+		{Name: "single code", Annotation: "202", ExpectedSuccessCodes: "202"},
+		{Name: "range and list", Annotation: "200-299, 301", ExpectedSuccessCodes: "200-299,301"},
+		{Name: "grpc-status codes", Annotation: "0-99", ExpectedSuccessCodes: "0-99"},
+		{Name: "empty entry", Annotation: "200,", ExpectedError: `invalid success-codes 200,: "" isn't a code or a range of codes`},
+		{Name: "reversed range", Annotation: "299-200", ExpectedError: `invalid success-codes 299-200: "299-200" isn't a code or a range of codes`},
+		{Name: "not a code", Annotation: "2xx", ExpectedError: `invalid success-codes 2xx: "2xx" isn't a code or a range of codes`},
+		{Name: "out of range", Annotation: "200-600", ExpectedError: "invalid success-codes 200-600: 200-600 must be within 0-599"},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			annos := map[string]string{"alb.ingress.kubernetes.io/target-type": "ip"}
+			if len(tc.Annotation) != 0 {
+				annos["alb.ingress.kubernetes.io/success-codes"] = tc.Annotation
+			}
+			ing := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ingress", Annotations: annos}}
+			cfg, err := NewParser(resolver.Mock{}).Parse(ing)
+			if tc.ExpectedError != "" {
+				assert.EqualError(t, err, tc.ExpectedError)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.ExpectedSuccessCodes, aws.StringValue(cfg.(*Config).SuccessCodes))
+			}
+		})
+	}
+}
+
+func TestConfig_GRPCSuccessCodes(t *testing.T) {
+	assert.Equal(t, DefaultGRPCSuccessCodes, aws.StringValue((&Config{SuccessCodes: aws.String(DefaultSuccessCodes)}).GRPCSuccessCodes()))
+	assert.Equal(t, "0-99", aws.StringValue((&Config{SuccessCodes: aws.String("0-99")}).GRPCSuccessCodes()))
+}
+
+func TestParse_attributes(t *testing.T) {
+	for _, tc := range []struct {
+		Name               string
+		Annotation         string
+		Annotations        map[string]string
+		ExpectedAttributes []*elbv2.TargetGroupAttribute
+		ExpectedError      string
+	}{
+		{
+			Name:       "attributes",
+			Annotation: "deregistration_delay.timeout_seconds=30, slow_start.duration_seconds=60, stickiness.enabled=true, stickiness.type=lb_cookie, stickiness.lb_cookie.duration_seconds=600, load_balancing.algorithm.type=least_outstanding_requests",
+			ExpectedAttributes: []*elbv2.TargetGroupAttribute{
+				{Key: aws.String("deregistration_delay.timeout_seconds"), Value: aws.String("30")},
+				{Key: aws.String("slow_start.duration_seconds"), Value: aws.String("60")},
+				{Key: aws.String("stickiness.enabled"), Value: aws.String("true")},
+				{Key: aws.String("stickiness.type"), Value: aws.String("lb_cookie")},
+				{Key: aws.String("stickiness.lb_cookie.duration_seconds"), Value: aws.String("600")},
+				{Key: aws.String("load_balancing.algorithm.type"), Value: aws.String("least_outstanding_requests")},
+			},
+		},
+		{
+			Name:       "slow start disabled",
+			Annotation: "slow_start.duration_seconds=0",
+			ExpectedAttributes: []*elbv2.TargetGroupAttribute{
+				{Key: aws.String("slow_start.duration_seconds"), Value: aws.String("0")},
+			},
+		},
+		{
+			Name:          "slow start out of range",
+			Annotation:    "slow_start.duration_seconds=5",
+			ExpectedError: "target group attribute slow_start.duration_seconds must be within 30-900 seconds, got 5",
+		},
+		{
+			Name:          "deregistration delay isn't a number",
+			Annotation:    "deregistration_delay.timeout_seconds=30s",
+			ExpectedError: "target group attribute deregistration_delay.timeout_seconds must be within 0-3600 seconds, got 30s",
+		},
+		{
+			Name:          "unknown algorithm",
+			Annotation:    "load_balancing.algorithm.type=random",
+			ExpectedError: "target group attribute load_balancing.algorithm.type must be round_robin or least_outstanding_requests, got random",
+		},
+		{
+			Name:       "application cookie stickiness",
+			Annotation: "stickiness.enabled=true, stickiness.type=app_cookie, stickiness.app_cookie.cookie_name=JSESSIONID, stickiness.app_cookie.duration_seconds=3600",
+			ExpectedAttributes: []*elbv2.TargetGroupAttribute{
+				{Key: aws.String("stickiness.enabled"), Value: aws.String("true")},
+				{Key: aws.String("stickiness.type"), Value: aws.String("app_cookie")},
+				{Key: aws.String("stickiness.app_cookie.cookie_name"), Value: aws.String("JSESSIONID")},
+				{Key: aws.String("stickiness.app_cookie.duration_seconds"), Value: aws.String("3600")},
+			},
+		},
+		{
+			Name:          "application cookie stickiness without cookie name",
+			Annotation:    "stickiness.enabled=true, stickiness.type=app_cookie",
+			ExpectedError: "target group attribute stickiness.app_cookie.cookie_name is required by app_cookie stickiness",
+		},
+		{
+			Name:          "reserved cookie name",
+			Annotation:    "stickiness.type=app_cookie, stickiness.app_cookie.cookie_name=AWSALBAPP-0",
+			ExpectedError: "target group attribute stickiness.app_cookie.cookie_name cannot start with AWSALB, which is reserved for the cookies of the load balancer",
+		},
+		{
+			Name:          "unknown stickiness type",
+			Annotation:    "stickiness.type=source_ip",
+			ExpectedError: "target group attribute stickiness.type must be lb_cookie or app_cookie, got source_ip",
+		},
+		{
+			Name:        "proxy protocol v2",
+			Annotation:  "deregistration_delay.timeout_seconds=30",
+			Annotations: map[string]string{"alb.ingress.kubernetes.io/proxy-protocol-v2-enabled": "true"},
+			ExpectedAttributes: []*elbv2.TargetGroupAttribute{
+				{Key: aws.String("deregistration_delay.timeout_seconds"), Value: aws.String("30")},
+				{Key: aws.String("proxy_protocol_v2.enabled"), Value: aws.String("true")},
+			},
+		},
+		{
+			Name:          "proxy protocol v2 set by both attributes and annotations",
+			Annotation:    "proxy_protocol_v2.enabled=false",
+			Annotations:   map[string]string{"alb.ingress.kubernetes.io/proxy-protocol-v2-enabled": "true"},
+			ExpectedError: "target group attribute proxy_protocol_v2.enabled cannot be combined with the proxy-protocol-v2-enabled annotation",
+		},
+		{
+			Name:        "preserve client IP",
+			Annotations: map[string]string{"alb.ingress.kubernetes.io/preserve-client-ip": "false"},
+			ExpectedAttributes: []*elbv2.TargetGroupAttribute{
+				{Key: aws.String("preserve_client_ip.enabled"), Value: aws.String("false")},
+			},
+		},
+		{
+			Name:          "preserve client IP set by both attributes and annotations",
+			Annotation:    "preserve_client_ip.enabled=true",
+			Annotations:   map[string]string{"alb.ingress.kubernetes.io/preserve-client-ip": "true"},
+			ExpectedError: "target group attribute preserve_client_ip.enabled cannot be combined with the preserve-client-ip annotation",
+		},
+		{
+			Name:          "proxy protocol v2 attribute isn't a boolean",
+			Annotation:    "proxy_protocol_v2.enabled=v2",
+			ExpectedError: "target group attribute proxy_protocol_v2.enabled must be true or false, got v2",
+		},
+		{
+			Name:          "unknown attribute",
+			Annotation:    "stickiness.app_cookie.name=session",
+			ExpectedError: "the target group attribute stickiness.app_cookie.name is not valid",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			annos := map[string]string{
+				"alb.ingress.kubernetes.io/target-type":             "ip",
+				"alb.ingress.kubernetes.io/target-group-attributes": tc.Annotation,
+			}
+			for k, v := range tc.Annotations {
+				annos[k] = v
+			}
+			ing := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ingress", Annotations: annos}}
+			cfg, err := NewParser(resolver.Mock{}).Parse(ing)
+			if tc.ExpectedError != "" {
+				assert.EqualError(t, err, tc.ExpectedError)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.ExpectedAttributes, cfg.(*Config).Attributes)
+			}
+		})
+	}
+}
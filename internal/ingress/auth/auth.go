@@ -6,6 +6,7 @@ import (
 	"strings"
 	"unicode"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/action"
@@ -15,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/source"
@@ -52,15 +54,28 @@ type Module interface {
 // NewModule constructs new Authentication module
 func NewModule(cache cache.Cache) Module {
 	return &defaultModule{
-		cache: cache,
+		cache:  cache,
+		reader: cache,
+	}
+}
+
+// NewReaderModule constructs an Authentication module reading services & secrets from reader, for use outside of the controller.
+// It can't be initialized.
+func NewReaderModule(reader client.Reader) Module {
+	return &defaultModule{
+		reader: reader,
 	}
 }
 
 type defaultModule struct {
-	cache cache.Cache
+	cache  cache.Cache
+	reader client.Reader
 }
 
 func (m *defaultModule) Init(controller controller.Controller, ingressChan chan<- event.GenericEvent, serviceChan chan<- event.GenericEvent) error {
+	if m.cache == nil {
+		return errors.New("authentication module without cache can't be initialized")
+	}
 	if err := m.cache.IndexField(&extensions.Ingress{}, FieldAuthOIDCSecret, func(obj runtime.Object) []string {
 		ingress := obj.(*extensions.Ingress)
 		return buildOIDCSecretIndex(ingress.Namespace, ingress.Annotations)
@@ -108,7 +123,7 @@ func (m *defaultModule) NewConfig(ctx context.Context, ingress *extensions.Ingre
 			Name:      backend.ServiceName,
 		}
 		service := corev1.Service{}
-		if err := m.cache.Get(ctx, serviceKey, &service); err != nil {
+		if err := m.reader.Get(ctx, serviceKey, &service); err != nil {
 			return Config{}, errors.Wrapf(err, "failed to get service %v", serviceKey)
 		}
 		serviceAnnos = service.Annotations
@@ -121,6 +136,7 @@ func (m *defaultModule) NewConfig(ctx context.Context, ingress *extensions.Ingre
 		return Config{}, err
 	}
 	switch cfg.Type {
+	case TypeNone:
 	case TypeCognito:
 		{
 			exists, err := annotations.LoadJSONAnnotation(AnnotationAuthIDPCognito, &cfg.IDPCognito, serviceAnnos, ingressAnnos)
@@ -130,6 +146,9 @@ func (m *defaultModule) NewConfig(ctx context.Context, ingress *extensions.Ingre
 			if !exists {
 				return Config{}, errors.New(fmt.Sprintf("annotation %s is required when authType == %s", AnnotationAuthIDPCognito, TypeCognito))
 			}
+			if err := validateIDPCognito(cfg.IDPCognito); err != nil {
+				return Config{}, err
+			}
 		}
 	case TypeOIDC:
 		{
@@ -141,11 +160,28 @@ func (m *defaultModule) NewConfig(ctx context.Context, ingress *extensions.Ingre
 				return Config{}, errors.New(fmt.Sprintf("annotation %s is required when authType == %s", AnnotationAuthIDPOIDC, TypeOIDC))
 			}
 		}
+	default:
+		return Config{}, errors.Errorf("annotation %s must be one of %s, %s or %s, got %s", AnnotationAuthType, TypeNone, TypeCognito, TypeOIDC, cfg.Type)
 	}
 
 	return cfg, nil
 }
 
+// validateIDPCognito checks the fields of the auth-idp-cognito annotation, which ALB would otherwise only reject when creating the listener rules.
+func validateIDPCognito(idp IDPCognito) error {
+	userPoolArn, err := arn.Parse(idp.UserPoolArn)
+	if err != nil || userPoolArn.Service != "cognito-idp" || !strings.HasPrefix(userPoolArn.Resource, "userpool/") {
+		return errors.Errorf("annotation %s: UserPoolArn must be the ARN of a Cognito user pool, got %q", AnnotationAuthIDPCognito, idp.UserPoolArn)
+	}
+	if idp.UserPoolClientId == "" {
+		return errors.Errorf("annotation %s: UserPoolClientId is required", AnnotationAuthIDPCognito)
+	}
+	if idp.UserPoolDomain == "" || strings.ContainsAny(idp.UserPoolDomain, ":/") {
+		return errors.Errorf("annotation %s: UserPoolDomain must be the domain prefix or the custom domain of the user pool, got %q", AnnotationAuthIDPCognito, idp.UserPoolDomain)
+	}
+	return nil
+}
+
 func (m *defaultModule) loadIDPOIDC(ctx context.Context, idpOIDC *IDPOIDC, namespace string, serviceAnnos map[string]string, ingressAnnos map[string]string) (bool, error) {
 	annoIDPOIDC := AnnotationSchemaIDPOIDC{}
 	exists, err := annotations.LoadJSONAnnotation(AnnotationAuthIDPOIDC, &annoIDPOIDC, serviceAnnos, ingressAnnos)
@@ -161,7 +197,7 @@ func (m *defaultModule) loadIDPOIDC(ctx context.Context, idpOIDC *IDPOIDC, names
 		Name:      annoIDPOIDC.SecretName,
 	}
 	k8sSecret := corev1.Secret{}
-	if err := m.cache.Get(ctx, secretKey, &k8sSecret); err != nil {
+	if err := m.reader.Get(ctx, secretKey, &k8sSecret); err != nil {
 		return true, errors.Wrapf(err, "failed to load k8s secret: %v", secretKey)
 	}
 	clientId := strings.TrimRightFunc(string(k8sSecret.Data["clientId"]), unicode.IsSpace)
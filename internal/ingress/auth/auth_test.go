@@ -34,7 +34,7 @@ func TestDefaultModule_Init(t *testing.T) {
 		Cache:       mockCache,
 	})
 
-	module := &defaultModule{mockCache}
+	module := &defaultModule{cache: mockCache, reader: mockCache}
 	assert.NoError(t, module.Init(mockController, ingressChan, serviceChan))
 }
 
@@ -86,7 +86,7 @@ func TestDefaultModule_NewConfig(t *testing.T) {
 					Name:      "ingress",
 					Annotations: map[string]string{
 						parser.GetAnnotationWithPrefix(AnnotationAuthType):       "cognito",
-						parser.GetAnnotationWithPrefix(AnnotationAuthIDPCognito): "{\"UserPoolArn\": \"UserPoolArn\",\"UserPoolClientId\": \"UserPoolClientId\",\"UserPoolDomain\": \"UserPoolDomain\",\"AuthenticationRequestExtraParams\": { \"param1\": \"value1\",\"param2\": \"value2\"}}",
+						parser.GetAnnotationWithPrefix(AnnotationAuthIDPCognito): "{\"UserPoolArn\": \"arn:aws:cognito-idp:us-west-2:000000000000:userpool/us-west-2_AbCdEfGhI\",\"UserPoolClientId\": \"UserPoolClientId\",\"UserPoolDomain\": \"UserPoolDomain\",\"AuthenticationRequestExtraParams\": { \"param1\": \"value1\",\"param2\": \"value2\"}}",
 					},
 				},
 			},
@@ -108,7 +108,7 @@ func TestDefaultModule_NewConfig(t *testing.T) {
 						"param1": "value1",
 						"param2": "value2",
 					},
-					UserPoolArn:      "UserPoolArn",
+					UserPoolArn:      "arn:aws:cognito-idp:us-west-2:000000000000:userpool/us-west-2_AbCdEfGhI",
 					UserPoolClientId: "UserPoolClientId",
 					UserPoolDomain:   "UserPoolDomain",
 				},
@@ -136,7 +136,7 @@ func TestDefaultModule_NewConfig(t *testing.T) {
 					Name:      "service",
 					Annotations: map[string]string{
 						parser.GetAnnotationWithPrefix(AnnotationAuthType):       "cognito",
-						parser.GetAnnotationWithPrefix(AnnotationAuthIDPCognito): "{\"UserPoolArn\": \"UserPoolArn\",\"UserPoolClientId\": \"UserPoolClientId\",\"UserPoolDomain\": \"UserPoolDomain\",\"AuthenticationRequestExtraParams\": { \"param1\": \"value1\",\"param2\": \"value2\"}}",
+						parser.GetAnnotationWithPrefix(AnnotationAuthIDPCognito): "{\"UserPoolArn\": \"arn:aws:cognito-idp:us-west-2:000000000000:userpool/us-west-2_AbCdEfGhI\",\"UserPoolClientId\": \"UserPoolClientId\",\"UserPoolDomain\": \"UserPoolDomain\",\"AuthenticationRequestExtraParams\": { \"param1\": \"value1\",\"param2\": \"value2\"}}",
 					},
 				},
 			},
@@ -148,7 +148,7 @@ func TestDefaultModule_NewConfig(t *testing.T) {
 						"param1": "value1",
 						"param2": "value2",
 					},
-					UserPoolArn:      "UserPoolArn",
+					UserPoolArn:      "arn:aws:cognito-idp:us-west-2:000000000000:userpool/us-west-2_AbCdEfGhI",
 					UserPoolClientId: "UserPoolClientId",
 					UserPoolDomain:   "UserPoolDomain",
 				},
@@ -325,7 +325,7 @@ func TestDefaultModule_NewConfig(t *testing.T) {
 					Name:      "ingress",
 					Annotations: map[string]string{
 						parser.GetAnnotationWithPrefix(AnnotationAuthType):                     "cognito",
-						parser.GetAnnotationWithPrefix(AnnotationAuthIDPCognito):               "{\"UserPoolArn\": \"UserPoolArn\",\"UserPoolClientId\": \"UserPoolClientId\",\"UserPoolDomain\": \"UserPoolDomain\"}",
+						parser.GetAnnotationWithPrefix(AnnotationAuthIDPCognito):               "{\"UserPoolArn\": \"arn:aws:cognito-idp:us-west-2:000000000000:userpool/us-west-2_AbCdEfGhI\",\"UserPoolClientId\": \"UserPoolClientId\",\"UserPoolDomain\": \"UserPoolDomain\"}",
 						parser.GetAnnotationWithPrefix(AnnotationAuthScope):                    "email openid",
 						parser.GetAnnotationWithPrefix(AnnotationAuthSessionCookie):            "customCookieName",
 						parser.GetAnnotationWithPrefix(AnnotationAuthSessionTimeout):           "600",
@@ -347,7 +347,7 @@ func TestDefaultModule_NewConfig(t *testing.T) {
 			expectedAuthCfg: Config{
 				Type: TypeCognito,
 				IDPCognito: IDPCognito{
-					UserPoolArn:      "UserPoolArn",
+					UserPoolArn:      "arn:aws:cognito-idp:us-west-2:000000000000:userpool/us-west-2_AbCdEfGhI",
 					UserPoolClientId: "UserPoolClientId",
 					UserPoolDomain:   "UserPoolDomain",
 				},
@@ -365,7 +365,7 @@ func TestDefaultModule_NewConfig(t *testing.T) {
 					Name:      "ingress",
 					Annotations: map[string]string{
 						parser.GetAnnotationWithPrefix(AnnotationAuthType):                     "cognito",
-						parser.GetAnnotationWithPrefix(AnnotationAuthIDPCognito):               "{\"UserPoolArn\": \"UserPoolArn\",\"UserPoolClientId\": \"UserPoolClientId\",\"UserPoolDomain\": \"UserPoolDomain\"}",
+						parser.GetAnnotationWithPrefix(AnnotationAuthIDPCognito):               "{\"UserPoolArn\": \"arn:aws:cognito-idp:us-west-2:000000000000:userpool/us-west-2_AbCdEfGhI\",\"UserPoolClientId\": \"UserPoolClientId\",\"UserPoolDomain\": \"UserPoolDomain\"}",
 						parser.GetAnnotationWithPrefix(AnnotationAuthScope):                    "email openid",
 						parser.GetAnnotationWithPrefix(AnnotationAuthSessionCookie):            "customCookieName",
 						parser.GetAnnotationWithPrefix(AnnotationAuthSessionTimeout):           "600",
@@ -390,7 +390,7 @@ func TestDefaultModule_NewConfig(t *testing.T) {
 			expectedAuthCfg: Config{
 				Type: TypeCognito,
 				IDPCognito: IDPCognito{
-					UserPoolArn:      "UserPoolArn",
+					UserPoolArn:      "arn:aws:cognito-idp:us-west-2:000000000000:userpool/us-west-2_AbCdEfGhI",
 					UserPoolClientId: "UserPoolClientId",
 					UserPoolDomain:   "UserPoolDomain",
 				},
@@ -408,7 +408,7 @@ func TestDefaultModule_NewConfig(t *testing.T) {
 					Name:      "ingress",
 					Annotations: map[string]string{
 						parser.GetAnnotationWithPrefix(AnnotationAuthType):       "cognito",
-						parser.GetAnnotationWithPrefix(AnnotationAuthIDPCognito): "{\"UserPoolArn\": \"UserPoolArn\",\"UserPoolClientId\": \"UserPoolClientId\",\"UserPoolDomain\": \"UserPoolDomain\"}",
+						parser.GetAnnotationWithPrefix(AnnotationAuthIDPCognito): "{\"UserPoolArn\": \"arn:aws:cognito-idp:us-west-2:000000000000:userpool/us-west-2_AbCdEfGhI\",\"UserPoolClientId\": \"UserPoolClientId\",\"UserPoolDomain\": \"UserPoolDomain\"}",
 					},
 				},
 			},
@@ -439,7 +439,7 @@ func TestDefaultModule_NewConfig(t *testing.T) {
 					Name:      tc.secret.Name,
 				}, gomock.Any()).SetArg(2, *tc.secret)
 			}
-			module := &defaultModule{mockCache}
+			module := &defaultModule{cache: mockCache, reader: mockCache}
 
 			authCfg, err := module.NewConfig(context.Background(), tc.ingress, tc.backend, tc.protocol)
 			assert.Equal(t, authCfg, tc.expectedAuthCfg)
@@ -447,3 +447,66 @@ func TestDefaultModule_NewConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultModule_NewConfig_invalidType(t *testing.T) {
+	ingress := &extensions.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "namespace",
+			Name:      "ingress",
+			Annotations: map[string]string{
+				parser.GetAnnotationWithPrefix(AnnotationAuthType):    "odic",
+				parser.GetAnnotationWithPrefix(AnnotationAuthIDPOIDC): "{\"SecretName\": \"oidc-secret\"}",
+			},
+		},
+	}
+	module := &defaultModule{}
+
+	_, err := module.NewConfig(context.Background(), ingress, extensions.IngressBackend{ServiceName: "use-annotation", ServicePort: intstr.FromString("use-annotation")}, "HTTPS")
+	assert.EqualError(t, err, "annotation auth-type must be one of none, cognito or oidc, got odic")
+}
+
+func TestDefaultModule_NewConfig_invalidCognito(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		idpCognito    string
+		expectedError string
+	}{
+		{
+			name:          "not an ARN",
+			idpCognito:    "{\"UserPoolArn\": \"us-west-2_AbCdEfGhI\",\"UserPoolClientId\": \"client\",\"UserPoolDomain\": \"domain\"}",
+			expectedError: "annotation auth-idp-cognito: UserPoolArn must be the ARN of a Cognito user pool, got \"us-west-2_AbCdEfGhI\"",
+		},
+		{
+			name:          "ARN of an identity pool",
+			idpCognito:    "{\"UserPoolArn\": \"arn:aws:cognito-identity:us-west-2:000000000000:identitypool/us-west-2:0000\",\"UserPoolClientId\": \"client\",\"UserPoolDomain\": \"domain\"}",
+			expectedError: "annotation auth-idp-cognito: UserPoolArn must be the ARN of a Cognito user pool, got \"arn:aws:cognito-identity:us-west-2:000000000000:identitypool/us-west-2:0000\"",
+		},
+		{
+			name:          "no client ID",
+			idpCognito:    "{\"UserPoolArn\": \"arn:aws:cognito-idp:us-west-2:000000000000:userpool/us-west-2_AbCdEfGhI\",\"UserPoolDomain\": \"domain\"}",
+			expectedError: "annotation auth-idp-cognito: UserPoolClientId is required",
+		},
+		{
+			name:          "domain URL",
+			idpCognito:    "{\"UserPoolArn\": \"arn:aws:cognito-idp:us-west-2:000000000000:userpool/us-west-2_AbCdEfGhI\",\"UserPoolClientId\": \"client\",\"UserPoolDomain\": \"https://domain.auth.us-west-2.amazoncognito.com\"}",
+			expectedError: "annotation auth-idp-cognito: UserPoolDomain must be the domain prefix or the custom domain of the user pool, got \"https://domain.auth.us-west-2.amazoncognito.com\"",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ingress := &extensions.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "namespace",
+					Name:      "ingress",
+					Annotations: map[string]string{
+						parser.GetAnnotationWithPrefix(AnnotationAuthType):       "cognito",
+						parser.GetAnnotationWithPrefix(AnnotationAuthIDPCognito): tc.idpCognito,
+					},
+				},
+			}
+			module := &defaultModule{}
+
+			_, err := module.NewConfig(context.Background(), ingress, extensions.IngressBackend{ServiceName: "use-annotation", ServicePort: intstr.FromString("use-annotation")}, "HTTPS")
+			assert.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
@@ -27,11 +27,14 @@ import (
 	api "k8s.io/api/core/v1"
 	corev1 "k8s.io/api/core/v1"
 	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 const (
 	labelNodeRoleMaster               = "node-role.kubernetes.io/master"
+	labelNodeRoleControlPlane         = "node-role.kubernetes.io/control-plane"
 	labelNodeRoleExcludeBalancer      = "node.kubernetes.io/exclude-from-external-load-balancers"
 	labelAlphaNodeRoleExcludeBalancer = "alpha.service-controller.kubernetes.io/exclude-balancer"
 	labelEKSComputeType               = "eks.amazonaws.com/compute-type"
@@ -39,26 +42,29 @@ const (
 
 // EndpointResolver resolves the endpoints for specific ingress backend
 type EndpointResolver interface {
-	Resolve(*extensions.Ingress, *extensions.IngressBackend, string) ([]*elbv2.TargetDescription, error)
+	// Resolve returns the targets of backend for targetType, instance targets are limited to the nodes matching nodeSelector unless it's nil.
+	Resolve(ingress *extensions.Ingress, backend *extensions.IngressBackend, targetType string, nodeSelector labels.Selector) ([]*elbv2.TargetDescription, error)
 	ReverseResolve(*extensions.Ingress, *extensions.IngressBackend, []*elbv2.TargetDescription) ([]*corev1.Pod, error)
 }
 
-// NewEndpointResolver constructs a new EndpointResolver
-func NewEndpointResolver(store store.Storer, cloud aws.CloudAPI) EndpointResolver {
+// NewEndpointResolver constructs a new EndpointResolver, nodes tainted with any of excludedTaints are never instance targets
+func NewEndpointResolver(store store.Storer, cloud aws.CloudAPI, excludedTaints []string) EndpointResolver {
 	return &endpointResolver{
-		cloud: cloud,
-		store: store,
+		cloud:          cloud,
+		store:          store,
+		excludedTaints: excludedTaints,
 	}
 }
 
 type endpointResolver struct {
-	cloud aws.CloudAPI
-	store store.Storer
+	cloud          aws.CloudAPI
+	store          store.Storer
+	excludedTaints []string
 }
 
-func (resolver *endpointResolver) Resolve(ingress *extensions.Ingress, backend *extensions.IngressBackend, targetType string) ([]*elbv2.TargetDescription, error) {
+func (resolver *endpointResolver) Resolve(ingress *extensions.Ingress, backend *extensions.IngressBackend, targetType string, nodeSelector labels.Selector) ([]*elbv2.TargetDescription, error) {
 	if targetType == elbv2.TargetTypeEnumInstance {
-		return resolver.resolveInstance(ingress, backend)
+		return resolver.resolveInstance(ingress, backend, nodeSelector)
 	}
 	return resolver.resolveIP(ingress, backend)
 }
@@ -107,7 +113,7 @@ func (resolver *endpointResolver) ReverseResolve(ingress *extensions.Ingress, ba
 	return result, nil
 }
 
-func (resolver *endpointResolver) resolveInstance(ingress *extensions.Ingress, backend *extensions.IngressBackend) ([]*elbv2.TargetDescription, error) {
+func (resolver *endpointResolver) resolveInstance(ingress *extensions.Ingress, backend *extensions.IngressBackend, nodeSelector labels.Selector) ([]*elbv2.TargetDescription, error) {
 	service, servicePort, err := findServiceAndPort(resolver.store, ingress.Namespace, backend.ServiceName, backend.ServicePort)
 	if err != nil {
 		return nil, err
@@ -117,9 +123,23 @@ func (resolver *endpointResolver) resolveInstance(ingress *extensions.Ingress, b
 	}
 	nodePort := servicePort.NodePort
 
+	// nodes without ready pods drop the traffic of services with externalTrafficPolicy Local
+	var localNodes sets.String
+	if service.Spec.ExternalTrafficPolicy == corev1.ServiceExternalTrafficPolicyTypeLocal {
+		if localNodes, err = resolver.nodesWithReadyEndpoints(ingress.Namespace + "/" + service.Name); err != nil {
+			return nil, err
+		}
+	}
+
 	var result []*elbv2.TargetDescription
 	for _, node := range resolver.store.ListNodes() {
-		if !IsNodeSuitableAsTrafficProxy(node) {
+		if !IsNodeSuitableAsTrafficProxy(node, resolver.excludedTaints) {
+			continue
+		}
+		if localNodes != nil && !localNodes.Has(node.Name) {
+			continue
+		}
+		if nodeSelector != nil && !nodeSelector.Matches(labels.Set(node.Labels)) {
 			continue
 		}
 		instanceID, err := resolver.store.GetNodeInstanceID(node)
@@ -134,6 +154,23 @@ func (resolver *endpointResolver) resolveInstance(ingress *extensions.Ingress, b
 	return result, nil
 }
 
+// nodesWithReadyEndpoints returns the names of the nodes hosting the ready endpoints of the service serviceKey.
+func (resolver *endpointResolver) nodesWithReadyEndpoints(serviceKey string) (sets.String, error) {
+	eps, err := resolver.store.GetServiceEndpoints(serviceKey)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to find service endpoints for %s: %v", serviceKey, err.Error())
+	}
+	nodes := sets.NewString()
+	for _, epSubset := range eps.Subsets {
+		for _, epAddr := range epSubset.Addresses {
+			if epAddr.NodeName != nil {
+				nodes.Insert(*epAddr.NodeName)
+			}
+		}
+	}
+	return nodes, nil
+}
+
 func (resolver *endpointResolver) resolveIP(ingress *extensions.Ingress, backend *extensions.IngressBackend) ([]*elbv2.TargetDescription, error) {
 	service, servicePort, err := findServiceAndPort(resolver.store, ingress.Namespace, backend.ServiceName, backend.ServicePort)
 	if err != nil {
@@ -157,7 +194,17 @@ func (resolver *endpointResolver) resolveIP(ingress *extensions.Ingress, backend
 				continue
 			}
 
-			addresses := epSubset.Addresses
+			var addresses []corev1.EndpointAddress
+			// the endpoints of terminating pods may not have been moved to `NotReadyAddresses` yet
+			for _, epAddr := range epSubset.Addresses {
+				if epAddr.TargetRef != nil && epAddr.TargetRef.Kind == "Pod" {
+					pod, err := resolver.store.GetPod(ingress.Namespace + "/" + epAddr.TargetRef.Name)
+					if err == nil && pod.DeletionTimestamp != nil {
+						continue
+					}
+				}
+				addresses = append(addresses, epAddr)
+			}
 
 			// we need to loop over all unready pods to check if the ALB readiness gate is the condition preventing the pod from being ready;
 			// if this is the case, we return the pod as a desired target although its not in `Addresses`
@@ -196,20 +243,27 @@ func (resolver *endpointResolver) resolveIP(ingress *extensions.Ingress, backend
 	return result, nil
 }
 
-// IsNodeSuitableAsTrafficProxy check whether node is suitable as a traffic proxy.
+// IsNodeSuitableAsTrafficProxy check whether node is suitable as a traffic proxy, nodes tainted with any of excludedTaints aren't.
 // mimic the logic of serviceController: https://github.com/kubernetes/kubernetes/blob/b6b494b4484b51df8dc6b692fab234573da30ab4/pkg/controller/service/controller.go#L605
-func IsNodeSuitableAsTrafficProxy(node *corev1.Node) bool {
+func IsNodeSuitableAsTrafficProxy(node *corev1.Node, excludedTaints []string) bool {
 	if node.Spec.Unschedulable {
 		return false
 	}
 	if s, ok := node.ObjectMeta.Labels[labelEKSComputeType]; ok && s == "fargate" {
 		return false
 	}
-	for _, label := range []string{labelNodeRoleMaster, labelNodeRoleExcludeBalancer, labelAlphaNodeRoleExcludeBalancer} {
+	for _, label := range []string{labelNodeRoleMaster, labelNodeRoleControlPlane, labelNodeRoleExcludeBalancer, labelAlphaNodeRoleExcludeBalancer} {
 		if _, hasLabel := node.ObjectMeta.Labels[label]; hasLabel {
 			return false
 		}
 	}
+	for _, taint := range node.Spec.Taints {
+		for _, key := range excludedTaints {
+			if taint.Key == key {
+				return false
+			}
+		}
+	}
 	for _, cond := range node.Status.Conditions {
 		if cond.Type == corev1.NodeReady && cond.Status == corev1.ConditionTrue {
 			return true
@@ -219,8 +273,12 @@ func IsNodeSuitableAsTrafficProxy(node *corev1.Node) bool {
 }
 
 // IsPodSuitableAsIPTarget check whether pod is suitable as a TargetGroup's target
-// (currently tested: are all pod's containers ready?).
+// (currently tested: is the pod running and are all pod's containers ready?).
+// Terminating pods aren't, so that they're deregistered as soon as their termination starts.
 func IsPodSuitableAsIPTarget(pod *corev1.Pod) bool {
+	if pod.DeletionTimestamp != nil {
+		return false
+	}
 	for _, condition := range pod.Status.Conditions {
 		if condition.Type == api.ContainersReady {
 			return condition.Status == api.ConditionTrue
@@ -32,6 +32,7 @@ import (
 	extensions "k8s.io/api/extensions/v1beta1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -47,7 +48,9 @@ func TestResolveWithModeInstance(t *testing.T) {
 		name            string
 		ingress         *extensions.Ingress
 		service         *api_v1.Service
+		endpoints       *api_v1.Endpoints
 		nodes           []*api_v1.Node
+		nodeSelector    labels.Selector
 		expectedTargets []*elbv2.TargetDescription
 		expectedError   bool
 	}{
@@ -215,6 +218,160 @@ func TestResolveWithModeInstance(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name: "success scenario by node selector",
+			ingress: &extensions.Ingress{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Name:      "ingress",
+					Namespace: api_v1.NamespaceDefault,
+				},
+				Spec: extensions.IngressSpec{
+					Backend: &extensions.IngressBackend{
+						ServiceName: "service",
+						ServicePort: intstr.FromInt(8080),
+					},
+				},
+			},
+			service: &api_v1.Service{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Name:      "service",
+					Namespace: api_v1.NamespaceDefault,
+				},
+				Spec: api_v1.ServiceSpec{
+					Type: api_v1.ServiceTypeNodePort,
+					Ports: []api_v1.ServicePort{
+						{
+							Port:     8080,
+							NodePort: nodePort,
+						},
+					},
+				},
+			},
+			nodes: []*api_v1.Node{
+				{
+					ObjectMeta: meta_v1.ObjectMeta{
+						Labels: map[string]string{"node-group": "ingress"},
+					},
+					Spec: api_v1.NodeSpec{
+						ProviderID: nodeName1,
+					},
+					Status: api_v1.NodeStatus{
+						Conditions: []api_v1.NodeCondition{
+							{
+								Type:   api_v1.NodeReady,
+								Status: api_v1.ConditionTrue,
+							},
+						},
+					},
+				},
+				{
+					ObjectMeta: meta_v1.ObjectMeta{
+						Labels: map[string]string{"node-group": "batch"},
+					},
+					Spec: api_v1.NodeSpec{
+						ProviderID: nodeName2,
+					},
+					Status: api_v1.NodeStatus{
+						Conditions: []api_v1.NodeCondition{
+							{
+								Type:   api_v1.NodeReady,
+								Status: api_v1.ConditionTrue,
+							},
+						},
+					},
+				},
+				{
+					Spec: api_v1.NodeSpec{
+						ProviderID: nodeName3,
+					},
+					Status: api_v1.NodeStatus{
+						Conditions: []api_v1.NodeCondition{
+							{
+								Type:   api_v1.NodeReady,
+								Status: api_v1.ConditionTrue,
+							},
+						},
+					},
+				},
+			},
+			nodeSelector: labels.SelectorFromSet(labels.Set{"node-group": "ingress"}),
+			expectedTargets: []*elbv2.TargetDescription{
+				{
+					Id:   &nodeName1,
+					Port: aws.Int64(nodePort),
+				},
+			},
+			expectedError: false,
+		},
+		{
+			name: "success scenario by externalTrafficPolicy Local",
+			ingress: &extensions.Ingress{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Name:      "ingress",
+					Namespace: api_v1.NamespaceDefault,
+				},
+				Spec: extensions.IngressSpec{
+					Backend: &extensions.IngressBackend{
+						ServiceName: "service",
+						ServicePort: intstr.FromInt(8080),
+					},
+				},
+			},
+			service: &api_v1.Service{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Name:      "service",
+					Namespace: api_v1.NamespaceDefault,
+				},
+				Spec: api_v1.ServiceSpec{
+					Type:                  api_v1.ServiceTypeNodePort,
+					ExternalTrafficPolicy: api_v1.ServiceExternalTrafficPolicyTypeLocal,
+					Ports: []api_v1.ServicePort{
+						{
+							Port:     8080,
+							NodePort: nodePort,
+						},
+					},
+				},
+			},
+			endpoints: &api_v1.Endpoints{
+				Subsets: []api_v1.EndpointSubset{
+					{
+						Addresses:         []api_v1.EndpointAddress{{IP: "192.168.1.1", NodeName: &nodeName2}},
+						NotReadyAddresses: []api_v1.EndpointAddress{{IP: "192.168.1.2", NodeName: &nodeName3}},
+					},
+				},
+			},
+			nodes: []*api_v1.Node{
+				{
+					ObjectMeta: meta_v1.ObjectMeta{Name: nodeName1},
+					Spec:       api_v1.NodeSpec{ProviderID: nodeName1},
+					Status: api_v1.NodeStatus{
+						Conditions: []api_v1.NodeCondition{{Type: api_v1.NodeReady, Status: api_v1.ConditionTrue}},
+					},
+				},
+				{
+					ObjectMeta: meta_v1.ObjectMeta{Name: nodeName2},
+					Spec:       api_v1.NodeSpec{ProviderID: nodeName2},
+					Status: api_v1.NodeStatus{
+						Conditions: []api_v1.NodeCondition{{Type: api_v1.NodeReady, Status: api_v1.ConditionTrue}},
+					},
+				},
+				{
+					ObjectMeta: meta_v1.ObjectMeta{Name: nodeName3},
+					Spec:       api_v1.NodeSpec{ProviderID: nodeName3},
+					Status: api_v1.NodeStatus{
+						Conditions: []api_v1.NodeCondition{{Type: api_v1.NodeReady, Status: api_v1.ConditionTrue}},
+					},
+				},
+			},
+			expectedTargets: []*elbv2.TargetDescription{
+				{
+					Id:   &nodeName2,
+					Port: aws.Int64(nodePort),
+				},
+			},
+			expectedError: false,
+		},
 		{
 			name: "failure scenario by service not found",
 			ingress: &extensions.Ingress{
@@ -310,6 +467,12 @@ func TestResolveWithModeInstance(t *testing.T) {
 				}
 				return nil, fmt.Errorf("No such service")
 			}
+			store.GetServiceEndpointsFunc = func(string) (*api_v1.Endpoints, error) {
+				if tc.endpoints != nil {
+					return tc.endpoints, nil
+				}
+				return nil, fmt.Errorf("No such endpoints")
+			}
 			store.ListNodesFunc = func() []*api_v1.Node {
 				return tc.nodes
 			}
@@ -319,8 +482,8 @@ func TestResolveWithModeInstance(t *testing.T) {
 
 			//  tc.nodeHealthProbe
 
-			resolver := NewEndpointResolver(store, cloud)
-			targets, err := resolver.Resolve(tc.ingress, tc.ingress.Spec.Backend, elbv2.TargetTypeEnumInstance)
+			resolver := NewEndpointResolver(store, cloud, nil)
+			targets, err := resolver.Resolve(tc.ingress, tc.ingress.Spec.Backend, elbv2.TargetTypeEnumInstance, tc.nodeSelector)
 			if !reflect.DeepEqual(tc.expectedTargets, targets) {
 				t.Errorf("expected targets: %#v, actual targets:%#v", tc.expectedTargets, targets)
 			}
@@ -394,6 +557,33 @@ func TestResolveWithModeIP(t *testing.T) {
 				},
 			},
 		},
+
+		// terminating pod with all containers ready
+		{
+			ObjectMeta: v1.ObjectMeta{
+				Name:              "pod3",
+				Namespace:         api_v1.NamespaceDefault,
+				DeletionTimestamp: &v1.Time{},
+				Labels: map[string]string{
+					"app": "my-app",
+				},
+			},
+			Spec: api_v1.PodSpec{
+				ReadinessGates: []api_v1.PodReadinessGate{
+					{
+						ConditionType: api_v1.PodConditionType("target-health.alb.ingress.k8s.aws/ingress_service_https"),
+					},
+				},
+			},
+			Status: api_v1.PodStatus{
+				Conditions: []api_v1.PodCondition{
+					{
+						Type:   api_v1.ContainersReady,
+						Status: api_v1.ConditionTrue,
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range []struct {
@@ -404,6 +594,71 @@ func TestResolveWithModeIP(t *testing.T) {
 		expectedTargets []*elbv2.TargetDescription
 		expectedError   bool
 	}{
+		{
+			name: "success scenario skipping terminating pods",
+			ingress: &extensions.Ingress{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Name:      "ingress",
+					Namespace: api_v1.NamespaceDefault,
+				},
+				Spec: extensions.IngressSpec{
+					Backend: &extensions.IngressBackend{
+						ServiceName: "service",
+						ServicePort: intstr.FromString("https"),
+					},
+				},
+			},
+			service: &api_v1.Service{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Name:      "service",
+					Namespace: api_v1.NamespaceDefault,
+				},
+				Spec: api_v1.ServiceSpec{
+					Type: api_v1.ServiceTypeClusterIP,
+					Ports: []api_v1.ServicePort{
+						{
+							Name: "https",
+							Port: portHTTPS,
+						},
+					},
+				},
+			},
+			endpoints: &api_v1.Endpoints{
+				Subsets: []api_v1.EndpointSubset{
+					{
+						Addresses: []api_v1.EndpointAddress{
+							{
+								IP: ip2,
+								TargetRef: &api_v1.ObjectReference{
+									Kind: "Pod",
+									Name: "pod2",
+								},
+							},
+							{
+								IP: ip3,
+								TargetRef: &api_v1.ObjectReference{
+									Kind: "Pod",
+									Name: "pod3",
+								},
+							},
+						},
+						Ports: []api_v1.EndpointPort{
+							{
+								Name: "https",
+								Port: portHTTPS,
+							},
+						},
+					},
+				},
+			},
+			expectedTargets: []*elbv2.TargetDescription{
+				{
+					Id:   aws.String(ip2),
+					Port: aws.Int64(portHTTPS),
+				},
+			},
+			expectedError: false,
+		},
 		{
 			name: "success scenario by numeric service port and numeric pod port",
 			ingress: &extensions.Ingress{
@@ -779,8 +1034,8 @@ func TestResolveWithModeIP(t *testing.T) {
 				return nil, store.NotExistsError(key)
 			}
 
-			resolver := NewEndpointResolver(s, cloud)
-			targets, err := resolver.Resolve(tc.ingress, tc.ingress.Spec.Backend, elbv2.TargetTypeEnumIp)
+			resolver := NewEndpointResolver(s, cloud, nil)
+			targets, err := resolver.Resolve(tc.ingress, tc.ingress.Spec.Backend, elbv2.TargetTypeEnumIp, nil)
 			if !reflect.DeepEqual(tc.expectedTargets, targets) {
 				t.Errorf("expected targets: %#v, actual targets:%#v", tc.expectedTargets, targets)
 			}
@@ -1217,7 +1472,7 @@ func TestReverseResolve(t *testing.T) {
 				return nil, store.NotExistsError(key)
 			}
 
-			resolver := NewEndpointResolver(s, cloud)
+			resolver := NewEndpointResolver(s, cloud, nil)
 			pods, err := resolver.ReverseResolve(tc.ingress, tc.ingress.Spec.Backend, tc.targets)
 			if !reflect.DeepEqual(tc.expectedPods, pods) {
 				t.Errorf("expected pods:%#v, actual pods: %#v", tc.expectedPods, pods)
@@ -1231,9 +1486,10 @@ func TestReverseResolve(t *testing.T) {
 
 func Test_IsNodeSuitableAsTrafficProxy(t *testing.T) {
 	tests := []struct {
-		name string
-		node *api_v1.Node
-		want bool
+		name           string
+		node           *api_v1.Node
+		excludedTaints []string
+		want           bool
 	}{
 		{
 			name: "suitable node",
@@ -1349,10 +1605,103 @@ func Test_IsNodeSuitableAsTrafficProxy(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "node is control-plane node",
+			node: &api_v1.Node{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Name: "awesome-node",
+					Labels: map[string]string{
+						"node-role.kubernetes.io/control-plane": "",
+					},
+				},
+				Status: api_v1.NodeStatus{
+					Conditions: []api_v1.NodeCondition{
+						{
+							Type:   api_v1.NodeReady,
+							Status: api_v1.ConditionTrue,
+						},
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "node is unschedulable",
+			node: &api_v1.Node{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Name: "awesome-node",
+				},
+				Spec: api_v1.NodeSpec{
+					Unschedulable: true,
+				},
+				Status: api_v1.NodeStatus{
+					Conditions: []api_v1.NodeCondition{
+						{
+							Type:   api_v1.NodeReady,
+							Status: api_v1.ConditionTrue,
+						},
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "node has excluded taint",
+			node: &api_v1.Node{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Name: "awesome-node",
+				},
+				Spec: api_v1.NodeSpec{
+					Taints: []api_v1.Taint{
+						{
+							Key:    "dedicated",
+							Value:  "batch",
+							Effect: api_v1.TaintEffectNoSchedule,
+						},
+					},
+				},
+				Status: api_v1.NodeStatus{
+					Conditions: []api_v1.NodeCondition{
+						{
+							Type:   api_v1.NodeReady,
+							Status: api_v1.ConditionTrue,
+						},
+					},
+				},
+			},
+			excludedTaints: []string{"dedicated"},
+			want:           false,
+		},
+		{
+			name: "node has other taint",
+			node: &api_v1.Node{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Name: "awesome-node",
+				},
+				Spec: api_v1.NodeSpec{
+					Taints: []api_v1.Taint{
+						{
+							Key:    "spot",
+							Effect: api_v1.TaintEffectPreferNoSchedule,
+						},
+					},
+				},
+				Status: api_v1.NodeStatus{
+					Conditions: []api_v1.NodeCondition{
+						{
+							Type:   api_v1.NodeReady,
+							Status: api_v1.ConditionTrue,
+						},
+					},
+				},
+			},
+			excludedTaints: []string{"dedicated"},
+			want:           true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := IsNodeSuitableAsTrafficProxy(tt.node)
+			got := IsNodeSuitableAsTrafficProxy(tt.node, tt.excludedTaints)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -0,0 +1,27 @@
+package backend
+
+import (
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// the DaemonSet of the Amazon VPC CNI plugin, which assigns pods secondary IPs of the VPC
+const (
+	vpcCNINamespace = "kube-system"
+	vpcCNIName      = "aws-node"
+)
+
+// DetectAutoTargetType returns the ip target type if the Amazon VPC CNI plugin is installed, as pod IPs are then routable from the VPC,
+// and the instance target type otherwise, or when the detection fails.
+func DetectAutoTargetType(clientSet kubernetes.Interface) (string, error) {
+	_, err := clientSet.AppsV1().DaemonSets(vpcCNINamespace).Get(vpcCNIName, metav1.GetOptions{})
+	if err == nil {
+		return elbv2.TargetTypeEnumIp, nil
+	}
+	if apierrors.IsNotFound(err) {
+		return elbv2.TargetTypeEnumInstance, nil
+	}
+	return elbv2.TargetTypeEnumInstance, err
+}
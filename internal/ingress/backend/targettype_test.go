@@ -0,0 +1,23 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestDetectAutoTargetType(t *testing.T) {
+	targetType, err := DetectAutoTargetType(fake.NewSimpleClientset())
+	assert.NoError(t, err)
+	assert.Equal(t, elbv2.TargetTypeEnumInstance, targetType)
+
+	targetType, err = DetectAutoTargetType(fake.NewSimpleClientset(&appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "aws-node"},
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, elbv2.TargetTypeEnumIp, targetType)
+}
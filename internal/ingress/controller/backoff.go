@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/workqueue"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// backoffJitterFactor is the maximum fraction of the computed delay added as jitter,
+// so ingresses failing on the same AWS error don't retry in lockstep.
+const backoffJitterFactor = 0.1
+
+// reconcileErrors is the reconcile errors counter of controller-runtime, which is registered by controller-runtime first.
+var reconcileErrors = registerCounterVec(prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "controller_runtime_reconcile_errors_total",
+	Help: "Total number of reconciliation errors per controller",
+}, []string{"controller"}))
+
+// registerCounterVec registers counter with the registry of controller-runtime, or returns the identical counter already registered.
+func registerCounterVec(counter *prometheus.CounterVec) *prometheus.CounterVec {
+	if err := ctrlmetrics.Registry.Register(counter); err != nil {
+		if registered, ok := err.(prometheus.AlreadyRegisteredError); ok {
+			return registered.ExistingCollector.(*prometheus.CounterVec)
+		}
+		panic(err)
+	}
+	return counter
+}
+
+// backoffReconciler wraps a reconciler and requeues failed requests with per-item exponential backoff,
+// and delays requests beyond an overall token bucket, so that bursts of ingress changes are spread out.
+// controller-runtime doesn't allow customizing the rate limiter of its work queue, so failures and delayed requests are translated into
+// RequeueAfter results instead of being returned as errors. Failures are still logged and counted by the reconcile errors metric
+// of controller-runtime, as returned errors are.
+type backoffReconciler struct {
+	reconcile.Reconciler
+
+	// name is the name of the controller of the reconciler, the label of its reconcile errors
+	name        string
+	rateLimiter workqueue.RateLimiter
+	// overallLimiter bounds the rate of reconciles of all requests, nil if unlimited
+	overallLimiter *rate.Limiter
+}
+
+// newBackoffReconciler constructs new backoffReconciler for the controller name, with the delay of each request starting at baseDelay and
+// doubling on every consecutive failure up to maxDelay, and at most qps reconciles per second in bursts of burst reconciles. A zero qps disables the latter.
+func newBackoffReconciler(name string, reconciler reconcile.Reconciler, baseDelay time.Duration, maxDelay time.Duration, qps float64, burst int) reconcile.Reconciler {
+	r := &backoffReconciler{
+		Reconciler:  reconciler,
+		name:        name,
+		rateLimiter: workqueue.NewItemExponentialFailureRateLimiter(baseDelay, maxDelay),
+	}
+	if qps > 0 {
+		r.overallLimiter = rate.NewLimiter(rate.Limit(qps), burst)
+	}
+	return r
+}
+
+func (r *backoffReconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	if r.overallLimiter != nil {
+		// the token is given back, the request takes one again when requeued.
+		reservation := r.overallLimiter.Reserve()
+		if delay := reservation.Delay(); delay > 0 {
+			reservation.Cancel()
+			glog.V(4).Infof("Delaying reconcile of %v by %v, reconciles are rate limited", request.NamespacedName, delay)
+			return reconcile.Result{RequeueAfter: delay}, nil
+		}
+	}
+	result, err := r.Reconciler.Reconcile(request)
+	if err != nil {
+		delay := wait.Jitter(r.rateLimiter.When(request), backoffJitterFactor)
+		glog.Errorf("Failed to reconcile %v, retrying in %v (attempt %d) due to %v",
+			request.NamespacedName, delay, r.rateLimiter.NumRequeues(request), err)
+		reconcileErrors.WithLabelValues(r.name).Inc()
+		return reconcile.Result{RequeueAfter: delay}, nil
+	}
+	r.rateLimiter.Forget(request)
+	return result, nil
+}
@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+type stubReconciler struct {
+	errs []error
+}
+
+func (r *stubReconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	err := r.errs[0]
+	r.errs = r.errs[1:]
+	return reconcile.Result{}, err
+}
+
+func TestBackoffReconciler_Reconcile(t *testing.T) {
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "ingress"}}
+	failure := errors.New("transient")
+	stub := &stubReconciler{errs: []error{failure, failure, failure, nil, failure}}
+	r := newBackoffReconciler("test-controller", stub, time.Second, 3*time.Second, 0, 0)
+	errorsBefore := testutil.ToFloat64(reconcileErrors.WithLabelValues("test-controller"))
+
+	// expectedMinDelays are the delays before jitter, which adds up to backoffJitterFactor on top.
+	for _, expectedMinDelay := range []time.Duration{time.Second, 2 * time.Second, 3 * time.Second, 0, time.Second} {
+		result, err := r.Reconcile(request)
+		assert.NoError(t, err)
+		assert.True(t, result.RequeueAfter >= expectedMinDelay)
+		assert.True(t, result.RequeueAfter <= time.Duration(float64(expectedMinDelay)*(1+backoffJitterFactor)))
+	}
+	// the failures are counted by the reconcile errors metric of controller-runtime, although they aren't returned.
+	assert.Equal(t, float64(4), testutil.ToFloat64(reconcileErrors.WithLabelValues("test-controller"))-errorsBefore)
+}
+
+func TestBackoffReconciler_Reconcile_rateLimited(t *testing.T) {
+	stub := &stubReconciler{errs: []error{nil, nil, nil}}
+	r := newBackoffReconciler("test-controller", stub, time.Second, 3*time.Second, 1, 2)
+
+	// a burst of requests for distinct ingresses is reconciled up to the burst, the rest is delayed until tokens are available again.
+	for i, expectedDelayed := range []bool{false, false, true, true} {
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: fmt.Sprintf("ingress-%d", i)}}
+		result, err := r.Reconcile(request)
+		assert.NoError(t, err)
+		if expectedDelayed {
+			assert.True(t, result.RequeueAfter > 0 && result.RequeueAfter <= time.Second, "delay %v", result.RequeueAfter)
+		} else {
+			assert.Equal(t, reconcile.Result{}, result)
+		}
+	}
+	assert.Len(t, stub.errs, 1, "delayed requests shouldn't be reconciled")
+}
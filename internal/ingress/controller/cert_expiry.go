@@ -0,0 +1,146 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/golang/glog"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/class"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/metric"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/k8s"
+	corev1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+)
+
+// certExpiryMonitor periodically checks the ACM and IAM certificates attached to the listeners of managed ingresses,
+// emitting warning events on ingresses with certificates that expire within window.
+type certExpiryMonitor struct {
+	cache           cache.Cache
+	cloud           aws.CloudAPI
+	recorder        record.EventRecorder
+	metricCollector metric.Collector
+	ingressClass    string
+
+	interval time.Duration
+	window   time.Duration
+	now      func() time.Time
+}
+
+func newCertExpiryMonitor(cache cache.Cache, cloud aws.CloudAPI, recorder record.EventRecorder, mc metric.Collector,
+	ingressClass string, interval time.Duration, window time.Duration) *certExpiryMonitor {
+	return &certExpiryMonitor{
+		cache:           cache,
+		cloud:           cloud,
+		recorder:        recorder,
+		metricCollector: mc,
+		ingressClass:    ingressClass,
+		interval:        interval,
+		window:          window,
+		now:             time.Now,
+	}
+}
+
+// Start implements manager.Runnable, it checks every interval until stop is closed.
+func (m *certExpiryMonitor) Start(stop <-chan struct{}) error {
+	wait.Until(func() { m.check(context.Background()) }, m.interval, stop)
+	return nil
+}
+
+func (m *certExpiryMonitor) check(ctx context.Context) {
+	ingressList := &extensions.IngressList{}
+	if err := m.cache.List(ctx, nil, ingressList); err != nil {
+		glog.Errorf("failed to list ingresses for certificate expiry checks due to %v", err)
+		return
+	}
+
+	// certificates are commonly shared by ingresses, so each one is only looked up once per check.
+	expiries := make(map[string]time.Time)
+	ingressExpiries := make(map[string]map[string]time.Time)
+	for i := range ingressList.Items {
+		ingress := &ingressList.Items[i]
+		if !class.IsValidIngress(m.ingressClass, ingress) {
+			continue
+		}
+		certExpiries, err := m.checkIngress(ctx, ingress, expiries)
+		if err != nil {
+			glog.Warningf("failed to check certificate expiry of ingress %v due to %v", k8s.MetaNamespaceKey(ingress), err)
+		}
+		if len(certExpiries) != 0 {
+			ingressExpiries[k8s.MetaNamespaceKey(ingress)] = certExpiries
+		}
+	}
+	m.metricCollector.SetCertificateExpiries(ingressExpiries)
+}
+
+// checkIngress returns the expiry of the certificates attached to the listeners of ingress, keyed by certificate ARN.
+func (m *certExpiryMonitor) checkIngress(ctx context.Context, ingress *extensions.Ingress, expiries map[string]time.Time) (map[string]time.Time, error) {
+	resources, err := GetManagedResources(ingress)
+	if err != nil || resources == nil {
+		return nil, err
+	}
+
+	certExpiries := make(map[string]time.Time)
+	for _, lsArn := range resources.ListenerARNs {
+		certificates, err := m.cloud.DescribeListenerCertificates(ctx, lsArn)
+		if err != nil {
+			return certExpiries, err
+		}
+		for _, certificate := range certificates {
+			certArn := aws.StringValue(certificate.CertificateArn)
+			if _, ok := certExpiries[certArn]; ok {
+				continue
+			}
+			expiry, ok := expiries[certArn]
+			if !ok {
+				if expiry, err = m.getCertificateExpiry(ctx, certArn); err != nil {
+					return certExpiries, err
+				}
+				expiries[certArn] = expiry
+			}
+			certExpiries[certArn] = expiry
+
+			remaining := expiry.Sub(m.now())
+			if remaining <= 0 {
+				m.recorder.Eventf(ingress, corev1.EventTypeWarning, "CERTIFICATE", "certificate %v expired at %v", certArn, expiry.UTC().Format(time.RFC3339))
+			} else if remaining < m.window {
+				m.recorder.Eventf(ingress, corev1.EventTypeWarning, "CERTIFICATE", "certificate %v expires in %v, at %v",
+					certArn, remaining.Truncate(time.Hour), expiry.UTC().Format(time.RFC3339))
+			}
+		}
+	}
+	return certExpiries, nil
+}
+
+func (m *certExpiryMonitor) getCertificateExpiry(ctx context.Context, certArn string) (time.Time, error) {
+	parsed, err := arn.Parse(certArn)
+	if err != nil {
+		return time.Time{}, err
+	}
+	switch parsed.Service {
+	case "acm":
+		certificate, err := m.cloud.DescribeCertificate(ctx, certArn)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if certificate.NotAfter == nil {
+			return time.Time{}, fmt.Errorf("certificate %v has no expiry, its status is %v", certArn, aws.StringValue(certificate.Status))
+		}
+		return *certificate.NotAfter, nil
+	case "iam":
+		// server-certificate/<path>/<name>
+		metadata, err := m.cloud.GetServerCertificateMetadata(ctx, path.Base(parsed.Resource))
+		if err != nil {
+			return time.Time{}, err
+		}
+		return aws.TimeValue(metadata.Expiration), nil
+	default:
+		return time.Time{}, fmt.Errorf("certificate %v is neither an ACM nor an IAM certificate", certArn)
+	}
+}
@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/metric"
+	albtesting "github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/testing"
+	"github.com/stretchr/testify/assert"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestCertExpiryMonitor_checkIngress(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	acmArn := "arn:aws:acm:us-west-2:123456789012:certificate/a1b2c3"
+	expiringAcmArn := "arn:aws:acm:us-west-2:123456789012:certificate/d4e5f6"
+	iamArn := "arn:aws:iam::123456789012:server-certificate/ingress/legacy"
+
+	cloud := albtesting.NewCloud("vpc-1", "cluster")
+	cloud.AddCertificate(&acm.CertificateDetail{CertificateArn: aws.String(acmArn), NotAfter: aws.Time(now.Add(90 * 24 * time.Hour))})
+	cloud.AddCertificate(&acm.CertificateDetail{CertificateArn: aws.String(expiringAcmArn), NotAfter: aws.Time(now.Add(10*24*time.Hour + 30*time.Minute))})
+	cloud.AddServerCertificate(&iam.ServerCertificateMetadata{ServerCertificateName: aws.String("legacy"), Expiration: aws.Time(now.Add(-time.Hour))})
+	lbOutput, err := cloud.CreateLoadBalancerWithContext(ctx, &elbv2.CreateLoadBalancerInput{Name: aws.String("lb")})
+	assert.NoError(t, err)
+	lbArn := lbOutput.LoadBalancers[0].LoadBalancerArn
+	httpOutput, err := cloud.CreateListenerWithContext(ctx, &elbv2.CreateListenerInput{LoadBalancerArn: lbArn, Port: aws.Int64(80), Protocol: aws.String("HTTP")})
+	assert.NoError(t, err)
+	httpsOutput, err := cloud.CreateListenerWithContext(ctx, &elbv2.CreateListenerInput{
+		LoadBalancerArn: lbArn,
+		Port:            aws.Int64(443),
+		Protocol:        aws.String("HTTPS"),
+		Certificates:    []*elbv2.Certificate{{CertificateArn: aws.String(acmArn)}},
+	})
+	assert.NoError(t, err)
+	httpsArn := httpsOutput.Listeners[0].ListenerArn
+	_, err = cloud.AddListenerCertificates(ctx, &elbv2.AddListenerCertificatesInput{
+		ListenerArn:  httpsArn,
+		Certificates: []*elbv2.Certificate{{CertificateArn: aws.String(expiringAcmArn)}, {CertificateArn: aws.String(iamArn)}},
+	})
+	assert.NoError(t, err)
+
+	ingress := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ingress"}}
+	listenerARNs := []string{aws.StringValue(httpOutput.Listeners[0].ListenerArn), aws.StringValue(httpsArn)}
+	recorder := record.NewFakeRecorder(10)
+	monitor := newCertExpiryMonitor(nil, cloud, recorder, metric.DummyCollector{}, "alb", time.Hour, 30*24*time.Hour)
+	monitor.now = func() time.Time { return now }
+
+	certExpiries, err := monitor.checkIngress(ctx, ingress, make(map[string]time.Time))
+	assert.NoError(t, err)
+	assert.Nil(t, certExpiries, "ingresses without managed resources have no certificates")
+
+	ingress.Annotations = map[string]string{ManagedResourcesAnnotation(): `{"listenerARNs":["` + listenerARNs[0] + `","` + listenerARNs[1] + `"]}`}
+	certExpiries, err = monitor.checkIngress(ctx, ingress, make(map[string]time.Time))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]time.Time{
+		acmArn:         now.Add(90 * 24 * time.Hour),
+		expiringAcmArn: now.Add(10*24*time.Hour + 30*time.Minute),
+		iamArn:         now.Add(-time.Hour),
+	}, certExpiries)
+	close(recorder.Events)
+	var events []string
+	for event := range recorder.Events {
+		events = append(events, event)
+	}
+	assert.Equal(t, []string{
+		"Warning CERTIFICATE certificate " + expiringAcmArn + " expires in 240h0m0s, at 2020-01-11T00:30:00Z",
+		"Warning CERTIFICATE certificate " + iamArn + " expired at 2019-12-31T23:00:00Z",
+	}, events)
+}
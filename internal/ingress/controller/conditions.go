@@ -0,0 +1,156 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+	"unicode/utf8"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/lb"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/parser"
+	corev1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// conditionsAnnotationSuffix is the suffix of the annotation publishing the reconciliation state of an ingress,
+// since extensions/v1beta1 ingresses have no status conditions.
+const conditionsAnnotationSuffix = "conditions"
+
+// Types of IngressCondition.
+const (
+	// IngressProvisioning is true while the LoadBalancer of the ingress is being provisioned.
+	IngressProvisioning = "Provisioning"
+	// IngressActive is true once the LoadBalancer of the ingress is active.
+	IngressActive = "Active"
+	// IngressDegraded is true while the LoadBalancer of the ingress is impaired or failed.
+	IngressDegraded = "Degraded"
+	// IngressError is true if the last reconcile of the ingress failed.
+	IngressError = "Error"
+)
+
+// provisioningRequeueInterval is how often ingresses whose LoadBalancer is provisioning are reconciled, until it's active.
+const provisioningRequeueInterval = 30 * time.Second
+
+// maxConditionMessageLength bounds the message of conditions, which hold the error of failed reconciles.
+const maxConditionMessageLength = 1024
+
+// IngressCondition is an aspect of the reconciliation state of an ingress, in the format of the status conditions of Kubernetes objects.
+type IngressCondition struct {
+	Type               string                 `json:"type"`
+	Status             corev1.ConditionStatus `json:"status"`
+	Reason             string                 `json:"reason,omitempty"`
+	Message            string                 `json:"message,omitempty"`
+	LastTransitionTime metav1.Time            `json:"lastTransitionTime"`
+}
+
+// ConditionsAnnotation returns the annotation key under which the IngressConditions of ingresses are published.
+func ConditionsAnnotation() string {
+	return parser.GetAnnotationWithPrefix(conditionsAnnotationSuffix)
+}
+
+// GetIngressConditions decodes the IngressConditions published on ingress, it returns nil if there aren't any.
+func GetIngressConditions(ingress *extensions.Ingress) ([]IngressCondition, error) {
+	raw, ok := ingress.Annotations[ConditionsAnnotation()]
+	if !ok {
+		return nil, nil
+	}
+	var conditions []IngressCondition
+	if err := json.Unmarshal([]byte(raw), &conditions); err != nil {
+		return nil, err
+	}
+	return conditions, nil
+}
+
+// isProvisioning returns whether ingress has a true IngressProvisioning condition.
+func isProvisioning(ingress *extensions.Ingress) bool {
+	conditions, _ := GetIngressConditions(ingress)
+	for _, condition := range conditions {
+		if condition.Type == IngressProvisioning {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// buildIngressConditions builds the IngressConditions of an ingress from its reconciled loadBalancer, or from the error of its reconcile.
+// The state of the loadBalancer is unknown if the reconcile failed, so the conditions other than IngressError are kept from current.
+func buildIngressConditions(current []IngressCondition, lbInfo *lb.LoadBalancer, reconcileErr error) []IngressCondition {
+	conditions := make([]IngressCondition, 0, 4)
+	if reconcileErr != nil {
+		for _, condition := range current {
+			if condition.Type != IngressError {
+				conditions = append(conditions, condition)
+			}
+		}
+		return append(conditions, IngressCondition{
+			Type:    IngressError,
+			Status:  corev1.ConditionTrue,
+			Reason:  "ReconcileFailed",
+			Message: truncateMessage(reconcileErr.Error()),
+		})
+	}
+
+	provisioning, active, degraded := corev1.ConditionFalse, corev1.ConditionFalse, corev1.ConditionFalse
+	reason, message := "LoadBalancerActive", ""
+	switch lbInfo.State {
+	case "provisioning":
+		provisioning, reason = corev1.ConditionTrue, "LoadBalancerProvisioning"
+	case "active_impaired":
+		active, degraded, reason, message = corev1.ConditionTrue, corev1.ConditionTrue, "LoadBalancerImpaired", lbInfo.StateReason
+	case "failed":
+		degraded, reason, message = corev1.ConditionTrue, "LoadBalancerFailed", lbInfo.StateReason
+	default:
+		active = corev1.ConditionTrue
+	}
+	return append(conditions,
+		IngressCondition{Type: IngressProvisioning, Status: provisioning, Reason: reason, Message: message},
+		IngressCondition{Type: IngressActive, Status: active, Reason: reason, Message: message},
+		IngressCondition{Type: IngressDegraded, Status: degraded, Reason: reason, Message: message},
+		IngressCondition{Type: IngressError, Status: corev1.ConditionFalse, Reason: "Reconciled"},
+	)
+}
+
+// withTransitionTimes sets the LastTransitionTime of conditions to the one of the current condition of the same type and status, or now.
+func withTransitionTimes(conditions []IngressCondition, current []IngressCondition, now metav1.Time) []IngressCondition {
+	for i := range conditions {
+		conditions[i].LastTransitionTime = now
+		for _, condition := range current {
+			if condition.Type == conditions[i].Type && condition.Status == conditions[i].Status {
+				conditions[i].LastTransitionTime = condition.LastTransitionTime
+			}
+		}
+	}
+	return conditions
+}
+
+func truncateMessage(message string) string {
+	if len(message) <= maxConditionMessageLength {
+		return message
+	}
+	message = message[:maxConditionMessageLength]
+	for !utf8.ValidString(message) {
+		message = message[:len(message)-1]
+	}
+	return message
+}
+
+// setIngressConditions records the IngressConditions of ingress given its reconciled loadBalancer, or the error of its reconcile.
+func setIngressConditions(ctx context.Context, ingress *extensions.Ingress, lbInfo *lb.LoadBalancer, reconcileErr error) error {
+	current, err := GetIngressConditions(ingress)
+	if err != nil {
+		albctx.GetLogger(ctx).Warnf("ignoring invalid %v annotation due to %v", ConditionsAnnotation(), err)
+		current = nil
+	}
+	conditions := withTransitionTimes(buildIngressConditions(current, lbInfo, reconcileErr), current, metav1.Now())
+	payload, err := json.Marshal(conditions)
+	if err != nil {
+		return err
+	}
+	if ingress.Annotations == nil {
+		ingress.Annotations = make(map[string]string)
+	}
+	ingress.Annotations[ConditionsAnnotation()] = string(payload)
+	return nil
+}
@@ -0,0 +1,121 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/lb"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_buildIngressConditions(t *testing.T) {
+	active := []IngressCondition{
+		{Type: IngressProvisioning, Status: corev1.ConditionFalse, Reason: "LoadBalancerActive"},
+		{Type: IngressActive, Status: corev1.ConditionTrue, Reason: "LoadBalancerActive"},
+		{Type: IngressDegraded, Status: corev1.ConditionFalse, Reason: "LoadBalancerActive"},
+		{Type: IngressError, Status: corev1.ConditionFalse, Reason: "Reconciled"},
+	}
+	for _, tc := range []struct {
+		Name               string
+		Current            []IngressCondition
+		LBInfo             *lb.LoadBalancer
+		ReconcileErr       error
+		ExpectedConditions []IngressCondition
+	}{
+		{
+			Name:   "provisioning",
+			LBInfo: &lb.LoadBalancer{State: "provisioning"},
+			ExpectedConditions: []IngressCondition{
+				{Type: IngressProvisioning, Status: corev1.ConditionTrue, Reason: "LoadBalancerProvisioning"},
+				{Type: IngressActive, Status: corev1.ConditionFalse, Reason: "LoadBalancerProvisioning"},
+				{Type: IngressDegraded, Status: corev1.ConditionFalse, Reason: "LoadBalancerProvisioning"},
+				{Type: IngressError, Status: corev1.ConditionFalse, Reason: "Reconciled"},
+			},
+		},
+		{
+			Name:               "active",
+			LBInfo:             &lb.LoadBalancer{State: "active"},
+			ExpectedConditions: active,
+		},
+		{
+			Name:   "impaired",
+			LBInfo: &lb.LoadBalancer{State: "active_impaired", StateReason: "subnet has no free addresses"},
+			ExpectedConditions: []IngressCondition{
+				{Type: IngressProvisioning, Status: corev1.ConditionFalse, Reason: "LoadBalancerImpaired", Message: "subnet has no free addresses"},
+				{Type: IngressActive, Status: corev1.ConditionTrue, Reason: "LoadBalancerImpaired", Message: "subnet has no free addresses"},
+				{Type: IngressDegraded, Status: corev1.ConditionTrue, Reason: "LoadBalancerImpaired", Message: "subnet has no free addresses"},
+				{Type: IngressError, Status: corev1.ConditionFalse, Reason: "Reconciled"},
+			},
+		},
+		{
+			Name:   "failed",
+			LBInfo: &lb.LoadBalancer{State: "failed", StateReason: "internal failure"},
+			ExpectedConditions: []IngressCondition{
+				{Type: IngressProvisioning, Status: corev1.ConditionFalse, Reason: "LoadBalancerFailed", Message: "internal failure"},
+				{Type: IngressActive, Status: corev1.ConditionFalse, Reason: "LoadBalancerFailed", Message: "internal failure"},
+				{Type: IngressDegraded, Status: corev1.ConditionTrue, Reason: "LoadBalancerFailed", Message: "internal failure"},
+				{Type: IngressError, Status: corev1.ConditionFalse, Reason: "Reconciled"},
+			},
+		},
+		{
+			Name:         "reconcile failed",
+			Current:      active,
+			ReconcileErr: errors.New("failed to reconcile listeners due to AccessDenied"),
+			ExpectedConditions: append(append([]IngressCondition{}, active[:3]...),
+				IngressCondition{Type: IngressError, Status: corev1.ConditionTrue, Reason: "ReconcileFailed", Message: "failed to reconcile listeners due to AccessDenied"}),
+		},
+		{
+			Name:         "first reconcile failed",
+			ReconcileErr: errors.New(strings.Repeat("é", maxConditionMessageLength)),
+			ExpectedConditions: []IngressCondition{
+				{Type: IngressError, Status: corev1.ConditionTrue, Reason: "ReconcileFailed", Message: strings.Repeat("é", maxConditionMessageLength/2)},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			assert.Equal(t, tc.ExpectedConditions, buildIngressConditions(tc.Current, tc.LBInfo, tc.ReconcileErr))
+		})
+	}
+}
+
+func Test_withTransitionTimes(t *testing.T) {
+	before := metav1.NewTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	now := metav1.NewTime(before.Add(time.Hour))
+	current := withTransitionTimes(buildIngressConditions(nil, &lb.LoadBalancer{State: "provisioning"}, nil), nil, before)
+	conditions := withTransitionTimes(buildIngressConditions(current, &lb.LoadBalancer{State: "active"}, nil), current, now)
+
+	// only the conditions whose status changed transitioned.
+	assert.Equal(t, map[string]metav1.Time{
+		IngressProvisioning: now,
+		IngressActive:       now,
+		IngressDegraded:     before,
+		IngressError:        before,
+	}, map[string]metav1.Time{
+		conditions[0].Type: conditions[0].LastTransitionTime,
+		conditions[1].Type: conditions[1].LastTransitionTime,
+		conditions[2].Type: conditions[2].LastTransitionTime,
+		conditions[3].Type: conditions[3].LastTransitionTime,
+	})
+}
+
+func Test_setIngressConditions(t *testing.T) {
+	ingress := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "ingress"}}
+	ctx := context.Background()
+
+	assert.NoError(t, setIngressConditions(ctx, ingress, &lb.LoadBalancer{State: "provisioning"}, nil))
+	assert.True(t, isProvisioning(ingress))
+
+	assert.NoError(t, setIngressConditions(ctx, ingress, nil, errors.New("AccessDenied")))
+	assert.True(t, isProvisioning(ingress))
+	conditions, err := GetIngressConditions(ingress)
+	assert.NoError(t, err)
+	assert.Equal(t, IngressError, conditions[3].Type)
+	assert.Equal(t, corev1.ConditionTrue, conditions[3].Status)
+	assert.Equal(t, "AccessDenied", conditions[3].Message)
+}
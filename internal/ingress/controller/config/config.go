@@ -6,14 +6,21 @@ import (
 	"hash/crc32"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/golang/glog"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/parser"
 	"github.com/spf13/pflag"
 	corev1 "k8s.io/api/core/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
+// TargetTypeAuto selects the ip target type if pod IPs are routable from the VPC, and the instance target type otherwise.
+const TargetTypeAuto = "auto"
+
 const (
 	defaultIngressClass            = ""
 	defaultAnnotationPrefix        = "alb.ingress.kubernetes.io"
@@ -24,10 +31,40 @@ const (
 	defaultRestrictSchemeNamespace = corev1.NamespaceDefault
 	defaultSyncRateLimit           = 0.3
 	defaultMaxConcurrentReconciles = 1
+
+	defaultReconcileBackoffBaseDelay = 1 * time.Second
+	defaultReconcileBackoffMaxDelay  = 5 * time.Minute
+	defaultReconcileRateLimit        = 10
+	defaultReconcileRateBurst        = 100
+
+	defaultEventDedupWindow = 10 * time.Minute
+	defaultEventRateLimit   = 10
+
+	defaultIngressFinalizer = true
+
+	defaultPreflightChecks             = true
+	defaultPreflightChecksPerReconcile = false
+
+	defaultCertificateExpiryCheckInterval = 12 * time.Hour
+	defaultCertificateExpiryWarningWindow = 30 * 24 * time.Hour
+
+	defaultCostEstimationInterval         = 6 * time.Hour
+	defaultCostEstimationRequestRate      = 10
+	defaultCostEstimationHourlyPrice      = 0.0225
+	defaultCostEstimationLCUPrice         = 0.008
+	defaultCostEstimationWarningThreshold = 0
+
+	defaultObserveInterval = 5 * time.Minute
+
+	defaultTargetsBatchSize = 200
 )
 
 var (
 	defaultDefaultTags = map[string]string{}
+
+	// reservedTagKeyPrefixes are the tag keys reserved by AWS or identifying the resources of the controller,
+	// which default tags would be overridden by or confuse ownership with.
+	reservedTagKeyPrefixes = []string{"aws:", "kubernetes.io/cluster", "kubernetes.io/namespace", "kubernetes.io/ingress-name", "kubernetes.io/service-", "ingress.k8s.aws/"}
 )
 
 // Configuration contains all the settings required by an Ingress controller
@@ -37,18 +74,104 @@ type Configuration struct {
 	// IngressClass is the ingress class that this controller will monitor for
 	IngressClass string
 
+	// IngressClassResources matches ingresses by the networking.k8s.io/v1 IngressClass resources implemented by the controller
+	IngressClassResources bool
+
 	AnnotationPrefix       string
 	ALBNamePrefix          string
 	DefaultTags            map[string]string
 	DefaultTargetType      string
 	DefaultBackendProtocol string
 
+	// AutoTargetType is the target type of the auto target type, ip if the cluster CNI plugin provides routable pod IPs, detected at startup
+	AutoTargetType string
+
+	// InternalSubnetTags and InternetFacingSubnetTags replace the role tags the subnets of internal and internet-facing LoadBalancers
+	// are discovered by, an empty value matching any value of the tag
+	InternalSubnetTags       map[string]string
+	InternetFacingSubnetTags map[string]string
+
 	SyncRateLimit           float32
 	MaxConcurrentReconciles int
 
+	// ReconcileBackoffBaseDelay and ReconcileBackoffMaxDelay bounds the exponential backoff of failed reconciles
+	ReconcileBackoffBaseDelay time.Duration
+	ReconcileBackoffMaxDelay  time.Duration
+
+	// ReconcileRateLimit and ReconcileRateBurst bound the rate of reconciles across all ingresses
+	ReconcileRateLimit float64
+	ReconcileRateBurst int
+
+	// EventDedupWindow and EventRateLimit bound the events emitted per ingress
+	EventDedupWindow time.Duration
+	EventRateLimit   int
+
 	RestrictScheme          bool
 	RestrictSchemeNamespace string
 
+	// IngressFinalizer adds a finalizer to ingresses, so they're only deleted once their AWS resources are
+	IngressFinalizer bool
+
+	// DisableDeletionProtectionOnDelete turns off the deletion protection of LoadBalancers whose ingress is deleted, instead of failing the deletion
+	DisableDeletionProtectionOnDelete bool
+
+	// BackendSecurityGroup attaches a shared backend securityGroup to the LoadBalancers with a managed securityGroup,
+	// worker node securityGroups allow traffic from it instead of from the securityGroup of each LoadBalancer
+	BackendSecurityGroup bool
+
+	// EndpointSlices resolves ip targets from the discovery.k8s.io/v1 EndpointSlices of services instead of their Endpoints,
+	// it's turned off at startup if the API server doesn't serve them
+	EndpointSlices bool
+
+	// TargetsBatchSize caps the targets of each RegisterTargets and DeregisterTargets call, 0 means unlimited
+	TargetsBatchSize int
+
+	// TargetDrainingWait is how long reconciles wait for the deregistered ip targets to be draining, 0 means they don't wait
+	TargetDrainingWait time.Duration
+
+	// EndpointsDebounceWindow delays the reconciles triggered by endpoint changes, so that the changes within the window are coalesced
+	EndpointsDebounceWindow time.Duration
+
+	// ExcludeNodeTaints are the keys of the taints of nodes never registered as instance targets
+	ExcludeNodeTaints []string
+
+	// TargetGroupBinding runs the controller of TargetGroupBindings, which register the endpoints of services into existing target groups
+	TargetGroupBinding bool
+
+	// ReadinessGateWebhookPort serves the webhook injecting the readiness gates of ip targets into pods over TLS, 0 disables the webhook
+	ReadinessGateWebhookPort     int
+	ReadinessGateWebhookCertFile string
+	ReadinessGateWebhookKeyFile  string
+
+	// NamespaceALBQuota and NamespaceInternetFacingALBQuota limit the ALBs of the ingresses in each namespace, 0 means unlimited
+	NamespaceALBQuota               int
+	NamespaceInternetFacingALBQuota int
+
+	// ExternalDNS maintains annotations for external-dns on ingresses, ExternalDNSOwnerID is recorded alongside as ownership hint
+	ExternalDNS        bool
+	ExternalDNSOwnerID string
+
+	// PreflightChecks checks IAM permissions and quotas at startup, PreflightChecksPerReconcile checks them for each ingress as well
+	PreflightChecks             bool
+	PreflightChecksPerReconcile bool
+
+	// CertificateExpiryCheckInterval is the period of checking listener certificates, warnings are emitted for those expiring within CertificateExpiryWarningWindow
+	CertificateExpiryCheckInterval time.Duration
+	CertificateExpiryWarningWindow time.Duration
+
+	// CostEstimationInterval is the period of estimating the monthly cost of ALBs, for CostEstimationRequestRate requests per second
+	// at CostEstimationHourlyPrice and CostEstimationLCUPrice. Warnings are emitted for those above CostEstimationWarningThreshold
+	CostEstimationInterval         time.Duration
+	CostEstimationRequestRate      float64
+	CostEstimationHourlyPrice      float64
+	CostEstimationLCUPrice         float64
+	CostEstimationWarningThreshold float64
+
+	// ObserveOnly compares the AWS resources of ingresses with their desired state every ObserveInterval and reports drift,
+	// without modifying AWS resources or ingresses
+	ObserveOnly     bool
+	ObserveInterval time.Duration
+
 	// InternetFacingIngresses is an dynamic setting that can be updated by configMaps
 	InternetFacingIngresses map[string][]string
 
@@ -69,6 +192,9 @@ func (cfg *Configuration) BindFlags(fs *pflag.FlagSet) {
 		`Name of the ingress class this controller satisfies.
 		The class of an Ingress object is set using the annotation "kubernetes.io/ingress.class".
 		All ingress classes are satisfied if this parameter is left empty.`)
+	fs.BoolVar(&cfg.IngressClassResources, "ingress-class-resources", false,
+		`Match ingresses by the networking.k8s.io/v1 IngressClass resources, which requires Kubernetes 1.19 or later.
+		Ingresses of an IngressClass are only satisfied if its controller is ingress.k8s.aws/alb, and ingresses without class take the class of the default IngressClass`)
 	fs.StringVar(&cfg.AnnotationPrefix, "annotations-prefix", defaultAnnotationPrefix,
 		`Prefix of the Ingress annotations specific to the AWS ALB controller.`)
 
@@ -76,19 +202,93 @@ func (cfg *Configuration) BindFlags(fs *pflag.FlagSet) {
 		`Prefix to add to ALB resources (11 alphanumeric characters or less)`)
 	fs.StringToStringVar(&cfg.DefaultTags, "default-tags", defaultDefaultTags,
 		`Default tags to add to all ALBs`)
+	fs.StringToStringVar(&cfg.InternalSubnetTags, "internal-subnet-tags", nil,
+		`Tags the subnets of internal ALBs are discovered by instead of kubernetes.io/role/internal-elb, an empty value matches any value of the tag`)
+	fs.StringToStringVar(&cfg.InternetFacingSubnetTags, "internet-facing-subnet-tags", nil,
+		`Tags the subnets of internet-facing ALBs are discovered by instead of kubernetes.io/role/elb, an empty value matches any value of the tag`)
 	fs.StringVar(&cfg.DefaultTargetType, "target-type", defaultTargetType,
-		`Default target type to use for target groups, must be "instance" or "ip"`)
+		`Default target type to use for target groups, must be "instance", "ip" or "auto" to use "ip" if the Amazon VPC CNI plugin is installed and "instance" otherwise`)
 	fs.StringVar(&cfg.DefaultBackendProtocol, "backend-protocol", defaultBackendProtocol,
 		`Default protocol to use for target groups, must be "HTTP" or "HTTPS"`)
 	fs.Float32Var(&cfg.SyncRateLimit, "sync-rate-limit", defaultSyncRateLimit,
 		`Define the sync frequency upper limit`)
 	fs.IntVar(&cfg.MaxConcurrentReconciles, "max-concurrent-reconciles", defaultMaxConcurrentReconciles,
-		`Define the maximum of number concurrently running reconcile loops`)
+		`Define the maximum of number concurrently running reconcile loops. Reconciles for the same ingress are never run concurrently`)
+	fs.DurationVar(&cfg.ReconcileBackoffBaseDelay, "reconcile-backoff-base-delay", defaultReconcileBackoffBaseDelay,
+		`Delay before retrying a failed reconcile. It doubles on every consecutive failure of the same ingress`)
+	fs.DurationVar(&cfg.ReconcileBackoffMaxDelay, "reconcile-backoff-max-delay", defaultReconcileBackoffMaxDelay,
+		`Maximum delay before retrying a failed reconcile`)
+	fs.Float64Var(&cfg.ReconcileRateLimit, "reconcile-rate-limit", defaultReconcileRateLimit,
+		`Maximum number of reconciles per second across all ingresses, further reconciles are delayed. 0 disables rate limiting`)
+	fs.IntVar(&cfg.ReconcileRateBurst, "reconcile-rate-burst", defaultReconcileRateBurst,
+		`Maximum number of reconciles above reconcile-rate-limit in a burst`)
+	fs.DurationVar(&cfg.EventDedupWindow, "event-dedup-window", defaultEventDedupWindow,
+		`Period during which identical events of an ingress are emitted only once, 0 disables deduplication`)
+	fs.IntVar(&cfg.EventRateLimit, "event-rate-limit", defaultEventRateLimit,
+		`Maximum number of events emitted per ingress per minute, 0 disables rate limiting`)
 	fs.BoolVar(&cfg.RestrictScheme, "restrict-scheme", defaultRestrictScheme,
 		`Restrict the scheme to internal except for whitelisted namespaces`)
 	fs.StringVar(&cfg.RestrictSchemeNamespace, "restrict-scheme-namespace", defaultRestrictSchemeNamespace,
 		`The namespace with the ConfigMap containing the allowed ingresses. Only respected when restrict-scheme is true.`)
+	fs.BoolVar(&cfg.IngressFinalizer, "ingress-finalizer", defaultIngressFinalizer,
+		`Add a finalizer to ingresses, so they're only deleted once the controller deleted their AWS resources. Existing finalizers are honored either way`)
+	fs.BoolVar(&cfg.DisableDeletionProtectionOnDelete, "disable-deletion-protection-on-delete", false,
+		`Turn off the deletion protection of the LoadBalancer of deleted ingresses so it can be deleted. Otherwise the deletion fails, and is retried until deletion protection is turned off`)
+	fs.BoolVar(&cfg.BackendSecurityGroup, "backend-security-group", false,
+		`Attach a backend security group shared by all ALBs alongside their managed security group, and allow traffic from it on worker nodes with a single rule instead of one rule per ALB`)
+	fs.BoolVar(&cfg.EndpointSlices, "endpoint-slices", true,
+		`Resolve ip targets from the discovery.k8s.io/v1 EndpointSlices of services instead of their Endpoints, which are truncated to 1000 addresses. Endpoints are used if the API server doesn't serve EndpointSlices`)
+	fs.IntVar(&cfg.TargetsBatchSize, "targets-batch-size", defaultTargetsBatchSize,
+		`Maximum number of targets registered or deregistered per call, larger changes are split in batches. 0 means unlimited`)
+	fs.DurationVar(&cfg.TargetDrainingWait, "target-draining-wait", 0,
+		`Maximum time a reconcile waits for the deregistered ip targets, e.g. of terminating pods, to be draining. 0 doesn't wait`)
+	fs.DurationVar(&cfg.EndpointsDebounceWindow, "endpoints-debounce-window", 0,
+		`Delay of the reconciles triggered by changes of the endpoints of services, so that rapid changes are coalesced into a single reconcile. 0 reconciles immediately`)
+	fs.StringSliceVar(&cfg.ExcludeNodeTaints, "exclude-node-taints", nil,
+		`Keys of the taints of nodes never registered as instance targets, in addition to unschedulable nodes and nodes labeled with node.kubernetes.io/exclude-from-external-load-balancers`)
+	fs.BoolVar(&cfg.TargetGroupBinding, "target-group-binding", false,
+		`Register the endpoints of services into the existing target groups of TargetGroupBinding resources. The TargetGroupBinding CRD must be installed`)
+	fs.IntVar(&cfg.ReadinessGateWebhookPort, "readiness-gate-webhook-port", 0,
+		`Port of the mutating webhook injecting the readiness gates of the ingresses with ip targets into new pods, 0 disables the webhook`)
+	fs.StringVar(&cfg.ReadinessGateWebhookCertFile, "readiness-gate-webhook-cert-file", "",
+		`Certificate served by the readiness gate webhook, which must be trusted by the caBundle of its MutatingWebhookConfiguration`)
+	fs.StringVar(&cfg.ReadinessGateWebhookKeyFile, "readiness-gate-webhook-key-file", "",
+		`Private key of the certificate served by the readiness gate webhook`)
+	fs.IntVar(&cfg.NamespaceALBQuota, "namespace-alb-quota", 0,
+		`Maximum number of ALBs for the ingresses of each namespace, further ingresses are rejected. 0 means unlimited`)
+	fs.IntVar(&cfg.NamespaceInternetFacingALBQuota, "namespace-internet-facing-alb-quota", 0,
+		`Maximum number of internet-facing ALBs for the ingresses of each namespace, further ingresses are rejected. 0 means unlimited`)
+
+	fs.BoolVar(&cfg.ExternalDNS, "external-dns", false,
+		`Annotate ingresses with the hostnames, ALB alias target and hosted zone for external-dns. Overridable per ingress with the external-dns annotation`)
+	fs.StringVar(&cfg.ExternalDNSOwnerID, "external-dns-owner-id", "",
+		`Ownership hint recorded on ingresses annotated for external-dns, to match with the external-dns --annotation-filter flag`)
+
+	fs.BoolVar(&cfg.PreflightChecks, "preflight-checks", defaultPreflightChecks,
+		`Check IAM permissions and ELBV2 quotas at startup, by simulating the IAM policies of the controller`)
+	fs.BoolVar(&cfg.PreflightChecksPerReconcile, "preflight-checks-per-reconcile", defaultPreflightChecksPerReconcile,
+		`Check the IAM permissions and ELBV2 quotas needed by each ingress before reconciling it, and emit warning events for the issues found`)
 
+	fs.DurationVar(&cfg.CertificateExpiryCheckInterval, "certificate-expiry-check-interval", defaultCertificateExpiryCheckInterval,
+		`Period at which the expiry of certificates attached to listeners is checked, 0 disables the checks`)
+	fs.DurationVar(&cfg.CertificateExpiryWarningWindow, "certificate-expiry-warning-window", defaultCertificateExpiryWarningWindow,
+		`Emit warning events on ingresses whose listener certificates expire within this period`)
+
+	fs.DurationVar(&cfg.CostEstimationInterval, "cost-estimation-interval", defaultCostEstimationInterval,
+		`Period at which the monthly cost of ALBs is estimated, 0 disables the estimation`)
+	fs.Float64Var(&cfg.CostEstimationRequestRate, "cost-estimation-request-rate", defaultCostEstimationRequestRate,
+		`Requests per second assumed for each ALB when estimating its LCUs`)
+	fs.Float64Var(&cfg.CostEstimationHourlyPrice, "cost-estimation-hourly-price", defaultCostEstimationHourlyPrice,
+		`Price of an ALB hour in US dollars, the default is the price in us-east-1`)
+	fs.Float64Var(&cfg.CostEstimationLCUPrice, "cost-estimation-lcu-price", defaultCostEstimationLCUPrice,
+		`Price of an LCU hour in US dollars, the default is the price in us-east-1`)
+	fs.Float64Var(&cfg.CostEstimationWarningThreshold, "cost-estimation-warning-threshold", defaultCostEstimationWarningThreshold,
+		`Emit warning events on ingresses whose ALB is estimated to cost more per month than this amount of US dollars, 0 disables the warnings`)
+
+	fs.BoolVar(&cfg.ObserveOnly, "observe-only", false,
+		`Only report drift between the AWS resources of ingresses and their desired state through events and metrics, without modifying anything. Meant for canaries of new versions alongside the active controller`)
+	fs.DurationVar(&cfg.ObserveInterval, "observe-interval", defaultObserveInterval,
+		`Period at which each ingress is compared with its AWS resources, only respected when observe-only is true`)
 	cfg.FeatureGate.BindFlags(fs)
 }
 
@@ -116,16 +316,105 @@ func (cfg *Configuration) BindEnv() error {
 	return nil
 }
 
+// Validate checks the whole configuration, all the errors found are returned together.
 func (cfg *Configuration) Validate() error {
+	var errs []error
 	if cfg.DefaultTargetType == "pod" {
 		glog.Warningf("The target type parameter for 'pod' has changed to 'ip' to better match AWS APIs and documentation.")
 		cfg.DefaultTargetType = elbv2.TargetTypeEnumIp
 	}
 	if len(cfg.ClusterName) == 0 {
-		return fmt.Errorf("clusterName must be specified")
+		errs = append(errs, fmt.Errorf("clusterName must be specified"))
+	}
+	if len(cfg.IngressClass) != 0 {
+		if msgs := validation.IsQualifiedName(cfg.IngressClass); len(msgs) != 0 {
+			errs = append(errs, fmt.Errorf("ingressClass %q is invalid: %v", cfg.IngressClass, strings.Join(msgs, "; ")))
+		}
+	}
+	for k, v := range cfg.DefaultTags {
+		if err := validateDefaultTag(k, v); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if _, ok := cfg.InternalSubnetTags[""]; ok {
+		errs = append(errs, fmt.Errorf("internalSubnetTags keys must not be empty"))
+	}
+	if _, ok := cfg.InternetFacingSubnetTags[""]; ok {
+		errs = append(errs, fmt.Errorf("internetFacingSubnetTags keys must not be empty"))
+	}
+	for _, key := range cfg.ExcludeNodeTaints {
+		if msgs := validation.IsQualifiedName(key); len(msgs) != 0 {
+			errs = append(errs, fmt.Errorf("excludeNodeTaints key %q is invalid: %v", key, strings.Join(msgs, "; ")))
+		}
+	}
+	if cfg.TargetsBatchSize < 0 {
+		errs = append(errs, fmt.Errorf("targetsBatchSize must not be negative, got %d", cfg.TargetsBatchSize))
+	}
+	if cfg.TargetDrainingWait < 0 {
+		errs = append(errs, fmt.Errorf("targetDrainingWait must not be negative, got %v", cfg.TargetDrainingWait))
+	}
+	if cfg.EndpointsDebounceWindow < 0 {
+		errs = append(errs, fmt.Errorf("endpointsDebounceWindow must not be negative, got %v", cfg.EndpointsDebounceWindow))
+	}
+	if cfg.MaxConcurrentReconciles < 1 {
+		errs = append(errs, fmt.Errorf("maxConcurrentReconciles must be at least 1, got %d", cfg.MaxConcurrentReconciles))
+	}
+	if cfg.ReconcileBackoffBaseDelay <= 0 {
+		errs = append(errs, fmt.Errorf("reconcileBackoffBaseDelay must be positive, got %v", cfg.ReconcileBackoffBaseDelay))
+	}
+	if cfg.ReconcileBackoffMaxDelay < cfg.ReconcileBackoffBaseDelay {
+		errs = append(errs, fmt.Errorf("reconcileBackoffMaxDelay must be at least reconcileBackoffBaseDelay, got %v", cfg.ReconcileBackoffMaxDelay))
+	}
+	if cfg.ReconcileRateLimit < 0 {
+		errs = append(errs, fmt.Errorf("reconcileRateLimit must not be negative, got %v", cfg.ReconcileRateLimit))
+	}
+	if cfg.ReconcileRateLimit > 0 && cfg.ReconcileRateBurst < 1 {
+		errs = append(errs, fmt.Errorf("reconcileRateBurst must be at least 1, got %d", cfg.ReconcileRateBurst))
+	}
+	if cfg.EventDedupWindow < 0 {
+		errs = append(errs, fmt.Errorf("eventDedupWindow must not be negative, got %v", cfg.EventDedupWindow))
+	}
+	if cfg.EventRateLimit < 0 {
+		errs = append(errs, fmt.Errorf("eventRateLimit must not be negative, got %d", cfg.EventRateLimit))
+	}
+	if cfg.NamespaceALBQuota < 0 {
+		errs = append(errs, fmt.Errorf("namespaceALBQuota must not be negative, got %d", cfg.NamespaceALBQuota))
+	}
+	if cfg.NamespaceInternetFacingALBQuota < 0 {
+		errs = append(errs, fmt.Errorf("namespaceInternetFacingALBQuota must not be negative, got %d", cfg.NamespaceInternetFacingALBQuota))
+	}
+	if cfg.ReadinessGateWebhookPort < 0 || cfg.ReadinessGateWebhookPort > 65535 {
+		errs = append(errs, fmt.Errorf("readinessGateWebhookPort must be between 0 and 65535, got %d", cfg.ReadinessGateWebhookPort))
+	}
+	if cfg.ReadinessGateWebhookPort != 0 && (len(cfg.ReadinessGateWebhookCertFile) == 0 || len(cfg.ReadinessGateWebhookKeyFile) == 0) {
+		errs = append(errs, fmt.Errorf("readinessGateWebhookCertFile and readinessGateWebhookKeyFile must be specified to serve the readiness gate webhook"))
+	}
+	if cfg.CertificateExpiryCheckInterval < 0 {
+		errs = append(errs, fmt.Errorf("certificateExpiryCheckInterval must not be negative, got %v", cfg.CertificateExpiryCheckInterval))
+	}
+	if cfg.CertificateExpiryWarningWindow < 0 {
+		errs = append(errs, fmt.Errorf("certificateExpiryWarningWindow must not be negative, got %v", cfg.CertificateExpiryWarningWindow))
+	}
+	if cfg.CostEstimationInterval < 0 {
+		errs = append(errs, fmt.Errorf("costEstimationInterval must not be negative, got %v", cfg.CostEstimationInterval))
+	}
+	if cfg.CostEstimationRequestRate < 0 {
+		errs = append(errs, fmt.Errorf("costEstimationRequestRate must not be negative, got %v", cfg.CostEstimationRequestRate))
+	}
+	if cfg.CostEstimationHourlyPrice < 0 {
+		errs = append(errs, fmt.Errorf("costEstimationHourlyPrice must not be negative, got %v", cfg.CostEstimationHourlyPrice))
+	}
+	if cfg.CostEstimationLCUPrice < 0 {
+		errs = append(errs, fmt.Errorf("costEstimationLCUPrice must not be negative, got %v", cfg.CostEstimationLCUPrice))
+	}
+	if cfg.CostEstimationWarningThreshold < 0 {
+		errs = append(errs, fmt.Errorf("costEstimationWarningThreshold must not be negative, got %v", cfg.CostEstimationWarningThreshold))
+	}
+	if cfg.ObserveOnly && cfg.ObserveInterval <= 0 {
+		errs = append(errs, fmt.Errorf("observeInterval must be positive, got %v", cfg.ObserveInterval))
 	}
 	if len(cfg.ALBNamePrefix) > 12 {
-		return fmt.Errorf("ALBNamePrefix must be 12 characters or less")
+		errs = append(errs, fmt.Errorf("ALBNamePrefix must be 12 characters or less"))
 	}
 	if len(cfg.ALBNamePrefix) == 0 {
 		cfg.ALBNamePrefix = generateALBNamePrefix(cfg.ClusterName)
@@ -133,6 +422,32 @@ func (cfg *Configuration) Validate() error {
 
 	// TODO: I know, bad smell here:D
 	parser.AnnotationsPrefix = cfg.AnnotationPrefix
+	return utilerrors.NewAggregate(errs)
+}
+
+// ResolveTargetType returns the target type of targetType, the detected AutoTargetType for TargetTypeAuto.
+func (cfg *Configuration) ResolveTargetType(targetType string) string {
+	if targetType != TargetTypeAuto {
+		return targetType
+	}
+	if len(cfg.AutoTargetType) == 0 {
+		return elbv2.TargetTypeEnumInstance
+	}
+	return cfg.AutoTargetType
+}
+
+func validateDefaultTag(key string, value string) error {
+	if len(key) == 0 || len(key) > 128 {
+		return fmt.Errorf("defaultTags key %q must be 1 to 128 characters", key)
+	}
+	if len(value) > 256 {
+		return fmt.Errorf("defaultTags value of %q must be 256 characters or less", key)
+	}
+	for _, prefix := range reservedTagKeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return fmt.Errorf("defaultTags key %q is reserved, keys must not start with %q", key, prefix)
+		}
+	}
 	return nil
 }
 
@@ -1 +1,228 @@
 package config
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfiguration_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		Name          string
+		Cfg           Configuration
+		ExpectedError error
+	}{
+		{
+			Name: "valid configuration",
+			Cfg: Configuration{
+				ClusterName:               "cluster",
+				MaxConcurrentReconciles:   3,
+				ReconcileBackoffBaseDelay: time.Second,
+				ReconcileBackoffMaxDelay:  time.Minute,
+			},
+		},
+		{
+			Name: "default tags",
+			Cfg: Configuration{
+				ClusterName:               "cluster",
+				DefaultTags:               map[string]string{"CostCenter": "1234", "kubernetes.io/team": "web"},
+				MaxConcurrentReconciles:   1,
+				ReconcileBackoffBaseDelay: time.Second,
+				ReconcileBackoffMaxDelay:  time.Minute,
+			},
+		},
+		{
+			Name: "default tag reserved by AWS",
+			Cfg: Configuration{
+				ClusterName:               "cluster",
+				DefaultTags:               map[string]string{"aws:cloudformation:stack-name": "stack"},
+				MaxConcurrentReconciles:   1,
+				ReconcileBackoffBaseDelay: time.Second,
+				ReconcileBackoffMaxDelay:  time.Minute,
+			},
+			ExpectedError: errors.New(`defaultTags key "aws:cloudformation:stack-name" is reserved, keys must not start with "aws:"`),
+		},
+		{
+			Name: "subnet tags",
+			Cfg: Configuration{
+				ClusterName:               "cluster",
+				InternalSubnetTags:        map[string]string{"network": "private"},
+				InternetFacingSubnetTags:  map[string]string{"network": "public", "alb": ""},
+				MaxConcurrentReconciles:   1,
+				ReconcileBackoffBaseDelay: time.Second,
+				ReconcileBackoffMaxDelay:  time.Minute,
+			},
+		},
+		{
+			Name: "subnet tag without key",
+			Cfg: Configuration{
+				ClusterName:               "cluster",
+				InternetFacingSubnetTags:  map[string]string{"": "public"},
+				MaxConcurrentReconciles:   1,
+				ReconcileBackoffBaseDelay: time.Second,
+				ReconcileBackoffMaxDelay:  time.Minute,
+			},
+			ExpectedError: errors.New("internetFacingSubnetTags keys must not be empty"),
+		},
+		{
+			Name: "invalid excluded node taint",
+			Cfg: Configuration{
+				ClusterName:               "cluster",
+				ExcludeNodeTaints:         []string{"dedicated=batch"},
+				MaxConcurrentReconciles:   1,
+				ReconcileBackoffBaseDelay: time.Second,
+				ReconcileBackoffMaxDelay:  time.Minute,
+			},
+			ExpectedError: errors.New(`excludeNodeTaints key "dedicated=batch" is invalid: name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')`),
+		},
+		{
+			Name: "default tag identifying the resources of the controller",
+			Cfg: Configuration{
+				ClusterName:               "cluster",
+				DefaultTags:               map[string]string{"ingress.k8s.aws/stack": "default/web"},
+				MaxConcurrentReconciles:   1,
+				ReconcileBackoffBaseDelay: time.Second,
+				ReconcileBackoffMaxDelay:  time.Minute,
+			},
+			ExpectedError: errors.New(`defaultTags key "ingress.k8s.aws/stack" is reserved, keys must not start with "ingress.k8s.aws/"`),
+		},
+		{
+			Name: "missing cluster name",
+			Cfg: Configuration{
+				MaxConcurrentReconciles:   1,
+				ReconcileBackoffBaseDelay: time.Second,
+				ReconcileBackoffMaxDelay:  time.Minute,
+			},
+			ExpectedError: errors.New("clusterName must be specified"),
+		},
+		{
+			Name: "non-positive max concurrent reconciles",
+			Cfg: Configuration{
+				ClusterName:               "cluster",
+				MaxConcurrentReconciles:   0,
+				ReconcileBackoffBaseDelay: time.Second,
+				ReconcileBackoffMaxDelay:  time.Minute,
+			},
+			ExpectedError: errors.New("maxConcurrentReconciles must be at least 1, got 0"),
+		},
+		{
+			Name: "max backoff delay smaller than base delay",
+			Cfg: Configuration{
+				ClusterName:               "cluster",
+				MaxConcurrentReconciles:   1,
+				ReconcileBackoffBaseDelay: time.Minute,
+				ReconcileBackoffMaxDelay:  time.Second,
+			},
+			ExpectedError: errors.New("reconcileBackoffMaxDelay must be at least reconcileBackoffBaseDelay, got 1s"),
+		},
+		{
+			Name: "reconcile rate limit without burst",
+			Cfg: Configuration{
+				ClusterName:               "cluster",
+				MaxConcurrentReconciles:   1,
+				ReconcileBackoffBaseDelay: time.Second,
+				ReconcileBackoffMaxDelay:  time.Minute,
+				ReconcileRateLimit:        10,
+			},
+			ExpectedError: errors.New("reconcileRateBurst must be at least 1, got 0"),
+		},
+		{
+			Name: "negative event rate limit",
+			Cfg: Configuration{
+				ClusterName:               "cluster",
+				MaxConcurrentReconciles:   1,
+				ReconcileBackoffBaseDelay: time.Second,
+				ReconcileBackoffMaxDelay:  time.Minute,
+				EventRateLimit:            -1,
+			},
+			ExpectedError: errors.New("eventRateLimit must not be negative, got -1"),
+		},
+		{
+			Name: "negative certificate expiry warning window",
+			Cfg: Configuration{
+				ClusterName:                    "cluster",
+				MaxConcurrentReconciles:        1,
+				ReconcileBackoffBaseDelay:      time.Second,
+				ReconcileBackoffMaxDelay:       time.Minute,
+				CertificateExpiryWarningWindow: -time.Hour,
+			},
+			ExpectedError: errors.New("certificateExpiryWarningWindow must not be negative, got -1h0m0s"),
+		},
+		{
+			Name: "negative cost estimation LCU price",
+			Cfg: Configuration{
+				ClusterName:               "cluster",
+				MaxConcurrentReconciles:   1,
+				ReconcileBackoffBaseDelay: time.Second,
+				ReconcileBackoffMaxDelay:  time.Minute,
+				CostEstimationLCUPrice:    -0.008,
+			},
+			ExpectedError: errors.New("costEstimationLCUPrice must not be negative, got -0.008"),
+		},
+		{
+			Name: "negative namespace internet-facing ALB quota",
+			Cfg: Configuration{
+				ClusterName:                     "cluster",
+				MaxConcurrentReconciles:         1,
+				ReconcileBackoffBaseDelay:       time.Second,
+				ReconcileBackoffMaxDelay:        time.Minute,
+				NamespaceInternetFacingALBQuota: -1,
+			},
+			ExpectedError: errors.New("namespaceInternetFacingALBQuota must not be negative, got -1"),
+		},
+		{
+			Name: "readiness gate webhook without certificate",
+			Cfg: Configuration{
+				ClusterName:                 "cluster",
+				MaxConcurrentReconciles:     1,
+				ReconcileBackoffBaseDelay:   time.Second,
+				ReconcileBackoffMaxDelay:    time.Minute,
+				ReadinessGateWebhookPort:    9443,
+				ReadinessGateWebhookKeyFile: "/etc/webhook/tls.key",
+			},
+			ExpectedError: errors.New("readinessGateWebhookCertFile and readinessGateWebhookKeyFile must be specified to serve the readiness gate webhook"),
+		},
+		{
+			Name: "invalid ingress class",
+			Cfg: Configuration{
+				ClusterName:               "cluster",
+				IngressClass:              "alb internal",
+				MaxConcurrentReconciles:   1,
+				ReconcileBackoffBaseDelay: time.Second,
+				ReconcileBackoffMaxDelay:  time.Minute,
+			},
+			ExpectedError: errors.New(`ingressClass "alb internal" is invalid: name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')`),
+		},
+		{
+			Name: "multiple errors",
+			Cfg: Configuration{
+				MaxConcurrentReconciles:   1,
+				ReconcileBackoffBaseDelay: time.Second,
+				ReconcileBackoffMaxDelay:  time.Minute,
+				EventRateLimit:            -1,
+				NamespaceALBQuota:         -2,
+			},
+			ExpectedError: errors.New("[clusterName must be specified, eventRateLimit must not be negative, got -1, namespaceALBQuota must not be negative, got -2]"),
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := tc.Cfg.Validate()
+			if tc.ExpectedError == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.ExpectedError.Error())
+			}
+		})
+	}
+}
+
+func TestConfiguration_ResolveTargetType(t *testing.T) {
+	cfg := &Configuration{}
+	assert.Equal(t, "ip", cfg.ResolveTargetType("ip"))
+	assert.Equal(t, "instance", cfg.ResolveTargetType(TargetTypeAuto), "auto is instance when undetected")
+	cfg.AutoTargetType = "ip"
+	assert.Equal(t, "ip", cfg.ResolveTargetType(TargetTypeAuto))
+	assert.Equal(t, "instance", cfg.ResolveTargetType("instance"))
+}
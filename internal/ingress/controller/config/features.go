@@ -30,6 +30,9 @@ const (
 	WAF            Feature = "waf"
 	WAFV2          Feature = "wafv2"
 	ShieldAdvanced Feature = "shield"
+	Route53        Feature = "route53"
+	CloudWatch     Feature = "cloudwatch"
+	ZonalShift     Feature = "zonalshift"
 )
 
 type FeatureGate interface {
@@ -60,6 +63,9 @@ func NewFeatureGate() FeatureGate {
 			WAF:            true,
 			WAFV2:          true,
 			ShieldAdvanced: true,
+			Route53:        false,
+			CloudWatch:     false,
+			ZonalShift:     false,
 		},
 	}
 }
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/auth"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -12,14 +13,22 @@ import (
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/sg"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/tags"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/tg"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/backend"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/config"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/handlers"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/store"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/ingressapi"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/metric"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/preflight"
+	discoveryv1 "github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/discovery/v1"
+	networking "github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/networking/v1"
 	corev1 "k8s.io/api/core/v1"
 	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -27,58 +36,141 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
-func Initialize(config *config.Configuration, mgr manager.Manager, mc metric.Collector, cloud aws.CloudAPI) error {
+// Initialize registers the ingress controller with mgr.
+// The returned Drainer should be invoked once mgr stopped, to let in-flight reconciles finish and the events they recorded be written.
+func Initialize(config *config.Configuration, mgr manager.Manager, mc metric.Collector, cloud aws.CloudAPI) (Drainer, error) {
 	authModule := auth.NewModule(mgr.GetCache())
-	reconciler, err := newReconciler(config, mgr, mc, cloud, authModule)
+	checker := preflight.NewChecker(cloud, config.FeatureGate)
+	if config.PreflightChecks {
+		runPreflightChecks(checker)
+	}
+	store, err := store.New(mgr, config)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	c, err := controller.New("alb-ingress-controller", mgr, controller.Options{Reconciler: reconciler, MaxConcurrentReconciles: config.MaxConcurrentReconciles})
+	clientSet, err := kubernetes.NewForConfig(mgr.GetConfig())
 	if err != nil {
-		return err
+		return nil, err
+	}
+	recorder := newEventRecorder(mgr.GetScheme(), "alb-ingress-controller", &typedcorev1.EventSinkImpl{Interface: clientSet.CoreV1().Events("")})
+	patcher, err := ingressapi.NewPatcher(mgr.GetConfig())
+	if err != nil {
+		return nil, err
+	}
+	reconciler, err := newReconciler(config, mgr, mc, cloud, store, authModule, checker, recorder, patcher)
+	if err != nil {
+		return nil, err
+	}
+	reconciler = newBackoffReconciler("alb-ingress-controller", reconciler, config.ReconcileBackoffBaseDelay, config.ReconcileBackoffMaxDelay,
+		config.ReconcileRateLimit, config.ReconcileRateBurst)
+	drainingReconciler := newDrainingReconciler(reconciler)
+	drainer := &shutdownDrainer{Drainer: drainingReconciler, recorder: recorder}
+	c, err := controller.New("alb-ingress-controller", mgr, controller.Options{Reconciler: drainingReconciler, MaxConcurrentReconciles: config.MaxConcurrentReconciles})
+	if err != nil {
+		return nil, err
 	}
 	if err := config.BindDynamicSettings(mgr, c, cloud); err != nil {
-		return err
+		return nil, err
 	}
 
 	ingressChan := make(chan event.GenericEvent)
 	serviceChan := make(chan event.GenericEvent)
 	if err := authModule.Init(c, ingressChan, serviceChan); err != nil {
-		return fmt.Errorf("failed to init auth module due to %v", err)
+		return nil, fmt.Errorf("failed to init auth module due to %v", err)
+	}
+	if err := watchClusterEvents(c, mgr.GetCache(), ingressChan, serviceChan, config); err != nil {
+		return nil, fmt.Errorf("failed to watch cluster events due to %v", err)
+	}
+	if config.IngressClassResources {
+		if err := c.Watch(&source.Kind{Type: &networking.IngressClass{}}, &handlers.EnqueueRequestsForIngressClassEvent{
+			Cache: mgr.GetCache(),
+		}); err != nil {
+			return nil, fmt.Errorf("failed to watch IngressClasses due to %v", err)
+		}
+	}
+	if config.TargetGroupBinding && !config.ObserveOnly {
+		if err := initTargetGroupBindingController(config, mgr, cloud, store, recorder); err != nil {
+			return nil, fmt.Errorf("failed to init TargetGroupBinding controller due to %v", err)
+		}
 	}
-	if err := watchClusterEvents(c, mgr.GetCache(), ingressChan, serviceChan, config.IngressClass); err != nil {
-		return fmt.Errorf("failed to watch cluster events due to %v", err)
+	if config.ReadinessGateWebhookPort != 0 {
+		webhook := newReadinessGateWebhook(mgr.GetCache(), store, config.IngressClass,
+			config.ReadinessGateWebhookPort, config.ReadinessGateWebhookCertFile, config.ReadinessGateWebhookKeyFile)
+		if err := mgr.Add(webhook); err != nil {
+			return nil, fmt.Errorf("failed to add readiness gate webhook due to %v", err)
+		}
+	}
+	if config.CertificateExpiryCheckInterval > 0 {
+		monitor := newCertExpiryMonitor(mgr.GetCache(), cloud, recorder, mc,
+			config.IngressClass, config.CertificateExpiryCheckInterval, config.CertificateExpiryWarningWindow)
+		if err := mgr.Add(monitor); err != nil {
+			return nil, fmt.Errorf("failed to add certificate expiry monitor due to %v", err)
+		}
+	}
+	if config.CostEstimationInterval > 0 {
+		model := costModel{
+			requestRate: config.CostEstimationRequestRate,
+			hourlyPrice: config.CostEstimationHourlyPrice,
+			lcuPrice:    config.CostEstimationLCUPrice,
+		}
+		estimator := newCostEstimator(mgr.GetCache(), cloud, recorder, mc,
+			config.IngressClass, config.CostEstimationInterval, config.CostEstimationWarningThreshold, model)
+		if err := mgr.Add(estimator); err != nil {
+			return nil, fmt.Errorf("failed to add cost estimator due to %v", err)
+		}
 	}
 
-	return nil
+	return drainer, nil
 }
 
-func newReconciler(config *config.Configuration, mgr manager.Manager, mc metric.Collector, cloud aws.CloudAPI, authModule auth.Module) (reconcile.Reconciler, error) {
-	store, err := store.New(mgr, config)
-	if err != nil {
-		return nil, err
-	}
+func newReconciler(config *config.Configuration, mgr manager.Manager, mc metric.Collector, cloud aws.CloudAPI, store store.Storer, authModule auth.Module, checker preflight.Checker, recorder record.EventRecorder, patcher ingressPatcher) (reconcile.Reconciler, error) {
 	client := mgr.GetClient()
+	if config.ObserveOnly {
+		client = readOnlyClient{client}
+		patcher = readOnlyIngressPatcher{}
+	}
 	nameTagGenerator := generator.NewNameTagGenerator(*config)
 	tagsController := tags.NewController(cloud)
-	endpointResolver := backend.NewEndpointResolver(store, cloud)
+	endpointResolver := backend.NewEndpointResolver(store, cloud, config.ExcludeNodeTaints)
 	tgGroupController := tg.NewGroupController(cloud, store, nameTagGenerator, tagsController, endpointResolver, client)
 	lsGroupController := ls.NewGroupController(store, cloud, authModule)
-	sgAssociationController := sg.NewAssociationController(store, cloud, tagsController, nameTagGenerator)
+	sgAssociationController := sg.NewAssociationController(store, cloud, tagsController, nameTagGenerator, config.BackendSecurityGroup)
 	lbController := lb.NewController(cloud, store,
 		nameTagGenerator, tgGroupController, lsGroupController, sgAssociationController, tagsController)
 
+	var preflightChecker preflight.Checker
+	if config.PreflightChecksPerReconcile {
+		preflightChecker = checker
+	}
+	var quota *namespaceQuota
+	if config.NamespaceALBQuota > 0 || config.NamespaceInternetFacingALBQuota > 0 {
+		apiClient, err := ingressapi.NewAPIClient(mgr.GetConfig())
+		if err != nil {
+			return nil, err
+		}
+		quota = newNamespaceQuota(apiClient, config.IngressClass, config.NamespaceALBQuota, config.NamespaceInternetFacingALBQuota)
+	}
+
 	return &Reconciler{
-		client:          client,
-		cache:           mgr.GetCache(),
-		recorder:        mgr.GetRecorder("alb-ingress-controller"),
-		store:           store,
-		lbController:    lbController,
-		metricCollector: mc,
+		client:           client,
+		ingressPatcher:   patcher,
+		cache:            mgr.GetCache(),
+		recorder:         recorder,
+		eventLimiter:     albctx.NewEventLimiter(config.EventDedupWindow, config.EventRateLimit, time.Minute),
+		store:            store,
+		lbController:     lbController,
+		preflightChecker: preflightChecker,
+		namespaceQuota:   quota,
+		ingressClass:     config.IngressClass,
+		addFinalizers:    config.IngressFinalizer,
+		observeOnly:      config.ObserveOnly,
+		observeInterval:  config.ObserveInterval,
+		metricCollector:  mc,
 	}, nil
 }
 
-func watchClusterEvents(c controller.Controller, cache cache.Cache, ingressChan <-chan event.GenericEvent, serviceChan <-chan event.GenericEvent, ingressClass string) error {
+func watchClusterEvents(c controller.Controller, cache cache.Cache, ingressChan <-chan event.GenericEvent, serviceChan <-chan event.GenericEvent, config *config.Configuration) error {
+	ingressClass := config.IngressClass
 	if err := c.Watch(&source.Kind{Type: &extensions.Ingress{}}, &handlers.EnqueueRequestsForIngressEvent{
 		IngressClass: ingressClass,
 	}); err != nil {
@@ -103,21 +195,35 @@ func watchClusterEvents(c controller.Controller, cache cache.Cache, ingressChan
 		return err
 	}
 
-	if err := c.Watch(&source.Kind{Type: &corev1.Endpoints{}}, &handlers.EnqueueRequestsForEndpointsEvent{
-		IngressClass: ingressClass,
-		Cache:        cache,
-	}); err != nil {
-		return err
+	if config.EndpointSlices {
+		if err := c.Watch(&source.Kind{Type: &discoveryv1.EndpointSlice{}}, &handlers.EnqueueRequestsForEndpointSliceEvent{
+			IngressClass:   ingressClass,
+			DebounceWindow: config.EndpointsDebounceWindow,
+			Cache:          cache,
+		}); err != nil {
+			return err
+		}
+	} else {
+		if err := c.Watch(&source.Kind{Type: &corev1.Endpoints{}}, &handlers.EnqueueRequestsForEndpointsEvent{
+			IngressClass:   ingressClass,
+			DebounceWindow: config.EndpointsDebounceWindow,
+			Cache:          cache,
+		}); err != nil {
+			return err
+		}
 	}
 	if err := c.Watch(&source.Kind{Type: &corev1.Node{}}, &handlers.EnqueueRequestsForNodeEvent{
-		IngressClass: ingressClass,
-		Cache:        cache,
+		IngressClass:   ingressClass,
+		ExcludedTaints: config.ExcludeNodeTaints,
+		Cache:          cache,
 	}); err != nil {
 		return err
 	}
 	if err := c.Watch(&source.Kind{Type: &corev1.Pod{}}, &handlers.EnqueueRequestsForPodsEvent{
-		IngressClass: ingressClass,
-		Cache:        cache,
+		IngressClass:   ingressClass,
+		DebounceWindow: config.EndpointsDebounceWindow,
+		EndpointSlices: config.EndpointSlices,
+		Cache:          cache,
 	}); err != nil {
 		return err
 	}
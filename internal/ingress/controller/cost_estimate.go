@@ -0,0 +1,151 @@
+package controller
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/class"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/metric"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/k8s"
+	corev1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+)
+
+const (
+	hoursPerMonth = 730
+	// each LCU covers 25 new connections per second, or 1000 rule evaluations per second beyond the first 10 rules of a request.
+	newConnectionsPerLCU  = 25
+	ruleEvaluationsPerLCU = 1000
+	freeRules             = 10
+)
+
+// costModel prices ALBs for a steady request rate, where each request opens a new connection and is evaluated by every rule of a listener.
+// It ignores the processed bytes and active connections dimensions of LCUs, which depend on traffic the controller can't see.
+type costModel struct {
+	requestRate float64
+	hourlyPrice float64
+	lcuPrice    float64
+}
+
+type costEstimate struct {
+	// rules is the highest number of rules of a listener.
+	rules   int
+	lcus    float64
+	monthly float64
+	// ruleBound is whether rule evaluations contribute the most LCUs.
+	ruleBound bool
+}
+
+func (m costModel) estimate(rules int) costEstimate {
+	connectionLCUs := m.requestRate / newConnectionsPerLCU
+	ruleLCUs := m.requestRate * math.Max(0, float64(rules-freeRules)) / ruleEvaluationsPerLCU
+	lcus := math.Max(connectionLCUs, ruleLCUs)
+	return costEstimate{
+		rules:     rules,
+		lcus:      lcus,
+		monthly:   hoursPerMonth * (m.hourlyPrice + lcus*m.lcuPrice),
+		ruleBound: ruleLCUs > connectionLCUs,
+	}
+}
+
+// costEstimator periodically estimates the monthly cost of the ALBs of managed ingresses,
+// emitting warning events on ingresses estimated above threshold.
+type costEstimator struct {
+	cache           cache.Cache
+	cloud           aws.CloudAPI
+	recorder        record.EventRecorder
+	metricCollector metric.Collector
+	ingressClass    string
+
+	interval  time.Duration
+	threshold float64
+	model     costModel
+}
+
+func newCostEstimator(cache cache.Cache, cloud aws.CloudAPI, recorder record.EventRecorder, mc metric.Collector,
+	ingressClass string, interval time.Duration, threshold float64, model costModel) *costEstimator {
+	return &costEstimator{
+		cache:           cache,
+		cloud:           cloud,
+		recorder:        recorder,
+		metricCollector: mc,
+		ingressClass:    ingressClass,
+		interval:        interval,
+		threshold:       threshold,
+		model:           model,
+	}
+}
+
+// Start implements manager.Runnable, it estimates every interval until stop is closed.
+func (e *costEstimator) Start(stop <-chan struct{}) error {
+	wait.Until(func() { e.estimateAll(context.Background()) }, e.interval, stop)
+	return nil
+}
+
+func (e *costEstimator) estimateAll(ctx context.Context) {
+	ingressList := &extensions.IngressList{}
+	if err := e.cache.List(ctx, nil, ingressList); err != nil {
+		glog.Errorf("failed to list ingresses for cost estimation due to %v", err)
+		return
+	}
+
+	costs := make(map[string]float64)
+	for i := range ingressList.Items {
+		ingress := &ingressList.Items[i]
+		if !class.IsValidIngress(e.ingressClass, ingress) {
+			continue
+		}
+		estimate, err := e.estimateIngress(ctx, ingress)
+		if err != nil {
+			glog.Warningf("failed to estimate cost of ingress %v due to %v", k8s.MetaNamespaceKey(ingress), err)
+			continue
+		}
+		if estimate != nil {
+			costs[k8s.MetaNamespaceKey(ingress)] = estimate.monthly
+		}
+	}
+	e.metricCollector.SetCostEstimates(costs)
+}
+
+// estimateIngress returns the cost estimate of the ALB of ingress, or nil if it has none yet.
+func (e *costEstimator) estimateIngress(ctx context.Context, ingress *extensions.Ingress) (*costEstimate, error) {
+	resources, err := GetManagedResources(ingress)
+	if err != nil || resources == nil {
+		return nil, err
+	}
+
+	maxRules := 0
+	for _, lsArn := range resources.ListenerARNs {
+		rules, err := e.cloud.GetRules(ctx, lsArn)
+		if err != nil {
+			return nil, err
+		}
+		count := 0
+		for _, rule := range rules {
+			if !aws.BoolValue(rule.IsDefault) {
+				count++
+			}
+		}
+		if count > maxRules {
+			maxRules = count
+		}
+	}
+
+	estimate := e.model.estimate(maxRules)
+	if e.threshold > 0 && estimate.monthly > e.threshold {
+		if estimate.ruleBound {
+			e.recorder.Eventf(ingress, corev1.EventTypeWarning, "COST", "estimated monthly cost of $%.2f exceeds $%.2f, mostly from evaluating %d rules of a listener at %v requests/s, only %d rules per request are free",
+				estimate.monthly, e.threshold, estimate.rules, e.model.requestRate, freeRules)
+		} else {
+			e.recorder.Eventf(ingress, corev1.EventTypeWarning, "COST", "estimated monthly cost of $%.2f exceeds $%.2f, with %.2f LCUs at %v requests/s",
+				estimate.monthly, e.threshold, estimate.lcus, e.model.requestRate)
+		}
+	}
+	return &estimate, nil
+}
@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/metric"
+	albtesting "github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/testing"
+	"github.com/stretchr/testify/assert"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+var testCostModel = costModel{requestRate: 10, hourlyPrice: 0.0225, lcuPrice: 0.008}
+
+func TestCostModel_estimate(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		rules    int
+		expected costEstimate
+	}{
+		{
+			name:     "no rules",
+			rules:    0,
+			expected: costEstimate{rules: 0, lcus: 0.4, monthly: 18.761},
+		},
+		{
+			name:     "rules within the new connections LCUs",
+			rules:    50,
+			expected: costEstimate{rules: 50, lcus: 0.4, monthly: 18.761},
+		},
+		{
+			name:     "rules beyond the new connections LCUs",
+			rules:    110,
+			expected: costEstimate{rules: 110, lcus: 1, monthly: 22.265, ruleBound: true},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			estimate := testCostModel.estimate(tc.rules)
+			assert.Equal(t, tc.expected.rules, estimate.rules)
+			assert.InDelta(t, tc.expected.lcus, estimate.lcus, 1e-9)
+			assert.InDelta(t, tc.expected.monthly, estimate.monthly, 1e-9)
+			assert.Equal(t, tc.expected.ruleBound, estimate.ruleBound)
+		})
+	}
+}
+
+func TestCostEstimator_estimateIngress(t *testing.T) {
+	ctx := context.Background()
+	cloud := albtesting.NewCloud("vpc-1", "cluster")
+	lbOutput, err := cloud.CreateLoadBalancerWithContext(ctx, &elbv2.CreateLoadBalancerInput{Name: aws.String("lb")})
+	assert.NoError(t, err)
+	lbArn := lbOutput.LoadBalancers[0].LoadBalancerArn
+	var lsArns []string
+	for i, ruleCount := range []int{3, 110} {
+		lsOutput, err := cloud.CreateListenerWithContext(ctx, &elbv2.CreateListenerInput{LoadBalancerArn: lbArn, Port: aws.Int64(int64(80 + i)), Protocol: aws.String("HTTP")})
+		assert.NoError(t, err)
+		lsArn := lsOutput.Listeners[0].ListenerArn
+		lsArns = append(lsArns, aws.StringValue(lsArn))
+		for priority := 1; priority <= ruleCount; priority++ {
+			_, err := cloud.CreateRuleWithContext(ctx, &elbv2.CreateRuleInput{ListenerArn: lsArn, Priority: aws.Int64(int64(priority))})
+			assert.NoError(t, err)
+		}
+	}
+
+	ingress := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ingress"}}
+	recorder := record.NewFakeRecorder(10)
+	estimator := newCostEstimator(nil, cloud, recorder, metric.DummyCollector{}, "alb", time.Hour, 20, testCostModel)
+
+	estimate, err := estimator.estimateIngress(ctx, ingress)
+	assert.NoError(t, err)
+	assert.Nil(t, estimate, "ingresses without managed resources have no ALB")
+
+	ingress.Annotations = map[string]string{ManagedResourcesAnnotation(): `{"listenerARNs":["` + lsArns[0] + `","` + lsArns[1] + `"]}`}
+	estimate, err = estimator.estimateIngress(ctx, ingress)
+	assert.NoError(t, err)
+	if assert.NotNil(t, estimate) {
+		assert.Equal(t, 110, estimate.rules)
+		assert.InDelta(t, 22.265, estimate.monthly, 1e-9)
+	}
+	close(recorder.Events)
+	var events []string
+	for event := range recorder.Events {
+		events = append(events, event)
+	}
+	assert.Equal(t, []string{
+		"Warning COST estimated monthly cost of $22.27 exceeds $20.00, mostly from evaluating 110 rules of a listener at 10 requests/s, only 10 rules per request are free",
+	}, events)
+}
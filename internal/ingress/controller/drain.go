@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Drainer stops accepting new reconciles and waits for in-flight ones to finish.
+type Drainer interface {
+	// Drain blocks until all in-flight reconciles finished or timeout elapsed.
+	Drain(timeout time.Duration) error
+}
+
+// drainingReconciler wraps a reconciler and tracks in-flight reconciles,
+// so that the controller don't exit in the middle of modifying AWS resources (e.g. rules deleted but not recreated).
+type drainingReconciler struct {
+	reconcile.Reconciler
+
+	mutex    sync.Mutex
+	draining bool
+	inFlight sync.WaitGroup
+}
+
+func newDrainingReconciler(reconciler reconcile.Reconciler) *drainingReconciler {
+	return &drainingReconciler{
+		Reconciler: reconciler,
+	}
+}
+
+func (r *drainingReconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	r.mutex.Lock()
+	if r.draining {
+		r.mutex.Unlock()
+		// the work queue is shutting down, the request will be picked up again by the next controller instance.
+		return reconcile.Result{}, nil
+	}
+	r.inFlight.Add(1)
+	r.mutex.Unlock()
+	defer r.inFlight.Done()
+
+	return r.Reconciler.Reconcile(request)
+}
+
+func (r *drainingReconciler) Drain(timeout time.Duration) error {
+	r.mutex.Lock()
+	r.draining = true
+	r.mutex.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		r.inFlight.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-time.After(timeout):
+		return fmt.Errorf("timed out after %v waiting for in-flight reconciles", timeout)
+	}
+}
+
+// shutdownDrainer flushes the event recorder once in-flight reconciles finished, within the rest of the timeout,
+// so that the events they recorded while draining are written before exit.
+type shutdownDrainer struct {
+	Drainer
+	recorder *eventRecorder
+}
+
+func (d *shutdownDrainer) Drain(timeout time.Duration) error {
+	start := time.Now()
+	err := d.Drainer.Drain(timeout)
+	if flushErr := d.recorder.Flush(timeout - time.Since(start)); err == nil {
+		err = flushErr
+	}
+	return err
+}
@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+type blockingReconciler struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (r *blockingReconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	close(r.started)
+	<-r.release
+	return reconcile.Result{}, nil
+}
+
+func TestDrainingReconciler_Drain(t *testing.T) {
+	t.Run("waits for in-flight reconcile", func(t *testing.T) {
+		inner := &blockingReconciler{started: make(chan struct{}), release: make(chan struct{})}
+		r := newDrainingReconciler(inner)
+		go r.Reconcile(reconcile.Request{})
+		<-inner.started
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			close(inner.release)
+		}()
+		assert.NoError(t, r.Drain(time.Second))
+	})
+
+	t.Run("times out on stuck reconcile", func(t *testing.T) {
+		inner := &blockingReconciler{started: make(chan struct{}), release: make(chan struct{})}
+		r := newDrainingReconciler(inner)
+		go r.Reconcile(reconcile.Request{})
+		<-inner.started
+		defer close(inner.release)
+
+		assert.Error(t, r.Drain(10*time.Millisecond))
+	})
+
+	t.Run("rejects reconciles once draining", func(t *testing.T) {
+		inner := &blockingReconciler{started: make(chan struct{}), release: make(chan struct{})}
+		r := newDrainingReconciler(inner)
+		assert.NoError(t, r.Drain(time.Second))
+
+		result, err := r.Reconcile(reconcile.Request{})
+		assert.NoError(t, err)
+		assert.Equal(t, reconcile.Result{}, result)
+		select {
+		case <-inner.started:
+			t.Error("reconcile shouldn't be invoked while draining")
+		default:
+		}
+	})
+}
@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+const eventFlushInterval = 10 * time.Millisecond
+
+// eventRecorder is a record.EventRecorder of a client-go event broadcaster, whose events can be flushed before exit.
+// The broadcaster of the manager's recorder is never flushed, so the events recorded while draining in-flight reconciles would be lost on exit.
+// Events are written as recorded, since reconciles deduplicate and rate limit them with albctx.EventLimiter.
+type eventRecorder struct {
+	record.EventRecorder
+	sink record.EventSink
+
+	// pending counts the events recorded but not written yet.
+	// The ones the broadcaster drops, e.g. when its queue is full, are never written and keep Flush waiting until it times out.
+	pending int64
+}
+
+func newEventRecorder(scheme *runtime.Scheme, component string, sink record.EventSink) *eventRecorder {
+	broadcaster := record.NewBroadcaster()
+	r := &eventRecorder{
+		EventRecorder: broadcaster.NewRecorder(scheme, corev1.EventSource{Component: component}),
+		sink:          sink,
+	}
+	broadcaster.StartEventWatcher(r.write)
+	return r
+}
+
+func (r *eventRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	atomic.AddInt64(&r.pending, 1)
+	r.EventRecorder.Event(object, eventtype, reason, message)
+}
+
+func (r *eventRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	atomic.AddInt64(&r.pending, 1)
+	r.EventRecorder.Eventf(object, eventtype, reason, messageFmt, args...)
+}
+
+func (r *eventRecorder) PastEventf(object runtime.Object, timestamp metav1.Time, eventtype, reason, messageFmt string, args ...interface{}) {
+	atomic.AddInt64(&r.pending, 1)
+	r.EventRecorder.PastEventf(object, timestamp, eventtype, reason, messageFmt, args...)
+}
+
+func (r *eventRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	atomic.AddInt64(&r.pending, 1)
+	r.EventRecorder.AnnotatedEventf(object, annotations, eventtype, reason, messageFmt, args...)
+}
+
+// Flush blocks until the events recorded so far are written, or timeout elapsed.
+func (r *eventRecorder) Flush(timeout time.Duration) error {
+	deadline := time.After(timeout)
+	ticker := time.NewTicker(eventFlushInterval)
+	defer ticker.Stop()
+	for atomic.LoadInt64(&r.pending) > 0 {
+		select {
+		case <-deadline:
+			return fmt.Errorf("timed out waiting for %v events to be written", atomic.LoadInt64(&r.pending))
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+func (r *eventRecorder) write(event *corev1.Event) {
+	defer atomic.AddInt64(&r.pending, -1)
+	if _, err := r.sink.Create(event); err != nil {
+		glog.Errorf("Failed to write event %v: %v", event.Name, err)
+	}
+}
@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+type fakeEventSink struct {
+	mutex  sync.Mutex
+	events []*corev1.Event
+	// release blocks the writes of events until closed, if not nil.
+	release chan struct{}
+}
+
+func (s *fakeEventSink) Create(event *corev1.Event) (*corev1.Event, error) {
+	if s.release != nil {
+		<-s.release
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.events = append(s.events, event)
+	return event, nil
+}
+
+func (s *fakeEventSink) Update(event *corev1.Event) (*corev1.Event, error) {
+	return event, nil
+}
+
+func (s *fakeEventSink) Patch(event *corev1.Event, data []byte) (*corev1.Event, error) {
+	return s.Create(event)
+}
+
+func (s *fakeEventSink) reasons() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var reasons []string
+	for _, event := range s.events {
+		reasons = append(reasons, event.Reason)
+	}
+	return reasons
+}
+
+// recordingReconciler records an event once released, as a reconcile in-flight while draining would.
+type recordingReconciler struct {
+	blockingReconciler
+	recorder *eventRecorder
+}
+
+func (r *recordingReconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	result, err := r.blockingReconciler.Reconcile(request)
+	r.recorder.Eventf(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "pod", SelfLink: "/api/v1/namespaces/ns/pods/pod"}}, corev1.EventTypeNormal, "Drained", "reconciled while draining")
+	return result, err
+}
+
+func TestShutdownDrainer_Drain(t *testing.T) {
+	sink := &fakeEventSink{}
+	recorder := newEventRecorder(scheme.Scheme, "alb-ingress-controller", sink)
+	inner := &recordingReconciler{
+		blockingReconciler: blockingReconciler{started: make(chan struct{}), release: make(chan struct{})},
+		recorder:           recorder,
+	}
+	r := newDrainingReconciler(inner)
+	drainer := &shutdownDrainer{Drainer: r, recorder: recorder}
+	go r.Reconcile(reconcile.Request{})
+	<-inner.started
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(inner.release)
+	}()
+	assert.NoError(t, drainer.Drain(time.Second))
+	assert.Equal(t, []string{"Drained"}, sink.reasons())
+}
+
+func TestShutdownDrainer_Drain_flushTimeout(t *testing.T) {
+	sink := &fakeEventSink{release: make(chan struct{})}
+	defer close(sink.release)
+	recorder := newEventRecorder(scheme.Scheme, "alb-ingress-controller", sink)
+	drainer := &shutdownDrainer{Drainer: newDrainingReconciler(&blockingReconciler{}), recorder: recorder}
+	recorder.Eventf(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "pod", SelfLink: "/api/v1/namespaces/ns/pods/pod"}}, corev1.EventTypeNormal, "Stuck", "written after the grace period")
+
+	// the flush is bounded by the grace period, even though the event can't be written.
+	start := time.Now()
+	assert.EqualError(t, drainer.Drain(50*time.Millisecond), "timed out waiting for 1 events to be written")
+	assert.True(t, time.Since(start) < time.Second)
+	assert.Empty(t, sink.reasons())
+}
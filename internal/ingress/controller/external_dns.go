@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/lb"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/parser"
+	extensions "k8s.io/api/extensions/v1beta1"
+)
+
+// Annotations consumed by external-dns, see https://github.com/kubernetes-sigs/external-dns/blob/master/docs/annotations/annotations.md
+const (
+	externalDNSHostnameAnnotation = "external-dns.alpha.kubernetes.io/hostname"
+	externalDNSTargetAnnotation   = "external-dns.alpha.kubernetes.io/target"
+	externalDNSAliasAnnotation    = "external-dns.alpha.kubernetes.io/alias"
+)
+
+const (
+	// externalDNSAnnotationSuffix is the suffix of the annotation enabling or disabling external-dns cooperation per ingress.
+	externalDNSAnnotationSuffix = "external-dns"
+	// hostedZoneIDAnnotationSuffix is the suffix of the annotation recording the canonical hosted zone of the ALB, for alias records.
+	hostedZoneIDAnnotationSuffix = "hosted-zone-id"
+	// externalDNSOwnerIDAnnotationSuffix is the suffix of the annotation recording the ownership hint of the DNS records.
+	externalDNSOwnerIDAnnotationSuffix = "external-dns-owner-id"
+)
+
+// externalDNSEnabled returns whether external-dns annotations should be maintained for ingress.
+// The alb.ingress.kubernetes.io/external-dns annotation takes precedence over the --external-dns flag.
+func externalDNSEnabled(ingress *extensions.Ingress, defaultEnabled bool) bool {
+	enabled, err := parser.GetBoolAnnotation(externalDNSAnnotationSuffix, ingress)
+	if err != nil {
+		return defaultEnabled
+	}
+	return *enabled
+}
+
+// buildExternalDNSAnnotations builds the annotations external-dns needs to create alias records of every host of ingress to the ALB,
+// or to its frontend NLB if there is one.
+func buildExternalDNSAnnotations(ingress *extensions.Ingress, lbInfo *lb.LoadBalancer, ownerID string) map[string]string {
+	dnsName, hostedZoneID := lbInfo.DNSName, lbInfo.CanonicalHostedZoneID
+	if lbInfo.FrontendNLB != nil {
+		dnsName, hostedZoneID = lbInfo.FrontendNLB.DNSName, lbInfo.FrontendNLB.CanonicalHostedZoneID
+	}
+	annotations := map[string]string{
+		externalDNSTargetAnnotation: dnsName,
+		externalDNSAliasAnnotation:  "true",
+	}
+	if hosts := ingressHosts(ingress); len(hosts) != 0 {
+		annotations[externalDNSHostnameAnnotation] = strings.Join(hosts, ",")
+	}
+	if len(hostedZoneID) != 0 {
+		annotations[parser.GetAnnotationWithPrefix(hostedZoneIDAnnotationSuffix)] = hostedZoneID
+	}
+	if len(ownerID) != 0 {
+		annotations[parser.GetAnnotationWithPrefix(externalDNSOwnerIDAnnotationSuffix)] = ownerID
+	}
+	return annotations
+}
+
+// ingressHosts returns the sorted, distinct hosts of ingress rules.
+func ingressHosts(ingress *extensions.Ingress) []string {
+	seen := make(map[string]bool)
+	var hosts []string
+	for _, rule := range ingress.Spec.Rules {
+		if len(rule.Host) == 0 || seen[rule.Host] {
+			continue
+		}
+		seen[rule.Host] = true
+		hosts = append(hosts, rule.Host)
+	}
+	sort.Strings(hosts)
+	return hosts
+}
+
+// setExternalDNSAnnotations sets the external-dns annotations of ingress for its reconciled loadBalancer, if enabled.
+func (r *Reconciler) setExternalDNSAnnotations(ingress *extensions.Ingress, lbInfo *lb.LoadBalancer) {
+	cfg := r.store.GetConfig()
+	if !externalDNSEnabled(ingress, cfg.ExternalDNS) {
+		return
+	}
+	desired := buildExternalDNSAnnotations(ingress, lbInfo, cfg.ExternalDNSOwnerID)
+	// optional annotations are owned by the controller once enabled, they're removed when no longer applicable.
+	for _, key := range []string{
+		externalDNSHostnameAnnotation,
+		parser.GetAnnotationWithPrefix(hostedZoneIDAnnotationSuffix),
+		parser.GetAnnotationWithPrefix(externalDNSOwnerIDAnnotationSuffix),
+	} {
+		if _, ok := desired[key]; !ok {
+			delete(ingress.Annotations, key)
+		}
+	}
+	if ingress.Annotations == nil {
+		ingress.Annotations = make(map[string]string)
+	}
+	for key, value := range desired {
+		ingress.Annotations[key] = value
+	}
+}
@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/lb"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/config"
+	albtesting "github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/testing"
+	"github.com/stretchr/testify/assert"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestReconciler_setExternalDNSAnnotations(t *testing.T) {
+	lbInfo := &lb.LoadBalancer{
+		Arn:                   "lbArn",
+		DNSName:               "lb.us-west-2.elb.amazonaws.com",
+		CanonicalHostedZoneID: "Z1H1FL5HABSF5",
+	}
+
+	for _, tc := range []struct {
+		Name                string
+		ExternalDNS         bool
+		OwnerID             string
+		Annotations         map[string]string
+		Hosts               []string
+		ExpectedAnnotations map[string]string
+	}{
+		{
+			Name:        "disabled",
+			Annotations: map[string]string{"alb.ingress.kubernetes.io/scheme": "internal"},
+			Hosts:       []string{"a.example.com"},
+			ExpectedAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/scheme": "internal",
+			},
+		},
+		{
+			Name:        "enabled by flag",
+			ExternalDNS: true,
+			OwnerID:     "cluster-a",
+			Hosts:       []string{"b.example.com", "a.example.com", "", "b.example.com"},
+			ExpectedAnnotations: map[string]string{
+				"external-dns.alpha.kubernetes.io/hostname":       "a.example.com,b.example.com",
+				"external-dns.alpha.kubernetes.io/target":         "lb.us-west-2.elb.amazonaws.com",
+				"external-dns.alpha.kubernetes.io/alias":          "true",
+				"alb.ingress.kubernetes.io/hosted-zone-id":        "Z1H1FL5HABSF5",
+				"alb.ingress.kubernetes.io/external-dns-owner-id": "cluster-a",
+			},
+		},
+		{
+			Name:        "disabled by annotation",
+			ExternalDNS: true,
+			Annotations: map[string]string{"alb.ingress.kubernetes.io/external-dns": "false"},
+			Hosts:       []string{"a.example.com"},
+			ExpectedAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/external-dns": "false",
+			},
+		},
+		{
+			Name: "enabled by annotation and hosts removed",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/external-dns":    "true",
+				"external-dns.alpha.kubernetes.io/hostname": "a.example.com",
+			},
+			ExpectedAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/external-dns":   "true",
+				"external-dns.alpha.kubernetes.io/target":  "lb.us-west-2.elb.amazonaws.com",
+				"external-dns.alpha.kubernetes.io/alias":   "true",
+				"alb.ingress.kubernetes.io/hosted-zone-id": "Z1H1FL5HABSF5",
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			ingress := &extensions.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "namespace",
+					Name:        "ingress",
+					Annotations: tc.Annotations,
+				},
+			}
+			for _, host := range tc.Hosts {
+				ingress.Spec.Rules = append(ingress.Spec.Rules, extensions.IngressRule{Host: host})
+			}
+			cfg := config.NewConfiguration()
+			cfg.ExternalDNS = tc.ExternalDNS
+			cfg.ExternalDNSOwnerID = tc.OwnerID
+			r := &Reconciler{store: albtesting.NewStore(&cfg)}
+
+			r.setExternalDNSAnnotations(ingress, lbInfo)
+			assert.Equal(t, tc.ExpectedAnnotations, ingress.Annotations)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package controller
+
+import (
+	"context"
+
+	extensions "k8s.io/api/extensions/v1beta1"
+)
+
+// IngressFinalizer blocks the deletion of ingresses until the controller deleted their AWS resources.
+// It's named after the finalizer of the V2 version, so ingresses carry over between versions.
+const IngressFinalizer = "ingress.k8s.aws/resources"
+
+// hasFinalizer returns whether ingress carries IngressFinalizer.
+func hasFinalizer(ingress *extensions.Ingress) bool {
+	for _, finalizer := range ingress.Finalizers {
+		if finalizer == IngressFinalizer {
+			return true
+		}
+	}
+	return false
+}
+
+// addFinalizer adds IngressFinalizer to ingress, before any AWS resource is created for it.
+func (r *Reconciler) addFinalizer(ctx context.Context, ingress *extensions.Ingress) error {
+	if hasFinalizer(ingress) {
+		return nil
+	}
+	ingress.Finalizers = append(ingress.Finalizers, IngressFinalizer)
+	return r.client.Update(ctx, ingress)
+}
+
+// removeFinalizer removes IngressFinalizer from ingress, once its AWS resources are deleted.
+func (r *Reconciler) removeFinalizer(ctx context.Context, ingress *extensions.Ingress) error {
+	if !hasFinalizer(ingress) {
+		return nil
+	}
+	var finalizers []string
+	for _, finalizer := range ingress.Finalizers {
+		if finalizer != IngressFinalizer {
+			finalizers = append(finalizers, finalizer)
+		}
+	}
+	ingress.Finalizers = finalizers
+	return r.client.Update(ctx, ingress)
+}
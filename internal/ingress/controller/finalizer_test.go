@@ -0,0 +1,170 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/lb"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/metric"
+	"github.com/stretchr/testify/assert"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// stubLBController records the ingresses deleted and the ingress groups reconciled, failing deletions with deleteErr.
+type stubLBController struct {
+	lb.Controller
+	deleted   []types.NamespacedName
+	migrated  []bool
+	deleteErr error
+	groups    []lb.Group
+}
+
+func (c *stubLBController) ReconcileGroup(ctx context.Context, group lb.Group) (*lb.LoadBalancer, error) {
+	c.groups = append(c.groups, group)
+	return &lb.LoadBalancer{Arn: "lbArn", DNSName: "shared.example.com", Group: group.Name}, nil
+}
+
+func (c *stubLBController) Delete(ctx context.Context, ingressKey types.NamespacedName, migrated bool) error {
+	c.deleted = append(c.deleted, ingressKey)
+	c.migrated = append(c.migrated, migrated)
+	return c.deleteErr
+}
+
+func TestReconciler_finalizeIngress(t *testing.T) {
+	ingressKey := types.NamespacedName{Namespace: "namespace", Name: "ingress"}
+	for _, tc := range []struct {
+		name               string
+		annotations        map[string]string
+		deleteErr          error
+		expectedFinalizers []string
+	}{
+		{
+			name:               "resources deleted",
+			expectedFinalizers: []string{"example.com/other"},
+		},
+		{
+			name:               "resources of migrated ingress deleted",
+			annotations:        map[string]string{"alb.ingress.kubernetes.io/migrated": "true"},
+			expectedFinalizers: []string{"example.com/other"},
+		},
+		{
+			name:               "resources not deleted",
+			deleteErr:          errors.New("LoadBalancer not deleted"),
+			expectedFinalizers: []string{"example.com/other", IngressFinalizer},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ingress := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{
+				Namespace:   ingressKey.Namespace,
+				Name:        ingressKey.Name,
+				Annotations: tc.annotations,
+				Finalizers:  []string{"example.com/other", IngressFinalizer},
+			}}
+			ctx := context.Background()
+			lbController := &stubLBController{deleteErr: tc.deleteErr}
+			r := &Reconciler{
+				client:          fake.NewFakeClient(ingress.DeepCopy()),
+				recorder:        record.NewFakeRecorder(10),
+				lbController:    lbController,
+				metricCollector: metric.DummyCollector{},
+			}
+
+			err := r.finalizeIngress(ctx, ingressKey, ingress)
+			assert.Equal(t, tc.deleteErr, err)
+			assert.Equal(t, []types.NamespacedName{ingressKey}, lbController.deleted)
+			assert.Equal(t, []bool{tc.annotations != nil}, lbController.migrated)
+
+			stored := &extensions.Ingress{}
+			assert.NoError(t, r.client.Get(ctx, ingressKey, stored))
+			assert.Equal(t, tc.expectedFinalizers, stored.Finalizers)
+		})
+	}
+}
+
+func TestReconciler_Reconcile_finalize(t *testing.T) {
+	ingressKey := types.NamespacedName{Namespace: "namespace", Name: "ingress"}
+	deleted := metav1.NewTime(time.Now())
+	for _, tc := range []struct {
+		name               string
+		ingress            *extensions.Ingress
+		expectedDeleted    []types.NamespacedName
+		expectedFinalizers []string
+	}{
+		{
+			name: "deleted ingress",
+			ingress: &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{
+				Namespace:         ingressKey.Namespace,
+				Name:              ingressKey.Name,
+				DeletionTimestamp: &deleted,
+				Finalizers:        []string{IngressFinalizer, "example.com/other"},
+			}},
+			expectedDeleted:    []types.NamespacedName{ingressKey},
+			expectedFinalizers: []string{"example.com/other"},
+		},
+		{
+			name: "ingress switched to another class",
+			ingress: &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{
+				Namespace:   ingressKey.Namespace,
+				Name:        ingressKey.Name,
+				Annotations: map[string]string{"kubernetes.io/ingress.class": "nginx"},
+				Finalizers:  []string{IngressFinalizer},
+			}},
+			expectedDeleted: []types.NamespacedName{ingressKey},
+		},
+		{
+			name: "deleted ingress without finalizer",
+			ingress: &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{
+				Namespace:         ingressKey.Namespace,
+				Name:              ingressKey.Name,
+				DeletionTimestamp: &deleted,
+				Finalizers:        []string{"example.com/other"},
+			}},
+			expectedFinalizers: []string{"example.com/other"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			c := fake.NewFakeClient(tc.ingress.DeepCopy())
+			lbController := &stubLBController{}
+			r := &Reconciler{
+				client:          c,
+				cache:           clientCache{reader: c},
+				recorder:        record.NewFakeRecorder(10),
+				eventLimiter:    albctx.NewEventLimiter(0, 0, time.Minute),
+				lbController:    lbController,
+				ingressClass:    "alb",
+				metricCollector: metric.DummyCollector{},
+			}
+
+			result, err := r.Reconcile(reconcile.Request{NamespacedName: ingressKey})
+			assert.NoError(t, err)
+			assert.Equal(t, reconcile.Result{}, result)
+			assert.Equal(t, tc.expectedDeleted, lbController.deleted)
+
+			stored := &extensions.Ingress{}
+			assert.NoError(t, c.Get(ctx, ingressKey, stored))
+			assert.Equal(t, tc.expectedFinalizers, stored.Finalizers)
+		})
+	}
+}
+
+func TestReconciler_addFinalizer(t *testing.T) {
+	ingressKey := types.NamespacedName{Namespace: "namespace", Name: "ingress"}
+	ingress := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: ingressKey.Namespace, Name: ingressKey.Name}}
+	ctx := context.Background()
+	r := &Reconciler{client: fake.NewFakeClient(ingress.DeepCopy())}
+
+	assert.NoError(t, r.addFinalizer(ctx, ingress))
+	assert.NoError(t, r.addFinalizer(ctx, ingress))
+	stored := &extensions.Ingress{}
+	assert.NoError(t, r.client.Get(ctx, ingressKey, stored))
+	assert.Equal(t, []string{IngressFinalizer}, stored.Finalizers)
+}
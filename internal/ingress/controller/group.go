@@ -0,0 +1,137 @@
+package controller
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/lb"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/class"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/k8s"
+	corev1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// keyLocks serializes work per key, e.g. the reconciles of each ingress group, whose members may be reconciled concurrently.
+type keyLocks struct {
+	mutex sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// lock locks key, and returns the func unlocking it.
+func (l *keyLocks) lock(key string) func() {
+	l.mutex.Lock()
+	if l.locks == nil {
+		l.locks = make(map[string]*sync.Mutex)
+	}
+	keyLock, ok := l.locks[key]
+	if !ok {
+		keyLock = &sync.Mutex{}
+		l.locks[key] = keyLock
+	}
+	l.mutex.Unlock()
+
+	keyLock.Lock()
+	return keyLock.Unlock
+}
+
+// activeGroupName returns the ingress group of ingress, or "" if it isn't a member of one.
+// Ingresses being deleted or of another class leave their group.
+func (r *Reconciler) activeGroupName(ingress *extensions.Ingress) string {
+	if ingress.DeletionTimestamp != nil || !class.IsValidIngress(r.ingressClass, ingress) {
+		return ""
+	}
+	return lb.GroupName(ingress)
+}
+
+// buildGroup lists the members of ingress group name across namespaces, using ingress in place of its cached copy if it's a member.
+func (r *Reconciler) buildGroup(ctx context.Context, name string, ingress *extensions.Ingress) (lb.Group, error) {
+	ingressList := &extensions.IngressList{}
+	if err := r.cache.List(ctx, nil, ingressList); err != nil {
+		return lb.Group{}, err
+	}
+	var members []*extensions.Ingress
+	if ingress != nil && r.activeGroupName(ingress) == name {
+		members = append(members, ingress)
+	}
+	for i := range ingressList.Items {
+		member := &ingressList.Items[i]
+		if ingress != nil && member.Namespace == ingress.Namespace && member.Name == ingress.Name {
+			continue
+		}
+		if r.activeGroupName(member) == name {
+			members = append(members, member)
+		}
+	}
+	return lb.NewGroup(name, members)
+}
+
+// reconcileGroup reconciles the LoadBalancer shared by ingress group name and publishes it on its members,
+// or deletes it once the group has no member left.
+func (r *Reconciler) reconcileGroup(ctx context.Context, name string, ingress *extensions.Ingress) error {
+	unlock := r.groupLocks.lock(name)
+	defer unlock()
+
+	group, err := r.buildGroup(ctx, name, ingress)
+	if err != nil {
+		albctx.GetEventf(ctx)(corev1.EventTypeWarning, "ERROR", "failed to reconcile ingress group %v due to %v", name, err)
+		return err
+	}
+	if len(group.Members) == 0 {
+		albctx.GetLogger(ctx).Infof("deleting LoadBalancer of ingress group %v, which has no member left", name)
+		return r.lbController.Delete(ctx, lb.GroupKey(name), false)
+	}
+	lbInfo, err := r.lbController.ReconcileGroup(ctx, group)
+	if err != nil {
+		albctx.GetEventf(ctx)(corev1.EventTypeWarning, "ERROR", "failed to reconcile ingress group %v due to %v", name, err)
+		return err
+	}
+	for _, member := range group.Members {
+		if err := r.publishIngress(ctx, member, lbInfo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reconcileFormerGroups reconciles the ingress groups ingress was annotated or recorded in other than groupName,
+// so that their rules no longer route to the targetGroups of ingress.
+func (r *Reconciler) reconcileFormerGroups(ctx context.Context, ingress *extensions.Ingress, groupName string) error {
+	names := sets.NewString()
+	if name := lb.GroupName(ingress); name != "" && name != groupName {
+		names.Insert(name)
+	}
+	resources, err := GetManagedResources(ingress)
+	if err != nil {
+		return err
+	}
+	if resources != nil && resources.Group != "" && resources.Group != groupName {
+		names.Insert(resources.Group)
+	}
+	for _, name := range names.List() {
+		if err := r.reconcileGroup(ctx, name, ingress); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// joinGroup deletes the LoadBalancer ingress had on its own before joining ingress group groupName, along with its targetGroups,
+// as a targetGroup can't be used by the LoadBalancers of both. The group is recorded right away so that it's only deleted once.
+func (r *Reconciler) joinGroup(ctx context.Context, ingress *extensions.Ingress, groupName string) error {
+	resources, err := GetManagedResources(ingress)
+	if err != nil {
+		return err
+	}
+	if resources == nil || resources.Group != "" || resources.LoadBalancerARN == "" {
+		return nil
+	}
+	albctx.GetLogger(ctx).Infof("deleting LoadBalancer %v of ingress joining ingress group %v", resources.LoadBalancerARN, groupName)
+	if err := r.lbController.Delete(ctx, k8s.NamespacedName(ingress), lb.IsMigrated(ingress)); err != nil {
+		return err
+	}
+	return r.updateIngressAnnotations(ctx, ingress, func(ingress *extensions.Ingress) error {
+		return setManagedResources(ingress, &lb.LoadBalancer{Group: groupName})
+	})
+}
@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/lb"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/store"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/metric"
+	"github.com/stretchr/testify/assert"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// clientCache serves the reads of the cache from a client, which unlike the cache requires ListOptions.
+type clientCache struct {
+	cache.Cache
+	reader client.Reader
+}
+
+func (c clientCache) Get(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+	return c.reader.Get(ctx, key, obj)
+}
+
+func (c clientCache) List(ctx context.Context, opts *client.ListOptions, list runtime.Object) error {
+	if opts == nil {
+		opts = &client.ListOptions{}
+	}
+	return c.reader.List(ctx, opts, list)
+}
+
+func TestReconciler_finalizeIngress_group(t *testing.T) {
+	now := metav1.Now()
+	groupAnnotations := map[string]string{"alb.ingress.kubernetes.io/group.name": "shared"}
+	leaving := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{
+		Namespace:         "alpha",
+		Name:              "ingress",
+		Annotations:       groupAnnotations,
+		Finalizers:        []string{IngressFinalizer},
+		DeletionTimestamp: &now,
+	}}
+	remaining := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "beta", Name: "ingress", Annotations: groupAnnotations}}
+	other := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{
+		Namespace:   "beta",
+		Name:        "other",
+		Annotations: map[string]string{"alb.ingress.kubernetes.io/group.name": "other"},
+	}}
+	k8sClient := fake.NewFakeClient(leaving.DeepCopy(), remaining.DeepCopy(), other.DeepCopy())
+	lbController := &stubLBController{}
+	r := &Reconciler{
+		client:          k8sClient,
+		ingressPatcher:  &fakeIngressPatcher{client: k8sClient},
+		cache:           clientCache{reader: k8sClient},
+		recorder:        record.NewFakeRecorder(10),
+		store:           store.NewDummy(),
+		lbController:    lbController,
+		metricCollector: metric.DummyCollector{},
+	}
+	ctx := context.Background()
+	leavingKey := types.NamespacedName{Namespace: "alpha", Name: "ingress"}
+
+	assert.NoError(t, r.finalizeIngress(ctx, leavingKey, leaving))
+	if assert.Len(t, lbController.groups, 1) {
+		group := lbController.groups[0]
+		assert.Equal(t, "shared", group.Name)
+		if assert.Len(t, group.Members, 1) {
+			assert.Equal(t, "beta/ingress", group.Members[0].Namespace+"/"+group.Members[0].Name)
+		}
+	}
+	assert.Equal(t, []types.NamespacedName{leavingKey}, lbController.deleted, "targetGroups of the leaving ingress should be deleted once the group no longer uses them")
+
+	stored := &extensions.Ingress{}
+	assert.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Namespace: "beta", Name: "ingress"}, stored))
+	assert.Equal(t, "shared.example.com", stored.Status.LoadBalancer.Ingress[0].Hostname)
+	resources, err := GetManagedResources(stored)
+	assert.NoError(t, err)
+	assert.Equal(t, "shared", resources.Group)
+
+	lbController.groups = nil
+	lbController.deleted = nil
+	assert.NoError(t, r.reconcileGroup(ctx, "empty", nil))
+	assert.Empty(t, lbController.groups)
+	assert.Equal(t, []types.NamespacedName{lb.GroupKey("empty")}, lbController.deleted, "the LoadBalancer of a group without members should be deleted")
+}
+
+func Test_keyLocks(t *testing.T) {
+	var locks keyLocks
+	unlock := locks.lock("group")
+
+	// another group isn't blocked by the lock of group.
+	locks.lock("other")()
+
+	locked := make(chan struct{})
+	go func() {
+		defer locks.lock("group")()
+		close(locked)
+	}()
+	select {
+	case <-locked:
+		t.Fatal("group locked twice")
+	case <-time.After(10 * time.Millisecond):
+	}
+	unlock()
+	select {
+	case <-locked:
+	case <-time.After(time.Second):
+		t.Fatal("group not locked once unlocked")
+	}
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"reflect"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/tg"
@@ -22,7 +23,11 @@ var _ handler.EventHandler = (*EnqueueRequestsForEndpointsEvent)(nil)
 
 type EnqueueRequestsForEndpointsEvent struct {
 	IngressClass string
-	Cache        cache.Cache
+
+	// DebounceWindow delays the enqueued requests, so that the changes of endpoints within the window are coalesced
+	DebounceWindow time.Duration
+
+	Cache cache.Cache
 }
 
 // Create is called in response to an create event - e.g. Pod Creation.
@@ -49,41 +54,55 @@ func (h *EnqueueRequestsForEndpointsEvent) Delete(e event.DeleteEvent, queue wor
 func (h *EnqueueRequestsForEndpointsEvent) Generic(event.GenericEvent, workqueue.RateLimitingInterface) {
 }
 
-//TODO: this can be further optimized to only reconcile the target group referenced by the endpoints(service) :D
 func (h *EnqueueRequestsForEndpointsEvent) enqueueImpactedIngresses(endpoints *corev1.Endpoints, queue workqueue.RateLimitingInterface) {
+	enqueueIngressesOfService(h.Cache, h.IngressClass, endpoints.Namespace, endpoints.Name, h.DebounceWindow, queue)
+}
+
+// enqueueIngressesOfService enqueues the ingresses of ingressClass with serviceName as backend, whose endpoints changed, after delay.
+//TODO: this can be further optimized to only reconcile the target group referenced by the endpoints(service) :D
+func enqueueIngressesOfService(reader client.Reader, ingressClass string, namespace string, serviceName string, delay time.Duration, queue workqueue.RateLimitingInterface) {
 	ingressList := &extensions.IngressList{}
-	if err := h.Cache.List(context.Background(), client.InNamespace(endpoints.Namespace), ingressList); err != nil {
+	if err := reader.List(context.Background(), client.InNamespace(namespace), ingressList); err != nil {
 		glog.Errorf("failed to fetch impacted ingresses by endpoints due to %v", err)
 		return
 	}
 
 	for _, ingress := range ingressList.Items {
-		if !class.IsValidIngress(h.IngressClass, &ingress) {
+		if !class.IsValidIngress(ingressClass, &ingress) {
 			continue
 		}
 
 		backends, _, err := tg.ExtractTargetGroupBackends(&ingress)
 		if err != nil {
 			glog.Errorf("Failed to extract backend services from ingress: %v, reconcile the ingress. error: %e", ingress.Name, err)
-			queue.Add(reconcile.Request{
+			enqueueAfter(queue, reconcile.Request{
 				NamespacedName: types.NamespacedName{
 					Namespace: ingress.Namespace,
 					Name:      ingress.Name,
 				},
-			})
+			}, delay)
 			break
 		}
 
 		for _, backend := range backends {
-			if backend.ServiceName == endpoints.Name {
-				queue.Add(reconcile.Request{
+			if backend.ServiceName == serviceName {
+				enqueueAfter(queue, reconcile.Request{
 					NamespacedName: types.NamespacedName{
 						Namespace: ingress.Namespace,
 						Name:      ingress.Name,
 					},
-				})
+				}, delay)
 				break
 			}
 		}
 	}
 }
+
+// enqueueAfter adds request to queue after delay, requests already waiting in queue are only added once.
+func enqueueAfter(queue workqueue.RateLimitingInterface, request reconcile.Request, delay time.Duration) {
+	if delay <= 0 {
+		queue.Add(request)
+		return
+	}
+	queue.AddAfter(request, delay)
+}
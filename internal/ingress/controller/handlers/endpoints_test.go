@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/mocks"
@@ -141,3 +142,14 @@ func TestEnqueueImpactedIngresses(t *testing.T) {
 	},
 		queueMock)
 }
+
+func TestEnqueueAfter(t *testing.T) {
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "namespace", Name: "ingress"}}
+	queueMock := &mocks.RateLimitingInterface{}
+	queueMock.On("Add", request).Once()
+	queueMock.On("AddAfter", request, 2*time.Second).Once()
+
+	enqueueAfter(queueMock, request, 0)
+	enqueueAfter(queueMock, request, 2*time.Second)
+	queueMock.AssertExpectations(t)
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"reflect"
+	"time"
+
+	discoveryv1 "github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/discovery/v1"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+var _ handler.EventHandler = (*EnqueueRequestsForEndpointSliceEvent)(nil)
+
+// EnqueueRequestsForEndpointSliceEvent enqueues the ingresses of the service of changed EndpointSlices,
+// it replaces EnqueueRequestsForEndpointsEvent when EndpointSlices are watched.
+type EnqueueRequestsForEndpointSliceEvent struct {
+	IngressClass string
+
+	// DebounceWindow delays the enqueued requests, so that the changes of EndpointSlices within the window are coalesced
+	DebounceWindow time.Duration
+
+	Cache cache.Cache
+}
+
+// Create is called in response to an create event - e.g. Pod Creation.
+func (h *EnqueueRequestsForEndpointSliceEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedIngresses(e.Object.(*discoveryv1.EndpointSlice), queue)
+}
+
+// Update is called in response to an update event -  e.g. Pod Updated.
+func (h *EnqueueRequestsForEndpointSliceEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	sliceOld := e.ObjectOld.(*discoveryv1.EndpointSlice)
+	sliceNew := e.ObjectNew.(*discoveryv1.EndpointSlice)
+	if !reflect.DeepEqual(sliceOld.Endpoints, sliceNew.Endpoints) || !reflect.DeepEqual(sliceOld.Ports, sliceNew.Ports) {
+		h.enqueueImpactedIngresses(sliceNew, queue)
+	}
+}
+
+// Delete is called in response to a delete event - e.g. Pod Deleted.
+func (h *EnqueueRequestsForEndpointSliceEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedIngresses(e.Object.(*discoveryv1.EndpointSlice), queue)
+}
+
+// Generic is called in response to an event of an unknown type or a synthetic event triggered as a cron or
+// external trigger request - e.g. reconcile Autoscaling, or a Webhook.
+func (h *EnqueueRequestsForEndpointSliceEvent) Generic(event.GenericEvent, workqueue.RateLimitingInterface) {
+}
+
+func (h *EnqueueRequestsForEndpointSliceEvent) enqueueImpactedIngresses(slice *discoveryv1.EndpointSlice, queue workqueue.RateLimitingInterface) {
+	serviceName, ok := slice.Labels[discoveryv1.LabelServiceName]
+	if !ok {
+		return
+	}
+	enqueueIngressesOfService(h.Cache, h.IngressClass, slice.Namespace, serviceName, h.DebounceWindow, queue)
+}
@@ -2,7 +2,9 @@ package handlers
 
 import (
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/class"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/parser"
 	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -12,6 +14,9 @@ import (
 
 var _ handler.EventHandler = (*EnqueueRequestsForIngressEvent)(nil)
 
+// conditionsAnnotationSuffix is the suffix of the annotation the controller publishes the conditions of ingresses in.
+const conditionsAnnotationSuffix = "conditions"
+
 type EnqueueRequestsForIngressEvent struct {
 	IngressClass string
 }
@@ -23,8 +28,12 @@ func (h *EnqueueRequestsForIngressEvent) Create(e event.CreateEvent, queue workq
 
 // Update is called in response to an update event -  e.g. Pod Updated.
 func (h *EnqueueRequestsForIngressEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
-	h.enqueueIfIngressClassMatched(e.ObjectOld.(*extensions.Ingress), queue)
-	h.enqueueIfIngressClassMatched(e.ObjectNew.(*extensions.Ingress), queue)
+	oldIngress, newIngress := e.ObjectOld.(*extensions.Ingress), e.ObjectNew.(*extensions.Ingress)
+	if onlyConditionsChanged(oldIngress, newIngress) {
+		return
+	}
+	h.enqueueIfIngressClassMatched(oldIngress, queue)
+	h.enqueueIfIngressClassMatched(newIngress, queue)
 }
 
 // Delete is called in response to a delete event - e.g. Pod Deleted.
@@ -49,3 +58,17 @@ func (h *EnqueueRequestsForIngressEvent) enqueueIfIngressClassMatched(ingress *e
 		},
 	})
 }
+
+// onlyConditionsChanged returns whether the update of oldIngress into newIngress only published its conditions,
+// which must not trigger a reconcile, as the error of each failed reconcile would otherwise bypass its backoff.
+func onlyConditionsChanged(oldIngress *extensions.Ingress, newIngress *extensions.Ingress) bool {
+	key := parser.GetAnnotationWithPrefix(conditionsAnnotationSuffix)
+	if oldIngress.Annotations[key] == newIngress.Annotations[key] {
+		return false
+	}
+	oldIngress, newIngress = oldIngress.DeepCopy(), newIngress.DeepCopy()
+	delete(oldIngress.Annotations, key)
+	delete(newIngress.Annotations, key)
+	oldIngress.ResourceVersion, newIngress.ResourceVersion = "", ""
+	return equality.Semantic.DeepEqual(oldIngress, newIngress)
+}
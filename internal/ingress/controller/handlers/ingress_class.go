@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/class"
+	networking "github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/networking/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ handler.EventHandler = (*EnqueueRequestsForIngressClassEvent)(nil)
+
+// EnqueueRequestsForIngressClassEvent enqueues the ingresses of an IngressClass, which may start or stop matching the controller.
+type EnqueueRequestsForIngressClassEvent struct {
+	Cache cache.Cache
+}
+
+// Create is called in response to an create event - e.g. Pod Creation.
+func (h *EnqueueRequestsForIngressClassEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedIngresses(e.Object.(*networking.IngressClass), queue)
+}
+
+// Update is called in response to an update event -  e.g. Pod Updated.
+func (h *EnqueueRequestsForIngressClassEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedIngresses(e.ObjectOld.(*networking.IngressClass), queue)
+	h.enqueueImpactedIngresses(e.ObjectNew.(*networking.IngressClass), queue)
+}
+
+// Delete is called in response to a delete event - e.g. Pod Deleted.
+func (h *EnqueueRequestsForIngressClassEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedIngresses(e.Object.(*networking.IngressClass), queue)
+}
+
+// Generic is called in response to an event of an unknown type or a synthetic event triggered as a cron or
+// external trigger request - e.g. reconcile Autoscaling, or a Webhook.
+func (h *EnqueueRequestsForIngressClassEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedIngresses(e.Object.(*networking.IngressClass), queue)
+}
+
+// enqueueImpactedIngresses enqueues the ingresses of ingressClass whether they match the controller or not,
+// so ingresses leaving the controller are finalized.
+func (h *EnqueueRequestsForIngressClassEvent) enqueueImpactedIngresses(ingressClass *networking.IngressClass, queue workqueue.RateLimitingInterface) {
+	ingressList := &extensions.IngressList{}
+	if err := h.Cache.List(context.Background(), nil, ingressList); err != nil {
+		glog.Errorf("failed to fetch impacted ingresses by ingressClass due to %v", err)
+		return
+	}
+	for _, ingress := range ingressList.Items {
+		if !class.ReferencesIngressClass(&ingress, ingressClass) {
+			continue
+		}
+		queue.Add(reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: ingress.Namespace,
+				Name:      ingress.Name,
+			},
+		})
+	}
+}
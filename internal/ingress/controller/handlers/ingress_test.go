@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	extensions "k8s.io/api/extensions/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func TestEnqueueRequestsForIngressEvent_Update(t *testing.T) {
+	const conditionsAnnotation = "alb.ingress.kubernetes.io/conditions"
+	ingress := &extensions.Ingress{
+		ObjectMeta: v1.ObjectMeta{
+			Namespace:       "namespace",
+			Name:            "ingress",
+			ResourceVersion: "1",
+			Annotations:     map[string]string{conditionsAnnotation: `[{"type":"Error","status":"False"}]`},
+		},
+	}
+	conditionsUpdated := ingress.DeepCopy()
+	conditionsUpdated.ResourceVersion = "2"
+	conditionsUpdated.Annotations[conditionsAnnotation] = `[{"type":"Error","status":"True"}]`
+	classUpdated := conditionsUpdated.DeepCopy()
+	classUpdated.Annotations["kubernetes.io/ingress.class"] = "alb"
+
+	for _, tc := range []struct {
+		Name            string
+		New             *extensions.Ingress
+		ExpectedEnqueue bool
+	}{
+		{
+			Name:            "resync",
+			New:             ingress,
+			ExpectedEnqueue: true,
+		},
+		{
+			Name:            "only conditions updated",
+			New:             conditionsUpdated,
+			ExpectedEnqueue: false,
+		},
+		{
+			Name:            "conditions and class updated",
+			New:             classUpdated,
+			ExpectedEnqueue: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+			defer queue.ShutDown()
+			h := &EnqueueRequestsForIngressEvent{}
+			h.Update(event.UpdateEvent{ObjectOld: ingress, MetaOld: ingress, ObjectNew: tc.New, MetaNew: tc.New}, queue)
+			assert.Equal(t, tc.ExpectedEnqueue, queue.Len() == 1)
+		})
+	}
+}
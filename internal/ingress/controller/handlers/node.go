@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"reflect"
 
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/backend"
 	corev1 "k8s.io/api/core/v1"
@@ -22,13 +23,16 @@ var _ handler.EventHandler = (*EnqueueRequestsForNodeEvent)(nil)
 type EnqueueRequestsForNodeEvent struct {
 	IngressClass string
 
+	// ExcludedTaints are the taints of the nodes which are never instance targets
+	ExcludedTaints []string
+
 	Cache cache.Cache
 }
 
 // Create is called in response to an create event - e.g. Pod Creation.
 func (h *EnqueueRequestsForNodeEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
 	node := e.Object.(*corev1.Node)
-	if backend.IsNodeSuitableAsTrafficProxy(node) {
+	if backend.IsNodeSuitableAsTrafficProxy(node, h.ExcludedTaints) {
 		h.enqueueImpactedIngresses(queue)
 	}
 }
@@ -36,7 +40,7 @@ func (h *EnqueueRequestsForNodeEvent) Create(e event.CreateEvent, queue workqueu
 // Delete is called in response to a delete event - e.g. Pod Deleted.
 func (h *EnqueueRequestsForNodeEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
 	node := e.Object.(*corev1.Node)
-	if backend.IsNodeSuitableAsTrafficProxy(node) {
+	if backend.IsNodeSuitableAsTrafficProxy(node, h.ExcludedTaints) {
 		h.enqueueImpactedIngresses(queue)
 	}
 }
@@ -45,7 +49,9 @@ func (h *EnqueueRequestsForNodeEvent) Delete(e event.DeleteEvent, queue workqueu
 func (h *EnqueueRequestsForNodeEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
 	nodeOld := e.ObjectOld.(*corev1.Node)
 	nodeNew := e.ObjectNew.(*corev1.Node)
-	if backend.IsNodeSuitableAsTrafficProxy(nodeOld) != backend.IsNodeSuitableAsTrafficProxy(nodeNew) {
+	suitableOld, suitableNew := backend.IsNodeSuitableAsTrafficProxy(nodeOld, h.ExcludedTaints), backend.IsNodeSuitableAsTrafficProxy(nodeNew, h.ExcludedTaints)
+	// the labels of suitable nodes may be selected by the target-node-labels of targetGroups
+	if suitableOld != suitableNew || (suitableNew && !reflect.DeepEqual(nodeOld.Labels, nodeNew.Labels)) {
 		h.enqueueImpactedIngresses(queue)
 	}
 }
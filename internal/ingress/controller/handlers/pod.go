@@ -2,11 +2,14 @@ package handlers
 
 import (
 	"context"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/tg"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/class"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/backend"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/store"
+	discoveryv1 "github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/discovery/v1"
 	corev1 "k8s.io/api/core/v1"
 	extensions "k8s.io/api/extensions/v1beta1"
 	"k8s.io/apimachinery/pkg/types"
@@ -22,7 +25,14 @@ var _ handler.EventHandler = (*EnqueueRequestsForPodsEvent)(nil)
 
 type EnqueueRequestsForPodsEvent struct {
 	IngressClass string
-	Cache        cache.Cache
+
+	// DebounceWindow delays the enqueued requests, so that the readiness changes of pods within the window are coalesced
+	DebounceWindow time.Duration
+
+	// EndpointSlices reads the endpoints of services from their EndpointSlices instead of their Endpoints
+	EndpointSlices bool
+
+	Cache cache.Cache
 }
 
 // Create is called in response to an create event - e.g. Pod Creation.
@@ -34,16 +44,23 @@ func (h *EnqueueRequestsForPodsEvent) Update(e event.UpdateEvent, queue workqueu
 	podOld := e.ObjectOld.(*corev1.Pod)
 	podNew := e.ObjectNew.(*corev1.Pod)
 
+	// terminating pods are deregistered right away, without waiting for their endpoints to be updated nor for the debounce window
+	if podNew.DeletionTimestamp != nil && podOld.DeletionTimestamp == nil {
+		h.enqueueImpactedIngresses(podNew, 0, queue)
+		return
+	}
+
 	// we only enqueue reconcile events for pods whose containers changed state
 	// (ContainersReady vs not ContainersReady).
 	if backend.IsPodSuitableAsIPTarget(podNew) != backend.IsPodSuitableAsIPTarget(podOld) {
 		// ... and only for pods referenced by an endpoint backing an ingress:
-		h.enqueueImpactedIngresses(podNew, queue)
+		h.enqueueImpactedIngresses(podNew, h.DebounceWindow, queue)
 	}
 }
 
 // Delete is called in response to a delete event - e.g. Pod Deleted.
 func (h *EnqueueRequestsForPodsEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedIngresses(e.Object.(*corev1.Pod), 0, queue)
 }
 
 // Generic is called in response to an event of an unknown type or a synthetic event triggered as a cron or
@@ -51,7 +68,7 @@ func (h *EnqueueRequestsForPodsEvent) Delete(e event.DeleteEvent, queue workqueu
 func (h *EnqueueRequestsForPodsEvent) Generic(event.GenericEvent, workqueue.RateLimitingInterface) {
 }
 
-func (h *EnqueueRequestsForPodsEvent) enqueueImpactedIngresses(pod *corev1.Pod, queue workqueue.RateLimitingInterface) {
+func (h *EnqueueRequestsForPodsEvent) enqueueImpactedIngresses(pod *corev1.Pod, delay time.Duration, queue workqueue.RateLimitingInterface) {
 	ingressList := &extensions.IngressList{}
 	if err := h.Cache.List(context.Background(), client.InNamespace(pod.Namespace), ingressList); err != nil {
 		glog.Errorf("failed to fetch ingresses impacted by pod %s due to %v", pod.GetName(), err)
@@ -71,40 +88,56 @@ func (h *EnqueueRequestsForPodsEvent) enqueueImpactedIngresses(pod *corev1.Pod,
 		if err != nil {
 			glog.Errorf("failed to extract backend services from ingress %s/%s, reconciling the ingress. Error: %e",
 				ingress.Namespace, ingress.Name, err)
-			queue.Add(reconcile.Request{
+			enqueueAfter(queue, reconcile.Request{
 				NamespacedName: types.NamespacedName{
 					Namespace: ingress.Namespace,
 					Name:      ingress.Name,
 				},
-			})
+			}, delay)
 			break
 		}
 
 		for _, backend := range backends {
-			endpoint := &corev1.Endpoints{}
-			nspname := types.NamespacedName{
-				Namespace: ingress.Namespace,
-				Name:      backend.ServiceName,
-			}
-			if err = h.Cache.Get(context.Background(), nspname, endpoint); err != nil {
+			endpoint, err := h.getServiceEndpoints(ingress.Namespace, backend.ServiceName)
+			if err != nil {
 				glog.Errorf("failed to fetch enpoint %s backing ingress %s/%s, ignoring",
 					backend.ServiceName, ingress.Namespace, ingress.Name)
 				continue
 			}
 
 			if h.isPodInEndpoint(pod, endpoint) {
-				queue.Add(reconcile.Request{
+				enqueueAfter(queue, reconcile.Request{
 					NamespacedName: types.NamespacedName{
 						Namespace: ingress.Namespace,
 						Name:      ingress.Name,
 					},
-				})
+				}, delay)
 				break
 			}
 		}
 	}
 }
 
+func (h *EnqueueRequestsForPodsEvent) getServiceEndpoints(namespace string, serviceName string) (*corev1.Endpoints, error) {
+	if h.EndpointSlices {
+		sliceList := &discoveryv1.EndpointSliceList{}
+		opts := client.InNamespace(namespace).MatchingLabels(map[string]string{discoveryv1.LabelServiceName: serviceName})
+		if err := h.Cache.List(context.Background(), opts, sliceList); err != nil {
+			return nil, err
+		}
+		slices := make([]*discoveryv1.EndpointSlice, 0, len(sliceList.Items))
+		for i := range sliceList.Items {
+			slices = append(slices, &sliceList.Items[i])
+		}
+		return store.EndpointsFromSlices(slices), nil
+	}
+	endpoint := &corev1.Endpoints{}
+	if err := h.Cache.Get(context.Background(), types.NamespacedName{Namespace: namespace, Name: serviceName}, endpoint); err != nil {
+		return nil, err
+	}
+	return endpoint, nil
+}
+
 func (h *EnqueueRequestsForPodsEvent) isPodInEndpoint(pod *corev1.Pod, endpoint *corev1.Endpoints) bool {
 	for _, sub := range endpoint.Subsets {
 		for _, addr := range sub.Addresses {
@@ -0,0 +1,95 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/server/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CacheSyncChecker reports healthy once the informer caches have been synced,
+// so that the controller pod doesn't receive readiness before it has a complete view of the cluster.
+type CacheSyncChecker struct {
+	synced int32
+}
+
+// NewCacheSyncChecker constructs new CacheSyncChecker, which waits for the sync of cache until stop is closed.
+func NewCacheSyncChecker(cache cache.Cache, stop <-chan struct{}) *CacheSyncChecker {
+	c := &CacheSyncChecker{}
+	go func() {
+		if cache.WaitForCacheSync(stop) {
+			atomic.StoreInt32(&c.synced, 1)
+		}
+	}()
+	return c
+}
+
+var _ healthz.HealthzChecker = (*CacheSyncChecker)(nil)
+
+func (c *CacheSyncChecker) Name() string {
+	return "cache-sync"
+}
+
+func (c *CacheSyncChecker) Check(_ *http.Request) error {
+	if atomic.LoadInt32(&c.synced) == 0 {
+		return errors.New("informer caches not synced")
+	}
+	return nil
+}
+
+// IngressListChecker reports healthy while ingresses can be listed from the API server,
+// so that a controller pod which lost access to ingresses stops receiving readiness.
+type IngressListChecker struct {
+	client    client.Reader
+	namespace string
+
+	// period is the duration a check result is reused for, so that frequent probes don't translate into API server calls.
+	period time.Duration
+
+	mutex       sync.Mutex
+	lastChecked time.Time
+	lastErr     error
+}
+
+// NewIngressListChecker constructs new IngressListChecker, which lists the ingresses of namespace from apiReader at most once per period.
+func NewIngressListChecker(apiReader client.Reader, namespace string, period time.Duration) *IngressListChecker {
+	return &IngressListChecker{
+		client:    apiReader,
+		namespace: namespace,
+		period:    period,
+	}
+}
+
+var _ healthz.HealthzChecker = (*IngressListChecker)(nil)
+
+func (c *IngressListChecker) Name() string {
+	return "ingress-list"
+}
+
+func (c *IngressListChecker) Check(req *http.Request) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if !c.lastChecked.IsZero() && time.Since(c.lastChecked) < c.period {
+		return c.lastErr
+	}
+	ctx := context.Background()
+	if req != nil {
+		ctx = req.Context()
+	}
+	c.lastErr = nil
+	if err := c.client.List(ctx, &client.ListOptions{Namespace: c.namespace, Raw: &metav1.ListOptions{Limit: 1}}, &extensions.IngressList{}); err != nil {
+		c.lastErr = fmt.Errorf("failed to list ingresses due to %v", err)
+	}
+	c.lastChecked = time.Now()
+	return c.lastErr
+}
@@ -0,0 +1,45 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// listRecorder records the options of the ingress lists, failing them with err.
+type listRecorder struct {
+	client.Reader
+	lists []client.ListOptions
+	err   error
+}
+
+func (r *listRecorder) List(ctx context.Context, opts *client.ListOptions, list runtime.Object) error {
+	if _, ok := list.(*extensions.IngressList); !ok {
+		return errors.New("unexpected list")
+	}
+	r.lists = append(r.lists, *opts)
+	return r.err
+}
+
+func TestIngressListChecker_Check(t *testing.T) {
+	reader := &listRecorder{err: errors.New(`ingresses.extensions is forbidden: User "system:serviceaccount:kube-system:alb-ingress-controller" cannot list resource "ingresses"`)}
+	checker := NewIngressListChecker(reader, "namespace", time.Hour)
+
+	expectedErr := errors.New(`failed to list ingresses due to ingresses.extensions is forbidden: User "system:serviceaccount:kube-system:alb-ingress-controller" cannot list resource "ingresses"`)
+	assert.Equal(t, expectedErr, checker.Check(nil))
+	assert.Equal(t, expectedErr, checker.Check(nil))
+	assert.Len(t, reader.lists, 1)
+	assert.Equal(t, "namespace", reader.lists[0].Namespace)
+	assert.Equal(t, int64(1), reader.lists[0].Raw.Limit)
+
+	reader.err = nil
+	checker.lastChecked = time.Now().Add(-2 * time.Hour)
+	assert.NoError(t, checker.Check(nil))
+	assert.Len(t, reader.lists, 2)
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/lb"
+	extensions "k8s.io/api/extensions/v1beta1"
+)
+
+// ingressPatcher applies JSON merge patches to ingresses, which the controller-runtime client can only update whole.
+// It's implemented by ingressapi.Patcher, which patches networking.k8s.io/v1 ingresses when the cluster serves them.
+type ingressPatcher interface {
+	Patch(ctx context.Context, ingress *extensions.Ingress, patch []byte) (*extensions.Ingress, error)
+}
+
+// updateIngressAnnotations applies the annotation changes mutate makes to a copy of ingress with a single merge patch,
+// so that a reconcile doesn't overwrite concurrent changes to the rest of ingress.
+func (r *Reconciler) updateIngressAnnotations(ctx context.Context, ingress *extensions.Ingress, mutate func(ingress *extensions.Ingress) error) error {
+	modified := ingress.DeepCopy()
+	if err := mutate(modified); err != nil {
+		return err
+	}
+	changes := make(map[string]*string)
+	for key, value := range modified.Annotations {
+		if current, ok := ingress.Annotations[key]; !ok || current != value {
+			value := value
+			changes[key] = &value
+		}
+	}
+	for key := range ingress.Annotations {
+		if _, ok := modified.Annotations[key]; !ok {
+			changes[key] = nil
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": changes},
+	})
+	if err != nil {
+		return err
+	}
+	patched, err := r.ingressPatcher.Patch(ctx, ingress, patch)
+	if err != nil {
+		return err
+	}
+	// the patched metadata carries the resourceVersion the status update that follows must be based on.
+	ingress.ObjectMeta = patched.ObjectMeta
+	return nil
+}
+
+// publishIngress records reconciled loadBalancer on the annotations of ingress with a single patch, then publishes it in the status of ingress.
+func (r *Reconciler) publishIngress(ctx context.Context, ingress *extensions.Ingress, lbInfo *lb.LoadBalancer) error {
+	if err := r.updateIngressAnnotations(ctx, ingress, func(ingress *extensions.Ingress) error {
+		if err := setManagedResources(ingress, lbInfo); err != nil {
+			return err
+		}
+		r.setExternalDNSAnnotations(ingress, lbInfo)
+		return setIngressConditions(ctx, ingress, lbInfo, nil)
+	}); err != nil {
+		return err
+	}
+	return r.updateIngressStatus(ctx, ingress, lbInfo)
+}
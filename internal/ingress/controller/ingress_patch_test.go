@@ -0,0 +1,109 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/lb"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/config"
+	albtesting "github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/testing"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// fakeIngressPatcher applies the annotation merge patches it records to the ingresses of client.
+type fakeIngressPatcher struct {
+	client  client.Client
+	patches []string
+}
+
+func (p *fakeIngressPatcher) Patch(ctx context.Context, ingress *extensions.Ingress, patch []byte) (*extensions.Ingress, error) {
+	p.patches = append(p.patches, string(patch))
+	var decoded struct {
+		Metadata struct {
+			Annotations map[string]*string `json:"annotations"`
+		} `json:"metadata"`
+	}
+	if err := json.Unmarshal(patch, &decoded); err != nil {
+		return nil, err
+	}
+	stored := &extensions.Ingress{}
+	if err := p.client.Get(ctx, types.NamespacedName{Namespace: ingress.Namespace, Name: ingress.Name}, stored); err != nil {
+		return nil, err
+	}
+	for key, value := range decoded.Metadata.Annotations {
+		if value == nil {
+			delete(stored.Annotations, key)
+			continue
+		}
+		if stored.Annotations == nil {
+			stored.Annotations = make(map[string]string)
+		}
+		stored.Annotations[key] = *value
+	}
+	if err := p.client.Update(ctx, stored); err != nil {
+		return nil, err
+	}
+	return stored, nil
+}
+
+func TestReconciler_updateIngressAnnotations(t *testing.T) {
+	ingress := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{
+		Namespace:   "namespace",
+		Name:        "ingress",
+		Annotations: map[string]string{"kept": "value", "changed": "old", "removed": "value"},
+	}}
+	ctx := context.Background()
+	patcher := &fakeIngressPatcher{client: fake.NewFakeClient(ingress.DeepCopy())}
+	r := &Reconciler{ingressPatcher: patcher}
+
+	assert.NoError(t, r.updateIngressAnnotations(ctx, ingress, func(ingress *extensions.Ingress) error {
+		ingress.Annotations["changed"] = "new"
+		ingress.Annotations["added"] = "value"
+		delete(ingress.Annotations, "removed")
+		return nil
+	}))
+	assert.Equal(t, []string{`{"metadata":{"annotations":{"added":"value","changed":"new","removed":null}}}`}, patcher.patches)
+	assert.Equal(t, map[string]string{"kept": "value", "changed": "new", "added": "value"}, ingress.Annotations)
+
+	// no patch is sent when annotations are up to date.
+	assert.NoError(t, r.updateIngressAnnotations(ctx, ingress, func(ingress *extensions.Ingress) error {
+		ingress.Annotations["changed"] = "new"
+		return nil
+	}))
+	assert.Len(t, patcher.patches, 1)
+}
+
+func TestReconciler_publishIngress(t *testing.T) {
+	ingress := &extensions.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "ingress"},
+		Spec:       extensions.IngressSpec{Rules: []extensions.IngressRule{{Host: "app.example.com"}}},
+	}
+	cfg := config.NewConfiguration()
+	cfg.ExternalDNS = true
+	ctx := context.Background()
+	k8sClient := fake.NewFakeClient(ingress.DeepCopy())
+	patcher := &fakeIngressPatcher{client: k8sClient}
+	r := &Reconciler{
+		client:         k8sClient,
+		ingressPatcher: patcher,
+		store:          albtesting.NewStore(&cfg),
+	}
+
+	assert.NoError(t, r.publishIngress(ctx, ingress, &lb.LoadBalancer{Arn: "lbArn", DNSName: "lb.example.com", State: "active"}))
+	// the managed resources, external-dns and conditions annotations are set by a single patch.
+	assert.Len(t, patcher.patches, 1)
+
+	stored := &extensions.Ingress{}
+	assert.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Namespace: "namespace", Name: "ingress"}, stored))
+	assert.Contains(t, stored.Annotations, ManagedResourcesAnnotation())
+	assert.Contains(t, stored.Annotations, externalDNSHostnameAnnotation)
+	assert.Contains(t, stored.Annotations, ConditionsAnnotation())
+	assert.Equal(t, []corev1.LoadBalancerIngress{{Hostname: "lb.example.com"}}, stored.Status.LoadBalancer.Ingress)
+}
@@ -0,0 +1,84 @@
+package controller
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/lb"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/parser"
+	extensions "k8s.io/api/extensions/v1beta1"
+)
+
+// managedResourcesAnnotationSuffix is the suffix of the annotation recording AWS resources owned for an ingress.
+const managedResourcesAnnotationSuffix = "managed-resources"
+
+// ManagedResources lists the AWS resources the controller currently owns for a single ingress.
+type ManagedResources struct {
+	LoadBalancerARN  string   `json:"loadBalancerARN"`
+	Scheme           string   `json:"scheme,omitempty"`
+	ListenerARNs     []string `json:"listenerARNs"`
+	TargetGroupARNs  []string `json:"targetGroupARNs"`
+	SecurityGroupIDs []string `json:"securityGroupIDs"`
+	// HealthCheckIDs are the Route 53 health checks of the hosts and paths served, for use in failover routing policies.
+	HealthCheckIDs []string `json:"route53HealthCheckIDs,omitempty"`
+	// FrontendNLBARN and FrontendTargetGroupARNs are the NLB giving the LoadBalancer static IPs, and its ALB-type targetGroups.
+	FrontendNLBARN          string   `json:"frontendNLBARN,omitempty"`
+	FrontendTargetGroupARNs []string `json:"frontendTargetGroupARNs,omitempty"`
+	// Group is the ingress group sharing the LoadBalancer, so that it's reconciled once the ingress leaves it.
+	Group string `json:"group,omitempty"`
+}
+
+// ManagedResourcesAnnotation returns the annotation key under which ManagedResources is recorded on ingresses.
+func ManagedResourcesAnnotation() string {
+	return parser.GetAnnotationWithPrefix(managedResourcesAnnotationSuffix)
+}
+
+// GetManagedResources decodes the ManagedResources recorded on ingress, it returns nil if there isn't one.
+func GetManagedResources(ingress *extensions.Ingress) (*ManagedResources, error) {
+	raw, ok := ingress.Annotations[ManagedResourcesAnnotation()]
+	if !ok {
+		return nil, nil
+	}
+	resources := &ManagedResources{}
+	if err := json.Unmarshal([]byte(raw), resources); err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+// buildManagedResources builds ManagedResources from reconciled loadBalancer, with every list sorted so the encoding is stable.
+func buildManagedResources(lbInfo *lb.LoadBalancer) ManagedResources {
+	resources := ManagedResources{
+		LoadBalancerARN:  lbInfo.Arn,
+		Scheme:           lbInfo.Scheme,
+		ListenerARNs:     sortedCopy(lbInfo.ListenerARNs),
+		TargetGroupARNs:  sortedCopy(lbInfo.TargetGroupARNs),
+		SecurityGroupIDs: sortedCopy(lbInfo.SecurityGroupIDs),
+		HealthCheckIDs:   sortedCopy(lbInfo.HealthCheckIDs),
+		Group:            lbInfo.Group,
+	}
+	if lbInfo.FrontendNLB != nil {
+		resources.FrontendNLBARN = lbInfo.FrontendNLB.Arn
+		resources.FrontendTargetGroupARNs = sortedCopy(lbInfo.FrontendNLB.TargetGroupARNs)
+	}
+	return resources
+}
+
+func sortedCopy(values []string) []string {
+	result := append([]string{}, values...)
+	sort.Strings(result)
+	return result
+}
+
+// setManagedResources records the ManagedResources of reconciled loadBalancer on ingress.
+func setManagedResources(ingress *extensions.Ingress, lbInfo *lb.LoadBalancer) error {
+	payload, err := json.Marshal(buildManagedResources(lbInfo))
+	if err != nil {
+		return err
+	}
+	if ingress.Annotations == nil {
+		ingress.Annotations = make(map[string]string)
+	}
+	ingress.Annotations[ManagedResourcesAnnotation()] = string(payload)
+	return nil
+}
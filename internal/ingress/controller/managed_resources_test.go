@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/lb"
+	"github.com/stretchr/testify/assert"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_setManagedResources(t *testing.T) {
+	lbInfo := &lb.LoadBalancer{
+		Arn:              "lbArn",
+		DNSName:          "lb.example.com",
+		ListenerARNs:     []string{"lsArn2", "lsArn1"},
+		TargetGroupARNs:  []string{"tgArn2", "tgArn1"},
+		SecurityGroupIDs: []string{"sg-2", "sg-1"},
+	}
+	expectedAnnotation := `{"loadBalancerARN":"lbArn","listenerARNs":["lsArn1","lsArn2"],"targetGroupARNs":["tgArn1","tgArn2"],"securityGroupIDs":["sg-1","sg-2"]}`
+
+	for _, tc := range []struct {
+		Name        string
+		Annotations map[string]string
+	}{
+		{
+			Name: "annotation missing",
+		},
+		{
+			Name:        "annotation outdated",
+			Annotations: map[string]string{ManagedResourcesAnnotation(): `{"loadBalancerARN":"oldArn"}`},
+		},
+		{
+			Name:        "annotation up to date",
+			Annotations: map[string]string{ManagedResourcesAnnotation(): expectedAnnotation},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			ingress := &extensions.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "namespace",
+					Name:        "ingress",
+					Annotations: tc.Annotations,
+				},
+			}
+			assert.NoError(t, setManagedResources(ingress, lbInfo))
+			assert.Equal(t, expectedAnnotation, ingress.Annotations[ManagedResourcesAnnotation()])
+
+			resources, err := GetManagedResources(ingress)
+			assert.NoError(t, err)
+			assert.Equal(t, &ManagedResources{
+				LoadBalancerARN:  "lbArn",
+				ListenerARNs:     []string{"lsArn1", "lsArn2"},
+				TargetGroupARNs:  []string{"tgArn1", "tgArn2"},
+				SecurityGroupIDs: []string{"sg-1", "sg-2"},
+			}, resources)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/class"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/k8s"
+	corev1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// namespaceQuota limits the ALBs created for the ingresses of each namespace.
+// ALBs are counted from the managed resources recorded on ingresses, so ingresses owning an ALB are never rejected,
+// unless its scheme changes, which replaces it with a new ALB.
+// reader should read from the API server rather than a cache, so that the ALBs just recorded on ingresses are counted.
+type namespaceQuota struct {
+	reader       client.Reader
+	ingressClass string
+
+	// maxALBs and maxInternetFacingALBs are the ALBs allowed per namespace, 0 means unlimited.
+	maxALBs               int
+	maxInternetFacingALBs int
+
+	// locks serializes the ingresses getting a new ALB in each namespace, from their check until their ALB is recorded.
+	locks keyLocks
+}
+
+func newNamespaceQuota(reader client.Reader, ingressClass string, maxALBs int, maxInternetFacingALBs int) *namespaceQuota {
+	return &namespaceQuota{
+		reader:                reader,
+		ingressClass:          ingressClass,
+		maxALBs:               maxALBs,
+		maxInternetFacingALBs: maxInternetFacingALBs,
+	}
+}
+
+// Check returns why the ALB of ingress with scheme exceeds the quotas of its namespace, or "" if it doesn't.
+// When ingress gets a new ALB within the quotas, the checks of other ingresses of its namespace wait until release is called,
+// once the ALB is recorded on ingress, so that concurrent reconciles don't both take the last ALB of the quotas.
+func (q *namespaceQuota) Check(ctx context.Context, ingress *extensions.Ingress, scheme string) (reason string, release func(), err error) {
+	current, err := GetManagedResources(ingress)
+	if err != nil {
+		return "", func() {}, err
+	}
+	hasALB := current != nil && current.LoadBalancerARN != ""
+	newALB := !hasALB
+	newInternetFacingALB := scheme == elbv2.LoadBalancerSchemeEnumInternetFacing &&
+		(!hasALB || (current.Scheme != "" && current.Scheme != scheme))
+	if !(newALB && q.maxALBs > 0) && !(newInternetFacingALB && q.maxInternetFacingALBs > 0) {
+		return "", func() {}, nil
+	}
+
+	release = q.locks.lock(ingress.Namespace)
+	albs, internetFacingALBs, err := q.countALBs(ctx, ingress)
+	if err != nil {
+		release()
+		return "", func() {}, err
+	}
+	if newALB && q.maxALBs > 0 && albs >= q.maxALBs {
+		release()
+		return fmt.Sprintf("namespace %v reached its quota of %d ALBs", ingress.Namespace, q.maxALBs), func() {}, nil
+	}
+	if newInternetFacingALB && q.maxInternetFacingALBs > 0 && internetFacingALBs >= q.maxInternetFacingALBs {
+		release()
+		return fmt.Sprintf("namespace %v reached its quota of %d internet-facing ALBs", ingress.Namespace, q.maxInternetFacingALBs), func() {}, nil
+	}
+	return "", release, nil
+}
+
+// countALBs counts the ALBs of the other ingresses in the namespace of ingress.
+func (q *namespaceQuota) countALBs(ctx context.Context, ingress *extensions.Ingress) (albs int, internetFacingALBs int, err error) {
+	ingressList := &extensions.IngressList{}
+	if err := q.reader.List(ctx, &client.ListOptions{Namespace: ingress.Namespace}, ingressList); err != nil {
+		return 0, 0, err
+	}
+	// the ALB of an ingress group is counted once, however many of its members are in the namespace.
+	countedARNs := sets.NewString()
+	for i := range ingressList.Items {
+		other := &ingressList.Items[i]
+		if other.Name == ingress.Name || !class.IsValidIngress(q.ingressClass, other) {
+			continue
+		}
+		resources, err := GetManagedResources(other)
+		if err != nil || resources == nil || resources.LoadBalancerARN == "" || countedARNs.Has(resources.LoadBalancerARN) {
+			continue
+		}
+		countedARNs.Insert(resources.LoadBalancerARN)
+		albs++
+		// ALBs recorded without scheme count as internet-facing, until their ingress is reconciled again.
+		if resources.Scheme != elbv2.LoadBalancerSchemeEnumInternal {
+			internetFacingALBs++
+		}
+	}
+	return albs, internetFacingALBs, nil
+}
+
+// checkNamespaceQuota rejects ingress if its ALB exceeds the quotas of its namespace,
+// and otherwise returns the func to call once the ALB of ingress is recorded on it.
+func (r *Reconciler) checkNamespaceQuota(ctx context.Context, ingress *extensions.Ingress) (func(), error) {
+	ingressAnnos, err := r.store.GetIngressAnnotations(k8s.MetaNamespaceKey(ingress))
+	if err != nil {
+		// invalid annotations are reported by the reconcile itself.
+		return func() {}, nil
+	}
+	reason, release, err := r.namespaceQuota.Check(ctx, ingress, aws.StringValue(ingressAnnos.LoadBalancer.Scheme))
+	if err != nil {
+		return nil, fmt.Errorf("failed to check ALB quota of namespace %v due to %v", ingress.Namespace, err)
+	}
+	if reason != "" {
+		albctx.GetEventf(ctx)(corev1.EventTypeWarning, "REJECTED", "%v", reason)
+		return nil, fmt.Errorf("ingress rejected: %v", reason)
+	}
+	return release, nil
+}
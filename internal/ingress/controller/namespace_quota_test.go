@@ -0,0 +1,142 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func quotaTestIngress(namespace, name, managedResources string) *extensions.Ingress {
+	ingress := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{
+		Namespace:   namespace,
+		Name:        name,
+		Annotations: map[string]string{"kubernetes.io/ingress.class": "alb"},
+	}}
+	if managedResources != "" {
+		ingress.Annotations[ManagedResourcesAnnotation()] = managedResources
+	}
+	return ingress
+}
+
+func TestNamespaceQuota_Check(t *testing.T) {
+	internetFacing := `{"loadBalancerARN":"arn:lb/internet-facing","scheme":"internet-facing"}`
+	internal := `{"loadBalancerARN":"arn:lb/internal","scheme":"internal"}`
+	existing := []runtime.Object{
+		quotaTestIngress("team-a", "public", internetFacing),
+		quotaTestIngress("team-a", "private", internal),
+		quotaTestIngress("team-a", "pending", ""),
+		quotaTestIngress("team-b", "public", internetFacing),
+	}
+
+	for _, tc := range []struct {
+		name                  string
+		maxALBs               int
+		maxInternetFacingALBs int
+		ingress               *extensions.Ingress
+		scheme                string
+		expected              string
+	}{
+		{
+			name:    "new ALB within quota",
+			maxALBs: 3,
+			ingress: quotaTestIngress("team-a", "new", ""),
+			scheme:  "internal",
+		},
+		{
+			name:     "new ALB exceeding quota",
+			maxALBs:  2,
+			ingress:  quotaTestIngress("team-a", "new", ""),
+			scheme:   "internal",
+			expected: "namespace team-a reached its quota of 2 ALBs",
+		},
+		{
+			name:    "existing ALB exceeding quota",
+			maxALBs: 1,
+			ingress: quotaTestIngress("team-a", "private", internal),
+			scheme:  "internal",
+		},
+		{
+			name:                  "new internal ALB with internet-facing quota reached",
+			maxInternetFacingALBs: 1,
+			ingress:               quotaTestIngress("team-a", "new", ""),
+			scheme:                "internal",
+		},
+		{
+			name:                  "new internet-facing ALB exceeding quota",
+			maxInternetFacingALBs: 1,
+			ingress:               quotaTestIngress("team-a", "new", ""),
+			scheme:                "internet-facing",
+			expected:              "namespace team-a reached its quota of 1 internet-facing ALBs",
+		},
+		{
+			name:                  "existing ALB switching to internet-facing",
+			maxInternetFacingALBs: 1,
+			ingress:               quotaTestIngress("team-a", "private", internal),
+			scheme:                "internet-facing",
+			expected:              "namespace team-a reached its quota of 1 internet-facing ALBs",
+		},
+		{
+			name:                  "new internet-facing ALB in other namespace",
+			maxInternetFacingALBs: 1,
+			ingress:               quotaTestIngress("team-c", "new", ""),
+			scheme:                "internet-facing",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			quota := newNamespaceQuota(fake.NewFakeClient(existing...), "alb", tc.maxALBs, tc.maxInternetFacingALBs)
+			reason, release, err := quota.Check(context.Background(), tc.ingress, tc.scheme)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, reason)
+			release()
+		})
+	}
+}
+
+func TestNamespaceQuota_Check_concurrent(t *testing.T) {
+	ctx := context.Background()
+	var ingresses []*extensions.Ingress
+	var existing []runtime.Object
+	for i := 0; i < 5; i++ {
+		ingress := quotaTestIngress("team-a", fmt.Sprintf("new-%d", i), "")
+		ingresses = append(ingresses, ingress)
+		existing = append(existing, ingress.DeepCopy())
+	}
+	k8sClient := fake.NewFakeClient(existing...)
+	quota := newNamespaceQuota(k8sClient, "alb", 2, 0)
+
+	// ingresses created at once are reconciled concurrently, each recording its new ALB a while after its check.
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	var rejected int
+	for _, ingress := range ingresses {
+		wg.Add(1)
+		go func(ingress *extensions.Ingress) {
+			defer wg.Done()
+			reason, release, err := quota.Check(ctx, ingress, "internal")
+			defer release()
+			assert.NoError(t, err)
+			if reason != "" {
+				mutex.Lock()
+				rejected++
+				mutex.Unlock()
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+			stored := &extensions.Ingress{}
+			assert.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Namespace: ingress.Namespace, Name: ingress.Name}, stored))
+			stored.Annotations[ManagedResourcesAnnotation()] = fmt.Sprintf(`{"loadBalancerARN":"arn:lb/%v","scheme":"internal"}`, ingress.Name)
+			assert.NoError(t, k8sClient.Update(ctx, stored))
+		}(ingress)
+	}
+	wg.Wait()
+	assert.Equal(t, 3, rejected)
+}
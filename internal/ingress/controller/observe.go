@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	corev1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// errObserveOnly is returned for the writes of Kubernetes objects in observe-only mode.
+var errObserveOnly = errors.New("writes are disabled in observe-only mode")
+
+// readOnlyClient is a client whose writes fail with errObserveOnly, so pod conditions are left to the active controller in observe-only mode.
+type readOnlyClient struct {
+	client.Client
+}
+
+func (c readOnlyClient) Create(ctx context.Context, obj runtime.Object) error {
+	return errObserveOnly
+}
+
+func (c readOnlyClient) Delete(ctx context.Context, obj runtime.Object, opts ...client.DeleteOptionFunc) error {
+	return errObserveOnly
+}
+
+func (c readOnlyClient) Update(ctx context.Context, obj runtime.Object) error {
+	return errObserveOnly
+}
+
+func (c readOnlyClient) Status() client.StatusWriter {
+	return c
+}
+
+// readOnlyIngressPatcher is an ingressPatcher whose patches fail with errObserveOnly.
+type readOnlyIngressPatcher struct{}
+
+func (readOnlyIngressPatcher) Patch(ctx context.Context, ingress *extensions.Ingress, patch []byte) (*extensions.Ingress, error) {
+	return nil, errObserveOnly
+}
+
+// observeIngress compares the AWS resources of ingress with its desired state, and requeues it to be compared again after observeInterval.
+// The cloud blocks mutating calls in observe-only mode, those the reconcile attempts are reported as drift.
+// A reconcile usually stops at its first blocked call, so further drift of the ingress is only reported once that one is resolved.
+func (r *Reconciler) observeIngress(ctx context.Context, ingressKey types.NamespacedName, ingress *extensions.Ingress) (reconcile.Result, error) {
+	ctx = r.buildReconcileContext(ctx, ingressKey, ingress)
+	ctx, recorder := aws.WithMutationRecorder(ctx)
+	_, err := r.lbController.Reconcile(ctx, ingress)
+	if calls := recorder.Calls(); len(calls) != 0 {
+		r.metricCollector.SetObservedDrift(ingressKey.String(), true)
+		albctx.GetEventf(ctx)(corev1.EventTypeWarning, "DRIFT", "AWS resources drifted from the desired state, reconciling would call %v", strings.Join(calls, ", "))
+		if err != nil {
+			albctx.GetLogger(ctx).Infof("observation stopped at the first blocked call: %v", err)
+		}
+		return reconcile.Result{RequeueAfter: r.observeInterval}, nil
+	}
+	if err != nil {
+		albctx.GetEventf(ctx)(corev1.EventTypeWarning, "ERROR", "failed to observe ingress due to %v", err)
+		return reconcile.Result{}, err
+	}
+	r.metricCollector.SetObservedDrift(ingressKey.String(), false)
+	return reconcile.Result{RequeueAfter: r.observeInterval}, nil
+}
@@ -0,0 +1,134 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/lb"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/metric"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// observedLBController describes the LoadBalancer of the ingress, and modifies its attributes when drifted.
+type observedLBController struct {
+	lb.Controller
+	elbv2   *elbv2.ELBV2
+	drifted bool
+}
+
+func (c *observedLBController) Reconcile(ctx context.Context, ingress *extensions.Ingress) (*lb.LoadBalancer, error) {
+	if _, err := c.elbv2.DescribeLoadBalancersWithContext(ctx, &elbv2.DescribeLoadBalancersInput{}); err != nil {
+		return nil, err
+	}
+	if c.drifted {
+		if _, err := c.elbv2.ModifyLoadBalancerAttributesWithContext(ctx, &elbv2.ModifyLoadBalancerAttributesInput{
+			LoadBalancerArn: aws.String("lbArn"),
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return &lb.LoadBalancer{DNSName: "lb.example.com"}, nil
+}
+
+type observedDriftCollector struct {
+	metric.DummyCollector
+	drift map[string]bool
+}
+
+func (c *observedDriftCollector) SetObservedDrift(ingress string, drift bool) {
+	c.drift[ingress] = drift
+}
+
+func TestReconciler_observeIngress(t *testing.T) {
+	sess := session.Must(session.NewSession(awssdk.NewConfig().
+		WithRegion("us-west-2").
+		WithCredentials(credentials.NewStaticCredentials("id", "secret", ""))))
+	sess.Handlers.Send.Clear()
+	sess.Handlers.Send.PushBack(func(r *request.Request) {
+		if r.Operation.Name != "DescribeLoadBalancers" {
+			r.Error = awserr.New("Unexpected", r.Operation.Name+" should not be sent", nil)
+			return
+		}
+		r.HTTPResponse = &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: ioutil.NopCloser(bytes.NewReader(nil))}
+	})
+	sess.Handlers.UnmarshalMeta.Clear()
+	sess.Handlers.Unmarshal.Clear()
+	aws.BlockMutations(sess)
+
+	ingressKey := types.NamespacedName{Namespace: "namespace", Name: "ingress"}
+	for _, tc := range []struct {
+		name           string
+		drifted        bool
+		expectedEvents []string
+	}{
+		{
+			name: "in sync",
+		},
+		{
+			name:    "drifted",
+			drifted: true,
+			expectedEvents: []string{
+				"Warning DRIFT AWS resources drifted from the desired state, reconciling would call elasticloadbalancing.ModifyLoadBalancerAttributes",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ingress := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: ingressKey.Namespace, Name: ingressKey.Name}}
+			recorder := record.NewFakeRecorder(10)
+			mc := &observedDriftCollector{drift: make(map[string]bool)}
+			r := &Reconciler{
+				client:          readOnlyClient{fake.NewFakeClient(ingress.DeepCopy())},
+				recorder:        recorder,
+				eventLimiter:    albctx.NewEventLimiter(0, 0, time.Minute),
+				lbController:    &observedLBController{elbv2: elbv2.New(sess), drifted: tc.drifted},
+				observeOnly:     true,
+				observeInterval: time.Minute,
+				metricCollector: mc,
+			}
+
+			result, err := r.observeIngress(context.Background(), ingressKey, ingress)
+			assert.NoError(t, err)
+			assert.Equal(t, reconcile.Result{RequeueAfter: time.Minute}, result)
+			assert.Equal(t, map[string]bool{ingressKey.String(): tc.drifted}, mc.drift)
+			close(recorder.Events)
+			var events []string
+			for event := range recorder.Events {
+				events = append(events, event)
+			}
+			assert.Equal(t, tc.expectedEvents, events)
+		})
+	}
+}
+
+func Test_readOnlyClient(t *testing.T) {
+	ingress := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "ingress"}}
+	c := readOnlyClient{fake.NewFakeClient(ingress.DeepCopy())}
+	ctx := context.Background()
+
+	stored := &extensions.Ingress{}
+	assert.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: "namespace", Name: "ingress"}, stored))
+	stored.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{Hostname: "lb.example.com"}}
+	assert.Equal(t, errObserveOnly, c.Update(ctx, stored))
+	assert.Equal(t, errObserveOnly, c.Status().Update(ctx, stored))
+	assert.Equal(t, errObserveOnly, c.Delete(ctx, stored))
+	assert.Equal(t, errObserveOnly, c.Create(ctx, &corev1.Pod{}))
+}
@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/preflight"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/k8s"
+	corev1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+)
+
+const preflightTimeout = time.Minute
+
+// runPreflightChecks logs the issues found with the permissions and quotas of the account.
+// They don't prevent the controller from starting, since IAM simulations ignore some policy types, like service control policies.
+func runPreflightChecks(checker preflight.Checker) {
+	ctx, cancel := context.WithTimeout(context.Background(), preflightTimeout)
+	defer cancel()
+	issues, err := checker.CheckAccount(ctx)
+	for _, issue := range issues {
+		glog.Warningf("preflight check: %v", issue)
+	}
+	if err != nil {
+		glog.Warningf("preflight checks incomplete: %v", err)
+		return
+	}
+	if len(issues) == 0 {
+		glog.Info("preflight checks passed")
+	}
+}
+
+// checkIngress emits a warning event per issue found for ingress, the reconcile proceeds regardless.
+func (r *Reconciler) checkIngress(ctx context.Context, ingress *extensions.Ingress) {
+	ingressAnnos, err := r.store.GetIngressAnnotations(k8s.MetaNamespaceKey(ingress))
+	if err != nil {
+		// invalid annotations are reported by the reconcile itself.
+		return
+	}
+	issues, err := r.preflightChecker.CheckIngress(ctx, ingress, ingressAnnos)
+	for _, issue := range issues {
+		albctx.GetEventf(ctx)(corev1.EventTypeWarning, "PREFLIGHT", "%v", issue)
+	}
+	if err != nil {
+		albctx.GetLogger(ctx).Warnf("preflight checks incomplete: %v", err)
+	}
+}
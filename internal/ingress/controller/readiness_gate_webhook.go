@@ -0,0 +1,216 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/golang/glog"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/tg"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/class"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/backend"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/store"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/k8s"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ReadinessGateWebhookPath is the path the readiness gate webhook is served at.
+const ReadinessGateWebhookPath = "/mutate-pod-readiness-gates"
+
+// readinessGateWebhook is a mutating admission webhook injecting into new pods the readiness gates of the ingresses
+// with ip targets they're backends of. The conditions of those gates are only set to True by the target health
+// controller once the pod is healthy in the corresponding target group.
+type readinessGateWebhook struct {
+	reader       client.Reader
+	store        store.Storer
+	ingressClass string
+
+	addr     string
+	certFile string
+	keyFile  string
+}
+
+func newReadinessGateWebhook(reader client.Reader, store store.Storer, ingressClass string, port int, certFile string, keyFile string) *readinessGateWebhook {
+	return &readinessGateWebhook{
+		reader:       reader,
+		store:        store,
+		ingressClass: ingressClass,
+		addr:         fmt.Sprintf(":%v", port),
+		certFile:     certFile,
+		keyFile:      keyFile,
+	}
+}
+
+// Start implements manager.Runnable, it serves the webhook over TLS until stop is closed.
+func (w *readinessGateWebhook) Start(stop <-chan struct{}) error {
+	mux := http.NewServeMux()
+	mux.Handle(ReadinessGateWebhookPath, w)
+	server := &http.Server{
+		Addr:              w.addr,
+		Handler:           mux,
+		ReadTimeout:       10 * time.Second,
+		ReadHeaderTimeout: 10 * time.Second,
+		WriteTimeout:      10 * time.Second,
+	}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- server.ListenAndServeTLS(w.certFile, w.keyFile)
+	}()
+	select {
+	case err := <-errs:
+		return fmt.Errorf("failed to serve readiness gate webhook due to %v", err)
+	case <-stop:
+		return server.Shutdown(context.Background())
+	}
+}
+
+func (w *readinessGateWebhook) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	review := &admissionv1beta1.AdmissionReview{}
+	if err := json.Unmarshal(body, review); err != nil || review.Request == nil {
+		http.Error(rw, "malformed AdmissionReview", http.StatusBadRequest)
+		return
+	}
+	review.Response = w.admit(r.Context(), review.Request)
+	review.Response.UID = review.Request.UID
+	review.Request = nil
+	resp, err := json.Marshal(review)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	_, _ = rw.Write(resp)
+}
+
+// admit allows every pod, patching in the readiness gates it misses. Failures to compute them are logged only,
+// so that the webhook never blocks the creation of pods.
+func (w *readinessGateWebhook) admit(ctx context.Context, req *admissionv1beta1.AdmissionRequest) *admissionv1beta1.AdmissionResponse {
+	resp := &admissionv1beta1.AdmissionResponse{Allowed: true}
+	if req.Operation != admissionv1beta1.Create {
+		return resp
+	}
+	pod := &corev1.Pod{}
+	if err := json.Unmarshal(req.Object.Raw, pod); err != nil {
+		resp.Result = &metav1.Status{Message: fmt.Sprintf("failed to decode pod due to %v", err)}
+		return resp
+	}
+	if pod.Namespace == "" {
+		pod.Namespace = req.Namespace
+	}
+	gates, err := w.missingReadinessGates(ctx, pod)
+	if err != nil {
+		glog.Errorf("failed to inject readiness gates into pod %v/%v due to %v", pod.Namespace, pod.GenerateName+pod.Name, err)
+		return resp
+	}
+	if len(gates) == 0 {
+		return resp
+	}
+	patch, err := json.Marshal(readinessGatesPatch(pod, gates))
+	if err != nil {
+		glog.Errorf("failed to inject readiness gates into pod %v/%v due to %v", pod.Namespace, pod.GenerateName+pod.Name, err)
+		return resp
+	}
+	patchType := admissionv1beta1.PatchTypeJSONPatch
+	resp.Patch = patch
+	resp.PatchType = &patchType
+	return resp
+}
+
+// missingReadinessGates returns the readiness gates of the ingresses pod is an ip target of, which pod doesn't have yet.
+func (w *readinessGateWebhook) missingReadinessGates(ctx context.Context, pod *corev1.Pod) ([]corev1.PodReadinessGate, error) {
+	ingressList := &extensions.IngressList{}
+	if err := w.reader.List(ctx, client.InNamespace(pod.Namespace), ingressList); err != nil {
+		return nil, err
+	}
+
+	existing := make(map[corev1.PodConditionType]bool)
+	for _, gate := range pod.Spec.ReadinessGates {
+		existing[gate.ConditionType] = true
+	}
+	var gates []corev1.PodReadinessGate
+	for i := range ingressList.Items {
+		ingress := &ingressList.Items[i]
+		if ingress.DeletionTimestamp != nil || !class.IsValidIngress(w.ingressClass, ingress) {
+			continue
+		}
+		backends, _, err := tg.ExtractTargetGroupBackends(ingress)
+		if err != nil {
+			return nil, err
+		}
+		for j := range backends {
+			ok, err := w.isIPTarget(ctx, pod, ingress, backends[j])
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			conditionType := backend.PodReadinessGateConditionType(ingress, &backends[j])
+			if !existing[conditionType] {
+				existing[conditionType] = true
+				gates = append(gates, corev1.PodReadinessGate{ConditionType: conditionType})
+			}
+		}
+	}
+	sort.Slice(gates, func(i, j int) bool { return gates[i].ConditionType < gates[j].ConditionType })
+	return gates, nil
+}
+
+// isIPTarget tells whether pod is selected by the service of ingressBackend, which targets pods by ip.
+func (w *readinessGateWebhook) isIPTarget(ctx context.Context, pod *corev1.Pod, ingress *extensions.Ingress, ingressBackend extensions.IngressBackend) (bool, error) {
+	service := &corev1.Service{}
+	if err := w.reader.Get(ctx, types.NamespacedName{Namespace: ingress.Namespace, Name: ingressBackend.ServiceName}, service); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if len(service.Spec.Selector) == 0 || !labels.SelectorFromSet(service.Spec.Selector).Matches(labels.Set(pod.Labels)) {
+		return false, nil
+	}
+	ingressAnnos, err := w.store.GetIngressAnnotations(k8s.MetaNamespaceKey(ingress))
+	if err != nil {
+		return false, err
+	}
+	serviceAnnos, err := w.store.GetServiceAnnotations(k8s.MetaNamespaceKey(service), ingressAnnos)
+	if err != nil {
+		return false, err
+	}
+	return aws.StringValue(serviceAnnos.TargetGroup.TargetType) == elbv2.TargetTypeEnumIp, nil
+}
+
+type jsonPatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"`
+}
+
+// readinessGatesPatch is the JSON patch appending gates to the readiness gates of pod.
+func readinessGatesPatch(pod *corev1.Pod, gates []corev1.PodReadinessGate) []jsonPatchOperation {
+	if len(pod.Spec.ReadinessGates) == 0 {
+		return []jsonPatchOperation{{Op: "add", Path: "/spec/readinessGates", Value: gates}}
+	}
+	var patch []jsonPatchOperation
+	for _, gate := range gates {
+		patch = append(patch, jsonPatchOperation{Op: "add", Path: "/spec/readinessGates/-", Value: gate})
+	}
+	return patch
+}
@@ -0,0 +1,117 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/targetgroup"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReadinessGateWebhook_admit(t *testing.T) {
+	ingress := func(name string, class string, serviceNames ...string) *extensions.Ingress {
+		ing := &extensions.Ingress{ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        name,
+			Annotations: map[string]string{"kubernetes.io/ingress.class": class},
+		}}
+		var paths []extensions.HTTPIngressPath
+		for _, serviceName := range serviceNames {
+			paths = append(paths, extensions.HTTPIngressPath{Backend: extensions.IngressBackend{ServiceName: serviceName, ServicePort: intstr.FromString("http")}})
+		}
+		ing.Spec.Rules = []extensions.IngressRule{{IngressRuleValue: extensions.IngressRuleValue{HTTP: &extensions.HTTPIngressRuleValue{Paths: paths}}}}
+		return ing
+	}
+	service := func(name string, selector map[string]string) *corev1.Service {
+		return &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name}, Spec: corev1.ServiceSpec{Selector: selector}}
+	}
+	reader := fake.NewFakeClient(
+		ingress("web", "alb", "web", "instance", "other", "missing"),
+		ingress("api", "alb", "web"),
+		ingress("nginx", "nginx", "web"),
+		service("web", map[string]string{"app": "web"}),
+		service("instance", map[string]string{"app": "web"}),
+		service("other", map[string]string{"app": "other"}),
+	)
+	mockStore := &store.MockStorer{}
+	mockStore.On("GetIngressAnnotations", mock.Anything).Return(&annotations.Ingress{}, nil)
+	targetType := func(targetType string) *annotations.Service {
+		return &annotations.Service{TargetGroup: &targetgroup.Config{TargetType: aws.String(targetType)}}
+	}
+	mockStore.On("GetServiceAnnotations", "default/web", mock.Anything).Return(targetType(elbv2.TargetTypeEnumIp), nil)
+	mockStore.On("GetServiceAnnotations", "default/instance", mock.Anything).Return(targetType(elbv2.TargetTypeEnumInstance), nil)
+	webhook := newReadinessGateWebhook(reader, mockStore, "alb", 9443, "tls.crt", "tls.key")
+
+	for _, tc := range []struct {
+		Name          string
+		Operation     admissionv1beta1.Operation
+		Pod           *corev1.Pod
+		ExpectedPatch []jsonPatchOperation
+	}{
+		{
+			Name:      "pod of ip targets without readiness gates",
+			Operation: admissionv1beta1.Create,
+			Pod:       &corev1.Pod{ObjectMeta: metav1.ObjectMeta{GenerateName: "web-", Labels: map[string]string{"app": "web"}}},
+			ExpectedPatch: []jsonPatchOperation{{Op: "add", Path: "/spec/readinessGates", Value: []interface{}{
+				map[string]interface{}{"conditionType": "target-health.alb.ingress.k8s.aws/api_web_http"},
+				map[string]interface{}{"conditionType": "target-health.alb.ingress.k8s.aws/web_web_http"},
+			}}},
+		},
+		{
+			Name:      "pod of ip targets with some readiness gates",
+			Operation: admissionv1beta1.Create,
+			Pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{GenerateName: "web-", Labels: map[string]string{"app": "web"}},
+				Spec: corev1.PodSpec{ReadinessGates: []corev1.PodReadinessGate{
+					{ConditionType: "custom"},
+					{ConditionType: "target-health.alb.ingress.k8s.aws/web_web_http"},
+				}},
+			},
+			ExpectedPatch: []jsonPatchOperation{
+				{Op: "add", Path: "/spec/readinessGates/-", Value: map[string]interface{}{"conditionType": "target-health.alb.ingress.k8s.aws/api_web_http"}},
+			},
+		},
+		{
+			Name:      "pod of no ingress",
+			Operation: admissionv1beta1.Create,
+			Pod:       &corev1.Pod{ObjectMeta: metav1.ObjectMeta{GenerateName: "db-", Labels: map[string]string{"app": "db"}}},
+		},
+		{
+			Name:      "pod updates",
+			Operation: admissionv1beta1.Update,
+			Pod:       &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Labels: map[string]string{"app": "web"}}},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			raw, err := json.Marshal(tc.Pod)
+			assert.NoError(t, err)
+			resp := webhook.admit(context.Background(), &admissionv1beta1.AdmissionRequest{
+				Namespace: "default",
+				Operation: tc.Operation,
+				Object:    runtime.RawExtension{Raw: raw},
+			})
+			assert.True(t, resp.Allowed)
+			if tc.ExpectedPatch == nil {
+				assert.Nil(t, resp.Patch)
+				return
+			}
+			var patch []jsonPatchOperation
+			assert.NoError(t, json.Unmarshal(resp.Patch, &patch))
+			assert.Equal(t, tc.ExpectedPatch, patch)
+			assert.Equal(t, admissionv1beta1.PatchTypeJSONPatch, *resp.PatchType)
+		})
+	}
+}
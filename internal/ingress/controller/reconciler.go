@@ -2,12 +2,17 @@ package controller
 
 import (
 	"context"
+	"time"
 
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/lb"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/albctx"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/class"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/store"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/metric"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/preflight"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/tracing"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/util/log"
+	"go.opentelemetry.io/otel/attribute"
 	corev1 "k8s.io/api/core/v1"
 	extensions "k8s.io/api/extensions/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -23,24 +28,49 @@ type Reconciler struct {
 	client   client.Client
 	cache    cache.Cache
 	recorder record.EventRecorder
+	// eventLimiter deduplicates and rate limits the events recorded for each ingress
+	eventLimiter *albctx.EventLimiter
+	// ingressPatcher patches the annotations of ingresses, which client can only update whole
+	ingressPatcher ingressPatcher
 
 	// TODO: move things out of store, and start to rely on functionality provided by client & cache
 	store store.Storer
 
 	lbController lb.Controller
+	// preflightChecker checks each ingress before reconciling it, nil if disabled
+	preflightChecker preflight.Checker
+	// namespaceQuota rejects ingresses exceeding the ALB quotas of their namespace, nil if unlimited
+	namespaceQuota *namespaceQuota
+
+	// ingressClass is the class of ingresses managed by the controller, addFinalizers is whether IngressFinalizer is added to them
+	// IngressFinalizer is always added to members of ingress groups, whose targetGroups can only be deleted once the group stops using them.
+	ingressClass  string
+	addFinalizers bool
+	groupLocks    keyLocks
+
+	// observeOnly only reports the drift of ingresses, observing each of them every observeInterval
+	observeOnly     bool
+	observeInterval time.Duration
 
 	metricCollector metric.Collector
 }
 
 // Reconcile will reconcile the aws resources with k8s state of ingress.
-func (r *Reconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
-	ctx := context.Background()
+func (r *Reconciler) Reconcile(request reconcile.Request) (_ reconcile.Result, err error) {
+	ctx, span := tracing.StartSpan(context.Background(), "Reconcile", attribute.String("ingress", request.NamespacedName.String()))
+	defer func() { tracing.EndSpan(span, err) }()
+	defer func(start time.Time) { r.metricCollector.ObserveReconcileDuration(time.Since(start)) }(time.Now())
+
 	ingress := &extensions.Ingress{}
 	if err := r.cache.Get(ctx, request.NamespacedName, ingress); err != nil {
 		if !errors.IsNotFound(err) {
 			r.metricCollector.IncReconcileErrorCount(request.NamespacedName.String())
 			return reconcile.Result{}, err
 		}
+		if r.observeOnly {
+			r.metricCollector.RemoveMetrics(request.NamespacedName.String())
+			return reconcile.Result{}, nil
+		}
 
 		if err := r.deleteIngress(ctx, request.NamespacedName); err != nil {
 			r.metricCollector.IncReconcileErrorCount(request.NamespacedName.String())
@@ -48,6 +78,39 @@ func (r *Reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		}
 
 		r.metricCollector.IncReconcileCount()
+		r.metricCollector.RemoveMetrics(request.NamespacedName.String())
+		return reconcile.Result{}, nil
+	}
+
+	if r.observeOnly {
+		// deletions are left to the active controller.
+		if ingress.DeletionTimestamp != nil || !class.IsValidIngress(r.ingressClass, ingress) {
+			r.metricCollector.RemoveMetrics(request.NamespacedName.String())
+			return reconcile.Result{}, nil
+		}
+		result, err := r.observeIngress(ctx, request.NamespacedName, ingress)
+		if err != nil {
+			r.metricCollector.IncReconcileErrorCount(request.NamespacedName.String())
+			return reconcile.Result{}, err
+		}
+		r.metricCollector.IncReconcileCount()
+		r.metricCollector.SetLastSuccessfulReconcile(request.NamespacedName.String())
+		return result, nil
+	}
+
+	// the finalizer is also honored for ingresses switching to another class, which would otherwise never be deleted.
+	if hasFinalizer(ingress) && (ingress.DeletionTimestamp != nil || !class.IsValidIngress(r.ingressClass, ingress)) {
+		if err := r.finalizeIngress(ctx, request.NamespacedName, ingress); err != nil {
+			r.metricCollector.IncReconcileErrorCount(request.NamespacedName.String())
+			return reconcile.Result{}, err
+		}
+
+		r.metricCollector.IncReconcileCount()
+		r.metricCollector.RemoveMetrics(request.NamespacedName.String())
+		return reconcile.Result{}, nil
+	}
+	if ingress.DeletionTimestamp != nil || !class.IsValidIngress(r.ingressClass, ingress) {
+		// ingresses without finalizer are cleaned up once they're gone, or left alone once they're of another class.
 		return reconcile.Result{}, nil
 	}
 
@@ -57,37 +120,95 @@ func (r *Reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 	}
 
 	r.metricCollector.IncReconcileCount()
+	r.metricCollector.SetLastSuccessfulReconcile(request.NamespacedName.String())
+	if isProvisioning(ingress) {
+		return reconcile.Result{RequeueAfter: provisioningRequeueInterval}, nil
+	}
 	return reconcile.Result{}, nil
 }
 
-func (r *Reconciler) reconcileIngress(ctx context.Context, ingressKey types.NamespacedName, ingress *extensions.Ingress) error {
+func (r *Reconciler) reconcileIngress(ctx context.Context, ingressKey types.NamespacedName, ingress *extensions.Ingress) (err error) {
 	ctx = r.buildReconcileContext(ctx, ingressKey, ingress)
-	lbInfo, err := r.lbController.Reconcile(ctx, ingress)
-	if err != nil {
+	defer func() {
+		if err == nil {
+			return
+		}
+		if updateErr := r.updateIngressAnnotations(ctx, ingress, func(ingress *extensions.Ingress) error {
+			return setIngressConditions(ctx, ingress, nil, err)
+		}); updateErr != nil {
+			albctx.GetLogger(ctx).Warnf("failed to publish conditions of ingress due to %v", updateErr)
+		}
+	}()
+	if r.preflightChecker != nil {
+		r.checkIngress(ctx, ingress)
+	}
+	if r.namespaceQuota != nil {
+		release, err := r.checkNamespaceQuota(ctx, ingress)
+		if err != nil {
+			return err
+		}
+		defer release()
+	}
+	groupName := lb.GroupName(ingress)
+	if r.addFinalizers || groupName != "" {
+		if err := r.addFinalizer(ctx, ingress); err != nil {
+			return err
+		}
+	}
+	if err := r.reconcileFormerGroups(ctx, ingress, groupName); err != nil {
 		return err
 	}
-	if err := r.updateIngressStatus(ctx, ingress, lbInfo); err != nil {
+	if groupName != "" {
+		if err := r.joinGroup(ctx, ingress, groupName); err != nil {
+			albctx.GetEventf(ctx)(corev1.EventTypeWarning, "ERROR", "failed to delete LoadBalancer of ingress joining group %v due to %v", groupName, err)
+			return err
+		}
+		return r.reconcileGroup(ctx, groupName, ingress)
+	}
+	lbInfo, err := r.lbController.Reconcile(ctx, ingress)
+	if err != nil {
+		albctx.GetEventf(ctx)(corev1.EventTypeWarning, "ERROR", "failed to reconcile ingress due to %v", err)
 		return err
 	}
-
-	return nil
+	return r.publishIngress(ctx, ingress, lbInfo)
 }
 
+// deleteIngress deletes the AWS resources of an ingress that is gone.
+// Migrated ingresses carry IngressFinalizer, so they're finalized instead.
 func (r *Reconciler) deleteIngress(ctx context.Context, ingressKey types.NamespacedName) error {
 	ctx = r.buildReconcileContext(ctx, ingressKey, nil)
-	if err := r.lbController.Delete(ctx, ingressKey); err != nil {
+	if err := r.lbController.Delete(ctx, ingressKey, false); err != nil {
 		return err
 	}
 	return nil
 }
 
+// finalizeIngress deletes the AWS resources of ingress, then lets its deletion proceed by removing IngressFinalizer.
+// The ingress group of ingress is reconciled without it first, so that its targetGroups are no longer in use.
+func (r *Reconciler) finalizeIngress(ctx context.Context, ingressKey types.NamespacedName, ingress *extensions.Ingress) error {
+	ctx = r.buildReconcileContext(ctx, ingressKey, ingress)
+	if err := r.reconcileFormerGroups(ctx, ingress, ""); err != nil {
+		return err
+	}
+	if err := r.lbController.Delete(ctx, ingressKey, lb.IsMigrated(ingress)); err != nil {
+		albctx.GetEventf(ctx)(corev1.EventTypeWarning, "ERROR", "failed to delete AWS resources of ingress due to %v", err)
+		return err
+	}
+	return r.removeFinalizer(ctx, ingress)
+}
+
+// updateIngressStatus publishes the DNS name of the frontend NLB of the LoadBalancer if there is one, as clients must reach it for its static IPs.
 func (r *Reconciler) updateIngressStatus(ctx context.Context, ingress *extensions.Ingress, lbInfo *lb.LoadBalancer) error {
+	hostname := lbInfo.DNSName
+	if lbInfo.FrontendNLB != nil {
+		hostname = lbInfo.FrontendNLB.DNSName
+	}
 	if len(ingress.Status.LoadBalancer.Ingress) != 1 ||
 		ingress.Status.LoadBalancer.Ingress[0].IP != "" ||
-		ingress.Status.LoadBalancer.Ingress[0].Hostname != lbInfo.DNSName {
+		ingress.Status.LoadBalancer.Ingress[0].Hostname != hostname {
 		ingress.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{
 			{
-				Hostname: lbInfo.DNSName,
+				Hostname: hostname,
 			},
 		}
 		return r.client.Status().Update(ctx, ingress)
@@ -98,9 +219,16 @@ func (r *Reconciler) updateIngressStatus(ctx context.Context, ingress *extension
 func (r *Reconciler) buildReconcileContext(ctx context.Context, ingressKey types.NamespacedName, ingress *extensions.Ingress) context.Context {
 	ctx = albctx.SetLogger(ctx, log.New(ingressKey.String()))
 	if ingress != nil {
-		ctx = albctx.SetEventf(ctx, func(eventType string, reason string, messageFmt string, args ...interface{}) {
+		eventf := r.eventLimiter.Wrap(ingressKey.String(), func(eventType string, reason string, messageFmt string, args ...interface{}) {
 			r.recorder.Eventf(ingress, eventType, reason, messageFmt, args...)
 		})
+		ctx = albctx.SetEventf(ctx, func(eventType string, reason string, messageFmt string, args ...interface{}) {
+			// modifications of existing AWS resources are counted as drift corrections, before events are deduplicated.
+			if eventType == corev1.EventTypeNormal && reason == "MODIFY" {
+				r.metricCollector.IncDriftCount(ingressKey.String())
+			}
+			eventf(eventType, reason, messageFmt, args...)
+		})
 	}
 	return ctx
 }
@@ -0,0 +1,115 @@
+package store
+
+import (
+	discoveryv1 "github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/discovery/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+// endpointSliceServiceIndex indexes EndpointSlices by the key of their service.
+const endpointSliceServiceIndex = "service"
+
+// EndpointSliceLister makes an Indexer that lists EndpointSlices.
+type EndpointSliceLister struct {
+	cache.Indexer
+}
+
+// ByService returns the Endpoints of the Service matching key, merged from its EndpointSlices in the local EndpointSlice Indexer.
+func (s *EndpointSliceLister) ByService(key string) (*corev1.Endpoints, error) {
+	items, err := s.ByIndex(endpointSliceServiceIndex, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, NotExistsError(key)
+	}
+	slices := make([]*discoveryv1.EndpointSlice, 0, len(items))
+	for _, item := range items {
+		slices = append(slices, item.(*discoveryv1.EndpointSlice))
+	}
+	return EndpointsFromSlices(slices), nil
+}
+
+// endpointSliceServiceKey is the IndexFunc of endpointSliceServiceIndex.
+func endpointSliceServiceKey(obj interface{}) ([]string, error) {
+	slice, ok := obj.(*discoveryv1.EndpointSlice)
+	if !ok {
+		return nil, nil
+	}
+	serviceName, ok := slice.Labels[discoveryv1.LabelServiceName]
+	if !ok {
+		return nil, nil
+	}
+	return []string{slice.Namespace + "/" + serviceName}, nil
+}
+
+// EndpointsFromSlices merges the IPv4 EndpointSlices of a service into its Endpoints, one subset per slice.
+// Endpoints whose readiness is unknown are ready, as they are for the Endpoints controller.
+func EndpointsFromSlices(slices []*discoveryv1.EndpointSlice) *corev1.Endpoints {
+	eps := &corev1.Endpoints{}
+	for _, slice := range slices {
+		if len(eps.Name) == 0 {
+			eps.ObjectMeta = metav1.ObjectMeta{Namespace: slice.Namespace, Name: slice.Labels[discoveryv1.LabelServiceName]}
+		}
+		if slice.AddressType != discoveryv1.AddressTypeIPv4 {
+			continue
+		}
+		var subset corev1.EndpointSubset
+		for _, port := range slice.Ports {
+			if port.Port == nil {
+				continue
+			}
+			epPort := corev1.EndpointPort{Port: *port.Port}
+			if port.Name != nil {
+				epPort.Name = *port.Name
+			}
+			if port.Protocol != nil {
+				epPort.Protocol = *port.Protocol
+			}
+			subset.Ports = append(subset.Ports, epPort)
+		}
+		for _, endpoint := range slice.Endpoints {
+			if len(endpoint.Addresses) == 0 {
+				continue
+			}
+			epAddr := corev1.EndpointAddress{IP: endpoint.Addresses[0], NodeName: endpoint.NodeName, TargetRef: endpoint.TargetRef}
+			if endpoint.Hostname != nil {
+				epAddr.Hostname = *endpoint.Hostname
+			}
+			if endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready {
+				subset.Addresses = append(subset.Addresses, epAddr)
+			} else {
+				subset.NotReadyAddresses = append(subset.NotReadyAddresses, epAddr)
+			}
+		}
+		if len(subset.Ports) != 0 && (len(subset.Addresses) != 0 || len(subset.NotReadyAddresses) != 0) {
+			eps.Subsets = append(eps.Subsets, subset)
+		}
+	}
+	return eps
+}
+
+// ServesEndpointSlices returns whether the API server of config serves discovery.k8s.io/v1 EndpointSlices.
+func ServesEndpointSlices(config *rest.Config) (bool, error) {
+	dc, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return false, err
+	}
+	resources, err := dc.ServerResourcesForGroupVersion(discoveryv1.SchemeGroupVersion.String())
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	for _, resource := range resources.APIResources {
+		if resource.Name == "endpointslices" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
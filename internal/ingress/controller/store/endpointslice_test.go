@@ -0,0 +1,74 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	discoveryv1 "github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/discovery/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func Test_EndpointsFromSlices(t *testing.T) {
+	podRef := func(name string) *corev1.ObjectReference {
+		return &corev1.ObjectReference{Kind: "Pod", Namespace: "default", Name: name}
+	}
+	slice := func(name string, addressType discoveryv1.AddressType, endpoints ...discoveryv1.Endpoint) *discoveryv1.EndpointSlice {
+		return &discoveryv1.EndpointSlice{
+			ObjectMeta:  metav1.ObjectMeta{Namespace: "default", Name: name, Labels: map[string]string{discoveryv1.LabelServiceName: "web"}},
+			AddressType: addressType,
+			Endpoints:   endpoints,
+			Ports:       []discoveryv1.EndpointPort{{Name: aws.String("http"), Port: func(p int32) *int32 { return &p }(8080)}},
+		}
+	}
+
+	eps := EndpointsFromSlices([]*discoveryv1.EndpointSlice{
+		slice("web-abcde", discoveryv1.AddressTypeIPv4,
+			discoveryv1.Endpoint{Addresses: []string{"10.0.0.1"}, TargetRef: podRef("web-1")},
+			discoveryv1.Endpoint{Addresses: []string{"10.0.0.2"}, Conditions: discoveryv1.EndpointConditions{Ready: aws.Bool(false)}, TargetRef: podRef("web-2")},
+		),
+		slice("web-fghij", discoveryv1.AddressTypeIPv4,
+			discoveryv1.Endpoint{Addresses: []string{"10.0.0.3"}, Conditions: discoveryv1.EndpointConditions{Ready: aws.Bool(true)}, NodeName: aws.String("node"), TargetRef: podRef("web-3")},
+		),
+		slice("web-klmno", discoveryv1.AddressTypeIPv6,
+			discoveryv1.Endpoint{Addresses: []string{"fd00::1"}, TargetRef: podRef("web-4")},
+		),
+		slice("web-pqrst", discoveryv1.AddressTypeIPv4),
+	})
+	assert.Equal(t, &corev1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"},
+		Subsets: []corev1.EndpointSubset{
+			{
+				Addresses:         []corev1.EndpointAddress{{IP: "10.0.0.1", TargetRef: podRef("web-1")}},
+				NotReadyAddresses: []corev1.EndpointAddress{{IP: "10.0.0.2", TargetRef: podRef("web-2")}},
+				Ports:             []corev1.EndpointPort{{Name: "http", Port: 8080}},
+			},
+			{
+				Addresses: []corev1.EndpointAddress{{IP: "10.0.0.3", NodeName: aws.String("node"), TargetRef: podRef("web-3")}},
+				Ports:     []corev1.EndpointPort{{Name: "http", Port: 8080}},
+			},
+		},
+	}, eps)
+}
+
+func Test_EndpointSliceLister_ByService(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{endpointSliceServiceIndex: endpointSliceServiceKey})
+	assert.NoError(t, indexer.Add(&discoveryv1.EndpointSlice{
+		ObjectMeta:  metav1.ObjectMeta{Namespace: "default", Name: "web-abcde", Labels: map[string]string{discoveryv1.LabelServiceName: "web"}},
+		AddressType: discoveryv1.AddressTypeIPv4,
+	}))
+	assert.NoError(t, indexer.Add(&discoveryv1.EndpointSlice{
+		ObjectMeta:  metav1.ObjectMeta{Namespace: "default", Name: "unmanaged"},
+		AddressType: discoveryv1.AddressTypeIPv4,
+	}))
+	lister := EndpointSliceLister{indexer}
+
+	eps, err := lister.ByService("default/web")
+	assert.NoError(t, err)
+	assert.Equal(t, &corev1.Endpoints{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"}}, eps)
+
+	_, err = lister.ByService("other/web")
+	assert.Equal(t, NotExistsError("other/web"), err)
+}
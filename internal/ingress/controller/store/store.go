@@ -29,6 +29,8 @@ import (
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/class"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/config"
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/k8s"
+	discoveryv1 "github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/discovery/v1"
+	networking "github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/networking/v1"
 	corev1 "k8s.io/api/core/v1"
 	extensions "k8s.io/api/extensions/v1beta1"
 	"k8s.io/client-go/tools/cache"
@@ -69,11 +71,16 @@ type Storer interface {
 
 // Informer defines the required SharedIndexInformers that interact with the API server.
 type Informer struct {
-	Ingress  cache.SharedIndexInformer
-	Service  cache.SharedIndexInformer
+	Ingress cache.SharedIndexInformer
+	Service cache.SharedIndexInformer
+	// Endpoint is only set if EndpointSlices aren't watched
 	Endpoint cache.SharedIndexInformer
-	Node     cache.SharedIndexInformer
-	Pod      cache.SharedIndexInformer
+	// EndpointSlice is only set if EndpointSlices are watched
+	EndpointSlice cache.SharedIndexInformer
+	Node          cache.SharedIndexInformer
+	Pod           cache.SharedIndexInformer
+	// IngressClass is only set if IngressClass resources are watched
+	IngressClass cache.SharedIndexInformer
 }
 
 // Lister contains object listers (stores).
@@ -81,6 +88,7 @@ type Lister struct {
 	Ingress           IngressLister
 	Service           ServiceLister
 	Endpoint          EndpointLister
+	EndpointSlice     EndpointSliceLister
 	Node              NodeLister
 	Pod               PodLister
 	IngressAnnotation IngressAnnotationsLister
@@ -142,11 +150,25 @@ func New(mgr manager.Manager, cfg *config.Configuration) (Storer, error) {
 	}
 	store.listers.Service.Store = store.informers.Service.GetStore()
 
-	store.informers.Endpoint, err = mgrCache.GetInformer(&corev1.Endpoints{})
-	if err != nil {
-		return nil, err
+	if cfg.EndpointSlices {
+		if err := discoveryv1.AddToScheme(mgr.GetScheme()); err != nil {
+			return nil, err
+		}
+		store.informers.EndpointSlice, err = mgrCache.GetInformer(&discoveryv1.EndpointSlice{})
+		if err != nil {
+			return nil, err
+		}
+		if err := store.informers.EndpointSlice.AddIndexers(cache.Indexers{endpointSliceServiceIndex: endpointSliceServiceKey}); err != nil {
+			return nil, err
+		}
+		store.listers.EndpointSlice.Indexer = store.informers.EndpointSlice.GetIndexer()
+	} else {
+		store.informers.Endpoint, err = mgrCache.GetInformer(&corev1.Endpoints{})
+		if err != nil {
+			return nil, err
+		}
+		store.listers.Endpoint.Store = store.informers.Endpoint.GetStore()
 	}
-	store.listers.Endpoint.Store = store.informers.Endpoint.GetStore()
 
 	store.informers.Node, err = mgrCache.GetInformer(&corev1.Node{})
 	if err != nil {
@@ -160,6 +182,17 @@ func New(mgr manager.Manager, cfg *config.Configuration) (Storer, error) {
 	}
 	store.listers.Pod.Store = store.informers.Pod.GetStore()
 
+	if cfg.IngressClassResources {
+		if err := networking.AddToScheme(mgr.GetScheme()); err != nil {
+			return nil, err
+		}
+		store.informers.IngressClass, err = mgrCache.GetInformer(&networking.IngressClass{})
+		if err != nil {
+			return nil, err
+		}
+		class.SetIngressClassStore(store.informers.IngressClass.GetStore())
+	}
+
 	ingEventHandler := cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
 			ing := obj.(*extensions.Ingress)
@@ -292,10 +325,18 @@ func (s k8sStore) GetServiceAnnotations(key string, ingress *annotations.Ingress
 
 // GetServiceEndpoints returns the Endpoints of a Service matching key.
 func (s k8sStore) GetServiceEndpoints(key string) (*corev1.Endpoints, error) {
+	if s.cfg.EndpointSlices {
+		return s.listers.EndpointSlice.ByService(key)
+	}
 	return s.listers.Endpoint.ByKey(key)
 }
 
 func (s *k8sStore) GetNodeInstanceID(node *corev1.Node) (string, error) {
+	return NodeInstanceID(node)
+}
+
+// NodeInstanceID returns the EC2 instance id of node, from its externalID on kubelets up to 1.10 and its providerID otherwise.
+func NodeInstanceID(node *corev1.Node) (string, error) {
 	nodeVersion, _ := semver.ParseTolerant(node.Status.NodeInfo.KubeletVersion)
 	if nodeVersion.Major == 1 && nodeVersion.Minor <= 10 {
 		return node.Spec.DoNotUse_ExternalID, nil
@@ -0,0 +1,107 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/alb/tg"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/backend"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/config"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/store"
+	discoveryv1 "github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/discovery/v1"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/elbv2/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// initTargetGroupBindingController registers the controller of TargetGroupBindings with mgr.
+// Bindings are reconciled on their own changes, on changes of the endpoints of their service, and on changes of nodes for instance targets.
+func initTargetGroupBindingController(config *config.Configuration, mgr manager.Manager, cloud aws.CloudAPI, store store.Storer, recorder record.EventRecorder) error {
+	if err := v1alpha1.AddToScheme(mgr.GetScheme()); err != nil {
+		return err
+	}
+	endpointResolver := backend.NewEndpointResolver(store, cloud, config.ExcludeNodeTaints)
+	healthController := tg.NewTargetHealthController(cloud, store, endpointResolver, mgr.GetClient())
+	targetsController := tg.NewTargetsController(cloud, endpointResolver, healthController, config.TargetsBatchSize, config.TargetDrainingWait)
+	reconciler := tg.NewBindingReconciler(mgr.GetClient(), recorder, cloud, targetsController)
+	c, err := controller.New("targetgroupbinding-controller", mgr, controller.Options{Reconciler: reconciler, MaxConcurrentReconciles: config.MaxConcurrentReconciles})
+	if err != nil {
+		return err
+	}
+
+	if err := c.Watch(&source.Kind{Type: &v1alpha1.TargetGroupBinding{}}, &handler.EnqueueRequestForObject{}); err != nil {
+		return err
+	}
+	if config.EndpointSlices {
+		if err := c.Watch(&source.Kind{Type: &discoveryv1.EndpointSlice{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: bindingsOfService(mgr.GetCache(), serviceOfEndpointSlice),
+		}); err != nil {
+			return err
+		}
+	} else {
+		if err := c.Watch(&source.Kind{Type: &corev1.Endpoints{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: bindingsOfService(mgr.GetCache(), metav1.Object.GetName),
+		}); err != nil {
+			return err
+		}
+	}
+	return c.Watch(&source.Kind{Type: &corev1.Node{}}, &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: allBindings(mgr.GetCache()),
+	})
+}
+
+// bindingsOfService maps the endpoints of a service, named by serviceName, to the TargetGroupBindings referencing that service.
+func bindingsOfService(reader client.Reader, serviceName func(metav1.Object) string) handler.ToRequestsFunc {
+	return func(obj handler.MapObject) []reconcile.Request {
+		bindings, err := listBindings(reader, obj.Meta.GetNamespace())
+		if err != nil {
+			glog.Errorf("failed to list TargetGroupBindings due to %v", err)
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, binding := range bindings {
+			if binding.Spec.ServiceRef.Name == serviceName(obj.Meta) {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: binding.Namespace, Name: binding.Name}})
+			}
+		}
+		return requests
+	}
+}
+
+// serviceOfEndpointSlice returns the name of the service of an EndpointSlice.
+func serviceOfEndpointSlice(obj metav1.Object) string {
+	return obj.GetLabels()[discoveryv1.LabelServiceName]
+}
+
+// allBindings maps any object to all TargetGroupBindings, the target type of bindings is only known from their target group.
+func allBindings(reader client.Reader) handler.ToRequestsFunc {
+	return func(obj handler.MapObject) []reconcile.Request {
+		bindings, err := listBindings(reader, "")
+		if err != nil {
+			glog.Errorf("failed to list TargetGroupBindings due to %v", err)
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, binding := range bindings {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: binding.Namespace, Name: binding.Name}})
+		}
+		return requests
+	}
+}
+
+func listBindings(reader client.Reader, namespace string) ([]v1alpha1.TargetGroupBinding, error) {
+	bindingList := &v1alpha1.TargetGroupBindingList{}
+	if err := reader.List(context.Background(), &client.ListOptions{Namespace: namespace}, bindingList); err != nil {
+		return nil, err
+	}
+	return bindingList.Items, nil
+}
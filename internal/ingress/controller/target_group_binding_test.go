@@ -0,0 +1,43 @@
+package controller
+
+import (
+	"testing"
+
+	discoveryv1 "github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/discovery/v1"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/elbv2/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func Test_bindingsOfService(t *testing.T) {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, v1alpha1.AddToScheme(scheme))
+	binding := func(namespace, name, serviceName string) *v1alpha1.TargetGroupBinding {
+		return &v1alpha1.TargetGroupBinding{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       v1alpha1.TargetGroupBindingSpec{ServiceRef: v1alpha1.ServiceReference{Name: serviceName}},
+		}
+	}
+	reader := fake.NewFakeClientWithScheme(scheme,
+		binding("alpha", "web", "service"),
+		binding("alpha", "api", "other"),
+		binding("beta", "web", "service"),
+	)
+
+	endpoints := &corev1.Endpoints{ObjectMeta: metav1.ObjectMeta{Namespace: "alpha", Name: "service"}}
+	requests := bindingsOfService(reader, metav1.Object.GetName)(handler.MapObject{Meta: endpoints, Object: endpoints})
+	assert.Equal(t, []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "alpha", Name: "web"}}}, requests)
+
+	slice := &discoveryv1.EndpointSlice{ObjectMeta: metav1.ObjectMeta{Namespace: "alpha", Name: "service-x2k9f", Labels: map[string]string{discoveryv1.LabelServiceName: "service"}}}
+	requests = bindingsOfService(reader, serviceOfEndpointSlice)(handler.MapObject{Meta: slice, Object: slice})
+	assert.Equal(t, []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "alpha", Name: "web"}}}, requests)
+
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+	assert.Len(t, allBindings(reader)(handler.MapObject{Meta: node, Object: node}), 3)
+}
@@ -0,0 +1,256 @@
+package ingressapi
+
+import (
+	"context"
+	"time"
+
+	networking "github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/networking/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewCache creates a cache serving extensions/v1beta1 ingresses from the networking.k8s.io/v1 ingresses of the API server.
+// It's a manager.NewCacheFunc.
+func NewCache(config *rest.Config, opts cache.Options) (cache.Cache, error) {
+	c, err := cache.New(config, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &ingressCache{Cache: c}, nil
+}
+
+// ingressCache serves extensions/v1beta1 ingresses from the networking.k8s.io/v1 ingresses of Cache.
+type ingressCache struct {
+	cache.Cache
+}
+
+func (c *ingressCache) Get(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+	return (&ingressReader{c.Cache}).Get(ctx, key, obj)
+}
+
+func (c *ingressCache) List(ctx context.Context, opts *client.ListOptions, list runtime.Object) error {
+	return (&ingressReader{c.Cache}).List(ctx, opts, list)
+}
+
+func (c *ingressCache) GetInformer(obj runtime.Object) (toolscache.SharedIndexInformer, error) {
+	if _, ok := obj.(*extensions.Ingress); !ok {
+		return c.Cache.GetInformer(obj)
+	}
+	informer, err := c.Cache.GetInformer(&networking.Ingress{})
+	if err != nil {
+		return nil, err
+	}
+	return &ingressInformer{informer}, nil
+}
+
+func (c *ingressCache) GetInformerForKind(gvk schema.GroupVersionKind) (toolscache.SharedIndexInformer, error) {
+	if gvk != extensions.SchemeGroupVersion.WithKind("Ingress") {
+		return c.Cache.GetInformerForKind(gvk)
+	}
+	informer, err := c.Cache.GetInformerForKind(networking.SchemeGroupVersion.WithKind("Ingress"))
+	if err != nil {
+		return nil, err
+	}
+	return &ingressInformer{informer}, nil
+}
+
+func (c *ingressCache) IndexField(obj runtime.Object, field string, extractValue client.IndexerFunc) error {
+	if _, ok := obj.(*extensions.Ingress); !ok {
+		return c.Cache.IndexField(obj, field, extractValue)
+	}
+	return c.Cache.IndexField(&networking.Ingress{}, field, func(obj runtime.Object) []string {
+		return extractValue(toV1beta1(obj.(*networking.Ingress)))
+	})
+}
+
+// ingressReader reads extensions/v1beta1 ingresses from the networking.k8s.io/v1 ingresses of Reader.
+type ingressReader struct {
+	client.Reader
+}
+
+func (r *ingressReader) Get(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+	ing, ok := obj.(*extensions.Ingress)
+	if !ok {
+		return r.Reader.Get(ctx, key, obj)
+	}
+	v1Ing := &networking.Ingress{}
+	if err := r.Reader.Get(ctx, key, v1Ing); err != nil {
+		return err
+	}
+	*ing = *toV1beta1(v1Ing)
+	return nil
+}
+
+func (r *ingressReader) List(ctx context.Context, opts *client.ListOptions, list runtime.Object) error {
+	ingList, ok := list.(*extensions.IngressList)
+	if !ok {
+		return r.Reader.List(ctx, opts, list)
+	}
+	v1IngList := &networking.IngressList{}
+	if err := r.Reader.List(ctx, opts, v1IngList); err != nil {
+		return err
+	}
+	ingList.ListMeta = v1IngList.ListMeta
+	ingList.Items = make([]extensions.Ingress, 0, len(v1IngList.Items))
+	for i := range v1IngList.Items {
+		ingList.Items = append(ingList.Items, *toV1beta1(&v1IngList.Items[i]))
+	}
+	return nil
+}
+
+// convertObject converts the networking.k8s.io/v1 ingresses of informers, including those of tombstones, to extensions/v1beta1.
+func convertObject(obj interface{}) interface{} {
+	switch o := obj.(type) {
+	case *networking.Ingress:
+		return toV1beta1(o)
+	case toolscache.DeletedFinalStateUnknown:
+		return toolscache.DeletedFinalStateUnknown{Key: o.Key, Obj: convertObject(o.Obj)}
+	}
+	return obj
+}
+
+func convertObjects(objs []interface{}) []interface{} {
+	converted := make([]interface{}, 0, len(objs))
+	for _, obj := range objs {
+		converted = append(converted, convertObject(obj))
+	}
+	return converted
+}
+
+// ingressInformer is an informer of networking.k8s.io/v1 ingresses handing out extensions/v1beta1 ones.
+type ingressInformer struct {
+	toolscache.SharedIndexInformer
+}
+
+func (i *ingressInformer) AddEventHandler(handler toolscache.ResourceEventHandler) {
+	i.SharedIndexInformer.AddEventHandler(&ingressEventHandler{handler})
+}
+
+func (i *ingressInformer) AddEventHandlerWithResyncPeriod(handler toolscache.ResourceEventHandler, resyncPeriod time.Duration) {
+	i.SharedIndexInformer.AddEventHandlerWithResyncPeriod(&ingressEventHandler{handler}, resyncPeriod)
+}
+
+func (i *ingressInformer) GetStore() toolscache.Store {
+	return &ingressIndexer{i.SharedIndexInformer.GetIndexer()}
+}
+
+func (i *ingressInformer) GetIndexer() toolscache.Indexer {
+	return &ingressIndexer{i.SharedIndexInformer.GetIndexer()}
+}
+
+func (i *ingressInformer) AddIndexers(indexers toolscache.Indexers) error {
+	return i.SharedIndexInformer.AddIndexers(convertIndexers(indexers))
+}
+
+func convertIndexers(indexers toolscache.Indexers) toolscache.Indexers {
+	converted := make(toolscache.Indexers, len(indexers))
+	for name, indexFunc := range indexers {
+		indexFunc := indexFunc
+		converted[name] = func(obj interface{}) ([]string, error) {
+			return indexFunc(convertObject(obj))
+		}
+	}
+	return converted
+}
+
+type ingressEventHandler struct {
+	handler toolscache.ResourceEventHandler
+}
+
+func (h *ingressEventHandler) OnAdd(obj interface{}) {
+	h.handler.OnAdd(convertObject(obj))
+}
+
+func (h *ingressEventHandler) OnUpdate(oldObj, newObj interface{}) {
+	h.handler.OnUpdate(convertObject(oldObj), convertObject(newObj))
+}
+
+func (h *ingressEventHandler) OnDelete(obj interface{}) {
+	h.handler.OnDelete(convertObject(obj))
+}
+
+// ingressIndexer is an indexer of networking.k8s.io/v1 ingresses handing out extensions/v1beta1 ones.
+// Its objects are only meant to be read, the ones written to it are converted back to networking.k8s.io/v1.
+type ingressIndexer struct {
+	indexer toolscache.Indexer
+}
+
+func writtenObject(obj interface{}) interface{} {
+	if ing, ok := obj.(*extensions.Ingress); ok {
+		return fromV1beta1(ing)
+	}
+	return obj
+}
+
+func (i *ingressIndexer) Add(obj interface{}) error {
+	return i.indexer.Add(writtenObject(obj))
+}
+
+func (i *ingressIndexer) Update(obj interface{}) error {
+	return i.indexer.Update(writtenObject(obj))
+}
+
+func (i *ingressIndexer) Delete(obj interface{}) error {
+	return i.indexer.Delete(writtenObject(obj))
+}
+
+func (i *ingressIndexer) List() []interface{} {
+	return convertObjects(i.indexer.List())
+}
+
+func (i *ingressIndexer) ListKeys() []string {
+	return i.indexer.ListKeys()
+}
+
+func (i *ingressIndexer) Get(obj interface{}) (interface{}, bool, error) {
+	item, exists, err := i.indexer.Get(obj)
+	return convertObject(item), exists, err
+}
+
+func (i *ingressIndexer) GetByKey(key string) (interface{}, bool, error) {
+	item, exists, err := i.indexer.GetByKey(key)
+	return convertObject(item), exists, err
+}
+
+func (i *ingressIndexer) Replace(objs []interface{}, resourceVersion string) error {
+	written := make([]interface{}, 0, len(objs))
+	for _, obj := range objs {
+		written = append(written, writtenObject(obj))
+	}
+	return i.indexer.Replace(written, resourceVersion)
+}
+
+func (i *ingressIndexer) Resync() error {
+	return i.indexer.Resync()
+}
+
+func (i *ingressIndexer) Index(indexName string, obj interface{}) ([]interface{}, error) {
+	items, err := i.indexer.Index(indexName, writtenObject(obj))
+	return convertObjects(items), err
+}
+
+func (i *ingressIndexer) IndexKeys(indexName, indexKey string) ([]string, error) {
+	return i.indexer.IndexKeys(indexName, indexKey)
+}
+
+func (i *ingressIndexer) ListIndexFuncValues(indexName string) []string {
+	return i.indexer.ListIndexFuncValues(indexName)
+}
+
+func (i *ingressIndexer) ByIndex(indexName, indexKey string) ([]interface{}, error) {
+	items, err := i.indexer.ByIndex(indexName, indexKey)
+	return convertObjects(items), err
+}
+
+func (i *ingressIndexer) GetIndexers() toolscache.Indexers {
+	return i.indexer.GetIndexers()
+}
+
+func (i *ingressIndexer) AddIndexers(newIndexers toolscache.Indexers) error {
+	return i.indexer.AddIndexers(convertIndexers(newIndexers))
+}
@@ -0,0 +1,62 @@
+package ingressapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	extensions "k8s.io/api/extensions/v1beta1"
+	toolscache "k8s.io/client-go/tools/cache"
+)
+
+func TestIngressIndexer(t *testing.T) {
+	indexer := &ingressIndexer{toolscache.NewIndexer(toolscache.MetaNamespaceKeyFunc, toolscache.Indexers{})}
+	assert.NoError(t, indexer.AddIndexers(toolscache.Indexers{
+		"class": func(obj interface{}) ([]string, error) {
+			return []string{obj.(*extensions.Ingress).Annotations[ingressClassAnnotation]}, nil
+		},
+	}))
+	assert.NoError(t, indexer.indexer.Add(newV1Ingress()))
+	expected := toV1beta1(newV1Ingress())
+
+	item, exists, err := indexer.GetByKey("default/ing")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, expected, item)
+	assert.Equal(t, []interface{}{expected}, indexer.List())
+	items, err := indexer.ByIndex("class", "alb")
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{expected}, items)
+
+	item, exists, err = indexer.GetByKey("default/other")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+	assert.Nil(t, item)
+}
+
+type recordingEventHandler struct {
+	objs []interface{}
+}
+
+func (h *recordingEventHandler) OnAdd(obj interface{}) {
+	h.objs = append(h.objs, obj)
+}
+
+func (h *recordingEventHandler) OnUpdate(oldObj, newObj interface{}) {
+	h.objs = append(h.objs, oldObj, newObj)
+}
+
+func (h *recordingEventHandler) OnDelete(obj interface{}) {
+	h.objs = append(h.objs, obj)
+}
+
+func TestIngressEventHandler(t *testing.T) {
+	recorder := &recordingEventHandler{}
+	handler := &ingressEventHandler{recorder}
+	handler.OnAdd(newV1Ingress())
+	handler.OnDelete(toolscache.DeletedFinalStateUnknown{Key: "default/ing", Obj: newV1Ingress()})
+
+	assert.Equal(t, []interface{}{
+		toV1beta1(newV1Ingress()),
+		toolscache.DeletedFinalStateUnknown{Key: "default/ing", Obj: toV1beta1(newV1Ingress())},
+	}, recorder.objs)
+}
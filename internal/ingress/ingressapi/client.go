@@ -0,0 +1,116 @@
+package ingressapi
+
+import (
+	"context"
+
+	networking "github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/networking/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewClient creates a client reading from c, which writes extensions/v1beta1 ingresses as networking.k8s.io/v1 ingresses.
+// It's a manager.NewClientFunc, c must be created by NewCache.
+func NewClient(c cache.Cache, config *rest.Config, options client.Options) (client.Client, error) {
+	apiClient, err := client.New(config, options)
+	if err != nil {
+		return nil, err
+	}
+	reader := &client.DelegatingReader{CacheReader: c, ClientReader: apiClient}
+	var v1Reader client.Reader = c
+	if ic, ok := c.(*ingressCache); ok {
+		v1Reader = ic.Cache
+	}
+	return newIngressClient(reader, v1Reader, apiClient), nil
+}
+
+// NewAPIClient creates a client reading and writing directly to the API server of config,
+// which reads and writes extensions/v1beta1 ingresses as networking.k8s.io/v1 ingresses if the API server serves them.
+func NewAPIClient(config *rest.Config) (client.Client, error) {
+	ok, err := ServesNetworkingV1(config)
+	if err != nil {
+		return nil, err
+	}
+	s := runtime.NewScheme()
+	if err := scheme.AddToScheme(s); err != nil {
+		return nil, err
+	}
+	if err := networking.AddToScheme(s); err != nil {
+		return nil, err
+	}
+	apiClient, err := client.New(config, client.Options{Scheme: s})
+	if err != nil || !ok {
+		return apiClient, err
+	}
+	return newIngressClient(&ingressReader{apiClient}, apiClient, apiClient), nil
+}
+
+func newIngressClient(reader client.Reader, v1Reader client.Reader, apiClient client.Client) *ingressClient {
+	return &ingressClient{Reader: reader, v1Reader: v1Reader, apiClient: apiClient}
+}
+
+// ingressClient writes extensions/v1beta1 ingresses as networking.k8s.io/v1 ingresses.
+// Only their metadata and status are updated, on top of their networking.k8s.io/v1 version read from v1Reader.
+type ingressClient struct {
+	client.Reader
+
+	v1Reader  client.Reader
+	apiClient client.Client
+}
+
+func (c *ingressClient) Create(ctx context.Context, obj runtime.Object) error {
+	ing, ok := obj.(*extensions.Ingress)
+	if !ok {
+		return c.apiClient.Create(ctx, obj)
+	}
+	v1Ing := fromV1beta1(ing)
+	if err := c.apiClient.Create(ctx, v1Ing); err != nil {
+		return err
+	}
+	*ing = *toV1beta1(v1Ing)
+	return nil
+}
+
+func (c *ingressClient) Delete(ctx context.Context, obj runtime.Object, opts ...client.DeleteOptionFunc) error {
+	if ing, ok := obj.(*extensions.Ingress); ok {
+		obj = fromV1beta1(ing)
+	}
+	return c.apiClient.Delete(ctx, obj, opts...)
+}
+
+func (c *ingressClient) Update(ctx context.Context, obj runtime.Object) error {
+	return c.update(ctx, obj, c.apiClient.Update)
+}
+
+func (c *ingressClient) Status() client.StatusWriter {
+	return &ingressStatusWriter{c}
+}
+
+func (c *ingressClient) update(ctx context.Context, obj runtime.Object, update func(context.Context, runtime.Object) error) error {
+	ing, ok := obj.(*extensions.Ingress)
+	if !ok {
+		return update(ctx, obj)
+	}
+	current := &networking.Ingress{}
+	if err := c.v1Reader.Get(ctx, types.NamespacedName{Namespace: ing.Namespace, Name: ing.Name}, current); err != nil {
+		return err
+	}
+	v1Ing := withV1beta1Changes(current, ing)
+	if err := update(ctx, v1Ing); err != nil {
+		return err
+	}
+	*ing = *toV1beta1(v1Ing)
+	return nil
+}
+
+type ingressStatusWriter struct {
+	c *ingressClient
+}
+
+func (w *ingressStatusWriter) Update(ctx context.Context, obj runtime.Object) error {
+	return w.c.update(ctx, obj, w.c.apiClient.Status().Update)
+}
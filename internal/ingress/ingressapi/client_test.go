@@ -0,0 +1,67 @@
+package ingressapi
+
+import (
+	"context"
+	"testing"
+
+	networking "github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/networking/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newFakeClient(t *testing.T, objs ...runtime.Object) client.Client {
+	s := runtime.NewScheme()
+	assert.NoError(t, scheme.AddToScheme(s))
+	assert.NoError(t, networking.AddToScheme(s))
+	return fake.NewFakeClientWithScheme(s, objs...)
+}
+
+func TestIngressClient_Update(t *testing.T) {
+	apiClient := newFakeClient(t, newV1Ingress())
+	c := newIngressClient(&ingressReader{apiClient}, apiClient, apiClient)
+	key := types.NamespacedName{Namespace: "default", Name: "ing"}
+
+	ing := &extensions.Ingress{}
+	assert.NoError(t, c.Get(context.Background(), key, ing))
+	assert.Equal(t, "alb", ing.Annotations[ingressClassAnnotation])
+	ing.Finalizers = append(ing.Finalizers, "ingress.k8s.aws/resources")
+	assert.NoError(t, c.Update(context.Background(), ing))
+	ing.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{Hostname: "new-lb.example.com"}}
+	assert.NoError(t, c.Status().Update(context.Background(), ing))
+
+	v1Ing := &networking.Ingress{}
+	assert.NoError(t, apiClient.Get(context.Background(), key, v1Ing))
+	assert.Equal(t, []string{"ingress.k8s.aws/resources"}, v1Ing.Finalizers)
+	assert.NotContains(t, v1Ing.Annotations, ingressClassAnnotation)
+	assert.Equal(t, newV1Ingress().Spec, v1Ing.Spec)
+	assert.Equal(t, "new-lb.example.com", v1Ing.Status.LoadBalancer.Ingress[0].Hostname)
+}
+
+func TestIngressClient_List(t *testing.T) {
+	apiClient := newFakeClient(t, newV1Ingress())
+	c := newIngressClient(&ingressReader{apiClient}, apiClient, apiClient)
+
+	ingList := &extensions.IngressList{}
+	assert.NoError(t, c.List(context.Background(), client.InNamespace("default"), ingList))
+	assert.Equal(t, []extensions.Ingress{*toV1beta1(newV1Ingress())}, ingList.Items)
+}
+
+func TestIngressClient_Create(t *testing.T) {
+	apiClient := newFakeClient(t)
+	c := newIngressClient(&ingressReader{apiClient}, apiClient, apiClient)
+
+	ing := toV1beta1(newV1Ingress())
+	ing.ResourceVersion = ""
+	assert.NoError(t, c.Create(context.Background(), ing))
+
+	v1Ing := &networking.Ingress{}
+	assert.NoError(t, apiClient.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "ing"}, v1Ing))
+	assert.Equal(t, "api", v1Ing.Spec.Rules[0].HTTP.Paths[0].Backend.Service.Name)
+	assert.Equal(t, networking.PathTypeImplementationSpecific, *v1Ing.Spec.Rules[0].HTTP.Paths[0].PathType)
+}
@@ -0,0 +1,181 @@
+package ingressapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	networking "github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/networking/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// ingressClassAnnotation is the class of extensions/v1beta1 ingresses, which spec.ingressClassName replaces.
+	ingressClassAnnotation = "kubernetes.io/ingress.class"
+	// rejectedPathsAnnotation lists the paths left out of a conversion as JSON, so that ValidatePaths rejects the converted ingress.
+	// Its key isn't a valid annotation key, which the API server refuses, so that only the conversion sets it. It's never written back.
+	rejectedPathsAnnotation = "ingressapi.alb.ingress.kubernetes.io/rejected paths"
+)
+
+// toV1beta1 converts a networking.k8s.io/v1 ingress to extensions/v1beta1.
+// spec.ingressClassName becomes the class annotation unless the ingress has one, and paths become the path patterns matching them as their pathType defines.
+// Paths no path pattern matches are left out, and recorded to be rejected by ValidatePaths.
+// Resource backends have no extensions/v1beta1 equivalent, and are converted to backends without service.
+func toV1beta1(in *networking.Ingress) *extensions.Ingress {
+	out := &extensions.Ingress{ObjectMeta: *in.ObjectMeta.DeepCopy()}
+	delete(out.Annotations, rejectedPathsAnnotation)
+	if in.Spec.IngressClassName != nil {
+		if _, ok := out.Annotations[ingressClassAnnotation]; !ok {
+			if out.Annotations == nil {
+				out.Annotations = make(map[string]string)
+			}
+			out.Annotations[ingressClassAnnotation] = *in.Spec.IngressClassName
+		}
+	}
+	if in.Spec.DefaultBackend != nil {
+		backend := toV1beta1Backend(*in.Spec.DefaultBackend)
+		out.Spec.Backend = &backend
+	}
+	for _, tls := range in.Spec.TLS {
+		out.Spec.TLS = append(out.Spec.TLS, extensions.IngressTLS{
+			Hosts:      append([]string(nil), tls.Hosts...),
+			SecretName: tls.SecretName,
+		})
+	}
+	var rejectedPaths []string
+	for _, rule := range in.Spec.Rules {
+		outRule := extensions.IngressRule{Host: rule.Host}
+		if rule.HTTP != nil {
+			outRule.HTTP = &extensions.HTTPIngressRuleValue{}
+			for _, path := range rule.HTTP.Paths {
+				patterns := pathPatterns(path)
+				if patterns == nil {
+					rejectedPaths = append(rejectedPaths, path.Path)
+				}
+				for _, pattern := range patterns {
+					outRule.HTTP.Paths = append(outRule.HTTP.Paths, extensions.HTTPIngressPath{
+						Path:    pattern,
+						Backend: toV1beta1Backend(path.Backend),
+					})
+				}
+			}
+		}
+		out.Spec.Rules = append(out.Spec.Rules, outRule)
+	}
+	if len(rejectedPaths) != 0 {
+		// a slice of strings always marshals.
+		raw, _ := json.Marshal(rejectedPaths)
+		if out.Annotations == nil {
+			out.Annotations = make(map[string]string)
+		}
+		out.Annotations[rejectedPathsAnnotation] = string(raw)
+	}
+	in.Status.LoadBalancer.DeepCopyInto(&out.Status.LoadBalancer)
+	return out
+}
+
+// ValidatePaths checks that ingress has no path which was left out of its conversion from networking.k8s.io/v1.
+func ValidatePaths(ingress *extensions.Ingress) error {
+	raw, ok := ingress.Annotations[rejectedPathsAnnotation]
+	if !ok {
+		return nil
+	}
+	var rejectedPaths []string
+	if err := json.Unmarshal([]byte(raw), &rejectedPaths); err != nil {
+		return fmt.Errorf("failed to parse rejected paths %v due to %v", raw, err)
+	}
+	return fmt.Errorf("paths %v of type Exact or Prefix must not contain * or ?, which path patterns only match as wildcards", strings.Join(rejectedPaths, ", "))
+}
+
+// pathPatterns returns the path patterns of rule conditions matching path as its pathType defines, or nil if none does.
+// Exact and ImplementationSpecific paths are kept as is, the latter matching as path patterns do, e.g. with wildcards.
+// Prefix paths match the path itself and the paths below it, element by element, e.g. /api matches /api and /api/*, but not /apis.
+// Path patterns can't escape their wildcards, so no path pattern matches the Exact and Prefix paths containing * or ? literally.
+func pathPatterns(path networking.HTTPIngressPath) []string {
+	if path.PathType == nil || *path.PathType == networking.PathTypeImplementationSpecific {
+		return []string{path.Path}
+	}
+	if strings.ContainsAny(path.Path, "*?") {
+		return nil
+	}
+	if *path.PathType != networking.PathTypePrefix {
+		return []string{path.Path}
+	}
+	prefix := strings.TrimSuffix(path.Path, "/")
+	if prefix == "" {
+		return []string{"/*"}
+	}
+	return []string{prefix, prefix + "/*"}
+}
+
+func toV1beta1Backend(in networking.IngressBackend) extensions.IngressBackend {
+	if in.Service == nil {
+		return extensions.IngressBackend{}
+	}
+	if in.Service.Port.Name != "" {
+		return extensions.IngressBackend{ServiceName: in.Service.Name, ServicePort: intstr.FromString(in.Service.Port.Name)}
+	}
+	return extensions.IngressBackend{ServiceName: in.Service.Name, ServicePort: intstr.FromInt(int(in.Service.Port.Number))}
+}
+
+// fromV1beta1 converts an extensions/v1beta1 ingress to networking.k8s.io/v1, the way the API server does.
+// The class annotation is kept, and paths are ImplementationSpecific since extensions/v1beta1 ingresses can't specify their pathType.
+func fromV1beta1(in *extensions.Ingress) *networking.Ingress {
+	out := &networking.Ingress{ObjectMeta: *in.ObjectMeta.DeepCopy()}
+	if in.Spec.Backend != nil {
+		backend := fromV1beta1Backend(*in.Spec.Backend)
+		out.Spec.DefaultBackend = &backend
+	}
+	for _, tls := range in.Spec.TLS {
+		out.Spec.TLS = append(out.Spec.TLS, networking.IngressTLS{
+			Hosts:      append([]string(nil), tls.Hosts...),
+			SecretName: tls.SecretName,
+		})
+	}
+	for _, rule := range in.Spec.Rules {
+		outRule := networking.IngressRule{Host: rule.Host}
+		if rule.HTTP != nil {
+			outRule.HTTP = &networking.HTTPIngressRuleValue{}
+			for _, path := range rule.HTTP.Paths {
+				pathType := networking.PathTypeImplementationSpecific
+				outRule.HTTP.Paths = append(outRule.HTTP.Paths, networking.HTTPIngressPath{
+					Path:     path.Path,
+					PathType: &pathType,
+					Backend:  fromV1beta1Backend(path.Backend),
+				})
+			}
+		}
+		out.Spec.Rules = append(out.Spec.Rules, outRule)
+	}
+	in.Status.LoadBalancer.DeepCopyInto(&out.Status.LoadBalancer)
+	return out
+}
+
+func fromV1beta1Backend(in extensions.IngressBackend) networking.IngressBackend {
+	if in.ServiceName == "" {
+		return networking.IngressBackend{}
+	}
+	service := &networking.IngressServiceBackend{Name: in.ServiceName}
+	if in.ServicePort.Type == intstr.String {
+		service.Port.Name = in.ServicePort.StrVal
+	} else {
+		service.Port.Number = in.ServicePort.IntVal
+	}
+	return networking.IngressBackend{Service: service}
+}
+
+// withV1beta1Changes returns a copy of current with the metadata and status of ing, the extensions/v1beta1 conversion of current modified by the controller.
+// The spec of current is kept, since the controller never modifies the spec of ingresses and the conversion isn't lossless.
+func withV1beta1Changes(current *networking.Ingress, ing *extensions.Ingress) *networking.Ingress {
+	out := current.DeepCopy()
+	out.ObjectMeta = *ing.ObjectMeta.DeepCopy()
+	if _, ok := current.Annotations[ingressClassAnnotation]; !ok && current.Spec.IngressClassName != nil &&
+		out.Annotations[ingressClassAnnotation] == *current.Spec.IngressClassName {
+		delete(out.Annotations, ingressClassAnnotation)
+	}
+	delete(out.Annotations, rejectedPathsAnnotation)
+	out.Status = networking.IngressStatus{}
+	ing.Status.LoadBalancer.DeepCopyInto(&out.Status.LoadBalancer)
+	return out
+}
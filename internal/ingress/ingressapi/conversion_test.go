@@ -0,0 +1,181 @@
+package ingressapi
+
+import (
+	"testing"
+
+	networking "github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/networking/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func pathType(t networking.PathType) *networking.PathType {
+	return &t
+}
+
+func newV1Ingress() *networking.Ingress {
+	className := "alb"
+	return &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ing", ResourceVersion: "1"},
+		Spec: networking.IngressSpec{
+			IngressClassName: &className,
+			DefaultBackend: &networking.IngressBackend{
+				Service: &networking.IngressServiceBackend{Name: "default", Port: networking.ServiceBackendPort{Number: 80}},
+			},
+			TLS: []networking.IngressTLS{{Hosts: []string{"example.com"}}},
+			Rules: []networking.IngressRule{{
+				Host: "example.com",
+				IngressRuleValue: networking.IngressRuleValue{HTTP: &networking.HTTPIngressRuleValue{
+					Paths: []networking.HTTPIngressPath{
+						{
+							Path:     "/api",
+							PathType: pathType(networking.PathTypePrefix),
+							Backend: networking.IngressBackend{
+								Service: &networking.IngressServiceBackend{Name: "api", Port: networking.ServiceBackendPort{Name: "http"}},
+							},
+						},
+						{
+							Path:     "/static",
+							PathType: pathType(networking.PathTypeExact),
+							Backend: networking.IngressBackend{
+								Resource: &corev1.TypedLocalObjectReference{Kind: "StorageBucket", Name: "static"},
+							},
+						},
+					},
+				}},
+			}},
+		},
+		Status: networking.IngressStatus{LoadBalancer: corev1.LoadBalancerStatus{
+			Ingress: []corev1.LoadBalancerIngress{{Hostname: "lb.example.com"}},
+		}},
+	}
+}
+
+func Test_toV1beta1(t *testing.T) {
+	ing := toV1beta1(newV1Ingress())
+	assert.Equal(t, &extensions.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "default",
+			Name:            "ing",
+			ResourceVersion: "1",
+			Annotations:     map[string]string{ingressClassAnnotation: "alb"},
+		},
+		Spec: extensions.IngressSpec{
+			Backend: &extensions.IngressBackend{ServiceName: "default", ServicePort: intstr.FromInt(80)},
+			TLS:     []extensions.IngressTLS{{Hosts: []string{"example.com"}}},
+			Rules: []extensions.IngressRule{{
+				Host: "example.com",
+				IngressRuleValue: extensions.IngressRuleValue{HTTP: &extensions.HTTPIngressRuleValue{
+					Paths: []extensions.HTTPIngressPath{
+						{Path: "/api", Backend: extensions.IngressBackend{ServiceName: "api", ServicePort: intstr.FromString("http")}},
+						{Path: "/api/*", Backend: extensions.IngressBackend{ServiceName: "api", ServicePort: intstr.FromString("http")}},
+						{Path: "/static"},
+					},
+				}},
+			}},
+		},
+		Status: extensions.IngressStatus{LoadBalancer: corev1.LoadBalancerStatus{
+			Ingress: []corev1.LoadBalancerIngress{{Hostname: "lb.example.com"}},
+		}},
+	}, ing)
+}
+
+func Test_pathPatterns(t *testing.T) {
+	for _, tc := range []struct {
+		path     string
+		pathType *networking.PathType
+		expected []string
+	}{
+		{path: "/api", pathType: pathType(networking.PathTypePrefix), expected: []string{"/api", "/api/*"}},
+		{path: "/api/", pathType: pathType(networking.PathTypePrefix), expected: []string{"/api", "/api/*"}},
+		{path: "/", pathType: pathType(networking.PathTypePrefix), expected: []string{"/*"}},
+		{path: "/api", pathType: pathType(networking.PathTypeExact), expected: []string{"/api"}},
+		{path: "/api/*", pathType: pathType(networking.PathTypeImplementationSpecific), expected: []string{"/api/*"}},
+		{path: "/api*", expected: []string{"/api*"}},
+		{path: "/api/v?", pathType: pathType(networking.PathTypeImplementationSpecific), expected: []string{"/api/v?"}},
+		{path: "/api/*", pathType: pathType(networking.PathTypeExact)},
+		{path: "/api/v?", pathType: pathType(networking.PathTypeExact)},
+		{path: "/api*", pathType: pathType(networking.PathTypePrefix)},
+		{path: "/api/v?/", pathType: pathType(networking.PathTypePrefix)},
+	} {
+		assert.Equal(t, tc.expected, pathPatterns(networking.HTTPIngressPath{Path: tc.path, PathType: tc.pathType}), tc.path)
+	}
+}
+
+func Test_toV1beta1_rejectsPaths(t *testing.T) {
+	in := newV1Ingress()
+	in.Spec.Rules[0].HTTP.Paths[0].Path = "/api*"
+	in.Spec.Rules[0].HTTP.Paths[1].Path = "/static?"
+	ing := toV1beta1(in)
+	assert.Empty(t, ing.Spec.Rules[0].HTTP.Paths)
+	assert.Equal(t, `["/api*","/static?"]`, ing.Annotations[rejectedPathsAnnotation])
+	assert.EqualError(t, ValidatePaths(ing), "paths /api*, /static? of type Exact or Prefix must not contain * or ?, which path patterns only match as wildcards")
+	assert.Empty(t, withV1beta1Changes(in, ing).Annotations, "rejected paths shouldn't be written back")
+
+	assert.NoError(t, ValidatePaths(toV1beta1(newV1Ingress())))
+
+	in = newV1Ingress()
+	in.Annotations = map[string]string{rejectedPathsAnnotation: `["/api"]`}
+	ing = toV1beta1(in)
+	assert.NoError(t, ValidatePaths(ing), "rejected paths shouldn't be read from the ingress")
+	assert.Empty(t, withV1beta1Changes(in, ing).Annotations)
+}
+
+func Test_toV1beta1_keepsClassAnnotation(t *testing.T) {
+	v1Ing := newV1Ingress()
+	v1Ing.Annotations = map[string]string{ingressClassAnnotation: "internal"}
+	assert.Equal(t, "internal", toV1beta1(v1Ing).Annotations[ingressClassAnnotation])
+	assert.Equal(t, "internal", v1Ing.Annotations[ingressClassAnnotation])
+}
+
+func Test_fromV1beta1(t *testing.T) {
+	ing := &extensions.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ing"},
+		Spec: extensions.IngressSpec{
+			Backend: &extensions.IngressBackend{ServiceName: "default", ServicePort: intstr.FromInt(80)},
+			Rules: []extensions.IngressRule{{
+				IngressRuleValue: extensions.IngressRuleValue{HTTP: &extensions.HTTPIngressRuleValue{
+					Paths: []extensions.HTTPIngressPath{
+						{Path: "/*", Backend: extensions.IngressBackend{ServiceName: "redirect", ServicePort: intstr.FromString("use-annotation")}},
+					},
+				}},
+			}},
+		},
+	}
+	assert.Equal(t, &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ing"},
+		Spec: networking.IngressSpec{
+			DefaultBackend: &networking.IngressBackend{
+				Service: &networking.IngressServiceBackend{Name: "default", Port: networking.ServiceBackendPort{Number: 80}},
+			},
+			Rules: []networking.IngressRule{{
+				IngressRuleValue: networking.IngressRuleValue{HTTP: &networking.HTTPIngressRuleValue{
+					Paths: []networking.HTTPIngressPath{{
+						Path:     "/*",
+						PathType: pathType(networking.PathTypeImplementationSpecific),
+						Backend: networking.IngressBackend{
+							Service: &networking.IngressServiceBackend{Name: "redirect", Port: networking.ServiceBackendPort{Name: "use-annotation"}},
+						},
+					}},
+				}},
+			}},
+		},
+	}, fromV1beta1(ing))
+}
+
+func Test_withV1beta1Changes(t *testing.T) {
+	current := newV1Ingress()
+	ing := toV1beta1(current)
+	ing.Finalizers = []string{"ingress.k8s.aws/resources"}
+	ing.Annotations["alb.ingress.kubernetes.io/managed-resources"] = "{}"
+	ing.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{Hostname: "new-lb.example.com"}}
+
+	expected := newV1Ingress()
+	expected.Finalizers = []string{"ingress.k8s.aws/resources"}
+	expected.Annotations = map[string]string{"alb.ingress.kubernetes.io/managed-resources": "{}"}
+	expected.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{Hostname: "new-lb.example.com"}}
+	assert.Equal(t, expected, withV1beta1Changes(current, ing))
+	assert.Equal(t, newV1Ingress(), current)
+}
@@ -0,0 +1,51 @@
+// Package ingressapi serves the extensions/v1beta1 ingresses the controller is written against from the networking.k8s.io/v1 API,
+// for clusters serving networking.k8s.io/v1 ingresses, which no longer serve extensions/v1beta1 ones from Kubernetes 1.22.
+package ingressapi
+
+import (
+	networking "github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Configure makes the manager created with opts serve ingresses from the networking.k8s.io/v1 API if the API server of config serves it,
+// and returns whether it does.
+func Configure(config *rest.Config, opts *manager.Options) (bool, error) {
+	ok, err := ServesNetworkingV1(config)
+	if err != nil || !ok {
+		return false, err
+	}
+	if opts.Scheme == nil {
+		opts.Scheme = scheme.Scheme
+	}
+	if err := networking.AddToScheme(opts.Scheme); err != nil {
+		return false, err
+	}
+	opts.NewCache = NewCache
+	opts.NewClient = NewClient
+	return true, nil
+}
+
+// ServesNetworkingV1 returns whether the API server of config serves networking.k8s.io/v1 ingresses.
+func ServesNetworkingV1(config *rest.Config) (bool, error) {
+	dc, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return false, err
+	}
+	resources, err := dc.ServerResourcesForGroupVersion(networking.SchemeGroupVersion.String())
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	for _, resource := range resources.APIResources {
+		if resource.Name == "ingresses" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
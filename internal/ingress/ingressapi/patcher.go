@@ -0,0 +1,62 @@
+package ingressapi
+
+import (
+	"context"
+
+	networking "github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/networking/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// Patcher applies JSON merge patches to the metadata of extensions/v1beta1 ingresses, which controller-runtime clients can only update whole.
+// Patches are sent to networking.k8s.io/v1 ingresses if the API server serves them, since their metadata is the same.
+type Patcher struct {
+	clientSet kubernetes.Interface
+	// v1Client is the REST client of networking.k8s.io/v1 ingresses, nil if the API server doesn't serve them.
+	v1Client rest.Interface
+}
+
+// NewPatcher creates a Patcher for the API server of config.
+func NewPatcher(config *rest.Config) (*Patcher, error) {
+	clientSet, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := ServesNetworkingV1(config)
+	if err != nil || !ok {
+		return &Patcher{clientSet: clientSet}, err
+	}
+	s := runtime.NewScheme()
+	if err := networking.AddToScheme(s); err != nil {
+		return nil, err
+	}
+	v1Client, err := apiutil.RESTClientForGVK(networking.SchemeGroupVersion.WithKind("Ingress"), config, serializer.NewCodecFactory(s))
+	if err != nil {
+		return nil, err
+	}
+	return &Patcher{clientSet: clientSet, v1Client: v1Client}, nil
+}
+
+// Patch applies the merge patch to the metadata of ingress, and returns the patched ingress.
+func (p *Patcher) Patch(ctx context.Context, ingress *extensions.Ingress, patch []byte) (*extensions.Ingress, error) {
+	if p.v1Client == nil {
+		return p.clientSet.ExtensionsV1beta1().Ingresses(ingress.Namespace).Patch(ingress.Name, types.MergePatchType, patch)
+	}
+	v1Ing := &networking.Ingress{}
+	if err := p.v1Client.Patch(types.MergePatchType).
+		Context(ctx).
+		Namespace(ingress.Namespace).
+		Resource("ingresses").
+		Name(ingress.Name).
+		Body(patch).
+		Do().
+		Into(v1Ing); err != nil {
+		return nil, err
+	}
+	return toV1beta1(v1Ing), nil
+}
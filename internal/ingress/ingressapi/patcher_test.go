@@ -0,0 +1,52 @@
+package ingressapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	networking "github.com/kubernetes-sigs/aws-alb-ingress-controller/pkg/apis/networking/v1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/rest/fake"
+)
+
+func TestPatcher_Patch(t *testing.T) {
+	const patch = `{"metadata":{"annotations":{"alb.ingress.kubernetes.io/managed-resources":"{}"}}}`
+	patched := newV1Ingress()
+	patched.APIVersion = networking.SchemeGroupVersion.String()
+	patched.Kind = "Ingress"
+	patched.Annotations = map[string]string{"alb.ingress.kubernetes.io/managed-resources": "{}"}
+
+	s := runtime.NewScheme()
+	assert.NoError(t, networking.AddToScheme(s))
+	var requests []string
+	v1Client := &fake.RESTClient{
+		NegotiatedSerializer: serializer.DirectCodecFactory{CodecFactory: serializer.NewCodecFactory(s)},
+		GroupVersion:         networking.SchemeGroupVersion,
+		Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			requests = append(requests, req.Method+" "+req.URL.Path+" "+req.Header.Get("Content-Type")+" "+string(body))
+			raw, err := json.Marshal(patched)
+			if err != nil {
+				return nil, err
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       ioutil.NopCloser(bytes.NewReader(raw)),
+			}, nil
+		}),
+	}
+	p := &Patcher{v1Client: v1Client}
+
+	ing, err := p.Patch(context.Background(), toV1beta1(newV1Ingress()), []byte(patch))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"PATCH /namespaces/default/ingresses/ing application/merge-patch+json " + patch}, requests)
+	assert.Equal(t, "{}", ing.Annotations["alb.ingress.kubernetes.io/managed-resources"])
+	assert.Equal(t, toV1beta1(newV1Ingress()).Spec, ing.Spec)
+}
@@ -0,0 +1,189 @@
+package metric
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/golang/glog"
+	"github.com/spf13/pflag"
+)
+
+const (
+	defaultCloudWatchNamespace = ""
+	defaultCloudWatchInterval  = time.Minute
+	minCloudWatchInterval      = 10 * time.Second
+)
+
+// CloudWatchConfig configures the publishing of controller metrics to CloudWatch.
+type CloudWatchConfig struct {
+	// Namespace is the CloudWatch namespace of the metrics, publishing is disabled if empty.
+	Namespace string
+	// Interval is the period over which metrics are aggregated before being published.
+	Interval time.Duration
+}
+
+// BindFlags will bind the commandline flags to fields in config
+func (cfg *CloudWatchConfig) BindFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&cfg.Namespace, "cloudwatch-metrics-namespace", defaultCloudWatchNamespace,
+		`CloudWatch namespace to publish controller metrics into, e.g. "ALBIngressController". Publishing is disabled if empty.`)
+	fs.DurationVar(&cfg.Interval, "cloudwatch-metrics-interval", defaultCloudWatchInterval,
+		`Period at which controller metrics are published to CloudWatch.`)
+}
+
+func (cfg *CloudWatchConfig) Validate() error {
+	if len(cfg.Namespace) != 0 && cfg.Interval < minCloudWatchInterval {
+		return fmt.Errorf("cloudwatch metrics interval must be at least %v, got %v", minCloudWatchInterval, cfg.Interval)
+	}
+	return nil
+}
+
+// MetricDataPublisher publishes custom CloudWatch metric data.
+type MetricDataPublisher interface {
+	PutMetricData(ctx context.Context, namespace string, data []*cloudwatch.MetricDatum) error
+}
+
+// NewCloudWatchCollector wraps collector to also publish reconcile counts, errors, drift corrections and durations to CloudWatch.
+// Metrics are aggregated over cfg.Interval for the whole controller, with a ClusterName dimension.
+func NewCloudWatchCollector(cfg CloudWatchConfig, collector Collector, publisher MetricDataPublisher, clusterName string) Collector {
+	return &cloudWatchCollector{
+		Collector: collector,
+		cfg:       cfg,
+		publisher: publisher,
+		dimensions: []*cloudwatch.Dimension{
+			{Name: aws.String("ClusterName"), Value: aws.String(clusterName)},
+		},
+		now: time.Now,
+	}
+}
+
+type cloudWatchCollector struct {
+	Collector
+
+	cfg        CloudWatchConfig
+	publisher  MetricDataPublisher
+	dimensions []*cloudwatch.Dimension
+	now        func() time.Time
+
+	mu               sync.Mutex
+	reconciles       float64
+	reconcileErrors  float64
+	driftCorrections float64
+	durations        *cloudwatch.StatisticSet
+
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+func (c *cloudWatchCollector) IncReconcileCount() {
+	c.Collector.IncReconcileCount()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reconciles++
+}
+
+func (c *cloudWatchCollector) IncReconcileErrorCount(s string) {
+	c.Collector.IncReconcileErrorCount(s)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reconcileErrors++
+}
+
+func (c *cloudWatchCollector) IncDriftCount(s string) {
+	c.Collector.IncDriftCount(s)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.driftCorrections++
+}
+
+func (c *cloudWatchCollector) ObserveReconcileDuration(d time.Duration) {
+	c.Collector.ObserveReconcileDuration(d)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	seconds := d.Seconds()
+	if c.durations == nil {
+		c.durations = &cloudwatch.StatisticSet{
+			Minimum:     aws.Float64(seconds),
+			Maximum:     aws.Float64(seconds),
+			Sum:         aws.Float64(0),
+			SampleCount: aws.Float64(0),
+		}
+	}
+	if seconds < aws.Float64Value(c.durations.Minimum) {
+		c.durations.Minimum = aws.Float64(seconds)
+	}
+	if seconds > aws.Float64Value(c.durations.Maximum) {
+		c.durations.Maximum = aws.Float64(seconds)
+	}
+	c.durations.Sum = aws.Float64(aws.Float64Value(c.durations.Sum) + seconds)
+	c.durations.SampleCount = aws.Float64(aws.Float64Value(c.durations.SampleCount) + 1)
+}
+
+func (c *cloudWatchCollector) Start() {
+	c.Collector.Start()
+	c.stopCh = make(chan struct{})
+	c.doneCh = make(chan struct{})
+	go c.run()
+}
+
+// Stop publishes the metrics aggregated so far before stopping.
+func (c *cloudWatchCollector) Stop() {
+	close(c.stopCh)
+	<-c.doneCh
+	c.Collector.Stop()
+}
+
+func (c *cloudWatchCollector) run() {
+	defer close(c.doneCh)
+	ticker := time.NewTicker(c.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.publish()
+		case <-c.stopCh:
+			c.publish()
+			return
+		}
+	}
+}
+
+// publish publishes and resets the aggregated metrics, counters are published even when zero so that alarms on them have data.
+func (c *cloudWatchCollector) publish() {
+	c.mu.Lock()
+	timestamp := c.now()
+	count := func(name string, value float64) *cloudwatch.MetricDatum {
+		return &cloudwatch.MetricDatum{
+			MetricName: aws.String(name),
+			Dimensions: c.dimensions,
+			Timestamp:  aws.Time(timestamp),
+			Unit:       aws.String(cloudwatch.StandardUnitCount),
+			Value:      aws.Float64(value),
+		}
+	}
+	data := []*cloudwatch.MetricDatum{
+		count("ReconcileCount", c.reconciles),
+		count("ReconcileErrorCount", c.reconcileErrors),
+		count("DriftCorrectionCount", c.driftCorrections),
+	}
+	if c.durations != nil {
+		data = append(data, &cloudwatch.MetricDatum{
+			MetricName:      aws.String("ReconcileDuration"),
+			Dimensions:      c.dimensions,
+			Timestamp:       aws.Time(timestamp),
+			Unit:            aws.String(cloudwatch.StandardUnitSeconds),
+			StatisticValues: c.durations,
+		})
+	}
+	c.reconciles, c.reconcileErrors, c.driftCorrections, c.durations = 0, 0, 0, nil
+	c.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.cfg.Interval)
+	defer cancel()
+	if err := c.publisher.PutMetricData(ctx, c.cfg.Namespace, data); err != nil {
+		glog.Warningf("failed to publish metrics to CloudWatch namespace %v due to %v", c.cfg.Namespace, err)
+	}
+}
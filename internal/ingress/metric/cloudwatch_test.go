@@ -0,0 +1,118 @@
+package metric
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingPublisher struct {
+	namespace string
+	data      []*cloudwatch.MetricDatum
+	err       error
+}
+
+func (p *recordingPublisher) PutMetricData(ctx context.Context, namespace string, data []*cloudwatch.MetricDatum) error {
+	p.namespace = namespace
+	p.data = data
+	return p.err
+}
+
+func TestCloudWatchCollector_publish(t *testing.T) {
+	timestamp := time.Unix(1500000000, 0)
+	publisher := &recordingPublisher{}
+	c := NewCloudWatchCollector(CloudWatchConfig{Namespace: "ALBIngressController", Interval: time.Minute}, DummyCollector{}, publisher, "cluster").(*cloudWatchCollector)
+	c.now = func() time.Time { return timestamp }
+	dimensions := []*cloudwatch.Dimension{{Name: aws.String("ClusterName"), Value: aws.String("cluster")}}
+	count := func(name string, value float64) *cloudwatch.MetricDatum {
+		return &cloudwatch.MetricDatum{
+			MetricName: aws.String(name),
+			Dimensions: dimensions,
+			Timestamp:  aws.Time(timestamp),
+			Unit:       aws.String(cloudwatch.StandardUnitCount),
+			Value:      aws.Float64(value),
+		}
+	}
+
+	c.IncReconcileCount()
+	c.IncReconcileCount()
+	c.IncReconcileErrorCount("namespace/ingress")
+	c.IncDriftCount("namespace/ingress")
+	c.ObserveReconcileDuration(2 * time.Second)
+	c.ObserveReconcileDuration(500 * time.Millisecond)
+	c.ObserveReconcileDuration(time.Second)
+	c.publish()
+	assert.Equal(t, "ALBIngressController", publisher.namespace)
+	assert.Equal(t, []*cloudwatch.MetricDatum{
+		count("ReconcileCount", 2),
+		count("ReconcileErrorCount", 1),
+		count("DriftCorrectionCount", 1),
+		{
+			MetricName: aws.String("ReconcileDuration"),
+			Dimensions: dimensions,
+			Timestamp:  aws.Time(timestamp),
+			Unit:       aws.String(cloudwatch.StandardUnitSeconds),
+			StatisticValues: &cloudwatch.StatisticSet{
+				Minimum:     aws.Float64(0.5),
+				Maximum:     aws.Float64(2),
+				Sum:         aws.Float64(3.5),
+				SampleCount: aws.Float64(3),
+			},
+		},
+	}, publisher.data)
+
+	publisher.err = errors.New("AccessDenied")
+	c.publish()
+	assert.Equal(t, []*cloudwatch.MetricDatum{
+		count("ReconcileCount", 0),
+		count("ReconcileErrorCount", 0),
+		count("DriftCorrectionCount", 0),
+	}, publisher.data, "metrics should be reset after publishing")
+}
+
+func TestCloudWatchCollector_Stop(t *testing.T) {
+	publisher := &recordingPublisher{}
+	c := NewCloudWatchCollector(CloudWatchConfig{Namespace: "ALBIngressController", Interval: time.Hour}, DummyCollector{}, publisher, "cluster")
+	c.Start()
+	c.IncReconcileCount()
+	c.Stop()
+	if assert.Len(t, publisher.data, 3) {
+		assert.Equal(t, 1.0, aws.Float64Value(publisher.data[0].Value), "pending metrics should be published on stop")
+	}
+}
+
+func TestCloudWatchConfig_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		Name          string
+		Config        CloudWatchConfig
+		ExpectedError string
+	}{
+		{
+			Name:   "disabled",
+			Config: CloudWatchConfig{Interval: time.Second},
+		},
+		{
+			Name:   "enabled",
+			Config: CloudWatchConfig{Namespace: "ALBIngressController", Interval: time.Minute},
+		},
+		{
+			Name:          "interval too short",
+			Config:        CloudWatchConfig{Namespace: "ALBIngressController", Interval: time.Second},
+			ExpectedError: "cloudwatch metrics interval must be at least 10s, got 1s",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := tc.Config.Validate()
+			if tc.ExpectedError != "" {
+				assert.EqualError(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package collectors
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/prometheus/client_golang/prometheus"
@@ -30,7 +31,13 @@ type Controller struct {
 
 	reconcileOperation       *prometheus.CounterVec
 	reconcileOperationErrors *prometheus.CounterVec
+	driftCorrections         *prometheus.CounterVec
+	observedDrift            *prometheus.GaugeVec
 	managedIngresses         *prometheus.GaugeVec
+	reconcileDuration        *prometheus.HistogramVec
+	lastSuccessfulReconcile  *prometheus.GaugeVec
+	certificateExpiry        *prometheus.GaugeVec
+	estimatedMonthlyCost     *prometheus.GaugeVec
 
 	labels prometheus.Labels
 }
@@ -60,6 +67,22 @@ func NewController(class string) *Controller {
 			},
 			[]string{"class", "ingress"},
 		),
+		driftCorrections: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: PrometheusNamespace,
+				Name:      "drift_corrections",
+				Help:      `Cumulative number of AWS resources modified to match the desired state of an ingress`,
+			},
+			[]string{"class", "ingress"},
+		),
+		observedDrift: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: PrometheusNamespace,
+				Name:      "observed_drift",
+				Help:      `Whether the AWS resources of an ingress differed from its desired state when last observed, in observe-only mode`,
+			},
+			[]string{"class", "ingress"},
+		),
 		managedIngresses: prometheus.NewGaugeVec(
 			prometheus.GaugeOpts{
 				Namespace: PrometheusNamespace,
@@ -68,6 +91,39 @@ func NewController(class string) *Controller {
 			},
 			[]string{"class", "namespace"},
 		),
+		reconcileDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: PrometheusNamespace,
+				Name:      "reconcile_duration_seconds",
+				Help:      `Duration of Ingress controller reconcile operations`,
+				Buckets:   []float64{0.1, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300},
+			},
+			[]string{"class"},
+		),
+		lastSuccessfulReconcile: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: PrometheusNamespace,
+				Name:      "last_successful_reconcile_timestamp_seconds",
+				Help:      `Unix timestamp of the last successful reconcile operation per ingress`,
+			},
+			[]string{"class", "ingress"},
+		),
+		certificateExpiry: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: PrometheusNamespace,
+				Name:      "certificate_expiry_timestamp_seconds",
+				Help:      `Unix timestamp at which a certificate attached to the listeners of an ingress expires`,
+			},
+			[]string{"class", "ingress", "certificate_arn"},
+		),
+		estimatedMonthlyCost: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: PrometheusNamespace,
+				Name:      "estimated_monthly_cost_dollars",
+				Help:      `Rough estimate of the monthly cost of the ALB of an ingress, in US dollars`,
+			},
+			[]string{"class", "ingress"},
+		),
 	}
 
 	return cm
@@ -87,6 +143,69 @@ func (cm *Controller) IncReconcileErrorCount(name string) {
 	cm.reconcileOperationErrors.With(l).Inc()
 }
 
+// IncDriftCount increment the drift correction counter
+func (cm *Controller) IncDriftCount(name string) {
+	l := prometheus.Labels{
+		"class": cm.labels["class"],
+	}
+	l["ingress"] = name
+	cm.driftCorrections.With(l).Inc()
+}
+
+// SetObservedDrift records whether the AWS resources of ingress drifted when last observed
+func (cm *Controller) SetObservedDrift(name string, drift bool) {
+	l := prometheus.Labels{
+		"class": cm.labels["class"],
+	}
+	l["ingress"] = name
+	value := 0.0
+	if drift {
+		value = 1
+	}
+	cm.observedDrift.With(l).Set(value)
+}
+
+// ObserveReconcileDuration records the duration of a reconcile operation
+func (cm *Controller) ObserveReconcileDuration(d time.Duration) {
+	cm.reconcileDuration.With(cm.labels).Observe(d.Seconds())
+}
+
+// SetLastSuccessfulReconcile records t as the time of the last successful reconcile of ingress
+func (cm *Controller) SetLastSuccessfulReconcile(name string, t time.Time) {
+	l := prometheus.Labels{
+		"class": cm.labels["class"],
+	}
+	l["ingress"] = name
+	cm.lastSuccessfulReconcile.With(l).Set(float64(t.Unix()))
+}
+
+// SetCertificateExpiries replaces the expiry times of certificates, keyed by ingress and certificate ARN
+func (cm *Controller) SetCertificateExpiries(expiries map[string]map[string]time.Time) {
+	cm.certificateExpiry.Reset()
+	for name, certExpiries := range expiries {
+		for certArn, t := range certExpiries {
+			l := prometheus.Labels{
+				"class":           cm.labels["class"],
+				"ingress":         name,
+				"certificate_arn": certArn,
+			}
+			cm.certificateExpiry.With(l).Set(float64(t.Unix()))
+		}
+	}
+}
+
+// SetCostEstimates replaces the estimated monthly costs, keyed by ingress
+func (cm *Controller) SetCostEstimates(costs map[string]float64) {
+	cm.estimatedMonthlyCost.Reset()
+	for name, cost := range costs {
+		l := prometheus.Labels{
+			"class":   cm.labels["class"],
+			"ingress": name,
+		}
+		cm.estimatedMonthlyCost.With(l).Set(cost)
+	}
+}
+
 // SetManagedIngresses sets the number of managed ingresses
 func (cm *Controller) SetManagedIngresses(nsmap map[string]int, registry prometheus.Gatherer) {
 	l := prometheus.Labels{
@@ -137,14 +256,26 @@ func (cm *Controller) SetManagedIngresses(nsmap map[string]int, registry prometh
 func (cm Controller) Describe(ch chan<- *prometheus.Desc) {
 	cm.reconcileOperation.Describe(ch)
 	cm.reconcileOperationErrors.Describe(ch)
+	cm.driftCorrections.Describe(ch)
+	cm.observedDrift.Describe(ch)
 	cm.managedIngresses.Describe(ch)
+	cm.reconcileDuration.Describe(ch)
+	cm.lastSuccessfulReconcile.Describe(ch)
+	cm.certificateExpiry.Describe(ch)
+	cm.estimatedMonthlyCost.Describe(ch)
 }
 
 // Collect implements the prometheus.Collector interface.
 func (cm Controller) Collect(ch chan<- prometheus.Metric) {
 	cm.reconcileOperation.Collect(ch)
 	cm.reconcileOperationErrors.Collect(ch)
+	cm.driftCorrections.Collect(ch)
+	cm.observedDrift.Collect(ch)
 	cm.managedIngresses.Collect(ch)
+	cm.reconcileDuration.Collect(ch)
+	cm.lastSuccessfulReconcile.Collect(ch)
+	cm.certificateExpiry.Collect(ch)
+	cm.estimatedMonthlyCost.Collect(ch)
 }
 
 // RemoveMetrics removes metrics for ingresses that have been removed
@@ -154,4 +285,7 @@ func (cm *Controller) RemoveMetrics(name string) {
 	}
 	l["ingress"] = name
 	cm.reconcileOperationErrors.Delete(l)
+	cm.driftCorrections.Delete(l)
+	cm.observedDrift.Delete(l)
+	cm.lastSuccessfulReconcile.Delete(l)
 }
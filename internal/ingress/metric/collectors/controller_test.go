@@ -18,6 +18,7 @@ package collectors
 
 import (
 	"testing"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -64,6 +65,70 @@ func TestControllerCounters(t *testing.T) {
 			`,
 			metrics: []string{"aws_alb_ingress_controller_errors"},
 		},
+		{
+			name: "drift corrections should be counted per ingress",
+			test: func(cm *Controller) {
+				cm.IncDriftCount("namespace/ingressName")
+				cm.IncDriftCount("namespace/ingressName")
+			},
+			want: `
+				# HELP aws_alb_ingress_controller_drift_corrections Cumulative number of AWS resources modified to match the desired state of an ingress
+				# TYPE aws_alb_ingress_controller_drift_corrections counter
+				aws_alb_ingress_controller_drift_corrections{class="alb",ingress="namespace/ingressName"} 2
+			`,
+			metrics: []string{"aws_alb_ingress_controller_drift_corrections"},
+		},
+		{
+			name: "successful reconcile should set the timestamp of ingress",
+			test: func(cm *Controller) {
+				cm.SetLastSuccessfulReconcile("namespace/ingressName", time.Unix(1500000000, 0))
+			},
+			want: `
+				# HELP aws_alb_ingress_controller_last_successful_reconcile_timestamp_seconds Unix timestamp of the last successful reconcile operation per ingress
+				# TYPE aws_alb_ingress_controller_last_successful_reconcile_timestamp_seconds gauge
+				aws_alb_ingress_controller_last_successful_reconcile_timestamp_seconds{class="alb",ingress="namespace/ingressName"} 1.5e+09
+			`,
+			metrics: []string{"aws_alb_ingress_controller_last_successful_reconcile_timestamp_seconds"},
+		},
+		{
+			name: "removed ingress should not report timestamp",
+			test: func(cm *Controller) {
+				cm.SetLastSuccessfulReconcile("namespace/ingressName", time.Unix(1500000000, 0))
+				cm.RemoveMetrics("namespace/ingressName")
+			},
+			want:    ``,
+			metrics: []string{"aws_alb_ingress_controller_last_successful_reconcile_timestamp_seconds"},
+		},
+		{
+			name: "certificate expiries should replace previous ones",
+			test: func(cm *Controller) {
+				cm.SetCertificateExpiries(map[string]map[string]time.Time{
+					"namespace/ingressName": {"arn:aws:acm:us-west-2:123456789012:certificate/old": time.Unix(1500000000, 0)},
+				})
+				cm.SetCertificateExpiries(map[string]map[string]time.Time{
+					"namespace/ingressName": {"arn:aws:acm:us-west-2:123456789012:certificate/new": time.Unix(1600000000, 0)},
+				})
+			},
+			want: `
+				# HELP aws_alb_ingress_controller_certificate_expiry_timestamp_seconds Unix timestamp at which a certificate attached to the listeners of an ingress expires
+				# TYPE aws_alb_ingress_controller_certificate_expiry_timestamp_seconds gauge
+				aws_alb_ingress_controller_certificate_expiry_timestamp_seconds{certificate_arn="arn:aws:acm:us-west-2:123456789012:certificate/new",class="alb",ingress="namespace/ingressName"} 1.6e+09
+			`,
+			metrics: []string{"aws_alb_ingress_controller_certificate_expiry_timestamp_seconds"},
+		},
+		{
+			name: "cost estimates should replace previous ones",
+			test: func(cm *Controller) {
+				cm.SetCostEstimates(map[string]float64{"namespace/removed": 20})
+				cm.SetCostEstimates(map[string]float64{"namespace/ingressName": 18.75})
+			},
+			want: `
+				# HELP aws_alb_ingress_controller_estimated_monthly_cost_dollars Rough estimate of the monthly cost of the ALB of an ingress, in US dollars
+				# TYPE aws_alb_ingress_controller_estimated_monthly_cost_dollars gauge
+				aws_alb_ingress_controller_estimated_monthly_cost_dollars{class="alb",ingress="namespace/ingressName"} 18.75
+			`,
+			metrics: []string{"aws_alb_ingress_controller_estimated_monthly_cost_dollars"},
+		},
 	}
 
 	for _, c := range cases {
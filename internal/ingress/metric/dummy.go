@@ -17,6 +17,8 @@ limitations under the License.
 package metric
 
 import (
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -29,9 +31,27 @@ func (dc DummyCollector) IncReconcileCount() {}
 // IncReloadErrorCount ...
 func (dc DummyCollector) IncReconcileErrorCount(string) {}
 
+// IncDriftCount ...
+func (dc DummyCollector) IncDriftCount(string) {}
+
+// SetObservedDrift ...
+func (dc DummyCollector) SetObservedDrift(string, bool) {}
+
+// ObserveReconcileDuration ...
+func (dc DummyCollector) ObserveReconcileDuration(time.Duration) {}
+
+// SetLastSuccessfulReconcile ...
+func (dc DummyCollector) SetLastSuccessfulReconcile(string) {}
+
 // SetManagedIngresses ...
 func (dc DummyCollector) SetManagedIngresses(map[string]int) {}
 
+// SetCertificateExpiries ...
+func (dc DummyCollector) SetCertificateExpiries(map[string]map[string]time.Time) {}
+
+// SetCostEstimates ...
+func (dc DummyCollector) SetCostEstimates(map[string]float64) {}
+
 // IncAPIRequestCount ...
 func (dc DummyCollector) IncAPIRequestCount(prometheus.Labels) {}
 
@@ -41,6 +61,12 @@ func (dc DummyCollector) IncAPIErrorCount(prometheus.Labels) {}
 // IncAPIRetryCount ...
 func (dc DummyCollector) IncAPIRetryCount(prometheus.Labels) {}
 
+// IncAPIThrottleCount ...
+func (dc DummyCollector) IncAPIThrottleCount(prometheus.Labels) {}
+
+// ObserveAPIRequestDuration ...
+func (dc DummyCollector) ObserveAPIRequestDuration(prometheus.Labels, time.Duration) {}
+
 // Start ...
 func (dc DummyCollector) Start() {}
 
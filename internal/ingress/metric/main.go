@@ -17,6 +17,8 @@ limitations under the License.
 package metric
 
 import (
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/metric/collectors"
@@ -26,11 +28,19 @@ import (
 type Collector interface {
 	IncReconcileCount()
 	IncReconcileErrorCount(string)
+	IncDriftCount(string)
+	SetObservedDrift(string, bool)
+	ObserveReconcileDuration(time.Duration)
+	SetLastSuccessfulReconcile(string)
 	SetManagedIngresses(map[string]int)
+	SetCertificateExpiries(map[string]map[string]time.Time)
+	SetCostEstimates(map[string]float64)
 
 	IncAPIRequestCount(prometheus.Labels)
 	IncAPIErrorCount(prometheus.Labels)
 	IncAPIRetryCount(prometheus.Labels)
+	IncAPIThrottleCount(prometheus.Labels)
+	ObserveAPIRequestDuration(prometheus.Labels, time.Duration)
 
 	RemoveMetrics(string)
 
@@ -65,10 +75,34 @@ func (c *collector) IncReconcileErrorCount(s string) {
 	c.ingressController.IncReconcileErrorCount(s)
 }
 
+func (c *collector) IncDriftCount(s string) {
+	c.ingressController.IncDriftCount(s)
+}
+
+func (c *collector) SetObservedDrift(s string, drift bool) {
+	c.ingressController.SetObservedDrift(s, drift)
+}
+
+func (c *collector) ObserveReconcileDuration(d time.Duration) {
+	c.ingressController.ObserveReconcileDuration(d)
+}
+
+func (c *collector) SetLastSuccessfulReconcile(s string) {
+	c.ingressController.SetLastSuccessfulReconcile(s, time.Now())
+}
+
 func (c *collector) SetManagedIngresses(i map[string]int) {
 	c.ingressController.SetManagedIngresses(i, c.registry)
 }
 
+func (c *collector) SetCertificateExpiries(e map[string]map[string]time.Time) {
+	c.ingressController.SetCertificateExpiries(e)
+}
+
+func (c *collector) SetCostEstimates(e map[string]float64) {
+	c.ingressController.SetCostEstimates(e)
+}
+
 func (c *collector) IncAPIRequestCount(l prometheus.Labels) {
 	c.awsAPIController.IncAPIRequestCount(l)
 }
@@ -81,6 +115,14 @@ func (c *collector) IncAPIRetryCount(l prometheus.Labels) {
 	c.awsAPIController.IncAPIRetryCount(l)
 }
 
+func (c *collector) IncAPIThrottleCount(l prometheus.Labels) {
+	c.awsAPIController.IncAPIThrottleCount(l)
+}
+
+func (c *collector) ObserveAPIRequestDuration(l prometheus.Labels, d time.Duration) {
+	c.awsAPIController.ObserveAPIRequestDuration(l, d)
+}
+
 func (c *collector) RemoveMetrics(ingressName string) {
 	c.ingressController.RemoveMetrics(ingressName)
 }
@@ -0,0 +1,350 @@
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/action"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/config"
+	extensions "k8s.io/api/extensions/v1beta1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+const (
+	// quotaWarningRatio is the usage of account quotas from which they are reported, before they're reached.
+	quotaWarningRatio = 0.9
+
+	// securityGroupRulesQuotaCode is the Service Quotas code of the inbound or outbound rules per security group.
+	securityGroupRulesQuotaCode = "L-0EA8095F"
+
+	// quotasTTL is the duration quotas are reused for by CheckIngress, since they rarely change.
+	quotasTTL = time.Hour
+)
+
+// requiredActions are the IAM actions the controller needs regardless of the features used, see docs/examples/iam-policy.json.
+var requiredActions = []string{
+	"acm:DescribeCertificate",
+	"acm:ListCertificates",
+	"ec2:AuthorizeSecurityGroupIngress",
+	"ec2:CreateSecurityGroup",
+	"ec2:CreateTags",
+	"ec2:DeleteSecurityGroup",
+	"ec2:DeleteTags",
+	"ec2:DescribeInstances",
+	"ec2:DescribeNetworkInterfaces",
+	"ec2:DescribeSecurityGroups",
+	"ec2:DescribeSubnets",
+	"ec2:DescribeVpcs",
+	"ec2:ModifyInstanceAttribute",
+	"ec2:ModifyNetworkInterfaceAttribute",
+	"ec2:RevokeSecurityGroupIngress",
+	"elasticloadbalancing:AddListenerCertificates",
+	"elasticloadbalancing:AddTags",
+	"elasticloadbalancing:CreateListener",
+	"elasticloadbalancing:CreateLoadBalancer",
+	"elasticloadbalancing:CreateRule",
+	"elasticloadbalancing:CreateTargetGroup",
+	"elasticloadbalancing:DeleteListener",
+	"elasticloadbalancing:DeleteLoadBalancer",
+	"elasticloadbalancing:DeleteRule",
+	"elasticloadbalancing:DeleteTargetGroup",
+	"elasticloadbalancing:DeregisterTargets",
+	"elasticloadbalancing:DescribeListenerCertificates",
+	"elasticloadbalancing:DescribeListeners",
+	"elasticloadbalancing:DescribeLoadBalancerAttributes",
+	"elasticloadbalancing:DescribeLoadBalancers",
+	"elasticloadbalancing:DescribeRules",
+	"elasticloadbalancing:DescribeTags",
+	"elasticloadbalancing:DescribeTargetGroupAttributes",
+	"elasticloadbalancing:DescribeTargetGroups",
+	"elasticloadbalancing:DescribeTargetHealth",
+	"elasticloadbalancing:ModifyListener",
+	"elasticloadbalancing:ModifyLoadBalancerAttributes",
+	"elasticloadbalancing:ModifyRule",
+	"elasticloadbalancing:ModifyTargetGroup",
+	"elasticloadbalancing:ModifyTargetGroupAttributes",
+	"elasticloadbalancing:RegisterTargets",
+	"elasticloadbalancing:RemoveListenerCertificates",
+	"elasticloadbalancing:RemoveTags",
+	"elasticloadbalancing:SetIpAddressType",
+	"elasticloadbalancing:SetSecurityGroups",
+	"elasticloadbalancing:SetSubnets",
+	"tag:GetResources",
+	"tag:TagResources",
+}
+
+// feature is an optional feature enabled per ingress by annotations, which needs additional IAM actions.
+type feature struct {
+	gate        config.Feature
+	annotations []string
+	actions     []string
+}
+
+var features = []feature{
+	{
+		gate:        config.WAF,
+		annotations: []string{"waf-acl-id"},
+		actions:     []string{"waf-regional:GetWebACL", "waf-regional:GetWebACLForResource", "waf-regional:AssociateWebACL", "waf-regional:DisassociateWebACL", "waf-regional:ListWebACLs", "wafv2:ListWebACLs"},
+	},
+	{
+		gate:        config.WAFV2,
+		annotations: []string{"wafv2-acl-arn"},
+		actions:     []string{"wafv2:GetWebACL", "wafv2:GetWebACLForResource", "wafv2:AssociateWebACL", "wafv2:DisassociateWebACL", "elasticloadbalancing:SetWebAcl"},
+	},
+	{
+		gate:        config.ShieldAdvanced,
+		annotations: []string{"shield-advanced-protection"},
+		actions:     []string{"shield:GetSubscriptionState", "shield:DescribeProtection", "shield:CreateProtection", "shield:DeleteProtection"},
+	},
+	{
+		gate:        config.Route53,
+		annotations: []string{"route53-health-checks"},
+		actions:     []string{"route53:ListHealthChecks", "route53:ListTagsForResources", "route53:CreateHealthCheck", "route53:ChangeTagsForResource", "route53:DeleteHealthCheck"},
+	},
+	{
+		gate:        config.CloudWatch,
+		annotations: []string{"alarm-5xx-rate-percent", "alarm-target-response-time-seconds", "alarm-unhealthy-host-count"},
+		actions:     []string{"cloudwatch:DescribeAlarms", "cloudwatch:PutMetricAlarm", "cloudwatch:DeleteAlarms", "cloudwatch:TagResource"},
+	},
+	{
+		gate:        config.ZonalShift,
+		annotations: []string{"zonal-autoshift", "practice-run-outcome-alarm"},
+		actions: []string{"arc-zonal-shift:GetManagedResource", "arc-zonal-shift:CreatePracticeRunConfiguration", "arc-zonal-shift:UpdatePracticeRunConfiguration",
+			"arc-zonal-shift:DeletePracticeRunConfiguration", "arc-zonal-shift:UpdateZonalAutoshiftConfiguration"},
+	},
+}
+
+// Checker detects missing IAM permissions and exhausted quotas, which would otherwise fail reconciles half way.
+// Permissions are checked by simulating the IAM policies of the controller, which requires iam:SimulatePrincipalPolicy.
+type Checker interface {
+	// CheckAccount checks the permissions of the controller and the account-wide quotas, returning the issues found.
+	// The simulated permissions are remembered for CheckIngress.
+	CheckAccount(ctx context.Context) ([]string, error)
+
+	// CheckIngress checks the permissions needed by the features ingress uses, and the quotas of a single ALB it would exceed.
+	CheckIngress(ctx context.Context, ingress *extensions.Ingress, ingressAnnos *annotations.Ingress) ([]string, error)
+}
+
+func NewChecker(cloud aws.CloudAPI, featureGate config.FeatureGate) Checker {
+	return &defaultChecker{
+		cloud:       cloud,
+		featureGate: featureGate,
+		now:         time.Now,
+	}
+}
+
+type defaultChecker struct {
+	cloud       aws.CloudAPI
+	featureGate config.FeatureGate
+	now         func() time.Time
+
+	mu sync.Mutex
+	// denied are the actions denied to the controller, nil until simulated successfully.
+	denied             map[string]bool
+	limits             map[string]int64
+	securityGroupRules int64
+	quotasFetched      time.Time
+}
+
+func (c *defaultChecker) CheckAccount(ctx context.Context) ([]string, error) {
+	var issues []string
+	var errs []error
+
+	denied, err := c.simulatePermissions(ctx)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed to simulate IAM permissions due to %v", err))
+	} else {
+		for _, action := range requiredActions {
+			if denied[action] {
+				issues = append(issues, fmt.Sprintf("missing %v", action))
+			}
+		}
+		for _, f := range c.enabledFeatures() {
+			for _, action := range f.actions {
+				if denied[action] {
+					issues = append(issues, fmt.Sprintf("missing %v, needed by ingresses with the %v annotation", action, f.annotations[0]))
+				}
+			}
+		}
+	}
+
+	limits, _, err := c.quotas(ctx)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed to get ELBV2 account limits due to %v", err))
+	} else {
+		usage, err := c.cloud.GetAccountUsage(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to get ELBV2 account usage due to %v", err))
+		} else {
+			for _, name := range []string{"application-load-balancers", "target-groups"} {
+				if issue := checkAccountQuota(name, usage[name], limits[name]); len(issue) != 0 {
+					issues = append(issues, issue)
+				}
+			}
+		}
+	}
+	return issues, utilerrors.NewAggregate(errs)
+}
+
+func (c *defaultChecker) CheckIngress(ctx context.Context, ingress *extensions.Ingress, ingressAnnos *annotations.Ingress) ([]string, error) {
+	var issues []string
+
+	c.mu.Lock()
+	denied := c.denied
+	c.mu.Unlock()
+	if denied != nil {
+		reported := make(map[string]bool)
+		for _, f := range c.enabledFeatures() {
+			annotation, ok := usedAnnotation(f, ingress)
+			if !ok {
+				continue
+			}
+			for _, action := range f.actions {
+				if denied[action] && !reported[action] {
+					reported[action] = true
+					issues = append(issues, fmt.Sprintf("missing %v, needed by the %v annotation", action, annotation))
+				}
+			}
+		}
+	}
+
+	limits, securityGroupRules, err := c.quotas(ctx)
+	if err != nil {
+		return issues, fmt.Errorf("failed to get quotas due to %v", err)
+	}
+	listeners := int64(len(ingressAnnos.LoadBalancer.Ports))
+	if issue := checkALBQuota("rules", countPaths(ingress)*listeners, "rules-per-application-load-balancer", limits["rules-per-application-load-balancer"]); len(issue) != 0 {
+		issues = append(issues, issue)
+	}
+	if issue := checkALBQuota("targetGroups", countBackends(ingress), "target-groups-per-application-load-balancer", limits["target-groups-per-application-load-balancer"]); len(issue) != 0 {
+		issues = append(issues, issue)
+	}
+	// the securityGroup is only managed by the controller when none is specified by annotation.
+	if len(ingressAnnos.LoadBalancer.SecurityGroups) == 0 {
+		// prefix lists count as their maximum number of entries, which is at least one.
+		cidrs := int64(len(ingressAnnos.LoadBalancer.InboundCidrs) + len(ingressAnnos.LoadBalancer.InboundV6CIDRs) + len(ingressAnnos.LoadBalancer.InboundPrefixLists))
+		if issue := checkALBQuota("securityGroup rules", cidrs*listeners, "inbound rules per security group", securityGroupRules); len(issue) != 0 {
+			issues = append(issues, issue)
+		}
+	}
+	return issues, nil
+}
+
+// simulatePermissions simulates the required actions and the ones of enabled features, returning the denied ones.
+func (c *defaultChecker) simulatePermissions(ctx context.Context) (map[string]bool, error) {
+	principalArn, err := c.cloud.GetCallerPrincipalArn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	actions := append([]string{}, requiredActions...)
+	for _, f := range c.enabledFeatures() {
+		actions = append(actions, f.actions...)
+	}
+	deniedActions, err := c.cloud.GetDeniedActions(ctx, principalArn, actions)
+	if err != nil {
+		return nil, err
+	}
+	denied := make(map[string]bool, len(deniedActions))
+	for _, action := range deniedActions {
+		denied[action] = true
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.denied = denied
+	return denied, nil
+}
+
+// quotas returns the ELBV2 account limits and the securityGroup rules quota, which are cached for quotasTTL.
+func (c *defaultChecker) quotas(ctx context.Context) (map[string]int64, int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.limits != nil && c.now().Sub(c.quotasFetched) < quotasTTL {
+		return c.limits, c.securityGroupRules, nil
+	}
+	limits, err := c.cloud.GetAccountLimits(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	securityGroupRules, err := c.cloud.GetServiceQuotaValue(ctx, "vpc", securityGroupRulesQuotaCode)
+	if err != nil {
+		return nil, 0, err
+	}
+	c.limits, c.securityGroupRules, c.quotasFetched = limits, int64(securityGroupRules), c.now()
+	return c.limits, c.securityGroupRules, nil
+}
+
+func (c *defaultChecker) enabledFeatures() []feature {
+	var enabled []feature
+	for _, f := range features {
+		if c.featureGate.Enabled(f.gate) {
+			enabled = append(enabled, f)
+		}
+	}
+	return enabled
+}
+
+// usedAnnotation returns the first annotation of feature f present on ingress.
+func usedAnnotation(f feature, ingress *extensions.Ingress) (string, bool) {
+	for _, annotation := range f.annotations {
+		var value string
+		if annotations.LoadStringAnnotation(annotation, &value, ingress.Annotations) {
+			return annotation, true
+		}
+	}
+	return "", false
+}
+
+// countPaths returns the number of listener rules created for ingress on each of its listeners.
+func countPaths(ingress *extensions.Ingress) int64 {
+	var paths int64
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP != nil {
+			paths += int64(len(rule.HTTP.Paths))
+		}
+	}
+	return paths
+}
+
+// countBackends returns the number of targetGroups created for ingress, one per distinct service backend.
+func countBackends(ingress *extensions.Ingress) int64 {
+	backends := make(map[extensions.IngressBackend]bool)
+	add := func(backend *extensions.IngressBackend) {
+		if backend != nil && backend.ServicePort.String() != action.UseActionAnnotation {
+			backends[*backend] = true
+		}
+	}
+	add(ingress.Spec.Backend)
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for i := range rule.HTTP.Paths {
+			add(&rule.HTTP.Paths[i].Backend)
+		}
+	}
+	return int64(len(backends))
+}
+
+func checkAccountQuota(name string, used int64, limit int64) string {
+	if limit <= 0 {
+		return ""
+	}
+	if used >= limit {
+		return fmt.Sprintf("quota %v reached, %d of %d used", name, used, limit)
+	}
+	if float64(used) >= quotaWarningRatio*float64(limit) {
+		return fmt.Sprintf("quota %v nearly reached, %d of %d used", name, used, limit)
+	}
+	return ""
+}
+
+func checkALBQuota(resource string, needed int64, quota string, limit int64) string {
+	if limit <= 0 || needed <= limit {
+		return ""
+	}
+	return fmt.Sprintf("ingress needs %d %v, exceeding the %v quota of %d", needed, resource, quota, limit)
+}
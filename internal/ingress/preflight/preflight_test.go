@@ -0,0 +1,134 @@
+package preflight
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations/loadbalancer"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/config"
+	albtesting "github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/testing"
+	"github.com/stretchr/testify/assert"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestDefaultChecker_CheckAccount(t *testing.T) {
+	ctx := context.Background()
+	cloud := albtesting.NewCloud("vpc-1", "cluster")
+	cloud.DenyActions("elasticloadbalancing:CreateRule", "elasticloadbalancing:SetWebAcl", "route53:CreateHealthCheck")
+	cloud.SetAccountLimit("application-load-balancers", 2)
+	cloud.SetAccountLimit("target-groups", 10)
+	for _, name := range []string{"lb-1", "lb-2"} {
+		_, err := cloud.CreateLoadBalancerWithContext(ctx, &elbv2.CreateLoadBalancerInput{Name: aws.String(name)})
+		assert.NoError(t, err)
+	}
+	for _, name := range []string{"tg-1", "tg-2", "tg-3", "tg-4", "tg-5", "tg-6", "tg-7", "tg-8", "tg-9"} {
+		_, err := cloud.CreateTargetGroupWithContext(ctx, &elbv2.CreateTargetGroupInput{Name: aws.String(name)})
+		assert.NoError(t, err)
+	}
+	checker := NewChecker(cloud, config.NewFeatureGate())
+
+	issues, err := checker.CheckAccount(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"missing elasticloadbalancing:CreateRule",
+		"missing elasticloadbalancing:SetWebAcl, needed by ingresses with the wafv2-acl-arn annotation",
+		"quota application-load-balancers reached, 2 of 2 used",
+		"quota target-groups nearly reached, 9 of 10 used",
+	}, issues, "actions of disabled features shouldn't be reported")
+}
+
+func TestDefaultChecker_CheckIngress(t *testing.T) {
+	ctx := context.Background()
+	backend := func(name string) extensions.IngressBackend {
+		return extensions.IngressBackend{ServiceName: name, ServicePort: intstr.FromInt(80)}
+	}
+	ingress := &extensions.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "ingress",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/wafv2-acl-arn": "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/acl/id",
+			},
+		},
+		Spec: extensions.IngressSpec{
+			Backend: &extensions.IngressBackend{ServiceName: "default", ServicePort: intstr.FromInt(80)},
+			Rules: []extensions.IngressRule{
+				{
+					IngressRuleValue: extensions.IngressRuleValue{HTTP: &extensions.HTTPIngressRuleValue{
+						Paths: []extensions.HTTPIngressPath{
+							{Path: "/a", Backend: backend("a")},
+							{Path: "/b", Backend: backend("b")},
+							{Path: "/c", Backend: backend("a")},
+							{Path: "/redirect", Backend: extensions.IngressBackend{ServiceName: "redirect", ServicePort: intstr.FromString("use-annotation")}},
+						},
+					}},
+				},
+			},
+		},
+	}
+	ingressAnnos := &annotations.Ingress{LoadBalancer: &loadbalancer.Config{
+		Ports:        []loadbalancer.PortData{{Port: 80, Scheme: "HTTP"}, {Port: 443, Scheme: "HTTPS"}},
+		InboundCidrs: []string{"10.0.0.0/16", "10.1.0.0/16"},
+	}}
+
+	for _, tc := range []struct {
+		Name           string
+		Setup          func(cloud *albtesting.Cloud)
+		SecurityGroups []string
+		ExpectedIssues []string
+	}{
+		{
+			Name: "no issues",
+		},
+		{
+			Name: "missing permissions of used features",
+			Setup: func(cloud *albtesting.Cloud) {
+				cloud.DenyActions("elasticloadbalancing:SetWebAcl", "wafv2:AssociateWebACL", "shield:CreateProtection")
+			},
+			ExpectedIssues: []string{
+				"missing wafv2:AssociateWebACL, needed by the wafv2-acl-arn annotation",
+				"missing elasticloadbalancing:SetWebAcl, needed by the wafv2-acl-arn annotation",
+			},
+		},
+		{
+			Name: "quotas of a single ALB exceeded",
+			Setup: func(cloud *albtesting.Cloud) {
+				cloud.SetAccountLimit("rules-per-application-load-balancer", 7)
+				cloud.SetAccountLimit("target-groups-per-application-load-balancer", 2)
+				cloud.SetServiceQuota("vpc", securityGroupRulesQuotaCode, 3)
+			},
+			ExpectedIssues: []string{
+				"ingress needs 8 rules, exceeding the rules-per-application-load-balancer quota of 7",
+				"ingress needs 3 targetGroups, exceeding the target-groups-per-application-load-balancer quota of 2",
+				"ingress needs 4 securityGroup rules, exceeding the inbound rules per security group quota of 3",
+			},
+		},
+		{
+			Name: "securityGroup rules aren't needed with securityGroups annotation",
+			Setup: func(cloud *albtesting.Cloud) {
+				cloud.SetServiceQuota("vpc", securityGroupRulesQuotaCode, 3)
+			},
+			SecurityGroups: []string{"sg-1"},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			cloud := albtesting.NewCloud("vpc-1", "cluster")
+			if tc.Setup != nil {
+				tc.Setup(cloud)
+			}
+			checker := NewChecker(cloud, config.NewFeatureGate())
+			_, err := checker.CheckAccount(ctx)
+			assert.NoError(t, err)
+			ingressAnnos.LoadBalancer.SecurityGroups = tc.SecurityGroups
+
+			issues, err := checker.CheckIngress(ctx, ingress, ingressAnnos)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedIssues, issues)
+		})
+	}
+}
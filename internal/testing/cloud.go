@@ -0,0 +1,429 @@
+package testing
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+
+	"github.com/aws/aws-sdk-go/service/arczonalshift"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+)
+
+const (
+	// DefaultRegion is the region of the resources created by Cloud.
+	DefaultRegion = "us-west-2"
+	// DefaultAccountID is the account of the resources created by Cloud.
+	DefaultAccountID = "123456789012"
+	// DefaultVpcCIDR is the CIDR block of the VPC returned by GetVpcWithContext, unless replaced with SetVpc.
+	DefaultVpcCIDR = "10.0.0.0/16"
+)
+
+var _ aws.CloudAPI = (*Cloud)(nil)
+
+// Cloud is an in-memory implementation of aws.CloudAPI.
+// It keeps the resources created through it, so that controllers can be exercised end to end in unit tests,
+// and tests can assert on the resulting state through the same API.
+// Objects are copied when stored and returned, so callers never share state with the Cloud.
+// Resources the controller only reads, like subnets, instances or certificates, are seeded with the Add* methods.
+type Cloud struct {
+	mu sync.Mutex
+
+	vpc         *ec2.Vpc
+	clusterName string
+	region      string
+	partition   string
+	accountID   string
+	nextID      int
+
+	subnets           map[string]*ec2.Subnet
+	instances         map[string]*ec2.Instance
+	securityGroups    map[string]*ec2.SecurityGroup
+	networkInterfaces map[string]*ec2.NetworkInterface
+
+	loadBalancers        map[string]*elbv2.LoadBalancer
+	lbAttributes         map[string]map[string]string
+	listeners            map[string]*elbv2.Listener
+	listenerCertificates map[string][]*elbv2.Certificate
+	rules                map[string]*elbv2.Rule
+	ruleListeners        map[string]string
+	targetGroups         map[string]*elbv2.TargetGroup
+	tgAttributes         map[string]map[string]string
+	targets              map[string][]*elbv2.TargetDescription
+	targetHealth         map[string]map[string]string
+	elbv2Tags            map[string]map[string]string
+
+	certificates       map[string]*acm.CertificateDetail
+	certificateTags    map[string]map[string]string
+	serverCertificates map[string]*iam.ServerCertificateMetadata
+
+	accountLimits map[string]int64
+	serviceQuotas map[string]float64
+	deniedActions map[string]bool
+
+	healthChecks    map[string]*route53.HealthCheck
+	healthCheckTags map[string]map[string]string
+
+	metricAlarms map[string]*cloudwatch.MetricAlarm
+	metricData   map[string][]*cloudwatch.MetricDatum
+
+	buckets map[string]string
+
+	shieldSubscribed  bool
+	protections       map[string]*shield.Protection
+	wafRegional       bool
+	webACLs           map[string]bool
+	webACLAssociation map[string]string
+	wafv2ACLs         map[string]*wafv2.WebACL
+	wafv2Association  map[string]string
+
+	practiceRuns   map[string]*arczonalshift.PracticeRunConfiguration
+	zonalAutoshift map[string]string
+}
+
+// NewCloud constructs an empty Cloud for the VPC and cluster.
+func NewCloud(vpcID string, clusterName string) *Cloud {
+	return &Cloud{
+		vpc:         &ec2.Vpc{VpcId: aws.String(vpcID), CidrBlock: aws.String(DefaultVpcCIDR)},
+		clusterName: clusterName,
+		region:      DefaultRegion,
+		partition:   aws.PartitionForRegion(DefaultRegion),
+		accountID:   DefaultAccountID,
+
+		subnets:           make(map[string]*ec2.Subnet),
+		instances:         make(map[string]*ec2.Instance),
+		securityGroups:    make(map[string]*ec2.SecurityGroup),
+		networkInterfaces: make(map[string]*ec2.NetworkInterface),
+
+		loadBalancers:        make(map[string]*elbv2.LoadBalancer),
+		lbAttributes:         make(map[string]map[string]string),
+		listeners:            make(map[string]*elbv2.Listener),
+		listenerCertificates: make(map[string][]*elbv2.Certificate),
+		rules:                make(map[string]*elbv2.Rule),
+		ruleListeners:        make(map[string]string),
+		targetGroups:         make(map[string]*elbv2.TargetGroup),
+		tgAttributes:         make(map[string]map[string]string),
+		targets:              make(map[string][]*elbv2.TargetDescription),
+		targetHealth:         make(map[string]map[string]string),
+		elbv2Tags:            make(map[string]map[string]string),
+
+		certificates:       make(map[string]*acm.CertificateDetail),
+		certificateTags:    make(map[string]map[string]string),
+		serverCertificates: make(map[string]*iam.ServerCertificateMetadata),
+
+		accountLimits: make(map[string]int64),
+		serviceQuotas: make(map[string]float64),
+		deniedActions: make(map[string]bool),
+
+		healthChecks:    make(map[string]*route53.HealthCheck),
+		healthCheckTags: make(map[string]map[string]string),
+
+		metricAlarms: make(map[string]*cloudwatch.MetricAlarm),
+		metricData:   make(map[string][]*cloudwatch.MetricDatum),
+
+		buckets: make(map[string]string),
+
+		protections:       make(map[string]*shield.Protection),
+		wafRegional:       true,
+		webACLs:           make(map[string]bool),
+		webACLAssociation: make(map[string]string),
+		wafv2ACLs:         make(map[string]*wafv2.WebACL),
+		wafv2Association:  make(map[string]string),
+
+		practiceRuns:   make(map[string]*arczonalshift.PracticeRunConfiguration),
+		zonalAutoshift: make(map[string]string),
+	}
+}
+
+func (c *Cloud) GetClusterName() string {
+	return c.clusterName
+}
+
+func (c *Cloud) GetVpcID() string {
+	return aws.StringValue(c.vpc.VpcId)
+}
+
+// SetRegion sets the region, and with it the partition, of the ARNs of resources created afterwards.
+func (c *Cloud) SetRegion(region string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.region = region
+	c.partition = aws.PartitionForRegion(region)
+}
+
+// SetVpc replaces the VPC returned by GetVpcWithContext.
+func (c *Cloud) SetVpc(vpc *ec2.Vpc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.vpc = copyOf(vpc).(*ec2.Vpc)
+}
+
+// AddSubnet seeds a subnet. Its VpcId defaults to the Cloud's VPC.
+func (c *Cloud) AddSubnet(subnet *ec2.Subnet) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	subnet = copyOf(subnet).(*ec2.Subnet)
+	if subnet.VpcId == nil {
+		subnet.VpcId = c.vpc.VpcId
+	}
+	c.subnets[aws.StringValue(subnet.SubnetId)] = subnet
+}
+
+// AddInstance seeds an EC2 instance. Its VpcId defaults to the Cloud's VPC.
+func (c *Cloud) AddInstance(instance *ec2.Instance) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	instance = copyOf(instance).(*ec2.Instance)
+	if instance.VpcId == nil {
+		instance.VpcId = c.vpc.VpcId
+	}
+	c.instances[aws.StringValue(instance.InstanceId)] = instance
+}
+
+// AddNetworkInterface seeds a network interface. Its VpcId defaults to the Cloud's VPC.
+func (c *Cloud) AddNetworkInterface(eni *ec2.NetworkInterface) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	eni = copyOf(eni).(*ec2.NetworkInterface)
+	if eni.VpcId == nil {
+		eni.VpcId = c.vpc.VpcId
+	}
+	c.networkInterfaces[aws.StringValue(eni.NetworkInterfaceId)] = eni
+}
+
+// AddCertificate seeds an ACM certificate.
+func (c *Cloud) AddCertificate(cert *acm.CertificateDetail) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.certificates[aws.StringValue(cert.CertificateArn)] = copyOf(cert).(*acm.CertificateDetail)
+}
+
+// SetCertificateTags replaces the tags of a seeded ACM certificate.
+func (c *Cloud) SetCertificateTags(certArn string, tags map[string]string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.certificateTags[certArn] = copyStringMap(tags)
+}
+
+// AddServerCertificate seeds an IAM server certificate.
+func (c *Cloud) AddServerCertificate(cert *iam.ServerCertificateMetadata) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.serverCertificates[aws.StringValue(cert.ServerCertificateName)] = copyOf(cert).(*iam.ServerCertificateMetadata)
+}
+
+// AddBucket seeds an S3 bucket of region.
+func (c *Cloud) AddBucket(bucket string, region string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.buckets[bucket] = region
+}
+
+// AddWebACL seeds a WAF Regional web ACL.
+func (c *Cloud) AddWebACL(webACLID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.webACLs[webACLID] = true
+}
+
+// AddWAFV2WebACL seeds a WAFv2 web ACL.
+func (c *Cloud) AddWAFV2WebACL(webACL *wafv2.WebACL) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.wafv2ACLs[aws.StringValue(webACL.ARN)] = copyOf(webACL).(*wafv2.WebACL)
+}
+
+// SetShieldSubscription sets whether the account has an active Shield Advanced subscription, it's inactive by default.
+func (c *Cloud) SetShieldSubscription(active bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.shieldSubscribed = active
+}
+
+// SetWAFRegionalAvailable sets the result of WAFRegionalAvailable, it's available by default.
+func (c *Cloud) SetWAFRegionalAvailable(available bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.wafRegional = available
+}
+
+// SetTargetHealth sets the health state of a registered target, targets are healthy by default.
+func (c *Cloud) SetTargetHealth(tgArn string, targetID string, state string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.targetHealth[tgArn] == nil {
+		c.targetHealth[tgArn] = make(map[string]string)
+	}
+	c.targetHealth[tgArn][targetID] = state
+}
+
+// SetAccountLimit overrides an ELBV2 limit of the account, they have their AWS default values otherwise.
+func (c *Cloud) SetAccountLimit(name string, max int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.accountLimits[name] = max
+}
+
+// SetServiceQuota overrides a service quota of the account.
+func (c *Cloud) SetServiceQuota(serviceCode string, quotaCode string, value float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.serviceQuotas[serviceCode+"/"+quotaCode] = value
+}
+
+// DenyActions makes IAM policy simulations deny actions, all actions are allowed by default.
+func (c *Cloud) DenyActions(actions ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, action := range actions {
+		c.deniedActions[action] = true
+	}
+}
+
+// MetricData returns the metric data published into namespace, in publishing order.
+func (c *Cloud) MetricData(namespace string) []*cloudwatch.MetricDatum {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var result []*cloudwatch.MetricDatum
+	for _, datum := range c.metricData[namespace] {
+		result = append(result, copyOf(datum).(*cloudwatch.MetricDatum))
+	}
+	return result
+}
+
+// newID returns a new unique hexadecimal resource ID.
+func (c *Cloud) newID() string {
+	c.nextID++
+	return fmt.Sprintf("%017x", c.nextID)
+}
+
+func (c *Cloud) arn(service string, resource string) string {
+	return aws.BuildARN(c.partition, service, c.region, c.accountID, resource)
+}
+
+func (c *Cloud) dnsSuffix() string {
+	for _, p := range endpoints.DefaultPartitions() {
+		if p.ID() == c.partition {
+			return p.DNSSuffix()
+		}
+	}
+	return "amazonaws.com"
+}
+
+// copyOf deep copies an AWS object, or a slice of them.
+func copyOf(v interface{}) interface{} {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		return awsutil.CopyOf(v)
+	}
+	if rv.IsNil() {
+		return v
+	}
+	result := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		result.Index(i).Set(reflect.ValueOf(awsutil.CopyOf(rv.Index(i).Interface())))
+	}
+	return result.Interface()
+}
+
+func newError(code string, format string, args ...interface{}) error {
+	return awserr.New(code, fmt.Sprintf(format, args...), nil)
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// matchesTagFilter returns whether tags contain key with one of values, or any value when values is empty.
+func matchesTagFilter(tags map[string]string, key string, values []string) bool {
+	value, ok := tags[key]
+	if !ok {
+		return false
+	}
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func ec2TagMap(tags []*ec2.Tag) map[string]string {
+	m := make(map[string]string)
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return m
+}
+
+// matchesEC2Filters returns whether a resource satisfies all filters. fields resolves a filter name to the resource's values for it.
+func matchesEC2Filters(filters []*ec2.Filter, tags []*ec2.Tag, fields func(name string) ([]string, bool)) (bool, error) {
+	tagMap := ec2TagMap(tags)
+	for _, filter := range filters {
+		name := aws.StringValue(filter.Name)
+		values := aws.StringValueSlice(filter.Values)
+		if strings.HasPrefix(name, "tag:") {
+			if !matchesTagFilter(tagMap, strings.TrimPrefix(name, "tag:"), values) {
+				return false, nil
+			}
+			continue
+		}
+		if name == "tag-key" {
+			found := false
+			for _, key := range values {
+				if _, ok := tagMap[key]; ok {
+					found = true
+				}
+			}
+			if !found {
+				return false, nil
+			}
+			continue
+		}
+		actual, ok := fields(name)
+		if !ok {
+			return false, fmt.Errorf("filter %v is not supported by the in-memory cloud", name)
+		}
+		found := false
+		for _, v := range actual {
+			if containsString(values, v) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	return true, nil
+}
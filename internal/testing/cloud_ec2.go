@@ -0,0 +1,413 @@
+package testing
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+)
+
+func (c *Cloud) StatusEC2() func() error {
+	return func() error { return nil }
+}
+
+func (c *Cloud) GetVpcWithContext(ctx context.Context) (*ec2.Vpc, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return copyOf(c.vpc).(*ec2.Vpc), nil
+}
+
+func (c *Cloud) GetSubnetsByNameOrID(ctx context.Context, nameOrIDs []string) ([]*ec2.Subnet, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var result []*ec2.Subnet
+	for _, id := range c.sortedSubnetIDs() {
+		subnet := c.subnets[id]
+		if aws.StringValue(subnet.VpcId) != c.GetVpcID() {
+			continue
+		}
+		if containsString(nameOrIDs, id) || containsString(nameOrIDs, ec2TagMap(subnet.Tags)["Name"]) {
+			result = append(result, copyOf(subnet).(*ec2.Subnet))
+		}
+	}
+	return result, nil
+}
+
+func (c *Cloud) GetClusterSubnets(tagFilters map[string][]string) ([]*ec2.Subnet, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var result []*ec2.Subnet
+	for _, id := range c.sortedSubnetIDs() {
+		tags := ec2TagMap(c.subnets[id].Tags)
+		if !matchesTagFilter(tags, aws.TagNameCluster+"/"+c.clusterName, []string{"owned", "shared"}) {
+			continue
+		}
+		matched := true
+		for key, values := range tagFilters {
+			matched = matched && matchesTagFilter(tags, key, values)
+		}
+		if matched {
+			result = append(result, copyOf(c.subnets[id]).(*ec2.Subnet))
+		}
+	}
+	return result, nil
+}
+
+func (c *Cloud) GetInstancesByIDs(instanceIDs []string) ([]*ec2.Instance, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var result []*ec2.Instance
+	for _, id := range instanceIDs {
+		instance, ok := c.instances[id]
+		if !ok {
+			return nil, newError("InvalidInstanceID.NotFound", "The instance ID '%v' does not exist", id)
+		}
+		result = append(result, copyOf(instance).(*ec2.Instance))
+	}
+	return result, nil
+}
+
+func (c *Cloud) GetSecurityGroupByID(groupID string) (*ec2.SecurityGroup, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sg, ok := c.securityGroups[groupID]
+	if !ok {
+		return nil, newError("InvalidGroup.NotFound", "The security group '%v' does not exist", groupID)
+	}
+	return copyOf(sg).(*ec2.SecurityGroup), nil
+}
+
+func (c *Cloud) GetSecurityGroupByName(groupName string) (*ec2.SecurityGroup, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, id := range c.sortedSecurityGroupIDs() {
+		sg := c.securityGroups[id]
+		if aws.StringValue(sg.VpcId) == c.GetVpcID() && aws.StringValue(sg.GroupName) == groupName {
+			return copyOf(sg).(*ec2.SecurityGroup), nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *Cloud) GetSecurityGroupsByName(ctx context.Context, names []string) ([]*ec2.SecurityGroup, error) {
+	return c.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
+		Filters: []*ec2.Filter{{Name: aws.String("tag:Name"), Values: aws.StringSlice(names)}},
+	})
+}
+
+func (c *Cloud) DescribeSecurityGroups(ctx context.Context, input *ec2.DescribeSecurityGroupsInput) ([]*ec2.SecurityGroup, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	groupIDs := aws.StringValueSlice(input.GroupIds)
+	for _, id := range groupIDs {
+		if _, ok := c.securityGroups[id]; !ok {
+			return nil, newError("InvalidGroup.NotFound", "The security group '%v' does not exist", id)
+		}
+	}
+
+	var result []*ec2.SecurityGroup
+	for _, id := range c.sortedSecurityGroupIDs() {
+		sg := c.securityGroups[id]
+		if aws.StringValue(sg.VpcId) != c.GetVpcID() {
+			continue
+		}
+		if len(groupIDs) != 0 && !containsString(groupIDs, id) {
+			continue
+		}
+		if len(input.GroupNames) != 0 && !containsString(aws.StringValueSlice(input.GroupNames), aws.StringValue(sg.GroupName)) {
+			continue
+		}
+		ok, err := matchesEC2Filters(input.Filters, sg.Tags, func(name string) ([]string, bool) {
+			switch name {
+			case "vpc-id":
+				return []string{aws.StringValue(sg.VpcId)}, true
+			case "group-id":
+				return []string{aws.StringValue(sg.GroupId)}, true
+			case "group-name":
+				return []string{aws.StringValue(sg.GroupName)}, true
+			case "ip-permission.group-id":
+				var ids []string
+				for _, permission := range sg.IpPermissions {
+					for _, pair := range permission.UserIdGroupPairs {
+						ids = append(ids, aws.StringValue(pair.GroupId))
+					}
+				}
+				return ids, true
+			}
+			return nil, false
+		})
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result = append(result, copyOf(sg).(*ec2.SecurityGroup))
+		}
+	}
+	return result, nil
+}
+
+func (c *Cloud) CreateSecurityGroupWithContext(ctx context.Context, input *ec2.CreateSecurityGroupInput) (*ec2.CreateSecurityGroupOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	vpcID := c.GetVpcID()
+	if input.VpcId != nil {
+		vpcID = aws.StringValue(input.VpcId)
+	}
+	for _, sg := range c.securityGroups {
+		if aws.StringValue(sg.VpcId) == vpcID && aws.StringValue(sg.GroupName) == aws.StringValue(input.GroupName) {
+			return nil, newError("InvalidGroup.Duplicate", "The security group '%v' already exists for VPC '%v'", aws.StringValue(input.GroupName), vpcID)
+		}
+	}
+	groupID := "sg-" + c.newID()
+	c.securityGroups[groupID] = &ec2.SecurityGroup{
+		GroupId:     aws.String(groupID),
+		GroupName:   input.GroupName,
+		Description: input.Description,
+		VpcId:       aws.String(vpcID),
+		OwnerId:     aws.String(c.accountID),
+	}
+	return &ec2.CreateSecurityGroupOutput{GroupId: aws.String(groupID)}, nil
+}
+
+// DeleteSecurityGroupByID fails with DependencyViolation while the securityGroup is used by a LoadBalancer,
+// a network interface or another securityGroup's permissions, instead of waiting like the AWS implementation does.
+func (c *Cloud) DeleteSecurityGroupByID(ctx context.Context, groupID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.securityGroups[groupID]; !ok {
+		return newError("InvalidGroup.NotFound", "The security group '%v' does not exist", groupID)
+	}
+	for _, lb := range c.loadBalancers {
+		if containsString(aws.StringValueSlice(lb.SecurityGroups), groupID) {
+			return newError("DependencyViolation", "resource %v has a dependent object", groupID)
+		}
+	}
+	for _, eni := range c.networkInterfaces {
+		for _, group := range eni.Groups {
+			if aws.StringValue(group.GroupId) == groupID {
+				return newError("DependencyViolation", "resource %v has a dependent object", groupID)
+			}
+		}
+	}
+	for id, sg := range c.securityGroups {
+		for _, permission := range sg.IpPermissions {
+			for _, pair := range permission.UserIdGroupPairs {
+				if id != groupID && aws.StringValue(pair.GroupId) == groupID {
+					return newError("DependencyViolation", "resource %v has a dependent object", groupID)
+				}
+			}
+		}
+	}
+	delete(c.securityGroups, groupID)
+	return nil
+}
+
+// AuthorizeSecurityGroupIngressWithContext stores permissions as they are passed in,
+// so the same permissions must be passed to RevokeSecurityGroupIngressWithContext.
+func (c *Cloud) AuthorizeSecurityGroupIngressWithContext(ctx context.Context, input *ec2.AuthorizeSecurityGroupIngressInput) (*ec2.AuthorizeSecurityGroupIngressOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sg, ok := c.securityGroups[aws.StringValue(input.GroupId)]
+	if !ok {
+		return nil, newError("InvalidGroup.NotFound", "The security group '%v' does not exist", aws.StringValue(input.GroupId))
+	}
+	for _, permission := range input.IpPermissions {
+		if indexOfPermission(sg.IpPermissions, permission) >= 0 {
+			return nil, newError("InvalidPermission.Duplicate", "the specified rule already exists in %v", aws.StringValue(input.GroupId))
+		}
+	}
+	for _, permission := range input.IpPermissions {
+		sg.IpPermissions = append(sg.IpPermissions, copyOf(permission).(*ec2.IpPermission))
+	}
+	return &ec2.AuthorizeSecurityGroupIngressOutput{}, nil
+}
+
+func (c *Cloud) RevokeSecurityGroupIngressWithContext(ctx context.Context, input *ec2.RevokeSecurityGroupIngressInput) (*ec2.RevokeSecurityGroupIngressOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sg, ok := c.securityGroups[aws.StringValue(input.GroupId)]
+	if !ok {
+		return nil, newError("InvalidGroup.NotFound", "The security group '%v' does not exist", aws.StringValue(input.GroupId))
+	}
+	for _, permission := range input.IpPermissions {
+		if indexOfPermission(sg.IpPermissions, permission) < 0 {
+			return nil, newError("InvalidPermission.NotFound", "the specified rule does not exist in %v", aws.StringValue(input.GroupId))
+		}
+	}
+	for _, permission := range input.IpPermissions {
+		i := indexOfPermission(sg.IpPermissions, permission)
+		sg.IpPermissions = append(sg.IpPermissions[:i], sg.IpPermissions[i+1:]...)
+	}
+	return &ec2.RevokeSecurityGroupIngressOutput{}, nil
+}
+
+func (c *Cloud) DescribeNetworkInterfaces(ctx context.Context, input *ec2.DescribeNetworkInterfacesInput) ([]*ec2.NetworkInterface, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	eniIDs := aws.StringValueSlice(input.NetworkInterfaceIds)
+	var ids []string
+	for id := range c.networkInterfaces {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var result []*ec2.NetworkInterface
+	for _, id := range ids {
+		eni := c.networkInterfaces[id]
+		if len(eniIDs) != 0 && !containsString(eniIDs, id) {
+			continue
+		}
+		ok, err := matchesEC2Filters(input.Filters, eni.TagSet, func(name string) ([]string, bool) {
+			switch name {
+			case "vpc-id":
+				return []string{aws.StringValue(eni.VpcId)}, true
+			case "network-interface-id":
+				return []string{id}, true
+			case "group-id":
+				var groupIDs []string
+				for _, group := range eni.Groups {
+					groupIDs = append(groupIDs, aws.StringValue(group.GroupId))
+				}
+				return groupIDs, true
+			case "attachment.instance-id":
+				if eni.Attachment == nil {
+					return nil, true
+				}
+				return []string{aws.StringValue(eni.Attachment.InstanceId)}, true
+			case "addresses.private-ip-address":
+				var addresses []string
+				for _, address := range eni.PrivateIpAddresses {
+					addresses = append(addresses, aws.StringValue(address.PrivateIpAddress))
+				}
+				return addresses, true
+			}
+			return nil, false
+		})
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result = append(result, copyOf(eni).(*ec2.NetworkInterface))
+		}
+	}
+	return result, nil
+}
+
+func (c *Cloud) ModifyNetworkInterfaceAttributeWithContext(ctx context.Context, input *ec2.ModifyNetworkInterfaceAttributeInput) (*ec2.ModifyNetworkInterfaceAttributeOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	eni, ok := c.networkInterfaces[aws.StringValue(input.NetworkInterfaceId)]
+	if !ok {
+		return nil, newError("InvalidNetworkInterfaceID.NotFound", "The networkInterface ID '%v' does not exist", aws.StringValue(input.NetworkInterfaceId))
+	}
+	if input.Groups != nil {
+		var groups []*ec2.GroupIdentifier
+		for _, groupID := range aws.StringValueSlice(input.Groups) {
+			sg, ok := c.securityGroups[groupID]
+			if !ok {
+				return nil, newError("InvalidGroup.NotFound", "The security group '%v' does not exist", groupID)
+			}
+			groups = append(groups, &ec2.GroupIdentifier{GroupId: sg.GroupId, GroupName: sg.GroupName})
+		}
+		eni.Groups = groups
+	}
+	return &ec2.ModifyNetworkInterfaceAttributeOutput{}, nil
+}
+
+func (c *Cloud) CreateEC2TagsWithContext(ctx context.Context, input *ec2.CreateTagsInput) (*ec2.CreateTagsOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, id := range aws.StringValueSlice(input.Resources) {
+		tags, err := c.ec2Tags(id)
+		if err != nil {
+			return nil, err
+		}
+		tagMap := ec2TagMap(*tags)
+		for _, tag := range input.Tags {
+			tagMap[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+		*tags = toEC2Tags(tagMap)
+	}
+	return &ec2.CreateTagsOutput{}, nil
+}
+
+func (c *Cloud) DeleteEC2TagsWithContext(ctx context.Context, input *ec2.DeleteTagsInput) (*ec2.DeleteTagsOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, id := range aws.StringValueSlice(input.Resources) {
+		tags, err := c.ec2Tags(id)
+		if err != nil {
+			return nil, err
+		}
+		tagMap := ec2TagMap(*tags)
+		for _, tag := range input.Tags {
+			if tag.Value == nil || aws.StringValue(tag.Value) == tagMap[aws.StringValue(tag.Key)] {
+				delete(tagMap, aws.StringValue(tag.Key))
+			}
+		}
+		*tags = toEC2Tags(tagMap)
+	}
+	return &ec2.DeleteTagsOutput{}, nil
+}
+
+// ec2Tags returns the tags field of the EC2 resource with id.
+func (c *Cloud) ec2Tags(id string) (*[]*ec2.Tag, error) {
+	switch {
+	case strings.HasPrefix(id, "sg-"):
+		if sg, ok := c.securityGroups[id]; ok {
+			return &sg.Tags, nil
+		}
+	case strings.HasPrefix(id, "subnet-"):
+		if subnet, ok := c.subnets[id]; ok {
+			return &subnet.Tags, nil
+		}
+	case strings.HasPrefix(id, "i-"):
+		if instance, ok := c.instances[id]; ok {
+			return &instance.Tags, nil
+		}
+	case strings.HasPrefix(id, "eni-"):
+		if eni, ok := c.networkInterfaces[id]; ok {
+			return &eni.TagSet, nil
+		}
+	}
+	return nil, newError("InvalidID", "The ID '%v' is not valid", id)
+}
+
+func (c *Cloud) sortedSubnetIDs() []string {
+	var ids []string
+	for id := range c.subnets {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (c *Cloud) sortedSecurityGroupIDs() []string {
+	var ids []string
+	for id := range c.securityGroups {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func toEC2Tags(tagMap map[string]string) []*ec2.Tag {
+	var tags []*ec2.Tag
+	for _, k := range sortedKeys(tagMap) {
+		tags = append(tags, &ec2.Tag{Key: aws.String(k), Value: aws.String(tagMap[k])})
+	}
+	return tags
+}
+
+func indexOfPermission(permissions []*ec2.IpPermission, permission *ec2.IpPermission) int {
+	for i, p := range permissions {
+		if reflect.DeepEqual(p, permission) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,1061 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+)
+
+const canonicalHostedZoneID = "Z1H1FL5HABSF5"
+
+// The protocol versions of targetGroups.
+const (
+	protocolVersionHTTP1 = "HTTP1"
+	protocolVersionHTTP2 = "HTTP2"
+	protocolVersionGRPC  = "GRPC"
+)
+
+// defaultLBAttributes are the attributes of a newly created LoadBalancer.
+// The keys are spelled out rather than taken from the lb and tg packages, so that their tests can use Cloud.
+var defaultLBAttributes = map[string]string{
+	"deletion_protection.enabled":                     "false",
+	"access_logs.s3.enabled":                          "false",
+	"access_logs.s3.bucket":                           "",
+	"access_logs.s3.prefix":                           "",
+	"idle_timeout.timeout_seconds":                    "60",
+	"routing.http2.enabled":                           "true",
+	"routing.http.drop_invalid_header_fields.enabled": "false",
+	"routing.http.desync_mitigation_mode":             "defensive",
+	"zonal_shift.config.enabled":                      "false",
+	"waf.fail_open.enabled":                           "false",
+}
+
+// sslPolicies are the predefined security policies of HTTPS listeners.
+var sslPolicies = []string{
+	"ELBSecurityPolicy-2016-08",
+	"ELBSecurityPolicy-TLS-1-0-2015-04",
+	"ELBSecurityPolicy-TLS-1-1-2017-01",
+	"ELBSecurityPolicy-TLS-1-2-2017-01",
+	"ELBSecurityPolicy-TLS-1-2-Ext-2018-06",
+	"ELBSecurityPolicy-FS-2018-06",
+	"ELBSecurityPolicy-FS-1-2-Res-2020-10",
+	"ELBSecurityPolicy-TLS13-1-2-2021-06",
+}
+
+// defaultTGAttributes are the attributes of a newly created targetGroup.
+var defaultTGAttributes = map[string]string{
+	"deregistration_delay.timeout_seconds":   "300",
+	"slow_start.duration_seconds":            "0",
+	"stickiness.enabled":                     "false",
+	"stickiness.type":                        "lb_cookie",
+	"stickiness.lb_cookie.duration_seconds":  "86400",
+	"load_balancing.algorithm.type":          "round_robin",
+	"stickiness.app_cookie.cookie_name":      "",
+	"stickiness.app_cookie.duration_seconds": "86400",
+}
+
+// defaultAccountLimits are the ELBV2 limits of a new account.
+var defaultAccountLimits = map[string]int64{
+	"application-load-balancers":                  50,
+	"listeners-per-application-load-balancer":     50,
+	"rules-per-application-load-balancer":         100,
+	"target-groups":                               3000,
+	"target-groups-per-application-load-balancer": 100,
+	"targets-per-application-load-balancer":       1000,
+}
+
+func (c *Cloud) StatusELBV2() func() error {
+	return func() error { return nil }
+}
+
+func (c *Cloud) CreateLoadBalancerWithContext(ctx context.Context, input *elbv2.CreateLoadBalancerInput) (*elbv2.CreateLoadBalancerOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, lb := range c.loadBalancers {
+		if aws.StringValue(lb.LoadBalancerName) == aws.StringValue(input.Name) {
+			return nil, newError(elbv2.ErrCodeDuplicateLoadBalancerNameException, "A load balancer with the same name '%v' exists", aws.StringValue(input.Name))
+		}
+	}
+	azs, err := c.availabilityZones(input.Subnets, input.SubnetMappings)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.validateSecurityGroups(input.SecurityGroups); err != nil {
+		return nil, err
+	}
+
+	lb := &elbv2.LoadBalancer{
+		LoadBalancerName:      input.Name,
+		Type:                  input.Type,
+		Scheme:                input.Scheme,
+		IpAddressType:         input.IpAddressType,
+		SecurityGroups:        copyOf(input.SecurityGroups).([]*string),
+		AvailabilityZones:     azs,
+		VpcId:                 c.vpc.VpcId,
+		CanonicalHostedZoneId: aws.String(canonicalHostedZoneID),
+		CreatedTime:           aws.Time(time.Now()),
+		State:                 &elbv2.LoadBalancerState{Code: aws.String(elbv2.LoadBalancerStateEnumActive)},
+	}
+	if lb.Type == nil {
+		lb.Type = aws.String(elbv2.LoadBalancerTypeEnumApplication)
+	}
+	if lb.Scheme == nil {
+		lb.Scheme = aws.String(elbv2.LoadBalancerSchemeEnumInternetFacing)
+	}
+	if lb.IpAddressType == nil {
+		lb.IpAddressType = aws.String(elbv2.IpAddressTypeIpv4)
+	}
+	id := c.newID()
+	lbType := "app"
+	if aws.StringValue(lb.Type) == elbv2.LoadBalancerTypeEnumNetwork {
+		lbType = "net"
+	}
+	lb.LoadBalancerArn = aws.String(c.arn("elasticloadbalancing", fmt.Sprintf("loadbalancer/%v/%v/%v", lbType, aws.StringValue(input.Name), id)))
+	dnsName := fmt.Sprintf("%v-%v.%v.elb.%v", aws.StringValue(input.Name), id, c.region, c.dnsSuffix())
+	if aws.StringValue(lb.Scheme) == elbv2.LoadBalancerSchemeEnumInternal {
+		dnsName = "internal-" + dnsName
+	}
+	lb.DNSName = aws.String(dnsName)
+
+	lbArn := aws.StringValue(lb.LoadBalancerArn)
+	c.loadBalancers[lbArn] = lb
+	c.lbAttributes[lbArn] = copyStringMap(defaultLBAttributes)
+	c.elbv2Tags[lbArn] = elbv2TagMap(input.Tags)
+	return &elbv2.CreateLoadBalancerOutput{LoadBalancers: []*elbv2.LoadBalancer{copyOf(lb).(*elbv2.LoadBalancer)}}, nil
+}
+
+func (c *Cloud) GetLoadBalancerByArn(ctx context.Context, arn string) (*elbv2.LoadBalancer, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	lb, ok := c.loadBalancers[arn]
+	if !ok {
+		return nil, newError(elbv2.ErrCodeLoadBalancerNotFoundException, "Load balancer '%v' not found", arn)
+	}
+	return copyOf(lb).(*elbv2.LoadBalancer), nil
+}
+
+func (c *Cloud) GetLoadBalancerByName(ctx context.Context, name string) (*elbv2.LoadBalancer, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, lb := range c.loadBalancers {
+		if aws.StringValue(lb.LoadBalancerName) == name {
+			return copyOf(lb).(*elbv2.LoadBalancer), nil
+		}
+	}
+	return nil, nil
+}
+
+// DeleteLoadBalancerByArn deletes the LoadBalancer along with its listeners and rules.
+// It fails with ResourceInUse while the LoadBalancer is the target of an ALB-type targetGroup.
+func (c *Cloud) DeleteLoadBalancerByArn(ctx context.Context, arn string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.loadBalancers[arn]; !ok {
+		return nil
+	}
+	if c.lbAttributes[arn]["deletion_protection.enabled"] == "true" {
+		return newError(elbv2.ErrCodeOperationNotPermittedException, "Load balancer '%v' cannot be deleted because deletion protection is enabled", arn)
+	}
+	for tgArn, targets := range c.targets {
+		if aws.StringValue(c.targetGroups[tgArn].TargetType) == "alb" && len(targets) != 0 && aws.StringValue(targets[0].Id) == arn {
+			return newError(elbv2.ErrCodeResourceInUseException, "Load balancer '%v' is currently registered to target group '%v'", arn, tgArn)
+		}
+	}
+	for lsArn, ls := range c.listeners {
+		if aws.StringValue(ls.LoadBalancerArn) == arn {
+			c.deleteListener(lsArn)
+		}
+	}
+	delete(c.loadBalancers, arn)
+	delete(c.lbAttributes, arn)
+	delete(c.elbv2Tags, arn)
+	delete(c.webACLAssociation, arn)
+	delete(c.wafv2Association, arn)
+	delete(c.practiceRuns, arn)
+	delete(c.zonalAutoshift, arn)
+	return nil
+}
+
+func (c *Cloud) SetSecurityGroupsWithContext(ctx context.Context, input *elbv2.SetSecurityGroupsInput) (*elbv2.SetSecurityGroupsOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	lb, err := c.loadBalancer(aws.StringValue(input.LoadBalancerArn))
+	if err != nil {
+		return nil, err
+	}
+	if err := c.validateSecurityGroups(input.SecurityGroups); err != nil {
+		return nil, err
+	}
+	lb.SecurityGroups = copyOf(input.SecurityGroups).([]*string)
+	return &elbv2.SetSecurityGroupsOutput{SecurityGroupIds: copyOf(input.SecurityGroups).([]*string)}, nil
+}
+
+func (c *Cloud) SetIpAddressTypeWithContext(ctx context.Context, input *elbv2.SetIpAddressTypeInput) (*elbv2.SetIpAddressTypeOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	lb, err := c.loadBalancer(aws.StringValue(input.LoadBalancerArn))
+	if err != nil {
+		return nil, err
+	}
+	lb.IpAddressType = input.IpAddressType
+	return &elbv2.SetIpAddressTypeOutput{IpAddressType: input.IpAddressType}, nil
+}
+
+func (c *Cloud) SetSubnetsWithContext(ctx context.Context, input *elbv2.SetSubnetsInput) (*elbv2.SetSubnetsOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	lb, err := c.loadBalancer(aws.StringValue(input.LoadBalancerArn))
+	if err != nil {
+		return nil, err
+	}
+	azs, err := c.availabilityZones(input.Subnets, input.SubnetMappings)
+	if err != nil {
+		return nil, err
+	}
+	lb.AvailabilityZones = azs
+	return &elbv2.SetSubnetsOutput{AvailabilityZones: copyOf(azs).([]*elbv2.AvailabilityZone)}, nil
+}
+
+func (c *Cloud) DescribeSSLPoliciesWithContext(ctx context.Context, input *elbv2.DescribeSSLPoliciesInput) (*elbv2.DescribeSSLPoliciesOutput, error) {
+	names := aws.StringValueSlice(input.Names)
+	if len(names) == 0 {
+		names = sslPolicies
+	}
+	output := &elbv2.DescribeSSLPoliciesOutput{}
+	for _, name := range names {
+		if !containsString(sslPolicies, name) {
+			return nil, newError(elbv2.ErrCodeSSLPolicyNotFoundException, "SSL policy '%v' not found", name)
+		}
+		output.SslPolicies = append(output.SslPolicies, &elbv2.SslPolicy{Name: aws.String(name)})
+	}
+	return output, nil
+}
+
+func (c *Cloud) DescribeLoadBalancerAttributesWithContext(ctx context.Context, input *elbv2.DescribeLoadBalancerAttributesInput) (*elbv2.DescribeLoadBalancerAttributesOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	attrs, ok := c.lbAttributes[aws.StringValue(input.LoadBalancerArn)]
+	if !ok {
+		return nil, newError(elbv2.ErrCodeLoadBalancerNotFoundException, "Load balancer '%v' not found", aws.StringValue(input.LoadBalancerArn))
+	}
+	output := &elbv2.DescribeLoadBalancerAttributesOutput{}
+	for _, k := range sortedKeys(attrs) {
+		output.Attributes = append(output.Attributes, &elbv2.LoadBalancerAttribute{Key: aws.String(k), Value: aws.String(attrs[k])})
+	}
+	return output, nil
+}
+
+func (c *Cloud) ModifyLoadBalancerAttributesWithContext(ctx context.Context, input *elbv2.ModifyLoadBalancerAttributesInput) (*elbv2.ModifyLoadBalancerAttributesOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	attrs, ok := c.lbAttributes[aws.StringValue(input.LoadBalancerArn)]
+	if !ok {
+		return nil, newError(elbv2.ErrCodeLoadBalancerNotFoundException, "Load balancer '%v' not found", aws.StringValue(input.LoadBalancerArn))
+	}
+	for _, attr := range input.Attributes {
+		if _, ok := defaultLBAttributes[aws.StringValue(attr.Key)]; !ok {
+			return nil, newError(elbv2.ErrCodeInvalidConfigurationRequestException, "Load balancer attribute key '%v' is not recognized", aws.StringValue(attr.Key))
+		}
+	}
+	for _, attr := range input.Attributes {
+		attrs[aws.StringValue(attr.Key)] = aws.StringValue(attr.Value)
+	}
+	return &elbv2.ModifyLoadBalancerAttributesOutput{Attributes: copyOf(input.Attributes).([]*elbv2.LoadBalancerAttribute)}, nil
+}
+
+func (c *Cloud) CreateTargetGroupWithContext(ctx context.Context, input *elbv2.CreateTargetGroupInput) (*elbv2.CreateTargetGroupOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, tg := range c.targetGroups {
+		if aws.StringValue(tg.TargetGroupName) == aws.StringValue(input.Name) {
+			return nil, newError(elbv2.ErrCodeDuplicateTargetGroupNameException, "A target group with the same name '%v' exists", aws.StringValue(input.Name))
+		}
+	}
+	tg := &elbv2.TargetGroup{
+		TargetGroupName:            input.Name,
+		Port:                       input.Port,
+		Protocol:                   input.Protocol,
+		TargetType:                 input.TargetType,
+		VpcId:                      input.VpcId,
+		HealthCheckEnabled:         aws.Bool(true),
+		HealthCheckIntervalSeconds: aws.Int64(30),
+		HealthCheckPath:            aws.String("/"),
+		HealthCheckPort:            aws.String("traffic-port"),
+		HealthCheckProtocol:        input.Protocol,
+		HealthCheckTimeoutSeconds:  aws.Int64(5),
+		HealthyThresholdCount:      aws.Int64(5),
+		UnhealthyThresholdCount:    aws.Int64(2),
+		Matcher:                    &elbv2.Matcher{HttpCode: aws.String("200")},
+	}
+	if tg.TargetType == nil {
+		tg.TargetType = aws.String(elbv2.TargetTypeEnumInstance)
+	}
+	if tg.VpcId == nil {
+		tg.VpcId = c.vpc.VpcId
+	}
+	// the protocol version of HTTP and HTTPS targetGroups defaults to HTTP1, gRPC ones are matched on grpc-status codes.
+	if protocol := aws.StringValue(input.Protocol); protocol == elbv2.ProtocolEnumHttp || protocol == elbv2.ProtocolEnumHttps {
+		tg.ProtocolVersion = aws.String(protocolVersionHTTP1)
+		if input.ProtocolVersion != nil {
+			tg.ProtocolVersion = input.ProtocolVersion
+		}
+	}
+	if aws.StringValue(tg.ProtocolVersion) == protocolVersionGRPC {
+		tg.Matcher = &elbv2.Matcher{GrpcCode: aws.String("12")}
+	}
+	modifyTargetGroup(tg, &elbv2.ModifyTargetGroupInput{
+		HealthCheckEnabled:         input.HealthCheckEnabled,
+		HealthCheckIntervalSeconds: input.HealthCheckIntervalSeconds,
+		HealthCheckPath:            input.HealthCheckPath,
+		HealthCheckPort:            input.HealthCheckPort,
+		HealthCheckProtocol:        input.HealthCheckProtocol,
+		HealthCheckTimeoutSeconds:  input.HealthCheckTimeoutSeconds,
+		HealthyThresholdCount:      input.HealthyThresholdCount,
+		Matcher:                    input.Matcher,
+		UnhealthyThresholdCount:    input.UnhealthyThresholdCount,
+	})
+	if err := validateProtocolVersionMatcher(tg); err != nil {
+		return nil, err
+	}
+	tg.TargetGroupArn = aws.String(c.arn("elasticloadbalancing", fmt.Sprintf("targetgroup/%v/%v", aws.StringValue(input.Name), c.newID())))
+
+	tgArn := aws.StringValue(tg.TargetGroupArn)
+	c.targetGroups[tgArn] = tg
+	c.tgAttributes[tgArn] = copyStringMap(defaultTGAttributes)
+	c.elbv2Tags[tgArn] = make(map[string]string)
+	return &elbv2.CreateTargetGroupOutput{TargetGroups: []*elbv2.TargetGroup{c.describeTargetGroup(tg)}}, nil
+}
+
+func (c *Cloud) GetTargetGroupByArn(ctx context.Context, arn string) (*elbv2.TargetGroup, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tg, ok := c.targetGroups[arn]
+	if !ok {
+		return nil, newError(elbv2.ErrCodeTargetGroupNotFoundException, "Target group '%v' not found", arn)
+	}
+	return c.describeTargetGroup(tg), nil
+}
+
+func (c *Cloud) GetTargetGroupByName(ctx context.Context, name string) (*elbv2.TargetGroup, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, tg := range c.targetGroups {
+		if aws.StringValue(tg.TargetGroupName) == name {
+			return c.describeTargetGroup(tg), nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *Cloud) ModifyTargetGroupWithContext(ctx context.Context, input *elbv2.ModifyTargetGroupInput) (*elbv2.ModifyTargetGroupOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tg, err := c.targetGroup(aws.StringValue(input.TargetGroupArn))
+	if err != nil {
+		return nil, err
+	}
+	modified := copyOf(tg).(*elbv2.TargetGroup)
+	modifyTargetGroup(modified, input)
+	if err := validateProtocolVersionMatcher(modified); err != nil {
+		return nil, err
+	}
+	modifyTargetGroup(tg, input)
+	return &elbv2.ModifyTargetGroupOutput{TargetGroups: []*elbv2.TargetGroup{c.describeTargetGroup(tg)}}, nil
+}
+
+// DeleteTargetGroupByArn fails with ResourceInUse while the targetGroup is referenced by a listener or rule.
+func (c *Cloud) DeleteTargetGroupByArn(ctx context.Context, arn string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.targetGroups[arn]; !ok {
+		return nil
+	}
+	if len(c.targetGroupLoadBalancers(arn)) != 0 {
+		return newError(elbv2.ErrCodeResourceInUseException, "Target group '%v' is currently in use by a listener or a rule", arn)
+	}
+	delete(c.targetGroups, arn)
+	delete(c.tgAttributes, arn)
+	delete(c.targets, arn)
+	delete(c.targetHealth, arn)
+	delete(c.elbv2Tags, arn)
+	return nil
+}
+
+func (c *Cloud) DescribeTargetGroupAttributesWithContext(ctx context.Context, input *elbv2.DescribeTargetGroupAttributesInput) (*elbv2.DescribeTargetGroupAttributesOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	attrs, ok := c.tgAttributes[aws.StringValue(input.TargetGroupArn)]
+	if !ok {
+		return nil, newError(elbv2.ErrCodeTargetGroupNotFoundException, "Target group '%v' not found", aws.StringValue(input.TargetGroupArn))
+	}
+	output := &elbv2.DescribeTargetGroupAttributesOutput{}
+	for _, k := range sortedKeys(attrs) {
+		output.Attributes = append(output.Attributes, &elbv2.TargetGroupAttribute{Key: aws.String(k), Value: aws.String(attrs[k])})
+	}
+	return output, nil
+}
+
+func (c *Cloud) ModifyTargetGroupAttributesWithContext(ctx context.Context, input *elbv2.ModifyTargetGroupAttributesInput) (*elbv2.ModifyTargetGroupAttributesOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	attrs, ok := c.tgAttributes[aws.StringValue(input.TargetGroupArn)]
+	if !ok {
+		return nil, newError(elbv2.ErrCodeTargetGroupNotFoundException, "Target group '%v' not found", aws.StringValue(input.TargetGroupArn))
+	}
+	for _, attr := range input.Attributes {
+		attrs[aws.StringValue(attr.Key)] = aws.StringValue(attr.Value)
+	}
+	return &elbv2.ModifyTargetGroupAttributesOutput{Attributes: copyOf(input.Attributes).([]*elbv2.TargetGroupAttribute)}, nil
+}
+
+func (c *Cloud) RegisterTargetsWithContext(ctx context.Context, input *elbv2.RegisterTargetsInput) (*elbv2.RegisterTargetsOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tgArn := aws.StringValue(input.TargetGroupArn)
+	tg, err := c.targetGroup(tgArn)
+	if err != nil {
+		return nil, err
+	}
+	for _, target := range input.Targets {
+		target = copyOf(target).(*elbv2.TargetDescription)
+		if target.Port == nil {
+			target.Port = tg.Port
+		}
+		if aws.StringValue(tg.TargetType) == elbv2.TargetTypeEnumInstance {
+			if _, ok := c.instances[aws.StringValue(target.Id)]; !ok {
+				return nil, newError(elbv2.ErrCodeInvalidTargetException, "The following targets are not in a running state and cannot be registered: '%v'", aws.StringValue(target.Id))
+			}
+		}
+		if aws.StringValue(tg.TargetType) == "alb" {
+			lb, ok := c.loadBalancers[aws.StringValue(target.Id)]
+			if !ok || aws.StringValue(lb.Type) != elbv2.LoadBalancerTypeEnumApplication {
+				return nil, newError(elbv2.ErrCodeInvalidTargetException, "The following targets are not application load balancers: '%v'", aws.StringValue(target.Id))
+			}
+			if len(c.targets[tgArn]) != 0 && indexOfTarget(c.targets[tgArn], target) < 0 {
+				return nil, newError(elbv2.ErrCodeTooManyTargetsException, "Target group '%v' can only have one target", tgArn)
+			}
+		}
+		if indexOfTarget(c.targets[tgArn], target) < 0 {
+			c.targets[tgArn] = append(c.targets[tgArn], target)
+		}
+	}
+	return &elbv2.RegisterTargetsOutput{}, nil
+}
+
+func (c *Cloud) DeregisterTargetsWithContext(ctx context.Context, input *elbv2.DeregisterTargetsInput) (*elbv2.DeregisterTargetsOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tgArn := aws.StringValue(input.TargetGroupArn)
+	tg, err := c.targetGroup(tgArn)
+	if err != nil {
+		return nil, err
+	}
+	for _, target := range input.Targets {
+		target = copyOf(target).(*elbv2.TargetDescription)
+		if target.Port == nil {
+			target.Port = tg.Port
+		}
+		if i := indexOfTarget(c.targets[tgArn], target); i >= 0 {
+			c.targets[tgArn] = append(c.targets[tgArn][:i], c.targets[tgArn][i+1:]...)
+		}
+	}
+	return &elbv2.DeregisterTargetsOutput{}, nil
+}
+
+// DescribeTargetHealthWithContext reports registered targets as healthy, unless set otherwise with SetTargetHealth.
+func (c *Cloud) DescribeTargetHealthWithContext(ctx context.Context, input *elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tgArn := aws.StringValue(input.TargetGroupArn)
+	tg, err := c.targetGroup(tgArn)
+	if err != nil {
+		return nil, err
+	}
+	targets := c.targets[tgArn]
+	if len(input.Targets) != 0 {
+		targets = input.Targets
+	}
+
+	output := &elbv2.DescribeTargetHealthOutput{}
+	for _, target := range targets {
+		target = copyOf(target).(*elbv2.TargetDescription)
+		if target.Port == nil {
+			target.Port = tg.Port
+		}
+		health := &elbv2.TargetHealth{State: aws.String(elbv2.TargetHealthStateEnumHealthy)}
+		if indexOfTarget(c.targets[tgArn], target) < 0 {
+			health = &elbv2.TargetHealth{
+				State:  aws.String(elbv2.TargetHealthStateEnumUnused),
+				Reason: aws.String(elbv2.TargetHealthReasonEnumTargetNotRegistered),
+			}
+		} else if state, ok := c.targetHealth[tgArn][aws.StringValue(target.Id)]; ok {
+			health.State = aws.String(state)
+		}
+		output.TargetHealthDescriptions = append(output.TargetHealthDescriptions, &elbv2.TargetHealthDescription{
+			Target:          target,
+			HealthCheckPort: aws.String(strconv.FormatInt(aws.Int64Value(target.Port), 10)),
+			TargetHealth:    health,
+		})
+	}
+	return output, nil
+}
+
+func (c *Cloud) CreateListenerWithContext(ctx context.Context, input *elbv2.CreateListenerInput) (*elbv2.CreateListenerOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	lb, err := c.loadBalancer(aws.StringValue(input.LoadBalancerArn))
+	if err != nil {
+		return nil, err
+	}
+	for _, ls := range c.listeners {
+		if aws.StringValue(ls.LoadBalancerArn) == aws.StringValue(input.LoadBalancerArn) && aws.Int64Value(ls.Port) == aws.Int64Value(input.Port) {
+			return nil, newError(elbv2.ErrCodeDuplicateListenerException, "A listener already exists on port %v", aws.Int64Value(input.Port))
+		}
+	}
+	ls := &elbv2.Listener{
+		LoadBalancerArn: input.LoadBalancerArn,
+		Port:            input.Port,
+		Protocol:        input.Protocol,
+		SslPolicy:       input.SslPolicy,
+		Certificates:    copyOf(input.Certificates).([]*elbv2.Certificate),
+		DefaultActions:  copyOf(input.DefaultActions).([]*elbv2.Action),
+	}
+	ls.MutualAuthentication = mutualAuthentication(ls, input.MutualAuthentication)
+	if err := c.validateListener(ls); err != nil {
+		return nil, err
+	}
+	ls.ListenerArn = aws.String(strings.Replace(aws.StringValue(lb.LoadBalancerArn), ":loadbalancer/", ":listener/", 1) + "/" + c.newID())
+	c.listeners[aws.StringValue(ls.ListenerArn)] = ls
+	c.elbv2Tags[aws.StringValue(ls.ListenerArn)] = make(map[string]string)
+	return &elbv2.CreateListenerOutput{Listeners: []*elbv2.Listener{copyOf(ls).(*elbv2.Listener)}}, nil
+}
+
+func (c *Cloud) ModifyListenerWithContext(ctx context.Context, input *elbv2.ModifyListenerInput) (*elbv2.ModifyListenerOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	current, err := c.listener(aws.StringValue(input.ListenerArn))
+	if err != nil {
+		return nil, err
+	}
+	ls := copyOf(current).(*elbv2.Listener)
+	if input.Port != nil {
+		ls.Port = input.Port
+	}
+	if input.Protocol != nil {
+		ls.Protocol = input.Protocol
+	}
+	if input.SslPolicy != nil {
+		ls.SslPolicy = input.SslPolicy
+	}
+	if input.Certificates != nil {
+		ls.Certificates = copyOf(input.Certificates).([]*elbv2.Certificate)
+	}
+	if input.DefaultActions != nil {
+		ls.DefaultActions = copyOf(input.DefaultActions).([]*elbv2.Action)
+	}
+	ls.MutualAuthentication = mutualAuthentication(ls, input.MutualAuthentication)
+	if err := c.validateListener(ls); err != nil {
+		return nil, err
+	}
+	c.listeners[aws.StringValue(ls.ListenerArn)] = ls
+	return &elbv2.ModifyListenerOutput{Listeners: []*elbv2.Listener{copyOf(ls).(*elbv2.Listener)}}, nil
+}
+
+func (c *Cloud) ListListenersByLoadBalancer(ctx context.Context, lbArn string) ([]*elbv2.Listener, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, err := c.loadBalancer(lbArn); err != nil {
+		return nil, err
+	}
+	var result []*elbv2.Listener
+	for _, ls := range c.listeners {
+		if aws.StringValue(ls.LoadBalancerArn) == lbArn {
+			result = append(result, copyOf(ls).(*elbv2.Listener))
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return aws.Int64Value(result[i].Port) < aws.Int64Value(result[j].Port) })
+	return result, nil
+}
+
+func (c *Cloud) DeleteListenersByArn(ctx context.Context, lsArn string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, err := c.listener(lsArn); err != nil {
+		return err
+	}
+	c.deleteListener(lsArn)
+	return nil
+}
+
+// DescribeListenerCertificates returns the listener's default certificate, followed by the ones added with AddListenerCertificates.
+func (c *Cloud) DescribeListenerCertificates(ctx context.Context, lsArn string) ([]*elbv2.Certificate, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ls, err := c.listener(lsArn)
+	if err != nil {
+		return nil, err
+	}
+	var result []*elbv2.Certificate
+	for _, cert := range ls.Certificates {
+		result = append(result, &elbv2.Certificate{CertificateArn: cert.CertificateArn, IsDefault: aws.Bool(true)})
+	}
+	for _, cert := range c.listenerCertificates[lsArn] {
+		result = append(result, &elbv2.Certificate{CertificateArn: cert.CertificateArn, IsDefault: aws.Bool(false)})
+	}
+	return result, nil
+}
+
+func (c *Cloud) AddListenerCertificates(ctx context.Context, input *elbv2.AddListenerCertificatesInput) (*elbv2.AddListenerCertificatesOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	lsArn := aws.StringValue(input.ListenerArn)
+	if _, err := c.listener(lsArn); err != nil {
+		return nil, err
+	}
+	for _, cert := range input.Certificates {
+		if indexOfCertificate(c.listenerCertificates[lsArn], aws.StringValue(cert.CertificateArn)) < 0 {
+			c.listenerCertificates[lsArn] = append(c.listenerCertificates[lsArn], copyOf(cert).(*elbv2.Certificate))
+		}
+	}
+	return &elbv2.AddListenerCertificatesOutput{Certificates: copyOf(c.listenerCertificates[lsArn]).([]*elbv2.Certificate)}, nil
+}
+
+func (c *Cloud) RemoveListenerCertificates(ctx context.Context, input *elbv2.RemoveListenerCertificatesInput) (*elbv2.RemoveListenerCertificatesOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	lsArn := aws.StringValue(input.ListenerArn)
+	if _, err := c.listener(lsArn); err != nil {
+		return nil, err
+	}
+	for _, cert := range input.Certificates {
+		if i := indexOfCertificate(c.listenerCertificates[lsArn], aws.StringValue(cert.CertificateArn)); i >= 0 {
+			c.listenerCertificates[lsArn] = append(c.listenerCertificates[lsArn][:i], c.listenerCertificates[lsArn][i+1:]...)
+		}
+	}
+	return &elbv2.RemoveListenerCertificatesOutput{}, nil
+}
+
+// GetRules returns the listener's rules ordered by priority, followed by its default rule.
+func (c *Cloud) GetRules(ctx context.Context, lsArn string) ([]*elbv2.Rule, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ls, err := c.listener(lsArn)
+	if err != nil {
+		return nil, err
+	}
+	var result []*elbv2.Rule
+	for ruleArn, rule := range c.rules {
+		if c.ruleListeners[ruleArn] == lsArn {
+			result = append(result, copyOf(rule).(*elbv2.Rule))
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return rulePriority(result[i]) < rulePriority(result[j]) })
+	result = append(result, &elbv2.Rule{
+		RuleArn:    aws.String(strings.Replace(lsArn, ":listener/", ":listener-rule/", 1) + "/default"),
+		Priority:   aws.String("default"),
+		IsDefault:  aws.Bool(true),
+		Conditions: []*elbv2.RuleCondition{},
+		Actions:    copyOf(ls.DefaultActions).([]*elbv2.Action),
+	})
+	return result, nil
+}
+
+func (c *Cloud) CreateRuleWithContext(ctx context.Context, input *elbv2.CreateRuleInput) (*elbv2.CreateRuleOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	lsArn := aws.StringValue(input.ListenerArn)
+	if _, err := c.listener(lsArn); err != nil {
+		return nil, err
+	}
+	priority := strconv.FormatInt(aws.Int64Value(input.Priority), 10)
+	for ruleArn, rule := range c.rules {
+		if c.ruleListeners[ruleArn] == lsArn && aws.StringValue(rule.Priority) == priority {
+			return nil, newError(elbv2.ErrCodePriorityInUseException, "Priority '%v' is currently in use", priority)
+		}
+	}
+	if err := c.validateActions(input.Actions); err != nil {
+		return nil, err
+	}
+	rule := &elbv2.Rule{
+		RuleArn:    aws.String(strings.Replace(lsArn, ":listener/", ":listener-rule/", 1) + "/" + c.newID()),
+		Priority:   aws.String(priority),
+		IsDefault:  aws.Bool(false),
+		Conditions: copyOf(input.Conditions).([]*elbv2.RuleCondition),
+		Actions:    copyOf(input.Actions).([]*elbv2.Action),
+	}
+	c.rules[aws.StringValue(rule.RuleArn)] = rule
+	c.ruleListeners[aws.StringValue(rule.RuleArn)] = lsArn
+	return &elbv2.CreateRuleOutput{Rules: []*elbv2.Rule{copyOf(rule).(*elbv2.Rule)}}, nil
+}
+
+func (c *Cloud) ModifyRuleWithContext(ctx context.Context, input *elbv2.ModifyRuleInput) (*elbv2.ModifyRuleOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	rule, ok := c.rules[aws.StringValue(input.RuleArn)]
+	if !ok {
+		return nil, newError(elbv2.ErrCodeRuleNotFoundException, "One or more rules not found")
+	}
+	if err := c.validateActions(input.Actions); err != nil {
+		return nil, err
+	}
+	if input.Conditions != nil {
+		rule.Conditions = copyOf(input.Conditions).([]*elbv2.RuleCondition)
+	}
+	if input.Actions != nil {
+		rule.Actions = copyOf(input.Actions).([]*elbv2.Action)
+	}
+	return &elbv2.ModifyRuleOutput{Rules: []*elbv2.Rule{copyOf(rule).(*elbv2.Rule)}}, nil
+}
+
+func (c *Cloud) DeleteRuleWithContext(ctx context.Context, input *elbv2.DeleteRuleInput) (*elbv2.DeleteRuleOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ruleArn := aws.StringValue(input.RuleArn)
+	if _, ok := c.rules[ruleArn]; !ok {
+		return nil, newError(elbv2.ErrCodeRuleNotFoundException, "One or more rules not found")
+	}
+	delete(c.rules, ruleArn)
+	delete(c.ruleListeners, ruleArn)
+	return &elbv2.DeleteRuleOutput{}, nil
+}
+
+func (c *Cloud) DescribeELBV2TagsWithContext(ctx context.Context, input *elbv2.DescribeTagsInput) (*elbv2.DescribeTagsOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	output := &elbv2.DescribeTagsOutput{}
+	for _, arn := range aws.StringValueSlice(input.ResourceArns) {
+		tags, err := c.elbv2TagsOf(arn)
+		if err != nil {
+			return nil, err
+		}
+		desc := &elbv2.TagDescription{ResourceArn: aws.String(arn)}
+		for _, k := range sortedKeys(tags) {
+			desc.Tags = append(desc.Tags, &elbv2.Tag{Key: aws.String(k), Value: aws.String(tags[k])})
+		}
+		output.TagDescriptions = append(output.TagDescriptions, desc)
+	}
+	return output, nil
+}
+
+func (c *Cloud) AddELBV2TagsWithContext(ctx context.Context, input *elbv2.AddTagsInput) (*elbv2.AddTagsOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, arn := range aws.StringValueSlice(input.ResourceArns) {
+		tags, err := c.elbv2TagsOf(arn)
+		if err != nil {
+			return nil, err
+		}
+		for _, tag := range input.Tags {
+			tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+	}
+	return &elbv2.AddTagsOutput{}, nil
+}
+
+func (c *Cloud) RemoveELBV2TagsWithContext(ctx context.Context, input *elbv2.RemoveTagsInput) (*elbv2.RemoveTagsOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, arn := range aws.StringValueSlice(input.ResourceArns) {
+		tags, err := c.elbv2TagsOf(arn)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range aws.StringValueSlice(input.TagKeys) {
+			delete(tags, key)
+		}
+	}
+	return &elbv2.RemoveTagsOutput{}, nil
+}
+
+func (c *Cloud) loadBalancer(arn string) (*elbv2.LoadBalancer, error) {
+	lb, ok := c.loadBalancers[arn]
+	if !ok {
+		return nil, newError(elbv2.ErrCodeLoadBalancerNotFoundException, "Load balancer '%v' not found", arn)
+	}
+	return lb, nil
+}
+
+func (c *Cloud) targetGroup(arn string) (*elbv2.TargetGroup, error) {
+	tg, ok := c.targetGroups[arn]
+	if !ok {
+		return nil, newError(elbv2.ErrCodeTargetGroupNotFoundException, "Target group '%v' not found", arn)
+	}
+	return tg, nil
+}
+
+func (c *Cloud) listener(arn string) (*elbv2.Listener, error) {
+	ls, ok := c.listeners[arn]
+	if !ok {
+		return nil, newError(elbv2.ErrCodeListenerNotFoundException, "Listener '%v' not found", arn)
+	}
+	return ls, nil
+}
+
+func (c *Cloud) elbv2TagsOf(arn string) (map[string]string, error) {
+	tags, ok := c.elbv2Tags[arn]
+	if !ok {
+		if strings.Contains(arn, ":targetgroup/") {
+			return nil, newError(elbv2.ErrCodeTargetGroupNotFoundException, "Target group '%v' not found", arn)
+		}
+		return nil, newError(elbv2.ErrCodeLoadBalancerNotFoundException, "Load balancer '%v' not found", arn)
+	}
+	return tags, nil
+}
+
+func (c *Cloud) deleteListener(lsArn string) {
+	for ruleArn, owner := range c.ruleListeners {
+		if owner == lsArn {
+			delete(c.rules, ruleArn)
+			delete(c.ruleListeners, ruleArn)
+		}
+	}
+	delete(c.listeners, lsArn)
+	delete(c.listenerCertificates, lsArn)
+	delete(c.elbv2Tags, lsArn)
+}
+
+// describeTargetGroup copies tg, filling in the LoadBalancers which route to it.
+func (c *Cloud) describeTargetGroup(tg *elbv2.TargetGroup) *elbv2.TargetGroup {
+	result := copyOf(tg).(*elbv2.TargetGroup)
+	result.LoadBalancerArns = aws.StringSlice(c.targetGroupLoadBalancers(aws.StringValue(tg.TargetGroupArn)))
+	return result
+}
+
+func (c *Cloud) targetGroupLoadBalancers(tgArn string) []string {
+	lbArns := make(map[string]string)
+	for lsArn, ls := range c.listeners {
+		if containsString(forwardedTargetGroups(ls.DefaultActions), tgArn) {
+			lbArns[aws.StringValue(ls.LoadBalancerArn)] = lsArn
+		}
+	}
+	for ruleArn, rule := range c.rules {
+		if containsString(forwardedTargetGroups(rule.Actions), tgArn) {
+			ls := c.listeners[c.ruleListeners[ruleArn]]
+			lbArns[aws.StringValue(ls.LoadBalancerArn)] = ruleArn
+		}
+	}
+	return sortedKeys(lbArns)
+}
+
+func (c *Cloud) validateListener(ls *elbv2.Listener) error {
+	if aws.StringValue(ls.Protocol) == elbv2.ProtocolEnumHttps && len(ls.Certificates) == 0 {
+		return newError(elbv2.ErrCodeCertificateNotFoundException, "A certificate must be specified for HTTPS listeners")
+	}
+	if err := validateMutualAuthentication(ls); err != nil {
+		return err
+	}
+	return c.validateActions(ls.DefaultActions)
+}
+
+// mutualAuthentication returns the mutual authentication of ls once modified to input, HTTPS listeners whose mutual
+// authentication was never modified have the mode off.
+func mutualAuthentication(ls *elbv2.Listener, input *elbv2.MutualAuthenticationAttributes) *elbv2.MutualAuthenticationAttributes {
+	if input != nil {
+		return &elbv2.MutualAuthenticationAttributes{
+			Mode:                          input.Mode,
+			TrustStoreArn:                 input.TrustStoreArn,
+			IgnoreClientCertificateExpiry: aws.Bool(aws.BoolValue(input.IgnoreClientCertificateExpiry)),
+		}
+	}
+	if aws.StringValue(ls.Protocol) != elbv2.ProtocolEnumHttps {
+		return nil
+	}
+	if ls.MutualAuthentication != nil {
+		return ls.MutualAuthentication
+	}
+	return &elbv2.MutualAuthenticationAttributes{Mode: aws.String("off"), IgnoreClientCertificateExpiry: aws.Bool(false)}
+}
+
+func validateMutualAuthentication(ls *elbv2.Listener) error {
+	attributes := ls.MutualAuthentication
+	if attributes == nil {
+		return nil
+	}
+	if aws.StringValue(ls.Protocol) != elbv2.ProtocolEnumHttps {
+		return newError(elbv2.ErrCodeInvalidConfigurationRequestException, "Mutual authentication is only supported on HTTPS listeners")
+	}
+	mode := aws.StringValue(attributes.Mode)
+	if mode == "verify" && attributes.TrustStoreArn == nil {
+		return newError(elbv2.ErrCodeInvalidConfigurationRequestException, "A trust store must be specified in the verify mode")
+	}
+	if mode != "verify" && (attributes.TrustStoreArn != nil || aws.BoolValue(attributes.IgnoreClientCertificateExpiry)) {
+		return newError(elbv2.ErrCodeInvalidConfigurationRequestException, "A trust store can only be specified in the verify mode")
+	}
+	return nil
+}
+
+func (c *Cloud) validateActions(actions []*elbv2.Action) error {
+	for _, tgArn := range forwardedTargetGroups(actions) {
+		if _, ok := c.targetGroups[tgArn]; !ok {
+			return newError(elbv2.ErrCodeTargetGroupNotFoundException, "Target group '%v' not found", tgArn)
+		}
+	}
+	return nil
+}
+
+func (c *Cloud) validateSecurityGroups(groupIDs []*string) error {
+	for _, groupID := range aws.StringValueSlice(groupIDs) {
+		if _, ok := c.securityGroups[groupID]; !ok {
+			return newError(elbv2.ErrCodeInvalidSecurityGroupException, "Security group '%v' does not exist", groupID)
+		}
+	}
+	return nil
+}
+
+func (c *Cloud) availabilityZones(subnetIDs []*string, mappings []*elbv2.SubnetMapping) ([]*elbv2.AvailabilityZone, error) {
+	for _, id := range subnetIDs {
+		mappings = append(mappings, &elbv2.SubnetMapping{SubnetId: id})
+	}
+	var azs []*elbv2.AvailabilityZone
+	for _, mapping := range mappings {
+		subnet, ok := c.subnets[aws.StringValue(mapping.SubnetId)]
+		if !ok {
+			return nil, newError(elbv2.ErrCodeSubnetNotFoundException, "The subnet ID '%v' is not valid", aws.StringValue(mapping.SubnetId))
+		}
+		az := &elbv2.AvailabilityZone{SubnetId: subnet.SubnetId, ZoneName: subnet.AvailabilityZone}
+		if mapping.AllocationId != nil {
+			az.LoadBalancerAddresses = []*elbv2.LoadBalancerAddress{{AllocationId: mapping.AllocationId}}
+		}
+		azs = append(azs, az)
+	}
+	return azs, nil
+}
+
+// validateProtocolVersionMatcher rejects the HttpCode of gRPC targetGroups, and the GrpcCode of the others.
+func validateProtocolVersionMatcher(tg *elbv2.TargetGroup) error {
+	matcher := tg.Matcher
+	if matcher == nil {
+		matcher = &elbv2.Matcher{}
+	}
+	switch protocolVersion := aws.StringValue(tg.ProtocolVersion); protocolVersion {
+	case protocolVersionGRPC:
+		if matcher.HttpCode != nil || matcher.GrpcCode == nil {
+			return newError("ValidationError", "A gRPC code must be specified for gRPC target groups, and HTTP codes cannot be")
+		}
+	case "", protocolVersionHTTP1, protocolVersionHTTP2:
+		if matcher.GrpcCode != nil {
+			return newError("ValidationError", "A gRPC code can only be specified for gRPC target groups")
+		}
+	default:
+		return newError("ValidationError", "Protocol version '%v' is not supported", protocolVersion)
+	}
+	return nil
+}
+
+func modifyTargetGroup(tg *elbv2.TargetGroup, input *elbv2.ModifyTargetGroupInput) {
+	if input.HealthCheckEnabled != nil {
+		tg.HealthCheckEnabled = input.HealthCheckEnabled
+	}
+	if input.HealthCheckIntervalSeconds != nil {
+		tg.HealthCheckIntervalSeconds = input.HealthCheckIntervalSeconds
+	}
+	if input.HealthCheckPath != nil {
+		tg.HealthCheckPath = input.HealthCheckPath
+	}
+	if input.HealthCheckPort != nil {
+		tg.HealthCheckPort = input.HealthCheckPort
+	}
+	if input.HealthCheckProtocol != nil {
+		tg.HealthCheckProtocol = input.HealthCheckProtocol
+	}
+	if input.HealthCheckTimeoutSeconds != nil {
+		tg.HealthCheckTimeoutSeconds = input.HealthCheckTimeoutSeconds
+	}
+	if input.HealthyThresholdCount != nil {
+		tg.HealthyThresholdCount = input.HealthyThresholdCount
+	}
+	if input.Matcher != nil {
+		tg.Matcher = copyOf(input.Matcher).(*elbv2.Matcher)
+	}
+	if input.UnhealthyThresholdCount != nil {
+		tg.UnhealthyThresholdCount = input.UnhealthyThresholdCount
+	}
+}
+
+func forwardedTargetGroups(actions []*elbv2.Action) []string {
+	var tgArns []string
+	for _, action := range actions {
+		if action.TargetGroupArn != nil {
+			tgArns = append(tgArns, aws.StringValue(action.TargetGroupArn))
+		}
+		if action.ForwardConfig != nil {
+			for _, tuple := range action.ForwardConfig.TargetGroups {
+				tgArns = append(tgArns, aws.StringValue(tuple.TargetGroupArn))
+			}
+		}
+	}
+	return tgArns
+}
+
+func rulePriority(rule *elbv2.Rule) int64 {
+	priority, _ := strconv.ParseInt(aws.StringValue(rule.Priority), 10, 64)
+	return priority
+}
+
+func indexOfTarget(targets []*elbv2.TargetDescription, target *elbv2.TargetDescription) int {
+	for i, t := range targets {
+		if aws.StringValue(t.Id) == aws.StringValue(target.Id) && aws.Int64Value(t.Port) == aws.Int64Value(target.Port) {
+			return i
+		}
+	}
+	return -1
+}
+
+func indexOfCertificate(certs []*elbv2.Certificate, certArn string) int {
+	for i, cert := range certs {
+		if aws.StringValue(cert.CertificateArn) == certArn {
+			return i
+		}
+	}
+	return -1
+}
+
+func elbv2TagMap(tags []*elbv2.Tag) map[string]string {
+	m := make(map[string]string)
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return m
+}
+
+func copyStringMap(m map[string]string) map[string]string {
+	result := make(map[string]string, len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
+
+func (c *Cloud) GetAccountLimits(ctx context.Context) (map[string]int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	limits := make(map[string]int64, len(defaultAccountLimits))
+	for name, max := range defaultAccountLimits {
+		limits[name] = max
+	}
+	for name, max := range c.accountLimits {
+		limits[name] = max
+	}
+	return limits, nil
+}
+
+func (c *Cloud) GetAccountUsage(ctx context.Context) (map[string]int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	usage := map[string]int64{
+		"application-load-balancers": 0,
+		"network-load-balancers":     0,
+		"target-groups":              int64(len(c.targetGroups)),
+	}
+	for _, lb := range c.loadBalancers {
+		usage[aws.StringValue(lb.Type)+"-load-balancers"]++
+	}
+	return usage, nil
+}
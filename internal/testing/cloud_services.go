@@ -0,0 +1,617 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+
+	"github.com/aws/aws-sdk-go/service/arczonalshift"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+)
+
+func (c *Cloud) StatusACM() func() error {
+	return func() error { return nil }
+}
+
+func (c *Cloud) ACMAvailable() bool {
+	return true
+}
+
+// ListCertificates lists the seeded certificates, filtered by input.CertificateStatuses.
+func (c *Cloud) ListCertificates(ctx context.Context, input *acm.ListCertificatesInput) ([]*acm.CertificateSummary, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var statuses []string
+	if input != nil {
+		statuses = aws.StringValueSlice(input.CertificateStatuses)
+	}
+	var result []*acm.CertificateSummary
+	for _, certArn := range sortedCertificateArns(c.certificates) {
+		cert := c.certificates[certArn]
+		if len(statuses) != 0 && !containsString(statuses, aws.StringValue(cert.Status)) {
+			continue
+		}
+		result = append(result, &acm.CertificateSummary{CertificateArn: cert.CertificateArn, DomainName: cert.DomainName})
+	}
+	return result, nil
+}
+
+func (c *Cloud) DescribeCertificate(ctx context.Context, certArn string) (*acm.CertificateDetail, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cert, ok := c.certificates[certArn]
+	if !ok {
+		return nil, newError(acm.ErrCodeResourceNotFoundException, "Could not find certificate %v", certArn)
+	}
+	return copyOf(cert).(*acm.CertificateDetail), nil
+}
+
+func (c *Cloud) ListTagsForCertificate(ctx context.Context, certArn string) (map[string]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.certificates[certArn]; !ok {
+		return nil, newError(acm.ErrCodeResourceNotFoundException, "Could not find certificate %v", certArn)
+	}
+	return copyStringMap(c.certificateTags[certArn]), nil
+}
+
+func (c *Cloud) StatusIAM() func() error {
+	return func() error { return nil }
+}
+
+func (c *Cloud) GetRoleArn(ctx context.Context, roleName string) (string, error) {
+	return aws.BuildARN(c.partition, "iam", "", c.accountID, "role/"+roleName), nil
+}
+
+// GetDeniedActions denies the actions passed to DenyActions, regardless of the principal.
+func (c *Cloud) GetDeniedActions(ctx context.Context, principalArn string, actions []string) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var denied []string
+	for _, action := range actions {
+		if c.deniedActions[action] {
+			denied = append(denied, action)
+		}
+	}
+	return denied, nil
+}
+
+func (c *Cloud) GetServerCertificateMetadata(ctx context.Context, certName string) (*iam.ServerCertificateMetadata, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cert, ok := c.serverCertificates[certName]
+	if !ok {
+		return nil, newError(iam.ErrCodeNoSuchEntityException, "The Server Certificate with name %v cannot be found.", certName)
+	}
+	return copyOf(cert).(*iam.ServerCertificateMetadata), nil
+}
+
+// GetCallerPrincipalArn returns the ARN of the alb-ingress-controller role.
+func (c *Cloud) GetCallerPrincipalArn(ctx context.Context) (string, error) {
+	return c.GetRoleArn(ctx, "alb-ingress-controller")
+}
+
+// defaultServiceQuotas are the AWS default values of the service quotas, keyed by service and quota code.
+var defaultServiceQuotas = map[string]float64{
+	"vpc/L-0EA8095F": 60,
+}
+
+func (c *Cloud) GetServiceQuotaValue(ctx context.Context, serviceCode string, quotaCode string) (float64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	value, ok := c.serviceQuotas[serviceCode+"/"+quotaCode]
+	if !ok {
+		value, ok = defaultServiceQuotas[serviceCode+"/"+quotaCode]
+	}
+	if !ok {
+		return 0, newError(servicequotas.ErrCodeNoSuchResourceException, "Quota %v of service %v not found", quotaCode, serviceCode)
+	}
+	return value, nil
+}
+
+// GetResourcesByFilters matches the tags of LoadBalancers, targetGroups and securityGroups.
+func (c *Cloud) GetResourcesByFilters(tagFilters map[string][]string, resourceTypeFilters ...string) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	resources := make(map[string]map[string]string)
+	if len(resourceTypeFilters) == 0 || containsString(resourceTypeFilters, aws.ResourceTypeEnumELBLoadBalancer) {
+		for lbArn := range c.loadBalancers {
+			resources[lbArn] = c.elbv2Tags[lbArn]
+		}
+	}
+	if len(resourceTypeFilters) == 0 || containsString(resourceTypeFilters, aws.ResourceTypeEnumELBTargetGroup) {
+		for tgArn := range c.targetGroups {
+			resources[tgArn] = c.elbv2Tags[tgArn]
+		}
+	}
+	if len(resourceTypeFilters) == 0 || containsString(resourceTypeFilters, aws.ResourceTypeEnumEC2SecurityGroup) {
+		for groupID, sg := range c.securityGroups {
+			resources[c.securityGroupArn(groupID)] = ec2TagMap(sg.Tags)
+		}
+	}
+
+	var result []string
+	for resourceArn, tags := range resources {
+		matches := true
+		for key, values := range tagFilters {
+			if !matchesTagFilter(tags, key, values) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			result = append(result, resourceArn)
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+func (c *Cloud) TagResourcesWithContext(ctx context.Context, input *resourcegroupstaggingapi.TagResourcesInput) (*resourcegroupstaggingapi.TagResourcesOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	output := &resourcegroupstaggingapi.TagResourcesOutput{FailedResourcesMap: make(map[string]*resourcegroupstaggingapi.FailureInfo)}
+	for _, resourceArn := range aws.StringValueSlice(input.ResourceARNList) {
+		if groupID := c.securityGroupIDFromArn(resourceArn); groupID != "" {
+			sg, ok := c.securityGroups[groupID]
+			if !ok {
+				output.FailedResourcesMap[resourceArn] = resourceNotFound(resourceArn)
+				continue
+			}
+			tags := ec2TagMap(sg.Tags)
+			for k, v := range input.Tags {
+				tags[k] = aws.StringValue(v)
+			}
+			sg.Tags = toEC2Tags(tags)
+			continue
+		}
+		tags, err := c.elbv2TagsOf(resourceArn)
+		if err != nil {
+			output.FailedResourcesMap[resourceArn] = resourceNotFound(resourceArn)
+			continue
+		}
+		for k, v := range input.Tags {
+			tags[k] = aws.StringValue(v)
+		}
+	}
+	return output, nil
+}
+
+func (c *Cloud) UntagResourcesWithContext(ctx context.Context, input *resourcegroupstaggingapi.UntagResourcesInput) (*resourcegroupstaggingapi.UntagResourcesOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	output := &resourcegroupstaggingapi.UntagResourcesOutput{FailedResourcesMap: make(map[string]*resourcegroupstaggingapi.FailureInfo)}
+	for _, resourceArn := range aws.StringValueSlice(input.ResourceARNList) {
+		if groupID := c.securityGroupIDFromArn(resourceArn); groupID != "" {
+			sg, ok := c.securityGroups[groupID]
+			if !ok {
+				output.FailedResourcesMap[resourceArn] = resourceNotFound(resourceArn)
+				continue
+			}
+			tags := ec2TagMap(sg.Tags)
+			for _, key := range aws.StringValueSlice(input.TagKeys) {
+				delete(tags, key)
+			}
+			sg.Tags = toEC2Tags(tags)
+			continue
+		}
+		tags, err := c.elbv2TagsOf(resourceArn)
+		if err != nil {
+			output.FailedResourcesMap[resourceArn] = resourceNotFound(resourceArn)
+			continue
+		}
+		for _, key := range aws.StringValueSlice(input.TagKeys) {
+			delete(tags, key)
+		}
+	}
+	return output, nil
+}
+
+// GetMetricAlarmsByPrefix returns the alarms whose name starts with prefix, sorted by name like DescribeAlarms.
+func (c *Cloud) GetMetricAlarmsByPrefix(ctx context.Context, prefix string) ([]*cloudwatch.MetricAlarm, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var names []string
+	for name := range c.metricAlarms {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	var result []*cloudwatch.MetricAlarm
+	for _, name := range names {
+		result = append(result, copyOf(c.metricAlarms[name]).(*cloudwatch.MetricAlarm))
+	}
+	return result, nil
+}
+
+func (c *Cloud) PutMetricAlarm(ctx context.Context, input *cloudwatch.PutMetricAlarmInput) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	input = copyOf(input).(*cloudwatch.PutMetricAlarmInput)
+	name := aws.StringValue(input.AlarmName)
+	c.metricAlarms[name] = &cloudwatch.MetricAlarm{
+		AlarmName:          input.AlarmName,
+		AlarmArn:           aws.String(c.arn("cloudwatch", "alarm:"+name)),
+		AlarmDescription:   input.AlarmDescription,
+		ActionsEnabled:     input.ActionsEnabled,
+		AlarmActions:       input.AlarmActions,
+		OKActions:          input.OKActions,
+		ComparisonOperator: input.ComparisonOperator,
+		EvaluationPeriods:  input.EvaluationPeriods,
+		Threshold:          input.Threshold,
+		TreatMissingData:   input.TreatMissingData,
+		Namespace:          input.Namespace,
+		MetricName:         input.MetricName,
+		Dimensions:         input.Dimensions,
+		Statistic:          input.Statistic,
+		Period:             input.Period,
+		Metrics:            input.Metrics,
+		StateValue:         aws.String(cloudwatch.StateValueInsufficientData),
+	}
+	return nil
+}
+
+// DeleteMetricAlarms fails without deleting anything when one of the alarms doesn't exist, like DeleteAlarms.
+func (c *Cloud) DeleteMetricAlarms(ctx context.Context, names []string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, name := range names {
+		if _, ok := c.metricAlarms[name]; !ok {
+			return newError(cloudwatch.ErrCodeResourceNotFound, "Alarm %v not found", name)
+		}
+	}
+	for _, name := range names {
+		delete(c.metricAlarms, name)
+	}
+	return nil
+}
+
+func (c *Cloud) PutMetricData(ctx context.Context, namespace string, data []*cloudwatch.MetricDatum) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, datum := range data {
+		c.metricData[namespace] = append(c.metricData[namespace], copyOf(datum).(*cloudwatch.MetricDatum))
+	}
+	return nil
+}
+
+// GetBucketRegion returns the region of the buckets seeded with AddBucket.
+func (c *Cloud) GetBucketRegion(ctx context.Context, bucket string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	region, ok := c.buckets[bucket]
+	if !ok {
+		return "", newError(s3.ErrCodeNoSuchBucket, "The specified bucket does not exist")
+	}
+	return region, nil
+}
+
+// GetHealthChecksByTags returns the health checks tagged with all of tags, sorted by ID.
+func (c *Cloud) GetHealthChecksByTags(ctx context.Context, tags map[string]string) ([]*route53.HealthCheck, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var ids []string
+	for id, healthCheckTags := range c.healthCheckTags {
+		matches := true
+		for k, v := range tags {
+			matches = matches && matchesTagFilter(healthCheckTags, k, []string{v})
+		}
+		if matches {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	var result []*route53.HealthCheck
+	for _, id := range ids {
+		result = append(result, copyOf(c.healthChecks[id]).(*route53.HealthCheck))
+	}
+	return result, nil
+}
+
+func (c *Cloud) CreateHealthCheck(ctx context.Context, config *route53.HealthCheckConfig, tags map[string]string) (*route53.HealthCheck, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	healthCheck := &route53.HealthCheck{
+		Id:                 aws.String(c.newID()),
+		CallerReference:    aws.String(c.newID()),
+		HealthCheckConfig:  copyOf(config).(*route53.HealthCheckConfig),
+		HealthCheckVersion: aws.Int64(1),
+	}
+	c.healthChecks[aws.StringValue(healthCheck.Id)] = healthCheck
+	healthCheckTags := make(map[string]string, len(tags))
+	for k, v := range tags {
+		healthCheckTags[k] = v
+	}
+	c.healthCheckTags[aws.StringValue(healthCheck.Id)] = healthCheckTags
+	return copyOf(healthCheck).(*route53.HealthCheck), nil
+}
+
+func (c *Cloud) DeleteHealthCheck(ctx context.Context, healthCheckID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.healthChecks, healthCheckID)
+	delete(c.healthCheckTags, healthCheckID)
+	return nil
+}
+
+func (c *Cloud) ShieldAvailable(ctx context.Context) (bool, error) {
+	status, err := c.GetSubscriptionStatus(ctx)
+	if err != nil {
+		return false, err
+	}
+	return aws.StringValue(status.SubscriptionState) == shield.SubscriptionStateActive, nil
+}
+
+func (c *Cloud) GetSubscriptionStatus(ctx context.Context) (*shield.GetSubscriptionStateOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	state := shield.SubscriptionStateInactive
+	if c.shieldSubscribed {
+		state = shield.SubscriptionStateActive
+	}
+	return &shield.GetSubscriptionStateOutput{SubscriptionState: aws.String(state)}, nil
+}
+
+// GetProtection returns nil when the resource isn't protected, like the real implementation.
+func (c *Cloud) GetProtection(ctx context.Context, resourceArn *string) (*shield.Protection, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	protection, ok := c.protections[aws.StringValue(resourceArn)]
+	if !ok {
+		return nil, nil
+	}
+	return copyOf(protection).(*shield.Protection), nil
+}
+
+func (c *Cloud) CreateProtection(ctx context.Context, resourceArn *string, protectionName *string) (*shield.CreateProtectionOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.shieldSubscribed {
+		return nil, newError(shield.ErrCodeResourceNotFoundException, "The subscription does not exist")
+	}
+	if _, ok := c.protections[aws.StringValue(resourceArn)]; ok {
+		return nil, newError(shield.ErrCodeResourceAlreadyExistsException, "Resource %v is already protected", aws.StringValue(resourceArn))
+	}
+	protection := &shield.Protection{
+		Id:          aws.String(c.newID()),
+		Name:        protectionName,
+		ResourceArn: resourceArn,
+	}
+	c.protections[aws.StringValue(resourceArn)] = protection
+	return &shield.CreateProtectionOutput{ProtectionId: protection.Id}, nil
+}
+
+func (c *Cloud) DeleteProtection(ctx context.Context, protectionID *string) (*shield.DeleteProtectionOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for resourceArn, protection := range c.protections {
+		if aws.StringValue(protection.Id) == aws.StringValue(protectionID) {
+			delete(c.protections, resourceArn)
+			return &shield.DeleteProtectionOutput{}, nil
+		}
+	}
+	return nil, newError(shield.ErrCodeResourceNotFoundException, "Protection %v not found", aws.StringValue(protectionID))
+}
+
+func (c *Cloud) WebACLExists(ctx context.Context, webACLId *string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.webACLs[aws.StringValue(webACLId)] {
+		return false, newError(waf.ErrCodeNonexistentItemException, "The referenced item does not exist")
+	}
+	return true, nil
+}
+
+// GetWebACLIdByName returns the ID of the web ACL named name, web ACLs are named by their ID.
+func (c *Cloud) GetWebACLIdByName(ctx context.Context, name string) (*string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.webACLs[name] {
+		return nil, nil
+	}
+	return aws.String(name), nil
+}
+
+// GetWebACLSummary returns nil when no web ACL is associated with the resource.
+func (c *Cloud) GetWebACLSummary(ctx context.Context, resourceArn *string) (*waf.WebACLSummary, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	webACLID, ok := c.webACLAssociation[aws.StringValue(resourceArn)]
+	if !ok {
+		return nil, nil
+	}
+	return &waf.WebACLSummary{WebACLId: aws.String(webACLID), Name: aws.String(webACLID)}, nil
+}
+
+func (c *Cloud) AssociateWAF(ctx context.Context, resourceArn *string, webACLId *string) (*wafregional.AssociateWebACLOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, err := c.loadBalancer(aws.StringValue(resourceArn)); err != nil {
+		return nil, newError(waf.ErrCodeNonexistentItemException, "The referenced item does not exist")
+	}
+	if !c.webACLs[aws.StringValue(webACLId)] {
+		return nil, newError(waf.ErrCodeNonexistentItemException, "The referenced item does not exist")
+	}
+	c.webACLAssociation[aws.StringValue(resourceArn)] = aws.StringValue(webACLId)
+	return &wafregional.AssociateWebACLOutput{}, nil
+}
+
+func (c *Cloud) DisassociateWAF(ctx context.Context, resourceArn *string) (*wafregional.DisassociateWebACLOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.webACLAssociation, aws.StringValue(resourceArn))
+	return &wafregional.DisassociateWebACLOutput{}, nil
+}
+
+func (c *Cloud) WAFRegionalAvailable() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.wafRegional
+}
+
+// GetWAFV2WebACLSummary returns nil when no WAFv2 web ACL is associated with the resource.
+func (c *Cloud) GetWAFV2WebACLSummary(ctx context.Context, resourceArn *string) (*wafv2.WebACL, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	webACLARN, ok := c.wafv2Association[aws.StringValue(resourceArn)]
+	if !ok {
+		return nil, nil
+	}
+	return copyOf(c.wafv2ACLs[webACLARN]).(*wafv2.WebACL), nil
+}
+
+func (c *Cloud) GetWAFV2WebACLARNByName(ctx context.Context, name string) (*string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for webACLARN, webACL := range c.wafv2ACLs {
+		if aws.StringValue(webACL.Name) == name {
+			return aws.String(webACLARN), nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *Cloud) AssociateWAFV2(ctx context.Context, resourceArn *string, webACLARN *string) (*wafv2.AssociateWebACLOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, err := c.loadBalancer(aws.StringValue(resourceArn)); err != nil {
+		return nil, newError(wafv2.ErrCodeWAFNonexistentItemException, "The referenced item does not exist")
+	}
+	if _, ok := c.wafv2ACLs[aws.StringValue(webACLARN)]; !ok {
+		return nil, newError(wafv2.ErrCodeWAFNonexistentItemException, "The referenced item does not exist")
+	}
+	c.wafv2Association[aws.StringValue(resourceArn)] = aws.StringValue(webACLARN)
+	return &wafv2.AssociateWebACLOutput{}, nil
+}
+
+func (c *Cloud) DisassociateWAFV2(ctx context.Context, resourceArn *string) (*wafv2.DisassociateWebACLOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.wafv2Association, aws.StringValue(resourceArn))
+	return &wafv2.DisassociateWebACLOutput{}, nil
+}
+
+// GetZonalShiftManagedResource returns nil unless the LoadBalancer has the zonal_shift.config.enabled attribute set.
+func (c *Cloud) GetZonalShiftManagedResource(ctx context.Context, resourceArn string) (*arczonalshift.GetManagedResourceOutput, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.zonalShiftEnabled(resourceArn) {
+		return nil, nil
+	}
+	output := &arczonalshift.GetManagedResourceOutput{
+		Arn:                  aws.String(resourceArn),
+		ZonalAutoshiftStatus: aws.String(arczonalshift.ZonalAutoshiftStatusDisabled),
+	}
+	if status, ok := c.zonalAutoshift[resourceArn]; ok {
+		output.ZonalAutoshiftStatus = aws.String(status)
+	}
+	if config, ok := c.practiceRuns[resourceArn]; ok {
+		output.PracticeRunConfiguration = copyOf(config).(*arczonalshift.PracticeRunConfiguration)
+	}
+	return output, nil
+}
+
+func (c *Cloud) CreatePracticeRunConfiguration(ctx context.Context, resourceArn string, config *arczonalshift.PracticeRunConfiguration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.zonalShiftEnabled(resourceArn) {
+		return newError(arczonalshift.ErrCodeResourceNotFoundException, "Resource '%v' is not registered for zonal shifts", resourceArn)
+	}
+	if _, ok := c.practiceRuns[resourceArn]; ok {
+		return newError(arczonalshift.ErrCodeConflictException, "Resource '%v' already has a practice run configuration", resourceArn)
+	}
+	c.practiceRuns[resourceArn] = copyOf(config).(*arczonalshift.PracticeRunConfiguration)
+	return nil
+}
+
+func (c *Cloud) UpdatePracticeRunConfiguration(ctx context.Context, resourceArn string, config *arczonalshift.PracticeRunConfiguration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	current, ok := c.practiceRuns[resourceArn]
+	if !ok {
+		return newError(arczonalshift.ErrCodeResourceNotFoundException, "Resource '%v' has no practice run configuration", resourceArn)
+	}
+	updated := copyOf(config).(*arczonalshift.PracticeRunConfiguration)
+	if len(updated.BlockingAlarms) == 0 {
+		updated.BlockingAlarms = current.BlockingAlarms
+	}
+	c.practiceRuns[resourceArn] = updated
+	return nil
+}
+
+// DeletePracticeRunConfiguration fails while zonal autoshift is enabled, like the real implementation.
+func (c *Cloud) DeletePracticeRunConfiguration(ctx context.Context, resourceArn string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.practiceRuns[resourceArn]; !ok {
+		return newError(arczonalshift.ErrCodeResourceNotFoundException, "Resource '%v' has no practice run configuration", resourceArn)
+	}
+	if c.zonalAutoshift[resourceArn] == arczonalshift.ZonalAutoshiftStatusEnabled {
+		return newError(arczonalshift.ErrCodeConflictException, "Zonal autoshift of resource '%v' must be disabled first", resourceArn)
+	}
+	delete(c.practiceRuns, resourceArn)
+	return nil
+}
+
+// SetZonalAutoshiftStatus fails to enable zonal autoshift without a practice run configuration, like the real implementation.
+func (c *Cloud) SetZonalAutoshiftStatus(ctx context.Context, resourceArn string, status string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.zonalShiftEnabled(resourceArn) {
+		return newError(arczonalshift.ErrCodeResourceNotFoundException, "Resource '%v' is not registered for zonal shifts", resourceArn)
+	}
+	if _, ok := c.practiceRuns[resourceArn]; !ok && status == arczonalshift.ZonalAutoshiftStatusEnabled {
+		return newError(arczonalshift.ErrCodeConflictException, "Resource '%v' needs a practice run configuration to enable zonal autoshift", resourceArn)
+	}
+	c.zonalAutoshift[resourceArn] = status
+	return nil
+}
+
+func (c *Cloud) zonalShiftEnabled(resourceArn string) bool {
+	attributes, ok := c.lbAttributes[resourceArn]
+	return ok && attributes["zonal_shift.config.enabled"] == "true"
+}
+
+func (c *Cloud) securityGroupArn(groupID string) string {
+	return c.arn("ec2", "security-group/"+groupID)
+}
+
+// securityGroupIDFromArn returns the securityGroup ID of an ARN built by securityGroupArn, or "" for other ARNs.
+func (c *Cloud) securityGroupIDFromArn(resourceArn string) string {
+	prefix := c.securityGroupArn("")
+	if !strings.HasPrefix(resourceArn, prefix) {
+		return ""
+	}
+	return strings.TrimPrefix(resourceArn, prefix)
+}
+
+func resourceNotFound(resourceArn string) *resourcegroupstaggingapi.FailureInfo {
+	return &resourcegroupstaggingapi.FailureInfo{
+		ErrorCode:    aws.String(resourcegroupstaggingapi.ErrorCodeInvalidParameterException),
+		ErrorMessage: aws.String(fmt.Sprintf("resource %v not found", resourceArn)),
+		StatusCode:   aws.Int64(400),
+	}
+}
+
+func sortedCertificateArns(certs map[string]*acm.CertificateDetail) []string {
+	certArns := make([]string, 0, len(certs))
+	for certArn := range certs {
+		certArns = append(certArns, certArn)
+	}
+	sort.Strings(certArns)
+	return certArns
+}
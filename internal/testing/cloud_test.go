@@ -0,0 +1,236 @@
+package testing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	albtesting "github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/testing"
+)
+
+func errorCode(err error) string {
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code()
+	}
+	return ""
+}
+
+func newCloud() *albtesting.Cloud {
+	cloud := albtesting.NewCloud("vpc-1", "cluster")
+	cloud.AddSubnet(&ec2.Subnet{SubnetId: aws.String("subnet-1"), AvailabilityZone: aws.String("us-west-2a")})
+	cloud.AddSubnet(&ec2.Subnet{SubnetId: aws.String("subnet-2"), AvailabilityZone: aws.String("us-west-2b")})
+	cloud.AddInstance(&ec2.Instance{InstanceId: aws.String("i-1")})
+	return cloud
+}
+
+func TestCloud_loadBalancerLifecycle(t *testing.T) {
+	ctx := context.Background()
+	cloud := newCloud()
+
+	sg, err := cloud.CreateSecurityGroupWithContext(ctx, &ec2.CreateSecurityGroupInput{GroupName: aws.String("lb-sg"), Description: aws.String("lb-sg")})
+	assert.NoError(t, err)
+	lbOutput, err := cloud.CreateLoadBalancerWithContext(ctx, &elbv2.CreateLoadBalancerInput{
+		Name:           aws.String("lb"),
+		Subnets:        aws.StringSlice([]string{"subnet-1", "subnet-2"}),
+		SecurityGroups: []*string{sg.GroupId},
+		Tags:           []*elbv2.Tag{{Key: aws.String("kubernetes.io/cluster/cluster"), Value: aws.String("owned")}},
+	})
+	assert.NoError(t, err)
+	lb := lbOutput.LoadBalancers[0]
+	assert.Equal(t, "internet-facing", aws.StringValue(lb.Scheme))
+	assert.Equal(t, "vpc-1", aws.StringValue(lb.VpcId))
+	assert.Len(t, lb.AvailabilityZones, 2)
+
+	_, err = cloud.CreateLoadBalancerWithContext(ctx, &elbv2.CreateLoadBalancerInput{Name: aws.String("lb")})
+	assert.Equal(t, elbv2.ErrCodeDuplicateLoadBalancerNameException, errorCode(err))
+
+	found, err := cloud.GetLoadBalancerByName(ctx, "lb")
+	assert.NoError(t, err)
+	assert.Equal(t, lb.LoadBalancerArn, found.LoadBalancerArn)
+	missing, err := cloud.GetLoadBalancerByName(ctx, "missing")
+	assert.NoError(t, err)
+	assert.Nil(t, missing)
+
+	arns, err := cloud.GetResourcesByFilters(map[string][]string{"kubernetes.io/cluster/cluster": {"owned", "shared"}}, aws.ResourceTypeEnumELBLoadBalancer)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{aws.StringValue(lb.LoadBalancerArn)}, arns)
+
+	tgOutput, err := cloud.CreateTargetGroupWithContext(ctx, &elbv2.CreateTargetGroupInput{
+		Name:     aws.String("tg"),
+		Port:     aws.Int64(80),
+		Protocol: aws.String(elbv2.ProtocolEnumHttp),
+	})
+	assert.NoError(t, err)
+	tgArn := tgOutput.TargetGroups[0].TargetGroupArn
+
+	lsOutput, err := cloud.CreateListenerWithContext(ctx, &elbv2.CreateListenerInput{
+		LoadBalancerArn: lb.LoadBalancerArn,
+		Port:            aws.Int64(80),
+		Protocol:        aws.String(elbv2.ProtocolEnumHttp),
+		DefaultActions:  []*elbv2.Action{{Type: aws.String(elbv2.ActionTypeEnumForward), TargetGroupArn: tgArn}},
+	})
+	assert.NoError(t, err)
+	lsArn := lsOutput.Listeners[0].ListenerArn
+
+	_, err = cloud.CreateRuleWithContext(ctx, &elbv2.CreateRuleInput{
+		ListenerArn: lsArn,
+		Priority:    aws.Int64(1),
+		Conditions:  []*elbv2.RuleCondition{{Field: aws.String("path-pattern"), Values: aws.StringSlice([]string{"/api"})}},
+		Actions:     []*elbv2.Action{{Type: aws.String(elbv2.ActionTypeEnumForward), TargetGroupArn: tgArn}},
+	})
+	assert.NoError(t, err)
+	_, err = cloud.CreateRuleWithContext(ctx, &elbv2.CreateRuleInput{ListenerArn: lsArn, Priority: aws.Int64(1)})
+	assert.Equal(t, elbv2.ErrCodePriorityInUseException, errorCode(err))
+
+	rules, err := cloud.GetRules(ctx, aws.StringValue(lsArn))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "default"}, []string{aws.StringValue(rules[0].Priority), aws.StringValue(rules[1].Priority)})
+	assert.True(t, aws.BoolValue(rules[1].IsDefault))
+
+	tg, err := cloud.GetTargetGroupByArn(ctx, aws.StringValue(tgArn))
+	assert.NoError(t, err)
+	assert.Equal(t, []*string{lb.LoadBalancerArn}, tg.LoadBalancerArns)
+
+	assert.Equal(t, elbv2.ErrCodeResourceInUseException, errorCode(cloud.DeleteTargetGroupByArn(ctx, aws.StringValue(tgArn))))
+	assert.Equal(t, "DependencyViolation", errorCode(cloud.DeleteSecurityGroupByID(ctx, aws.StringValue(sg.GroupId))))
+
+	assert.NoError(t, cloud.DeleteLoadBalancerByArn(ctx, aws.StringValue(lb.LoadBalancerArn)))
+	_, err = cloud.GetLoadBalancerByArn(ctx, aws.StringValue(lb.LoadBalancerArn))
+	assert.Equal(t, elbv2.ErrCodeLoadBalancerNotFoundException, errorCode(err))
+	_, err = cloud.GetRules(ctx, aws.StringValue(lsArn))
+	assert.Equal(t, elbv2.ErrCodeListenerNotFoundException, errorCode(err))
+	assert.NoError(t, cloud.DeleteTargetGroupByArn(ctx, aws.StringValue(tgArn)))
+	assert.NoError(t, cloud.DeleteSecurityGroupByID(ctx, aws.StringValue(sg.GroupId)))
+}
+
+func TestCloud_deletionProtection(t *testing.T) {
+	ctx := context.Background()
+	cloud := newCloud()
+	lbOutput, err := cloud.CreateLoadBalancerWithContext(ctx, &elbv2.CreateLoadBalancerInput{Name: aws.String("lb")})
+	assert.NoError(t, err)
+	lbArn := lbOutput.LoadBalancers[0].LoadBalancerArn
+
+	_, err = cloud.ModifyLoadBalancerAttributesWithContext(ctx, &elbv2.ModifyLoadBalancerAttributesInput{
+		LoadBalancerArn: lbArn,
+		Attributes:      []*elbv2.LoadBalancerAttribute{{Key: aws.String("deletion_protection.enabled"), Value: aws.String("true")}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, elbv2.ErrCodeOperationNotPermittedException, errorCode(cloud.DeleteLoadBalancerByArn(ctx, aws.StringValue(lbArn))))
+
+	_, err = cloud.ModifyLoadBalancerAttributesWithContext(ctx, &elbv2.ModifyLoadBalancerAttributesInput{
+		LoadBalancerArn: lbArn,
+		Attributes:      []*elbv2.LoadBalancerAttribute{{Key: aws.String("unknown.attribute"), Value: aws.String("true")}},
+	})
+	assert.Equal(t, elbv2.ErrCodeInvalidConfigurationRequestException, errorCode(err))
+}
+
+func TestCloud_targets(t *testing.T) {
+	ctx := context.Background()
+	cloud := newCloud()
+	tgOutput, err := cloud.CreateTargetGroupWithContext(ctx, &elbv2.CreateTargetGroupInput{Name: aws.String("tg"), Port: aws.Int64(80)})
+	assert.NoError(t, err)
+	tgArn := tgOutput.TargetGroups[0].TargetGroupArn
+
+	_, err = cloud.RegisterTargetsWithContext(ctx, &elbv2.RegisterTargetsInput{
+		TargetGroupArn: tgArn,
+		Targets:        []*elbv2.TargetDescription{{Id: aws.String("i-missing")}},
+	})
+	assert.Equal(t, elbv2.ErrCodeInvalidTargetException, errorCode(err))
+
+	_, err = cloud.RegisterTargetsWithContext(ctx, &elbv2.RegisterTargetsInput{
+		TargetGroupArn: tgArn,
+		Targets:        []*elbv2.TargetDescription{{Id: aws.String("i-1"), Port: aws.Int64(8080)}},
+	})
+	assert.NoError(t, err)
+	cloud.SetTargetHealth(aws.StringValue(tgArn), "i-1", elbv2.TargetHealthStateEnumUnhealthy)
+
+	health, err := cloud.DescribeTargetHealthWithContext(ctx, &elbv2.DescribeTargetHealthInput{TargetGroupArn: tgArn})
+	assert.NoError(t, err)
+	assert.Len(t, health.TargetHealthDescriptions, 1)
+	assert.Equal(t, int64(8080), aws.Int64Value(health.TargetHealthDescriptions[0].Target.Port))
+	assert.Equal(t, elbv2.TargetHealthStateEnumUnhealthy, aws.StringValue(health.TargetHealthDescriptions[0].TargetHealth.State))
+
+	_, err = cloud.DeregisterTargetsWithContext(ctx, &elbv2.DeregisterTargetsInput{
+		TargetGroupArn: tgArn,
+		Targets:        []*elbv2.TargetDescription{{Id: aws.String("i-1"), Port: aws.Int64(8080)}},
+	})
+	assert.NoError(t, err)
+	health, err = cloud.DescribeTargetHealthWithContext(ctx, &elbv2.DescribeTargetHealthInput{TargetGroupArn: tgArn})
+	assert.NoError(t, err)
+	assert.Empty(t, health.TargetHealthDescriptions)
+}
+
+func TestCloud_securityGroupPermissions(t *testing.T) {
+	ctx := context.Background()
+	cloud := newCloud()
+	sg, err := cloud.CreateSecurityGroupWithContext(ctx, &ec2.CreateSecurityGroupInput{GroupName: aws.String("sg"), Description: aws.String("sg")})
+	assert.NoError(t, err)
+	_, err = cloud.CreateSecurityGroupWithContext(ctx, &ec2.CreateSecurityGroupInput{GroupName: aws.String("sg"), Description: aws.String("sg")})
+	assert.Equal(t, "InvalidGroup.Duplicate", errorCode(err))
+
+	permissions := []*ec2.IpPermission{{
+		IpProtocol: aws.String("tcp"),
+		FromPort:   aws.Int64(80),
+		ToPort:     aws.Int64(80),
+		IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+	}}
+	_, err = cloud.AuthorizeSecurityGroupIngressWithContext(ctx, &ec2.AuthorizeSecurityGroupIngressInput{GroupId: sg.GroupId, IpPermissions: permissions})
+	assert.NoError(t, err)
+	_, err = cloud.AuthorizeSecurityGroupIngressWithContext(ctx, &ec2.AuthorizeSecurityGroupIngressInput{GroupId: sg.GroupId, IpPermissions: permissions})
+	assert.Equal(t, "InvalidPermission.Duplicate", errorCode(err))
+
+	group, err := cloud.GetSecurityGroupByID(aws.StringValue(sg.GroupId))
+	assert.NoError(t, err)
+	assert.Equal(t, permissions, group.IpPermissions)
+
+	_, err = cloud.RevokeSecurityGroupIngressWithContext(ctx, &ec2.RevokeSecurityGroupIngressInput{GroupId: sg.GroupId, IpPermissions: permissions})
+	assert.NoError(t, err)
+	_, err = cloud.RevokeSecurityGroupIngressWithContext(ctx, &ec2.RevokeSecurityGroupIngressInput{GroupId: sg.GroupId, IpPermissions: permissions})
+	assert.Equal(t, "InvalidPermission.NotFound", errorCode(err))
+}
+
+func TestCloud_targetGroupProtocolVersion(t *testing.T) {
+	ctx := context.Background()
+	cloud := newCloud()
+
+	_, err := cloud.CreateTargetGroupWithContext(ctx, &elbv2.CreateTargetGroupInput{
+		Name:            aws.String("grpc"),
+		Protocol:        aws.String(elbv2.ProtocolEnumHttp),
+		ProtocolVersion: aws.String("GRPC"),
+		Matcher:         &elbv2.Matcher{HttpCode: aws.String("200")},
+	})
+	assert.Equal(t, "ValidationError", errorCode(err))
+
+	output, err := cloud.CreateTargetGroupWithContext(ctx, &elbv2.CreateTargetGroupInput{
+		Name:            aws.String("grpc"),
+		Protocol:        aws.String(elbv2.ProtocolEnumHttp),
+		ProtocolVersion: aws.String("GRPC"),
+	})
+	assert.NoError(t, err)
+	tgArn := aws.StringValue(output.TargetGroups[0].TargetGroupArn)
+	assert.Equal(t, "GRPC", aws.StringValue(output.TargetGroups[0].ProtocolVersion))
+	assert.Nil(t, output.TargetGroups[0].Matcher.HttpCode)
+	assert.Equal(t, "12", aws.StringValue(output.TargetGroups[0].Matcher.GrpcCode))
+
+	_, err = cloud.ModifyTargetGroupWithContext(ctx, &elbv2.ModifyTargetGroupInput{TargetGroupArn: aws.String(tgArn), Matcher: &elbv2.Matcher{GrpcCode: aws.String("0-99")}})
+	assert.NoError(t, err)
+	tg, err := cloud.GetTargetGroupByArn(ctx, tgArn)
+	assert.NoError(t, err)
+	assert.Equal(t, "0-99", aws.StringValue(tg.Matcher.GrpcCode))
+
+	httpOutput, err := cloud.CreateTargetGroupWithContext(ctx, &elbv2.CreateTargetGroupInput{Name: aws.String("http"), Protocol: aws.String(elbv2.ProtocolEnumHttp)})
+	assert.NoError(t, err)
+	httpArn := aws.StringValue(httpOutput.TargetGroups[0].TargetGroupArn)
+	assert.Equal(t, "HTTP1", aws.StringValue(httpOutput.TargetGroups[0].ProtocolVersion))
+	assert.Equal(t, "200", aws.StringValue(httpOutput.TargetGroups[0].Matcher.HttpCode))
+	_, err = cloud.ModifyTargetGroupWithContext(ctx, &elbv2.ModifyTargetGroupInput{TargetGroupArn: aws.String(httpArn), Matcher: &elbv2.Matcher{GrpcCode: aws.String("0")}})
+	assert.Equal(t, "ValidationError", errorCode(err))
+	tg, err = cloud.GetTargetGroupByArn(ctx, httpArn)
+	assert.NoError(t, err)
+	assert.Nil(t, tg.Matcher.GrpcCode, "invalid modifications are not applied")
+}
@@ -0,0 +1,13 @@
+// Package testing provides in-memory implementations of the controller's AWS and Kubernetes dependencies,
+// for unit testing controllers without hand-rolled mocks.
+// It implements interfaces internal to this module, so it's only importable by the controller, its tests and forks of it.
+//
+// Cloud implements aws.CloudAPI. It keeps LoadBalancers, targetGroups, listeners, rules, securityGroups and tags
+// created through it, returning the same errors as AWS for conflicts such as duplicate names or deleting a
+// targetGroup still in use. Store implements store.Storer over objects added to it.
+//
+//	cloud := testing.NewCloud("vpc-0123456789", "cluster")
+//	cloud.AddSubnet(&ec2.Subnet{SubnetId: aws.String("subnet-1"), AvailabilityZone: aws.String("us-west-2a")})
+//	store := testing.NewStore(nil)
+//	store.AddService(svc)
+package testing
@@ -0,0 +1,202 @@
+package testing
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/spf13/pflag"
+	corev1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/annotations"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/config"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/store"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/resolver"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/k8s"
+)
+
+var _ store.Storer = (*Store)(nil)
+var _ resolver.Resolver = (*Store)(nil)
+
+// Store is an in-memory implementation of store.Storer.
+// Annotations of ingresses and services are parsed when they're added, the same way the informer based store does.
+type Store struct {
+	mu  sync.Mutex
+	cfg *config.Configuration
+
+	ingannotations annotations.Extractor
+	svcannotations annotations.Extractor
+
+	services           map[string]*corev1.Service
+	serviceAnnotations map[string]*annotations.Service
+	ingressAnnotations map[string]*annotations.Ingress
+	endpoints          map[string]*corev1.Endpoints
+	nodes              map[string]*corev1.Node
+	pods               map[string]*corev1.Pod
+}
+
+// NewStore constructs an empty Store using cfg, or the flags' default configuration when cfg is nil.
+func NewStore(cfg *config.Configuration) *Store {
+	if cfg == nil {
+		defaults := config.NewConfiguration()
+		defaults.BindFlags(pflag.NewFlagSet("", pflag.ContinueOnError))
+		cfg = &defaults
+	}
+	s := &Store{
+		cfg:                cfg,
+		services:           make(map[string]*corev1.Service),
+		serviceAnnotations: make(map[string]*annotations.Service),
+		ingressAnnotations: make(map[string]*annotations.Ingress),
+		endpoints:          make(map[string]*corev1.Endpoints),
+		nodes:              make(map[string]*corev1.Node),
+		pods:               make(map[string]*corev1.Pod),
+	}
+	s.ingannotations = annotations.NewIngressAnnotationExtractor(s)
+	s.svcannotations = annotations.NewServiceAnnotationExtractor(s)
+	return s
+}
+
+// AddIngress adds or replaces the parsed annotations of ing.
+func (s *Store) AddIngress(ing *extensions.Ingress) {
+	anns := s.ingannotations.ExtractIngress(ing)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ingressAnnotations[k8s.MetaNamespaceKey(ing)] = anns
+}
+
+// AddService adds or replaces svc along with its parsed annotations.
+func (s *Store) AddService(svc *corev1.Service) {
+	anns := s.svcannotations.ExtractService(svc)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := k8s.MetaNamespaceKey(svc)
+	s.services[key] = svc.DeepCopy()
+	s.serviceAnnotations[key] = anns
+}
+
+// AddEndpoints adds or replaces the endpoints of a service.
+func (s *Store) AddEndpoints(endpoints *corev1.Endpoints) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.endpoints[k8s.MetaNamespaceKey(endpoints)] = endpoints.DeepCopy()
+}
+
+// AddNode adds or replaces node.
+func (s *Store) AddNode(node *corev1.Node) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nodes[node.Name] = node.DeepCopy()
+}
+
+// AddPod adds or replaces pod.
+func (s *Store) AddPod(pod *corev1.Pod) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pods[k8s.MetaNamespaceKey(pod)] = pod.DeepCopy()
+}
+
+func (s *Store) GetService(key string) (*corev1.Service, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	svc, ok := s.services[key]
+	if !ok {
+		return nil, store.NotExistsError(key)
+	}
+	return svc.DeepCopy(), nil
+}
+
+func (s *Store) GetServiceEndpoints(key string) (*corev1.Endpoints, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	endpoints, ok := s.endpoints[key]
+	if !ok {
+		return nil, store.NotExistsError(key)
+	}
+	return endpoints.DeepCopy(), nil
+}
+
+func (s *Store) GetServiceAnnotations(key string, ingress *annotations.Ingress) (*annotations.Service, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sa, ok := s.serviceAnnotations[key]
+	if !ok {
+		return nil, store.NotExistsError(key)
+	}
+	if ingress != nil {
+		return sa.Merge(ingress, s.cfg), nil
+	}
+	return sa, nil
+}
+
+// ListNodes returns the nodes ordered by name.
+func (s *Store) ListNodes() []*corev1.Node {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var nodes []*corev1.Node
+	for _, name := range s.sortedNodeNames() {
+		nodes = append(nodes, s.nodes[name].DeepCopy())
+	}
+	return nodes
+}
+
+func (s *Store) GetIngressAnnotations(key string) (*annotations.Ingress, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ia, ok := s.ingressAnnotations[key]
+	if !ok {
+		return nil, store.NotExistsError(key)
+	}
+	return ia, nil
+}
+
+func (s *Store) GetConfig() *config.Configuration {
+	return s.cfg
+}
+
+func (s *Store) GetInstanceIDFromPodIP(ip string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var hostIP string
+	for _, pod := range s.pods {
+		if pod.Status.PodIP == ip {
+			hostIP = pod.Status.HostIP
+			break
+		}
+	}
+	if hostIP == "" {
+		return "", fmt.Errorf("Unable to locate a host for pod ip: %v", ip)
+	}
+
+	for _, name := range s.sortedNodeNames() {
+		for _, addr := range s.nodes[name].Status.Addresses {
+			if addr.Address == hostIP {
+				return store.NodeInstanceID(s.nodes[name])
+			}
+		}
+	}
+	return "", fmt.Errorf("Unable to locate a host for pod ip: %v", ip)
+}
+
+func (s *Store) GetNodeInstanceID(node *corev1.Node) (string, error) {
+	return store.NodeInstanceID(node)
+}
+
+func (s *Store) GetPod(key string) (*corev1.Pod, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pod, ok := s.pods[key]
+	if !ok {
+		return nil, store.NotExistsError(key)
+	}
+	return pod.DeepCopy(), nil
+}
+
+func (s *Store) sortedNodeNames() []string {
+	names := make([]string, 0, len(s.nodes))
+	for name := range s.nodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,57 @@
+package testing_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/store"
+	albtesting "github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/testing"
+)
+
+func TestStore(t *testing.T) {
+	s := albtesting.NewStore(nil)
+	s.AddIngress(&extensions.Ingress{ObjectMeta: metav1.ObjectMeta{
+		Namespace:   "default",
+		Name:        "ingress",
+		Annotations: map[string]string{"alb.ingress.kubernetes.io/target-type": "ip"},
+	}})
+	s.AddService(&corev1.Service{ObjectMeta: metav1.ObjectMeta{
+		Namespace:   "default",
+		Name:        "service",
+		Annotations: map[string]string{"alb.ingress.kubernetes.io/healthcheck-path": "/healthz"},
+	}})
+	s.AddNode(&corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node"},
+		Spec:       corev1.NodeSpec{ProviderID: "aws:///us-west-2a/i-1"},
+		Status: corev1.NodeStatus{
+			NodeInfo:  corev1.NodeSystemInfo{KubeletVersion: "v1.14.0"},
+			Addresses: []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: "10.0.0.1"}},
+		},
+	})
+	s.AddPod(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod"},
+		Status:     corev1.PodStatus{HostIP: "10.0.0.1", PodIP: "10.0.1.1"},
+	})
+
+	ia, err := s.GetIngressAnnotations("default/ingress")
+	assert.NoError(t, err)
+	assert.Equal(t, "ip", *ia.TargetGroup.TargetType)
+
+	sa, err := s.GetServiceAnnotations("default/service", ia)
+	assert.NoError(t, err)
+	assert.Equal(t, "/healthz", *sa.HealthCheck.Path)
+	assert.Equal(t, "ip", *sa.TargetGroup.TargetType)
+
+	_, err = s.GetService("default/missing")
+	assert.Equal(t, store.NotExistsError("default/missing"), err)
+
+	instanceID, err := s.GetInstanceIDFromPodIP("10.0.1.1")
+	assert.NoError(t, err)
+	assert.Equal(t, "i-1", instanceID)
+	_, err = s.GetInstanceIDFromPodIP("10.0.1.2")
+	assert.EqualError(t, err, "Unable to locate a host for pod ip: 10.0.1.2")
+}
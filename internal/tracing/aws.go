@@ -0,0 +1,37 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type awsSpanKey struct{}
+
+// InstrumentAWSHandlers adds handlers that emit a span for each AWS API call, annotated with the AWS request ID.
+func InstrumentAWSHandlers(handlers *request.Handlers) {
+	handlers.Validate.PushFront(func(r *request.Request) {
+		if !enabled {
+			return
+		}
+		ctx, span := StartSpan(r.Context(), r.ClientInfo.ServiceName+"."+r.Operation.Name,
+			attribute.String("aws.service", r.ClientInfo.ServiceName),
+			attribute.String("aws.operation", r.Operation.Name),
+		)
+		r.SetContext(context.WithValue(ctx, awsSpanKey{}, span))
+	})
+	handlers.Complete.PushBack(func(r *request.Request) {
+		// only end spans started above, the context may carry the span of the reconcile otherwise.
+		span, ok := r.Context().Value(awsSpanKey{}).(trace.Span)
+		if !ok {
+			return
+		}
+		span.SetAttributes(
+			attribute.String("aws.request_id", r.RequestID),
+			attribute.Int("aws.retry_count", r.RetryCount),
+		)
+		EndSpan(span, r.Error)
+	})
+}
@@ -0,0 +1,52 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestInstrumentAWSHandlers(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	enabled = true
+	defer func() { enabled = false }()
+
+	handlers := request.Handlers{}
+	InstrumentAWSHandlers(&handlers)
+
+	ctx, parent := StartSpan(context.Background(), "Reconcile")
+	r := request.New(aws.Config{}, metadata.ClientInfo{ServiceName: "elasticloadbalancing"}, handlers, nil,
+		&request.Operation{Name: "DescribeLoadBalancers"}, nil, nil)
+	r.SetContext(ctx)
+	r.Handlers.Validate.Run(r)
+	r.RequestID = "req-1"
+	r.Error = errors.New("Throttling")
+	r.Handlers.Complete.Run(r)
+	parent.End()
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 2)
+	awsSpan := spans[0]
+	assert.Equal(t, "elasticloadbalancing.DescribeLoadBalancers", awsSpan.Name())
+	assert.Equal(t, parent.SpanContext().SpanID(), awsSpan.Parent().SpanID())
+	assert.Contains(t, awsSpan.Attributes(), attribute.String("aws.request_id", "req-1"))
+	assert.Equal(t, codes.Error, awsSpan.Status().Code)
+}
+
+func TestStartSpan_Disabled(t *testing.T) {
+	ctx := context.Background()
+	spanCtx, span := StartSpan(ctx, "Reconcile")
+	assert.Equal(t, ctx, spanCtx)
+	assert.False(t, span.SpanContext().IsValid())
+}
@@ -0,0 +1,100 @@
+// Package tracing emits OpenTelemetry spans for reconciles and AWS API calls.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/pflag"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName  = "github.com/kubernetes-sigs/aws-alb-ingress-controller"
+	serviceName = "aws-alb-ingress-controller"
+
+	defaultEndpoint    = ""
+	defaultInsecure    = false
+	defaultSampleRatio = 1.0
+)
+
+// enabled is set once Init installed an exporting tracer provider.
+// Spans are not started otherwise, which leaves contexts untouched.
+var enabled bool
+
+// Config contains the settings for exporting traces.
+type Config struct {
+	// Endpoint is the host:port of the OTLP/HTTP collector, tracing is disabled if empty.
+	Endpoint string
+	// Insecure disables TLS to the collector.
+	Insecure bool
+	// SampleRatio is the fraction of reconciles being traced.
+	SampleRatio float64
+}
+
+// BindFlags will bind the commandline flags to fields in config
+func (cfg *Config) BindFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&cfg.Endpoint, "tracing-endpoint", defaultEndpoint,
+		`host:port of the OTLP/HTTP collector to export traces to. Tracing is disabled if empty.`)
+	fs.BoolVar(&cfg.Insecure, "tracing-insecure", defaultInsecure,
+		`Connect to the OTLP/HTTP collector without TLS`)
+	fs.Float64Var(&cfg.SampleRatio, "tracing-sample-ratio", defaultSampleRatio,
+		`Fraction of reconciles to trace, between 0 and 1`)
+}
+
+func (cfg *Config) Validate() error {
+	if cfg.SampleRatio < 0 || cfg.SampleRatio > 1 {
+		return fmt.Errorf("tracing sample ratio must be between 0 and 1, got %v", cfg.SampleRatio)
+	}
+	return nil
+}
+
+// Init installs the global tracer provider exporting to cfg.Endpoint.
+// The returned function flushes pending spans, and should be invoked before exiting.
+func Init(cfg Config) (func(context.Context) error, error) {
+	if len(cfg.Endpoint) == 0 {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	exporter, err := otlptracehttp.New(context.Background(), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP exporter due to %v", err)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+	enabled = true
+	return provider.Shutdown, nil
+}
+
+// StartSpan starts a span as child of the span in ctx, if any.
+// It returns ctx and a no-op span if tracing is disabled.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if !enabled {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan ends span, marking it as failed if err is not nil.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -329,6 +329,84 @@ func (_m *ACMAPI) ExportCertificateWithContext(_a0 context.Context, _a1 *acm.Exp
 	return r0, r1
 }
 
+// GetAccountConfiguration provides a mock function with given fields: _a0
+func (_m *ACMAPI) GetAccountConfiguration(_a0 *acm.GetAccountConfigurationInput) (*acm.GetAccountConfigurationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *acm.GetAccountConfigurationOutput
+	if rf, ok := ret.Get(0).(func(*acm.GetAccountConfigurationInput) *acm.GetAccountConfigurationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*acm.GetAccountConfigurationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*acm.GetAccountConfigurationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAccountConfigurationRequest provides a mock function with given fields: _a0
+func (_m *ACMAPI) GetAccountConfigurationRequest(_a0 *acm.GetAccountConfigurationInput) (*request.Request, *acm.GetAccountConfigurationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*acm.GetAccountConfigurationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *acm.GetAccountConfigurationOutput
+	if rf, ok := ret.Get(1).(func(*acm.GetAccountConfigurationInput) *acm.GetAccountConfigurationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*acm.GetAccountConfigurationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetAccountConfigurationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ACMAPI) GetAccountConfigurationWithContext(_a0 context.Context, _a1 *acm.GetAccountConfigurationInput, _a2 ...request.Option) (*acm.GetAccountConfigurationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *acm.GetAccountConfigurationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *acm.GetAccountConfigurationInput, ...request.Option) *acm.GetAccountConfigurationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*acm.GetAccountConfigurationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *acm.GetAccountConfigurationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCertificate provides a mock function with given fields: _a0
 func (_m *ACMAPI) GetCertificate(_a0 *acm.GetCertificateInput) (*acm.GetCertificateOutput, error) {
 	ret := _m.Called(_a0)
@@ -676,6 +754,84 @@ func (_m *ACMAPI) ListTagsForCertificateWithContext(_a0 context.Context, _a1 *ac
 	return r0, r1
 }
 
+// PutAccountConfiguration provides a mock function with given fields: _a0
+func (_m *ACMAPI) PutAccountConfiguration(_a0 *acm.PutAccountConfigurationInput) (*acm.PutAccountConfigurationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *acm.PutAccountConfigurationOutput
+	if rf, ok := ret.Get(0).(func(*acm.PutAccountConfigurationInput) *acm.PutAccountConfigurationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*acm.PutAccountConfigurationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*acm.PutAccountConfigurationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutAccountConfigurationRequest provides a mock function with given fields: _a0
+func (_m *ACMAPI) PutAccountConfigurationRequest(_a0 *acm.PutAccountConfigurationInput) (*request.Request, *acm.PutAccountConfigurationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*acm.PutAccountConfigurationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *acm.PutAccountConfigurationOutput
+	if rf, ok := ret.Get(1).(func(*acm.PutAccountConfigurationInput) *acm.PutAccountConfigurationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*acm.PutAccountConfigurationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// PutAccountConfigurationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ACMAPI) PutAccountConfigurationWithContext(_a0 context.Context, _a1 *acm.PutAccountConfigurationInput, _a2 ...request.Option) (*acm.PutAccountConfigurationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *acm.PutAccountConfigurationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *acm.PutAccountConfigurationInput, ...request.Option) *acm.PutAccountConfigurationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*acm.PutAccountConfigurationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *acm.PutAccountConfigurationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveTagsFromCertificate provides a mock function with given fields: _a0
 func (_m *ACMAPI) RemoveTagsFromCertificate(_a0 *acm.RemoveTagsFromCertificateInput) (*acm.RemoveTagsFromCertificateOutput, error) {
 	ret := _m.Called(_a0)
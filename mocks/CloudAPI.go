@@ -5,16 +5,24 @@ package mocks
 import (
 	acm "github.com/aws/aws-sdk-go/service/acm"
 
+	arczonalshift "github.com/aws/aws-sdk-go/service/arczonalshift"
+
+	cloudwatch "github.com/aws/aws-sdk-go/service/cloudwatch"
+
 	context "context"
 
 	ec2 "github.com/aws/aws-sdk-go/service/ec2"
 
 	elbv2 "github.com/aws/aws-sdk-go/service/elbv2"
 
+	iam "github.com/aws/aws-sdk-go/service/iam"
+
 	mock "github.com/stretchr/testify/mock"
 
 	resourcegroupstaggingapi "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 
+	route53 "github.com/aws/aws-sdk-go/service/route53"
+
 	shield "github.com/aws/aws-sdk-go/service/shield"
 
 	waf "github.com/aws/aws-sdk-go/service/waf"
@@ -181,6 +189,29 @@ func (_m *CloudAPI) CreateEC2TagsWithContext(_a0 context.Context, _a1 *ec2.Creat
 	return r0, r1
 }
 
+// CreateHealthCheck provides a mock function with given fields: ctx, config, tags
+func (_m *CloudAPI) CreateHealthCheck(ctx context.Context, config *route53.HealthCheckConfig, tags map[string]string) (*route53.HealthCheck, error) {
+	ret := _m.Called(ctx, config, tags)
+
+	var r0 *route53.HealthCheck
+	if rf, ok := ret.Get(0).(func(context.Context, *route53.HealthCheckConfig, map[string]string) *route53.HealthCheck); ok {
+		r0 = rf(ctx, config, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*route53.HealthCheck)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *route53.HealthCheckConfig, map[string]string) error); ok {
+		r1 = rf(ctx, config, tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateListenerWithContext provides a mock function with given fields: _a0, _a1
 func (_m *CloudAPI) CreateListenerWithContext(_a0 context.Context, _a1 *elbv2.CreateListenerInput) (*elbv2.CreateListenerOutput, error) {
 	ret := _m.Called(_a0, _a1)
@@ -227,6 +258,20 @@ func (_m *CloudAPI) CreateLoadBalancerWithContext(_a0 context.Context, _a1 *elbv
 	return r0, r1
 }
 
+// CreatePracticeRunConfiguration provides a mock function with given fields: ctx, resourceArn, config
+func (_m *CloudAPI) CreatePracticeRunConfiguration(ctx context.Context, resourceArn string, config *arczonalshift.PracticeRunConfiguration) error {
+	ret := _m.Called(ctx, resourceArn, config)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *arczonalshift.PracticeRunConfiguration) error); ok {
+		r0 = rf(ctx, resourceArn, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateProtection provides a mock function with given fields: ctx, resourceArn, protectionName
 func (_m *CloudAPI) CreateProtection(ctx context.Context, resourceArn *string, protectionName *string) (*shield.CreateProtectionOutput, error) {
 	ret := _m.Called(ctx, resourceArn, protectionName)
@@ -342,6 +387,20 @@ func (_m *CloudAPI) DeleteEC2TagsWithContext(_a0 context.Context, _a1 *ec2.Delet
 	return r0, r1
 }
 
+// DeleteHealthCheck provides a mock function with given fields: ctx, healthCheckID
+func (_m *CloudAPI) DeleteHealthCheck(ctx context.Context, healthCheckID string) error {
+	ret := _m.Called(ctx, healthCheckID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, healthCheckID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteListenersByArn provides a mock function with given fields: _a0, _a1
 func (_m *CloudAPI) DeleteListenersByArn(_a0 context.Context, _a1 string) error {
 	ret := _m.Called(_a0, _a1)
@@ -370,6 +429,34 @@ func (_m *CloudAPI) DeleteLoadBalancerByArn(_a0 context.Context, _a1 string) err
 	return r0
 }
 
+// DeleteMetricAlarms provides a mock function with given fields: ctx, names
+func (_m *CloudAPI) DeleteMetricAlarms(ctx context.Context, names []string) error {
+	ret := _m.Called(ctx, names)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = rf(ctx, names)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeletePracticeRunConfiguration provides a mock function with given fields: ctx, resourceArn
+func (_m *CloudAPI) DeletePracticeRunConfiguration(ctx context.Context, resourceArn string) error {
+	ret := _m.Called(ctx, resourceArn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, resourceArn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteProtection provides a mock function with given fields: ctx, protectionID
 func (_m *CloudAPI) DeleteProtection(ctx context.Context, protectionID *string) (*shield.DeleteProtectionOutput, error) {
 	ret := _m.Called(ctx, protectionID)
@@ -582,6 +669,29 @@ func (_m *CloudAPI) DescribeNetworkInterfaces(_a0 context.Context, _a1 *ec2.Desc
 	return r0, r1
 }
 
+// DescribeSSLPoliciesWithContext provides a mock function with given fields: _a0, _a1
+func (_m *CloudAPI) DescribeSSLPoliciesWithContext(_a0 context.Context, _a1 *elbv2.DescribeSSLPoliciesInput) (*elbv2.DescribeSSLPoliciesOutput, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *elbv2.DescribeSSLPoliciesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *elbv2.DescribeSSLPoliciesInput) *elbv2.DescribeSSLPoliciesOutput); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.DescribeSSLPoliciesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *elbv2.DescribeSSLPoliciesInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DescribeSecurityGroups provides a mock function with given fields: _a0, _a1
 func (_m *CloudAPI) DescribeSecurityGroups(_a0 context.Context, _a1 *ec2.DescribeSecurityGroupsInput) ([]*ec2.SecurityGroup, error) {
 	ret := _m.Called(_a0, _a1)
@@ -697,6 +807,94 @@ func (_m *CloudAPI) DisassociateWAFV2(ctx context.Context, resourceArn *string)
 	return r0, r1
 }
 
+// GetAccountLimits provides a mock function with given fields: _a0
+func (_m *CloudAPI) GetAccountLimits(_a0 context.Context) (map[string]int64, error) {
+	ret := _m.Called(_a0)
+
+	var r0 map[string]int64
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]int64); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAccountUsage provides a mock function with given fields: _a0
+func (_m *CloudAPI) GetAccountUsage(_a0 context.Context) (map[string]int64, error) {
+	ret := _m.Called(_a0)
+
+	var r0 map[string]int64
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]int64); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBucketRegion provides a mock function with given fields: ctx, bucket
+func (_m *CloudAPI) GetBucketRegion(ctx context.Context, bucket string) (string, error) {
+	ret := _m.Called(ctx, bucket)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, bucket)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, bucket)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCallerPrincipalArn provides a mock function with given fields: ctx
+func (_m *CloudAPI) GetCallerPrincipalArn(ctx context.Context) (string, error) {
+	ret := _m.Called(ctx)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetClusterName provides a mock function with given fields:
 func (_m *CloudAPI) GetClusterName() string {
 	ret := _m.Called()
@@ -712,11 +910,11 @@ func (_m *CloudAPI) GetClusterName() string {
 }
 
 // GetClusterSubnets provides a mock function with given fields: _a0
-func (_m *CloudAPI) GetClusterSubnets(_a0 string) ([]*ec2.Subnet, error) {
+func (_m *CloudAPI) GetClusterSubnets(_a0 map[string][]string) ([]*ec2.Subnet, error) {
 	ret := _m.Called(_a0)
 
 	var r0 []*ec2.Subnet
-	if rf, ok := ret.Get(0).(func(string) []*ec2.Subnet); ok {
+	if rf, ok := ret.Get(0).(func(map[string][]string) []*ec2.Subnet); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -725,7 +923,7 @@ func (_m *CloudAPI) GetClusterSubnets(_a0 string) ([]*ec2.Subnet, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
+	if rf, ok := ret.Get(1).(func(map[string][]string) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -734,6 +932,52 @@ func (_m *CloudAPI) GetClusterSubnets(_a0 string) ([]*ec2.Subnet, error) {
 	return r0, r1
 }
 
+// GetDeniedActions provides a mock function with given fields: ctx, principalArn, actions
+func (_m *CloudAPI) GetDeniedActions(ctx context.Context, principalArn string, actions []string) ([]string, error) {
+	ret := _m.Called(ctx, principalArn, actions)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []string); ok {
+		r0 = rf(ctx, principalArn, actions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, principalArn, actions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHealthChecksByTags provides a mock function with given fields: ctx, tags
+func (_m *CloudAPI) GetHealthChecksByTags(ctx context.Context, tags map[string]string) ([]*route53.HealthCheck, error) {
+	ret := _m.Called(ctx, tags)
+
+	var r0 []*route53.HealthCheck
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]string) []*route53.HealthCheck); ok {
+		r0 = rf(ctx, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*route53.HealthCheck)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, map[string]string) error); ok {
+		r1 = rf(ctx, tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetInstancesByIDs provides a mock function with given fields: _a0
 func (_m *CloudAPI) GetInstancesByIDs(_a0 []string) ([]*ec2.Instance, error) {
 	ret := _m.Called(_a0)
@@ -803,6 +1047,29 @@ func (_m *CloudAPI) GetLoadBalancerByName(_a0 context.Context, _a1 string) (*elb
 	return r0, r1
 }
 
+// GetMetricAlarmsByPrefix provides a mock function with given fields: ctx, prefix
+func (_m *CloudAPI) GetMetricAlarmsByPrefix(ctx context.Context, prefix string) ([]*cloudwatch.MetricAlarm, error) {
+	ret := _m.Called(ctx, prefix)
+
+	var r0 []*cloudwatch.MetricAlarm
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*cloudwatch.MetricAlarm); ok {
+		r0 = rf(ctx, prefix)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*cloudwatch.MetricAlarm)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, prefix)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetProtection provides a mock function with given fields: ctx, resourceArn
 func (_m *CloudAPI) GetProtection(ctx context.Context, resourceArn *string) (*shield.Protection, error) {
 	ret := _m.Called(ctx, resourceArn)
@@ -856,6 +1123,27 @@ func (_m *CloudAPI) GetResourcesByFilters(tagFilters map[string][]string, resour
 	return r0, r1
 }
 
+// GetRoleArn provides a mock function with given fields: ctx, roleName
+func (_m *CloudAPI) GetRoleArn(ctx context.Context, roleName string) (string, error) {
+	ret := _m.Called(ctx, roleName)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, roleName)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, roleName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRules provides a mock function with given fields: _a0, _a1
 func (_m *CloudAPI) GetRules(_a0 context.Context, _a1 string) ([]*elbv2.Rule, error) {
 	ret := _m.Called(_a0, _a1)
@@ -948,6 +1236,50 @@ func (_m *CloudAPI) GetSecurityGroupsByName(_a0 context.Context, _a1 []string) (
 	return r0, r1
 }
 
+// GetServerCertificateMetadata provides a mock function with given fields: ctx, certName
+func (_m *CloudAPI) GetServerCertificateMetadata(ctx context.Context, certName string) (*iam.ServerCertificateMetadata, error) {
+	ret := _m.Called(ctx, certName)
+
+	var r0 *iam.ServerCertificateMetadata
+	if rf, ok := ret.Get(0).(func(context.Context, string) *iam.ServerCertificateMetadata); ok {
+		r0 = rf(ctx, certName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.ServerCertificateMetadata)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, certName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetServiceQuotaValue provides a mock function with given fields: ctx, serviceCode, quotaCode
+func (_m *CloudAPI) GetServiceQuotaValue(ctx context.Context, serviceCode string, quotaCode string) (float64, error) {
+	ret := _m.Called(ctx, serviceCode, quotaCode)
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) float64); ok {
+		r0 = rf(ctx, serviceCode, quotaCode)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, serviceCode, quotaCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSubnetsByNameOrID provides a mock function with given fields: _a0, _a1
 func (_m *CloudAPI) GetSubnetsByNameOrID(_a0 context.Context, _a1 []string) ([]*ec2.Subnet, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1077,6 +1409,29 @@ func (_m *CloudAPI) GetVpcWithContext(_a0 context.Context) (*ec2.Vpc, error) {
 	return r0, r1
 }
 
+// GetWAFV2WebACLARNByName provides a mock function with given fields: ctx, name
+func (_m *CloudAPI) GetWAFV2WebACLARNByName(ctx context.Context, name string) (*string, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 *string
+	if rf, ok := ret.Get(0).(func(context.Context, string) *string); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetWAFV2WebACLSummary provides a mock function with given fields: ctx, webACLId
 func (_m *CloudAPI) GetWAFV2WebACLSummary(ctx context.Context, webACLId *string) (*wafv2.WebACL, error) {
 	ret := _m.Called(ctx, webACLId)
@@ -1100,6 +1455,29 @@ func (_m *CloudAPI) GetWAFV2WebACLSummary(ctx context.Context, webACLId *string)
 	return r0, r1
 }
 
+// GetWebACLIdByName provides a mock function with given fields: ctx, name
+func (_m *CloudAPI) GetWebACLIdByName(ctx context.Context, name string) (*string, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 *string
+	if rf, ok := ret.Get(0).(func(context.Context, string) *string); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetWebACLSummary provides a mock function with given fields: ctx, resourceArn
 func (_m *CloudAPI) GetWebACLSummary(ctx context.Context, resourceArn *string) (*waf.WebACLSummary, error) {
 	ret := _m.Called(ctx, resourceArn)
@@ -1123,6 +1501,29 @@ func (_m *CloudAPI) GetWebACLSummary(ctx context.Context, resourceArn *string) (
 	return r0, r1
 }
 
+// GetZonalShiftManagedResource provides a mock function with given fields: ctx, resourceArn
+func (_m *CloudAPI) GetZonalShiftManagedResource(ctx context.Context, resourceArn string) (*arczonalshift.GetManagedResourceOutput, error) {
+	ret := _m.Called(ctx, resourceArn)
+
+	var r0 *arczonalshift.GetManagedResourceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, string) *arczonalshift.GetManagedResourceOutput); ok {
+		r0 = rf(ctx, resourceArn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*arczonalshift.GetManagedResourceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, resourceArn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListCertificates provides a mock function with given fields: ctx, input
 func (_m *CloudAPI) ListCertificates(ctx context.Context, input *acm.ListCertificatesInput) ([]*acm.CertificateSummary, error) {
 	ret := _m.Called(ctx, input)
@@ -1169,6 +1570,29 @@ func (_m *CloudAPI) ListListenersByLoadBalancer(_a0 context.Context, _a1 string)
 	return r0, r1
 }
 
+// ListTagsForCertificate provides a mock function with given fields: ctx, certArn
+func (_m *CloudAPI) ListTagsForCertificate(ctx context.Context, certArn string) (map[string]string, error) {
+	ret := _m.Called(ctx, certArn)
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[string]string); ok {
+		r0 = rf(ctx, certArn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, certArn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ModifyListenerWithContext provides a mock function with given fields: _a0, _a1
 func (_m *CloudAPI) ModifyListenerWithContext(_a0 context.Context, _a1 *elbv2.ModifyListenerInput) (*elbv2.ModifyListenerOutput, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1307,6 +1731,34 @@ func (_m *CloudAPI) ModifyTargetGroupWithContext(_a0 context.Context, _a1 *elbv2
 	return r0, r1
 }
 
+// PutMetricAlarm provides a mock function with given fields: ctx, input
+func (_m *CloudAPI) PutMetricAlarm(ctx context.Context, input *cloudwatch.PutMetricAlarmInput) error {
+	ret := _m.Called(ctx, input)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudwatch.PutMetricAlarmInput) error); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutMetricData provides a mock function with given fields: ctx, namespace, data
+func (_m *CloudAPI) PutMetricData(ctx context.Context, namespace string, data []*cloudwatch.MetricDatum) error {
+	ret := _m.Called(ctx, namespace, data)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*cloudwatch.MetricDatum) error); ok {
+		r0 = rf(ctx, namespace, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RegisterTargetsWithContext provides a mock function with given fields: _a0, _a1
 func (_m *CloudAPI) RegisterTargetsWithContext(_a0 context.Context, _a1 *elbv2.RegisterTargetsInput) (*elbv2.RegisterTargetsOutput, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1468,6 +1920,20 @@ func (_m *CloudAPI) SetSubnetsWithContext(_a0 context.Context, _a1 *elbv2.SetSub
 	return r0, r1
 }
 
+// SetZonalAutoshiftStatus provides a mock function with given fields: ctx, resourceArn, status
+func (_m *CloudAPI) SetZonalAutoshiftStatus(ctx context.Context, resourceArn string, status string) error {
+	ret := _m.Called(ctx, resourceArn, status)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, resourceArn, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ShieldAvailable provides a mock function with given fields: ctx
 func (_m *CloudAPI) ShieldAvailable(ctx context.Context) (bool, error) {
 	ret := _m.Called(ctx)
@@ -1599,6 +2065,20 @@ func (_m *CloudAPI) UntagResourcesWithContext(_a0 context.Context, _a1 *resource
 	return r0, r1
 }
 
+// UpdatePracticeRunConfiguration provides a mock function with given fields: ctx, resourceArn, config
+func (_m *CloudAPI) UpdatePracticeRunConfiguration(ctx context.Context, resourceArn string, config *arczonalshift.PracticeRunConfiguration) error {
+	ret := _m.Called(ctx, resourceArn, config)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *arczonalshift.PracticeRunConfiguration) error); ok {
+		r0 = rf(ctx, resourceArn, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // WAFRegionalAvailable provides a mock function with given fields:
 func (_m *CloudAPI) WAFRegionalAvailable() bool {
 	ret := _m.Called()
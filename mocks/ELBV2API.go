@@ -173,6 +173,84 @@ func (_m *ELBV2API) AddTagsWithContext(_a0 context.Context, _a1 *elbv2.AddTagsIn
 	return r0, r1
 }
 
+// AddTrustStoreRevocations provides a mock function with given fields: _a0
+func (_m *ELBV2API) AddTrustStoreRevocations(_a0 *elbv2.AddTrustStoreRevocationsInput) (*elbv2.AddTrustStoreRevocationsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *elbv2.AddTrustStoreRevocationsOutput
+	if rf, ok := ret.Get(0).(func(*elbv2.AddTrustStoreRevocationsInput) *elbv2.AddTrustStoreRevocationsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.AddTrustStoreRevocationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*elbv2.AddTrustStoreRevocationsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddTrustStoreRevocationsRequest provides a mock function with given fields: _a0
+func (_m *ELBV2API) AddTrustStoreRevocationsRequest(_a0 *elbv2.AddTrustStoreRevocationsInput) (*request.Request, *elbv2.AddTrustStoreRevocationsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*elbv2.AddTrustStoreRevocationsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *elbv2.AddTrustStoreRevocationsOutput
+	if rf, ok := ret.Get(1).(func(*elbv2.AddTrustStoreRevocationsInput) *elbv2.AddTrustStoreRevocationsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*elbv2.AddTrustStoreRevocationsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddTrustStoreRevocationsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ELBV2API) AddTrustStoreRevocationsWithContext(_a0 context.Context, _a1 *elbv2.AddTrustStoreRevocationsInput, _a2 ...request.Option) (*elbv2.AddTrustStoreRevocationsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *elbv2.AddTrustStoreRevocationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *elbv2.AddTrustStoreRevocationsInput, ...request.Option) *elbv2.AddTrustStoreRevocationsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.AddTrustStoreRevocationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *elbv2.AddTrustStoreRevocationsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateListener provides a mock function with given fields: _a0
 func (_m *ELBV2API) CreateListener(_a0 *elbv2.CreateListenerInput) (*elbv2.CreateListenerOutput, error) {
 	ret := _m.Called(_a0)
@@ -485,6 +563,84 @@ func (_m *ELBV2API) CreateTargetGroupWithContext(_a0 context.Context, _a1 *elbv2
 	return r0, r1
 }
 
+// CreateTrustStore provides a mock function with given fields: _a0
+func (_m *ELBV2API) CreateTrustStore(_a0 *elbv2.CreateTrustStoreInput) (*elbv2.CreateTrustStoreOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *elbv2.CreateTrustStoreOutput
+	if rf, ok := ret.Get(0).(func(*elbv2.CreateTrustStoreInput) *elbv2.CreateTrustStoreOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.CreateTrustStoreOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*elbv2.CreateTrustStoreInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateTrustStoreRequest provides a mock function with given fields: _a0
+func (_m *ELBV2API) CreateTrustStoreRequest(_a0 *elbv2.CreateTrustStoreInput) (*request.Request, *elbv2.CreateTrustStoreOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*elbv2.CreateTrustStoreInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *elbv2.CreateTrustStoreOutput
+	if rf, ok := ret.Get(1).(func(*elbv2.CreateTrustStoreInput) *elbv2.CreateTrustStoreOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*elbv2.CreateTrustStoreOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateTrustStoreWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ELBV2API) CreateTrustStoreWithContext(_a0 context.Context, _a1 *elbv2.CreateTrustStoreInput, _a2 ...request.Option) (*elbv2.CreateTrustStoreOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *elbv2.CreateTrustStoreOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *elbv2.CreateTrustStoreInput, ...request.Option) *elbv2.CreateTrustStoreOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.CreateTrustStoreOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *elbv2.CreateTrustStoreInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteListener provides a mock function with given fields: _a0
 func (_m *ELBV2API) DeleteListener(_a0 *elbv2.DeleteListenerInput) (*elbv2.DeleteListenerOutput, error) {
 	ret := _m.Called(_a0)
@@ -719,6 +875,84 @@ func (_m *ELBV2API) DeleteRuleWithContext(_a0 context.Context, _a1 *elbv2.Delete
 	return r0, r1
 }
 
+// DeleteSharedTrustStoreAssociation provides a mock function with given fields: _a0
+func (_m *ELBV2API) DeleteSharedTrustStoreAssociation(_a0 *elbv2.DeleteSharedTrustStoreAssociationInput) (*elbv2.DeleteSharedTrustStoreAssociationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *elbv2.DeleteSharedTrustStoreAssociationOutput
+	if rf, ok := ret.Get(0).(func(*elbv2.DeleteSharedTrustStoreAssociationInput) *elbv2.DeleteSharedTrustStoreAssociationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.DeleteSharedTrustStoreAssociationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*elbv2.DeleteSharedTrustStoreAssociationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteSharedTrustStoreAssociationRequest provides a mock function with given fields: _a0
+func (_m *ELBV2API) DeleteSharedTrustStoreAssociationRequest(_a0 *elbv2.DeleteSharedTrustStoreAssociationInput) (*request.Request, *elbv2.DeleteSharedTrustStoreAssociationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*elbv2.DeleteSharedTrustStoreAssociationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *elbv2.DeleteSharedTrustStoreAssociationOutput
+	if rf, ok := ret.Get(1).(func(*elbv2.DeleteSharedTrustStoreAssociationInput) *elbv2.DeleteSharedTrustStoreAssociationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*elbv2.DeleteSharedTrustStoreAssociationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteSharedTrustStoreAssociationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ELBV2API) DeleteSharedTrustStoreAssociationWithContext(_a0 context.Context, _a1 *elbv2.DeleteSharedTrustStoreAssociationInput, _a2 ...request.Option) (*elbv2.DeleteSharedTrustStoreAssociationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *elbv2.DeleteSharedTrustStoreAssociationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *elbv2.DeleteSharedTrustStoreAssociationInput, ...request.Option) *elbv2.DeleteSharedTrustStoreAssociationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.DeleteSharedTrustStoreAssociationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *elbv2.DeleteSharedTrustStoreAssociationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteTargetGroup provides a mock function with given fields: _a0
 func (_m *ELBV2API) DeleteTargetGroup(_a0 *elbv2.DeleteTargetGroupInput) (*elbv2.DeleteTargetGroupOutput, error) {
 	ret := _m.Called(_a0)
@@ -797,6 +1031,84 @@ func (_m *ELBV2API) DeleteTargetGroupWithContext(_a0 context.Context, _a1 *elbv2
 	return r0, r1
 }
 
+// DeleteTrustStore provides a mock function with given fields: _a0
+func (_m *ELBV2API) DeleteTrustStore(_a0 *elbv2.DeleteTrustStoreInput) (*elbv2.DeleteTrustStoreOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *elbv2.DeleteTrustStoreOutput
+	if rf, ok := ret.Get(0).(func(*elbv2.DeleteTrustStoreInput) *elbv2.DeleteTrustStoreOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.DeleteTrustStoreOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*elbv2.DeleteTrustStoreInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteTrustStoreRequest provides a mock function with given fields: _a0
+func (_m *ELBV2API) DeleteTrustStoreRequest(_a0 *elbv2.DeleteTrustStoreInput) (*request.Request, *elbv2.DeleteTrustStoreOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*elbv2.DeleteTrustStoreInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *elbv2.DeleteTrustStoreOutput
+	if rf, ok := ret.Get(1).(func(*elbv2.DeleteTrustStoreInput) *elbv2.DeleteTrustStoreOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*elbv2.DeleteTrustStoreOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteTrustStoreWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ELBV2API) DeleteTrustStoreWithContext(_a0 context.Context, _a1 *elbv2.DeleteTrustStoreInput, _a2 ...request.Option) (*elbv2.DeleteTrustStoreOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *elbv2.DeleteTrustStoreOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *elbv2.DeleteTrustStoreInput, ...request.Option) *elbv2.DeleteTrustStoreOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.DeleteTrustStoreOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *elbv2.DeleteTrustStoreInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeregisterTargets provides a mock function with given fields: _a0
 func (_m *ELBV2API) DeregisterTargets(_a0 *elbv2.DeregisterTargetsInput) (*elbv2.DeregisterTargetsOutput, error) {
 	ret := _m.Called(_a0)
@@ -1838,21 +2150,21 @@ func (_m *ELBV2API) DescribeTargetHealthWithContext(_a0 context.Context, _a1 *el
 	return r0, r1
 }
 
-// ModifyListener provides a mock function with given fields: _a0
-func (_m *ELBV2API) ModifyListener(_a0 *elbv2.ModifyListenerInput) (*elbv2.ModifyListenerOutput, error) {
+// DescribeTrustStoreAssociations provides a mock function with given fields: _a0
+func (_m *ELBV2API) DescribeTrustStoreAssociations(_a0 *elbv2.DescribeTrustStoreAssociationsInput) (*elbv2.DescribeTrustStoreAssociationsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *elbv2.ModifyListenerOutput
-	if rf, ok := ret.Get(0).(func(*elbv2.ModifyListenerInput) *elbv2.ModifyListenerOutput); ok {
+	var r0 *elbv2.DescribeTrustStoreAssociationsOutput
+	if rf, ok := ret.Get(0).(func(*elbv2.DescribeTrustStoreAssociationsInput) *elbv2.DescribeTrustStoreAssociationsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*elbv2.ModifyListenerOutput)
+			r0 = ret.Get(0).(*elbv2.DescribeTrustStoreAssociationsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*elbv2.ModifyListenerInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*elbv2.DescribeTrustStoreAssociationsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1861,15 +2173,588 @@ func (_m *ELBV2API) ModifyListener(_a0 *elbv2.ModifyListenerInput) (*elbv2.Modif
 	return r0, r1
 }
 
-// ModifyListenerRequest provides a mock function with given fields: _a0
-func (_m *ELBV2API) ModifyListenerRequest(_a0 *elbv2.ModifyListenerInput) (*request.Request, *elbv2.ModifyListenerOutput) {
-	ret := _m.Called(_a0)
+// DescribeTrustStoreAssociationsPages provides a mock function with given fields: _a0, _a1
+func (_m *ELBV2API) DescribeTrustStoreAssociationsPages(_a0 *elbv2.DescribeTrustStoreAssociationsInput, _a1 func(*elbv2.DescribeTrustStoreAssociationsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*elbv2.ModifyListenerInput) *request.Request); ok {
-		r0 = rf(_a0)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*elbv2.DescribeTrustStoreAssociationsInput, func(*elbv2.DescribeTrustStoreAssociationsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		if ret.Get(0) != nil {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeTrustStoreAssociationsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *ELBV2API) DescribeTrustStoreAssociationsPagesWithContext(_a0 context.Context, _a1 *elbv2.DescribeTrustStoreAssociationsInput, _a2 func(*elbv2.DescribeTrustStoreAssociationsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *elbv2.DescribeTrustStoreAssociationsInput, func(*elbv2.DescribeTrustStoreAssociationsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeTrustStoreAssociationsRequest provides a mock function with given fields: _a0
+func (_m *ELBV2API) DescribeTrustStoreAssociationsRequest(_a0 *elbv2.DescribeTrustStoreAssociationsInput) (*request.Request, *elbv2.DescribeTrustStoreAssociationsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*elbv2.DescribeTrustStoreAssociationsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *elbv2.DescribeTrustStoreAssociationsOutput
+	if rf, ok := ret.Get(1).(func(*elbv2.DescribeTrustStoreAssociationsInput) *elbv2.DescribeTrustStoreAssociationsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*elbv2.DescribeTrustStoreAssociationsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeTrustStoreAssociationsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ELBV2API) DescribeTrustStoreAssociationsWithContext(_a0 context.Context, _a1 *elbv2.DescribeTrustStoreAssociationsInput, _a2 ...request.Option) (*elbv2.DescribeTrustStoreAssociationsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *elbv2.DescribeTrustStoreAssociationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *elbv2.DescribeTrustStoreAssociationsInput, ...request.Option) *elbv2.DescribeTrustStoreAssociationsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.DescribeTrustStoreAssociationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *elbv2.DescribeTrustStoreAssociationsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeTrustStoreRevocations provides a mock function with given fields: _a0
+func (_m *ELBV2API) DescribeTrustStoreRevocations(_a0 *elbv2.DescribeTrustStoreRevocationsInput) (*elbv2.DescribeTrustStoreRevocationsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *elbv2.DescribeTrustStoreRevocationsOutput
+	if rf, ok := ret.Get(0).(func(*elbv2.DescribeTrustStoreRevocationsInput) *elbv2.DescribeTrustStoreRevocationsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.DescribeTrustStoreRevocationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*elbv2.DescribeTrustStoreRevocationsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeTrustStoreRevocationsPages provides a mock function with given fields: _a0, _a1
+func (_m *ELBV2API) DescribeTrustStoreRevocationsPages(_a0 *elbv2.DescribeTrustStoreRevocationsInput, _a1 func(*elbv2.DescribeTrustStoreRevocationsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*elbv2.DescribeTrustStoreRevocationsInput, func(*elbv2.DescribeTrustStoreRevocationsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeTrustStoreRevocationsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *ELBV2API) DescribeTrustStoreRevocationsPagesWithContext(_a0 context.Context, _a1 *elbv2.DescribeTrustStoreRevocationsInput, _a2 func(*elbv2.DescribeTrustStoreRevocationsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *elbv2.DescribeTrustStoreRevocationsInput, func(*elbv2.DescribeTrustStoreRevocationsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeTrustStoreRevocationsRequest provides a mock function with given fields: _a0
+func (_m *ELBV2API) DescribeTrustStoreRevocationsRequest(_a0 *elbv2.DescribeTrustStoreRevocationsInput) (*request.Request, *elbv2.DescribeTrustStoreRevocationsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*elbv2.DescribeTrustStoreRevocationsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *elbv2.DescribeTrustStoreRevocationsOutput
+	if rf, ok := ret.Get(1).(func(*elbv2.DescribeTrustStoreRevocationsInput) *elbv2.DescribeTrustStoreRevocationsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*elbv2.DescribeTrustStoreRevocationsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeTrustStoreRevocationsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ELBV2API) DescribeTrustStoreRevocationsWithContext(_a0 context.Context, _a1 *elbv2.DescribeTrustStoreRevocationsInput, _a2 ...request.Option) (*elbv2.DescribeTrustStoreRevocationsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *elbv2.DescribeTrustStoreRevocationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *elbv2.DescribeTrustStoreRevocationsInput, ...request.Option) *elbv2.DescribeTrustStoreRevocationsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.DescribeTrustStoreRevocationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *elbv2.DescribeTrustStoreRevocationsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeTrustStores provides a mock function with given fields: _a0
+func (_m *ELBV2API) DescribeTrustStores(_a0 *elbv2.DescribeTrustStoresInput) (*elbv2.DescribeTrustStoresOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *elbv2.DescribeTrustStoresOutput
+	if rf, ok := ret.Get(0).(func(*elbv2.DescribeTrustStoresInput) *elbv2.DescribeTrustStoresOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.DescribeTrustStoresOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*elbv2.DescribeTrustStoresInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeTrustStoresPages provides a mock function with given fields: _a0, _a1
+func (_m *ELBV2API) DescribeTrustStoresPages(_a0 *elbv2.DescribeTrustStoresInput, _a1 func(*elbv2.DescribeTrustStoresOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*elbv2.DescribeTrustStoresInput, func(*elbv2.DescribeTrustStoresOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeTrustStoresPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *ELBV2API) DescribeTrustStoresPagesWithContext(_a0 context.Context, _a1 *elbv2.DescribeTrustStoresInput, _a2 func(*elbv2.DescribeTrustStoresOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *elbv2.DescribeTrustStoresInput, func(*elbv2.DescribeTrustStoresOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeTrustStoresRequest provides a mock function with given fields: _a0
+func (_m *ELBV2API) DescribeTrustStoresRequest(_a0 *elbv2.DescribeTrustStoresInput) (*request.Request, *elbv2.DescribeTrustStoresOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*elbv2.DescribeTrustStoresInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *elbv2.DescribeTrustStoresOutput
+	if rf, ok := ret.Get(1).(func(*elbv2.DescribeTrustStoresInput) *elbv2.DescribeTrustStoresOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*elbv2.DescribeTrustStoresOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeTrustStoresWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ELBV2API) DescribeTrustStoresWithContext(_a0 context.Context, _a1 *elbv2.DescribeTrustStoresInput, _a2 ...request.Option) (*elbv2.DescribeTrustStoresOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *elbv2.DescribeTrustStoresOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *elbv2.DescribeTrustStoresInput, ...request.Option) *elbv2.DescribeTrustStoresOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.DescribeTrustStoresOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *elbv2.DescribeTrustStoresInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetResourcePolicy provides a mock function with given fields: _a0
+func (_m *ELBV2API) GetResourcePolicy(_a0 *elbv2.GetResourcePolicyInput) (*elbv2.GetResourcePolicyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *elbv2.GetResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(*elbv2.GetResourcePolicyInput) *elbv2.GetResourcePolicyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.GetResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*elbv2.GetResourcePolicyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetResourcePolicyRequest provides a mock function with given fields: _a0
+func (_m *ELBV2API) GetResourcePolicyRequest(_a0 *elbv2.GetResourcePolicyInput) (*request.Request, *elbv2.GetResourcePolicyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*elbv2.GetResourcePolicyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *elbv2.GetResourcePolicyOutput
+	if rf, ok := ret.Get(1).(func(*elbv2.GetResourcePolicyInput) *elbv2.GetResourcePolicyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*elbv2.GetResourcePolicyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetResourcePolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ELBV2API) GetResourcePolicyWithContext(_a0 context.Context, _a1 *elbv2.GetResourcePolicyInput, _a2 ...request.Option) (*elbv2.GetResourcePolicyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *elbv2.GetResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *elbv2.GetResourcePolicyInput, ...request.Option) *elbv2.GetResourcePolicyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.GetResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *elbv2.GetResourcePolicyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrustStoreCaCertificatesBundle provides a mock function with given fields: _a0
+func (_m *ELBV2API) GetTrustStoreCaCertificatesBundle(_a0 *elbv2.GetTrustStoreCaCertificatesBundleInput) (*elbv2.GetTrustStoreCaCertificatesBundleOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *elbv2.GetTrustStoreCaCertificatesBundleOutput
+	if rf, ok := ret.Get(0).(func(*elbv2.GetTrustStoreCaCertificatesBundleInput) *elbv2.GetTrustStoreCaCertificatesBundleOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.GetTrustStoreCaCertificatesBundleOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*elbv2.GetTrustStoreCaCertificatesBundleInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrustStoreCaCertificatesBundleRequest provides a mock function with given fields: _a0
+func (_m *ELBV2API) GetTrustStoreCaCertificatesBundleRequest(_a0 *elbv2.GetTrustStoreCaCertificatesBundleInput) (*request.Request, *elbv2.GetTrustStoreCaCertificatesBundleOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*elbv2.GetTrustStoreCaCertificatesBundleInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *elbv2.GetTrustStoreCaCertificatesBundleOutput
+	if rf, ok := ret.Get(1).(func(*elbv2.GetTrustStoreCaCertificatesBundleInput) *elbv2.GetTrustStoreCaCertificatesBundleOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*elbv2.GetTrustStoreCaCertificatesBundleOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetTrustStoreCaCertificatesBundleWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ELBV2API) GetTrustStoreCaCertificatesBundleWithContext(_a0 context.Context, _a1 *elbv2.GetTrustStoreCaCertificatesBundleInput, _a2 ...request.Option) (*elbv2.GetTrustStoreCaCertificatesBundleOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *elbv2.GetTrustStoreCaCertificatesBundleOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *elbv2.GetTrustStoreCaCertificatesBundleInput, ...request.Option) *elbv2.GetTrustStoreCaCertificatesBundleOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.GetTrustStoreCaCertificatesBundleOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *elbv2.GetTrustStoreCaCertificatesBundleInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrustStoreRevocationContent provides a mock function with given fields: _a0
+func (_m *ELBV2API) GetTrustStoreRevocationContent(_a0 *elbv2.GetTrustStoreRevocationContentInput) (*elbv2.GetTrustStoreRevocationContentOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *elbv2.GetTrustStoreRevocationContentOutput
+	if rf, ok := ret.Get(0).(func(*elbv2.GetTrustStoreRevocationContentInput) *elbv2.GetTrustStoreRevocationContentOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.GetTrustStoreRevocationContentOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*elbv2.GetTrustStoreRevocationContentInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrustStoreRevocationContentRequest provides a mock function with given fields: _a0
+func (_m *ELBV2API) GetTrustStoreRevocationContentRequest(_a0 *elbv2.GetTrustStoreRevocationContentInput) (*request.Request, *elbv2.GetTrustStoreRevocationContentOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*elbv2.GetTrustStoreRevocationContentInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *elbv2.GetTrustStoreRevocationContentOutput
+	if rf, ok := ret.Get(1).(func(*elbv2.GetTrustStoreRevocationContentInput) *elbv2.GetTrustStoreRevocationContentOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*elbv2.GetTrustStoreRevocationContentOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetTrustStoreRevocationContentWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ELBV2API) GetTrustStoreRevocationContentWithContext(_a0 context.Context, _a1 *elbv2.GetTrustStoreRevocationContentInput, _a2 ...request.Option) (*elbv2.GetTrustStoreRevocationContentOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *elbv2.GetTrustStoreRevocationContentOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *elbv2.GetTrustStoreRevocationContentInput, ...request.Option) *elbv2.GetTrustStoreRevocationContentOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.GetTrustStoreRevocationContentOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *elbv2.GetTrustStoreRevocationContentInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyListener provides a mock function with given fields: _a0
+func (_m *ELBV2API) ModifyListener(_a0 *elbv2.ModifyListenerInput) (*elbv2.ModifyListenerOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *elbv2.ModifyListenerOutput
+	if rf, ok := ret.Get(0).(func(*elbv2.ModifyListenerInput) *elbv2.ModifyListenerOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.ModifyListenerOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*elbv2.ModifyListenerInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyListenerRequest provides a mock function with given fields: _a0
+func (_m *ELBV2API) ModifyListenerRequest(_a0 *elbv2.ModifyListenerInput) (*request.Request, *elbv2.ModifyListenerOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*elbv2.ModifyListenerInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*request.Request)
 		}
 	}
@@ -2228,6 +3113,84 @@ func (_m *ELBV2API) ModifyTargetGroupWithContext(_a0 context.Context, _a1 *elbv2
 	return r0, r1
 }
 
+// ModifyTrustStore provides a mock function with given fields: _a0
+func (_m *ELBV2API) ModifyTrustStore(_a0 *elbv2.ModifyTrustStoreInput) (*elbv2.ModifyTrustStoreOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *elbv2.ModifyTrustStoreOutput
+	if rf, ok := ret.Get(0).(func(*elbv2.ModifyTrustStoreInput) *elbv2.ModifyTrustStoreOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.ModifyTrustStoreOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*elbv2.ModifyTrustStoreInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyTrustStoreRequest provides a mock function with given fields: _a0
+func (_m *ELBV2API) ModifyTrustStoreRequest(_a0 *elbv2.ModifyTrustStoreInput) (*request.Request, *elbv2.ModifyTrustStoreOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*elbv2.ModifyTrustStoreInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *elbv2.ModifyTrustStoreOutput
+	if rf, ok := ret.Get(1).(func(*elbv2.ModifyTrustStoreInput) *elbv2.ModifyTrustStoreOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*elbv2.ModifyTrustStoreOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyTrustStoreWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ELBV2API) ModifyTrustStoreWithContext(_a0 context.Context, _a1 *elbv2.ModifyTrustStoreInput, _a2 ...request.Option) (*elbv2.ModifyTrustStoreOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *elbv2.ModifyTrustStoreOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *elbv2.ModifyTrustStoreInput, ...request.Option) *elbv2.ModifyTrustStoreOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.ModifyTrustStoreOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *elbv2.ModifyTrustStoreInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RegisterTargets provides a mock function with given fields: _a0
 func (_m *ELBV2API) RegisterTargets(_a0 *elbv2.RegisterTargetsInput) (*elbv2.RegisterTargetsOutput, error) {
 	ret := _m.Called(_a0)
@@ -2462,6 +3425,84 @@ func (_m *ELBV2API) RemoveTagsWithContext(_a0 context.Context, _a1 *elbv2.Remove
 	return r0, r1
 }
 
+// RemoveTrustStoreRevocations provides a mock function with given fields: _a0
+func (_m *ELBV2API) RemoveTrustStoreRevocations(_a0 *elbv2.RemoveTrustStoreRevocationsInput) (*elbv2.RemoveTrustStoreRevocationsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *elbv2.RemoveTrustStoreRevocationsOutput
+	if rf, ok := ret.Get(0).(func(*elbv2.RemoveTrustStoreRevocationsInput) *elbv2.RemoveTrustStoreRevocationsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.RemoveTrustStoreRevocationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*elbv2.RemoveTrustStoreRevocationsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveTrustStoreRevocationsRequest provides a mock function with given fields: _a0
+func (_m *ELBV2API) RemoveTrustStoreRevocationsRequest(_a0 *elbv2.RemoveTrustStoreRevocationsInput) (*request.Request, *elbv2.RemoveTrustStoreRevocationsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*elbv2.RemoveTrustStoreRevocationsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *elbv2.RemoveTrustStoreRevocationsOutput
+	if rf, ok := ret.Get(1).(func(*elbv2.RemoveTrustStoreRevocationsInput) *elbv2.RemoveTrustStoreRevocationsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*elbv2.RemoveTrustStoreRevocationsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// RemoveTrustStoreRevocationsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ELBV2API) RemoveTrustStoreRevocationsWithContext(_a0 context.Context, _a1 *elbv2.RemoveTrustStoreRevocationsInput, _a2 ...request.Option) (*elbv2.RemoveTrustStoreRevocationsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *elbv2.RemoveTrustStoreRevocationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *elbv2.RemoveTrustStoreRevocationsInput, ...request.Option) *elbv2.RemoveTrustStoreRevocationsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elbv2.RemoveTrustStoreRevocationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *elbv2.RemoveTrustStoreRevocationsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetIpAddressType provides a mock function with given fields: _a0
 func (_m *ELBV2API) SetIpAddressType(_a0 *elbv2.SetIpAddressTypeInput) (*elbv2.SetIpAddressTypeOutput, error) {
 	ret := _m.Called(_a0)
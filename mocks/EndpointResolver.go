@@ -9,6 +9,8 @@ import (
 	v1 "k8s.io/api/core/v1"
 
 	v1beta1 "k8s.io/api/extensions/v1beta1"
+
+	labels "k8s.io/apimachinery/pkg/labels"
 )
 
 // EndpointResolver is an autogenerated mock type for the EndpointResolver type
@@ -16,13 +18,13 @@ type EndpointResolver struct {
 	mock.Mock
 }
 
-// Resolve provides a mock function with given fields: _a0, _a1, _a2
-func (_m *EndpointResolver) Resolve(_a0 *v1beta1.Ingress, _a1 *v1beta1.IngressBackend, _a2 string) ([]*elbv2.TargetDescription, error) {
-	ret := _m.Called(_a0, _a1, _a2)
+// Resolve provides a mock function with given fields: ingress, backend, targetType, nodeSelector
+func (_m *EndpointResolver) Resolve(ingress *v1beta1.Ingress, backend *v1beta1.IngressBackend, targetType string, nodeSelector labels.Selector) ([]*elbv2.TargetDescription, error) {
+	ret := _m.Called(ingress, backend, targetType, nodeSelector)
 
 	var r0 []*elbv2.TargetDescription
-	if rf, ok := ret.Get(0).(func(*v1beta1.Ingress, *v1beta1.IngressBackend, string) []*elbv2.TargetDescription); ok {
-		r0 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(*v1beta1.Ingress, *v1beta1.IngressBackend, string, labels.Selector) []*elbv2.TargetDescription); ok {
+		r0 = rf(ingress, backend, targetType, nodeSelector)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*elbv2.TargetDescription)
@@ -30,8 +32,8 @@ func (_m *EndpointResolver) Resolve(_a0 *v1beta1.Ingress, _a1 *v1beta1.IngressBa
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*v1beta1.Ingress, *v1beta1.IngressBackend, string) error); ok {
-		r1 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(1).(func(*v1beta1.Ingress, *v1beta1.IngressBackend, string, labels.Selector) error); ok {
+		r1 = rf(ingress, backend, targetType, nodeSelector)
 	} else {
 		r1 = ret.Error(1)
 	}
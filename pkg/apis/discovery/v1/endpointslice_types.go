@@ -0,0 +1,103 @@
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LabelServiceName is the label of EndpointSlices naming the service they belong to.
+const LabelServiceName = "kubernetes.io/service-name"
+
+// AddressType is the type of the addresses of an EndpointSlice.
+type AddressType string
+
+const (
+	// AddressTypeIPv4 are IPv4 addresses.
+	AddressTypeIPv4 AddressType = "IPv4"
+	// AddressTypeIPv6 are IPv6 addresses.
+	AddressTypeIPv6 AddressType = "IPv6"
+	// AddressTypeFQDN are fully qualified domain names.
+	AddressTypeFQDN AddressType = "FQDN"
+)
+
+// EndpointConditions is the state of an endpoint.
+type EndpointConditions struct {
+	// Ready is whether the endpoint is ready to receive traffic, nil means ready.
+	// +optional
+	Ready *bool `json:"ready,omitempty"`
+
+	// Serving is whether the endpoint is ready regardless of its termination.
+	// +optional
+	Serving *bool `json:"serving,omitempty"`
+
+	// Terminating is whether the endpoint is terminating.
+	// +optional
+	Terminating *bool `json:"terminating,omitempty"`
+}
+
+// Endpoint is a backend of a service.
+type Endpoint struct {
+	// Addresses are the fungible addresses of the endpoint, of the AddressType of its EndpointSlice.
+	Addresses []string `json:"addresses"`
+
+	// +optional
+	Conditions EndpointConditions `json:"conditions,omitempty"`
+
+	// +optional
+	Hostname *string `json:"hostname,omitempty"`
+
+	// TargetRef is the object backing the endpoint, usually a pod.
+	// +optional
+	TargetRef *corev1.ObjectReference `json:"targetRef,omitempty"`
+
+	// NodeName is the node hosting the endpoint.
+	// +optional
+	NodeName *string `json:"nodeName,omitempty"`
+
+	// Zone is the zone of the endpoint.
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+}
+
+// EndpointPort is a port served by the endpoints of an EndpointSlice.
+type EndpointPort struct {
+	// Name is the name of the service port.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// +optional
+	Protocol *corev1.Protocol `json:"protocol,omitempty"`
+
+	// Port is the port number, nil means all ports.
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+
+	// +optional
+	AppProtocol *string `json:"appProtocol,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EndpointSlice is a subset of the endpoints of a service.
+type EndpointSlice struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	AddressType AddressType `json:"addressType"`
+
+	Endpoints []Endpoint `json:"endpoints"`
+
+	// +optional
+	Ports []EndpointPort `json:"ports"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EndpointSliceList contains a list of EndpointSlice.
+type EndpointSliceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []EndpointSlice `json:"items"`
+}
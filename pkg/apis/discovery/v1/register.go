@@ -0,0 +1,23 @@
+// Package v1 contains the EndpointSlice objects of the discovery.k8s.io/v1 API, which k8s.io/api predates in this module.
+// +groupName=discovery.k8s.io
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/scheme"
+)
+
+var (
+	// SchemeGroupVersion is the group version of the objects of this package.
+	SchemeGroupVersion = schema.GroupVersion{Group: "discovery.k8s.io", Version: "v1"}
+
+	// SchemeBuilder registers the objects of this package to a scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+
+	// AddToScheme adds the objects of this package to a scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
+
+func init() {
+	SchemeBuilder.Register(&EndpointSlice{}, &EndpointSliceList{})
+}
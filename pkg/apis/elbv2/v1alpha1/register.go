@@ -0,0 +1,23 @@
+// Package v1alpha1 contains the v1alpha1 API of the elbv2.k8s.aws group, binding Kubernetes services to ELBV2 resources.
+// +groupName=elbv2.k8s.aws
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/scheme"
+)
+
+var (
+	// SchemeGroupVersion is the group version of the objects of this package.
+	SchemeGroupVersion = schema.GroupVersion{Group: "elbv2.k8s.aws", Version: "v1alpha1"}
+
+	// SchemeBuilder registers the objects of this package to a scheme.
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+
+	// AddToScheme adds the objects of this package to a scheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
+
+func init() {
+	SchemeBuilder.Register(&TargetGroupBinding{}, &TargetGroupBindingList{})
+}
@@ -0,0 +1,75 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// TargetType is the type of the targets registered into a target group.
+type TargetType string
+
+const (
+	TargetTypeInstance TargetType = "instance"
+	TargetTypeIP       TargetType = "ip"
+)
+
+// ServiceReference references a port of a service in the namespace of the TargetGroupBinding.
+type ServiceReference struct {
+	// Name is the name of the service.
+	Name string `json:"name"`
+
+	// Port is the name or number of the service port.
+	Port intstr.IntOrString `json:"port"`
+}
+
+// TargetGroupBindingSpec defines the target group the endpoints of a service are registered into.
+type TargetGroupBindingSpec struct {
+	// TargetGroupARN is the ARN of the target group, which is managed outside of the controller.
+	TargetGroupARN string `json:"targetGroupARN"`
+
+	// TargetType is the type of the targets registered, it must match the target type of the target group.
+	// It defaults to the target type of the target group.
+	// +optional
+	TargetType *TargetType `json:"targetType,omitempty"`
+
+	// ServiceRef is the service whose endpoints are registered.
+	ServiceRef ServiceReference `json:"serviceRef"`
+
+	// MultiCluster shares the target group with the controllers of other clusters,
+	// only the targets registered by the TargetGroupBinding are deregistered, instead of all the other targets.
+	// +optional
+	MultiCluster bool `json:"multiCluster,omitempty"`
+}
+
+// TargetGroupBindingStatus defines the observed state of TargetGroupBinding.
+type TargetGroupBindingStatus struct {
+	// ObservedGeneration is the last generation whose targets were registered.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Targets are the targets registered by a MultiCluster TargetGroupBinding, as id:port.
+	// +optional
+	Targets []string `json:"targets,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TargetGroupBinding registers the endpoints of a service into an existing target group.
+type TargetGroupBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TargetGroupBindingSpec   `json:"spec,omitempty"`
+	Status TargetGroupBindingStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TargetGroupBindingList contains a list of TargetGroupBinding.
+type TargetGroupBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []TargetGroupBinding `json:"items"`
+}
@@ -0,0 +1,137 @@
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PathType is how the path of an HTTPIngressPath is matched.
+type PathType string
+
+const (
+	// PathTypeExact matches the path exactly.
+	PathTypeExact PathType = "Exact"
+	// PathTypePrefix matches the paths starting with the path, element by element.
+	PathTypePrefix PathType = "Prefix"
+	// PathTypeImplementationSpecific leaves the matching to the controller of the ingress.
+	PathTypeImplementationSpecific PathType = "ImplementationSpecific"
+)
+
+// ServiceBackendPort is the name or number of a service port.
+type ServiceBackendPort struct {
+	// Name is the name of the port, exclusive with Number.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Number is the number of the port, exclusive with Name.
+	// +optional
+	Number int32 `json:"number,omitempty"`
+}
+
+// IngressServiceBackend references a port of a service in the namespace of the ingress.
+type IngressServiceBackend struct {
+	// Name is the name of the service.
+	Name string `json:"name"`
+
+	// Port is the port of the service.
+	Port ServiceBackendPort `json:"port,omitempty"`
+}
+
+// IngressBackend is the destination of the requests matched by an ingress, a service or a resource.
+type IngressBackend struct {
+	// Service is the service receiving the requests, exclusive with Resource.
+	// +optional
+	Service *IngressServiceBackend `json:"service,omitempty"`
+
+	// Resource is an object in the namespace of the ingress receiving the requests, exclusive with Service.
+	// +optional
+	Resource *corev1.TypedLocalObjectReference `json:"resource,omitempty"`
+}
+
+// HTTPIngressPath routes the requests of a path to a backend.
+type HTTPIngressPath struct {
+	// Path is matched against the path of requests as PathType defines.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// PathType is how Path is matched.
+	PathType *PathType `json:"pathType,omitempty"`
+
+	// Backend is the destination of the requests matching Path.
+	Backend IngressBackend `json:"backend"`
+}
+
+// HTTPIngressRuleValue is the list of paths of an HTTP rule.
+type HTTPIngressRuleValue struct {
+	Paths []HTTPIngressPath `json:"paths"`
+}
+
+// IngressRuleValue is how the requests of a rule are routed.
+type IngressRuleValue struct {
+	// +optional
+	HTTP *HTTPIngressRuleValue `json:"http,omitempty"`
+}
+
+// IngressRule routes the requests of a host.
+type IngressRule struct {
+	// Host is the host of the requests, all hosts if empty.
+	// +optional
+	Host string `json:"host,omitempty"`
+
+	IngressRuleValue `json:",inline,omitempty"`
+}
+
+// IngressTLS is the TLS configuration of the hosts of an ingress.
+type IngressTLS struct {
+	// +optional
+	Hosts []string `json:"hosts,omitempty"`
+
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// IngressSpec defines how the requests to an ingress are routed.
+type IngressSpec struct {
+	// IngressClassName is the name of the IngressClass of the ingress.
+	// +optional
+	IngressClassName *string `json:"ingressClassName,omitempty"`
+
+	// DefaultBackend receives the requests no rule matches.
+	// +optional
+	DefaultBackend *IngressBackend `json:"defaultBackend,omitempty"`
+
+	// +optional
+	TLS []IngressTLS `json:"tls,omitempty"`
+
+	// +optional
+	Rules []IngressRule `json:"rules,omitempty"`
+}
+
+// IngressStatus is the observed state of an ingress.
+type IngressStatus struct {
+	// LoadBalancer is the load balancer of the ingress.
+	// +optional
+	LoadBalancer corev1.LoadBalancerStatus `json:"loadBalancer,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Ingress routes external requests to the services of its namespace.
+type Ingress struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IngressSpec   `json:"spec,omitempty"`
+	Status IngressStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// IngressList contains a list of Ingress.
+type IngressList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Ingress `json:"items"`
+}
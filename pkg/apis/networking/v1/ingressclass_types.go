@@ -0,0 +1,47 @@
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AnnotationIsDefaultIngressClass marks the IngressClass of the ingresses which don't specify a class.
+const AnnotationIsDefaultIngressClass = "ingressclass.kubernetes.io/is-default-class"
+
+// IngressClassSpec defines the controller implementing an IngressClass.
+type IngressClassSpec struct {
+	// Controller is the name of the controller implementing the class, e.g. ingress.k8s.aws/alb.
+	// +optional
+	Controller string `json:"controller,omitempty"`
+
+	// Parameters references the configuration of the class, which is specific to its controller.
+	// +optional
+	Parameters *corev1.TypedLocalObjectReference `json:"parameters,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// IngressClass represents the class of ingresses, naming the controller which implements them.
+type IngressClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec IngressClassSpec `json:"spec,omitempty"`
+}
+
+// IsDefault returns whether the class applies to ingresses which don't specify a class.
+func (c *IngressClass) IsDefault() bool {
+	return c.Annotations[AnnotationIsDefaultIngressClass] == "true"
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// IngressClassList contains a list of IngressClass.
+type IngressClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []IngressClass `json:"items"`
+}
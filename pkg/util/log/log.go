@@ -2,16 +2,131 @@
 package log
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 	"reflect"
+	"runtime"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/golang/glog"
 )
 
+const (
+	// FormatText writes log lines through glog.
+	FormatText = "text"
+	// FormatJSON writes one JSON object per log line to stderr.
+	FormatJSON = "json"
+)
+
+// Level is the severity of a log line.
+type Level int
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+var levelNames = map[Level]string{
+	LevelDebug: "debug",
+	LevelInfo:  "info",
+	LevelWarn:  "warn",
+	LevelError: "error",
+}
+
+func (l Level) String() string {
+	return levelNames[l]
+}
+
+// ParseLevel parses the name of a Level.
+func ParseLevel(s string) (Level, error) {
+	for level, name := range levelNames {
+		if name == strings.ToLower(s) {
+			return level, nil
+		}
+	}
+	return LevelInfo, fmt.Errorf("unknown log level %v, must be one of debug, info, warn, error", s)
+}
+
+var (
+	outputMutex  sync.Mutex
+	jsonOutput   io.Writer = os.Stderr
+	outputFormat           = FormatText
+	levelsMutex  sync.RWMutex
+	moduleLevels = map[string]Level{}
+)
+
+// Configure sets the output format and the minimum level per module for all Loggers.
+// The module of a log line is the name of the package issuing it, e.g. lb, ls, tg, sg or aws.
+// Modules without an explicit level log debug messages only if glog's verbosity is at least 2.
+// It's not safe to be called concurrently with logging.
+func Configure(logFormat string, levels map[string]string) error {
+	parsedLevels, err := parseConfig(logFormat, levels)
+	if err != nil {
+		return err
+	}
+	outputFormat = logFormat
+	setModuleLevels(parsedLevels)
+	return nil
+}
+
+// SetLevels replaces the minimum level per module set by Configure.
+// Unlike Configure, it's safe to be called concurrently with logging.
+func SetLevels(levels map[string]string) error {
+	parsedLevels, err := parseLevels(levels)
+	if err != nil {
+		return err
+	}
+	setModuleLevels(parsedLevels)
+	return nil
+}
+
+func setModuleLevels(levels map[string]Level) {
+	levelsMutex.Lock()
+	defer levelsMutex.Unlock()
+	moduleLevels = levels
+}
+
+// ValidateConfig checks the arguments of Configure, without configuring Loggers.
+func ValidateConfig(logFormat string, levels map[string]string) error {
+	_, err := parseConfig(logFormat, levels)
+	return err
+}
+
+func parseConfig(logFormat string, levels map[string]string) (map[string]Level, error) {
+	if logFormat != FormatText && logFormat != FormatJSON {
+		return nil, fmt.Errorf("unknown log format %v, must be %v or %v", logFormat, FormatText, FormatJSON)
+	}
+	return parseLevels(levels)
+}
+
+func parseLevels(levels map[string]string) (map[string]Level, error) {
+	parsedLevels := make(map[string]Level, len(levels))
+	for module, s := range levels {
+		level, err := ParseLevel(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid log level for module %v due to %v", module, err)
+		}
+		parsedLevels[module] = level
+	}
+	return parsedLevels, nil
+}
+
+type field struct {
+	key   string
+	value interface{}
+}
+
 type Logger struct {
-	name string
+	name   string
+	fields []field
 }
 
 // New creates a new Logger.
@@ -20,85 +135,154 @@ func New(name string) *Logger {
 	return &Logger{name: name}
 }
 
+// WithField returns a copy of the Logger which adds the key/value pair to every log line.
+func (l *Logger) WithField(key string, value interface{}) *Logger {
+	fields := make([]field, 0, len(l.fields)+1)
+	fields = append(fields, l.fields...)
+	fields = append(fields, field{key: key, value: value})
+	return &Logger{name: l.name, fields: fields}
+}
+
 // Debugf will print debug messages if debug logging is enabled
 func (l *Logger) Debugf(format string, args ...interface{}) {
-	debugf(format, l.name, 2, args...)
+	l.output(LevelDebug, 0, format, args...)
 }
 
-// DebugLevelf will print debug messages if debug logging is enabled
+// DebugLevelf will print debug messages if debug logging is enabled, level is the depth passed to glog.InfoDepth,
+// 2 attributing the message to the caller of DebugLevelf, 3 to its own caller and so on.
 func (l *Logger) DebugLevelf(level int, format string, args ...interface{}) {
-	debugf(format, l.name, level, args...)
+	l.output(LevelDebug, level-2, format, args...)
 }
 
 // Infof will print info level messages
 func (l *Logger) Infof(format string, args ...interface{}) {
-	infof(format, l.name, args...)
+	l.output(LevelInfo, 0, format, args...)
 }
 
 // Warnf will print warning level messages
 func (l *Logger) Warnf(format string, args ...interface{}) {
-	warnf(format, l.name, args...)
+	l.output(LevelWarn, 0, format, args...)
 }
 
 // Errorf will print error level messages
 func (l *Logger) Errorf(format string, args ...interface{}) {
-	errorf(format, l.name, args...)
+	l.output(LevelError, 0, format, args...)
 }
 
 // Fatalf will print error level messages
 func (l *Logger) Fatalf(format string, args ...interface{}) {
-	fatalf(format, l.name, args...)
+	if outputFormat == FormatJSON {
+		l.output(LevelError, 0, format, args...)
+		os.Exit(255)
+	}
+	glog.FatalDepth(1, fmt.Sprintf(l.prefix()+format, args...))
 }
 
 // Exitf will print error level messages and exit
 func (l *Logger) Exitf(format string, args ...interface{}) {
-	exitf(format, l.name, args...)
+	if outputFormat == FormatJSON {
+		l.output(LevelError, 0, format, args...)
+		os.Exit(1)
+	}
+	glog.ExitDepth(1, fmt.Sprintf(l.prefix()+format, args...))
 }
 
-// debugf will print debug messages if debug logging is enabled
-func debugf(format, ingressName string, level int, args ...interface{}) {
-	if glog.V(2) {
-		prefix := fmt.Sprintf("%s: ", ingressName)
-		for _, line := range strings.Split(fmt.Sprintf(format, args...), "\n") {
-			glog.InfoDepth(level, prefix, line)
+// output writes a log line if level is enabled for the module of the caller.
+// depth is the number of stack frames between the exported logging method and its caller.
+func (l *Logger) output(level Level, depth int, format string, args ...interface{}) {
+	module := callerModule(depth + 3)
+	if !enabled(module, level) {
+		return
+	}
+	msg := fmt.Sprintf(format, args...)
+	if outputFormat == FormatJSON {
+		l.outputJSON(level, module, msg, args)
+		return
+	}
+
+	prefix := l.prefix()
+	for _, line := range strings.Split(msg, "\n") {
+		switch level {
+		case LevelDebug, LevelInfo:
+			glog.InfoDepth(depth+2, prefix, line)
+		case LevelWarn:
+			glog.WarningDepth(depth+2, prefix, line)
+		case LevelError:
+			glog.ErrorDepth(depth+2, prefix, line)
 		}
 	}
 }
 
-// infof will print info level messages
-func infof(format, ingressName string, args ...interface{}) {
-	prefix := fmt.Sprintf("%s: ", ingressName)
-	for _, line := range strings.Split(fmt.Sprintf(format, args...), "\n") {
-		glog.InfoDepth(2, prefix, line)
+func (l *Logger) outputJSON(level Level, module string, msg string, args []interface{}) {
+	entry := map[string]interface{}{
+		"ts":      time.Now().UTC().Format(time.RFC3339Nano),
+		"level":   level.String(),
+		"module":  module,
+		"ingress": l.name,
+		"msg":     msg,
+	}
+	for _, f := range l.fields {
+		entry[f.key] = f.value
+	}
+	if requestIDs := requestIDs(args); len(requestIDs) != 0 {
+		entry["requestIDs"] = requestIDs
 	}
+	b, err := json.Marshal(entry)
+	if err != nil {
+		b, _ = json.Marshal(map[string]interface{}{"level": LevelError.String(), "msg": fmt.Sprintf("failed to encode log entry due to %v", err)})
+	}
+
+	outputMutex.Lock()
+	defer outputMutex.Unlock()
+	_, _ = jsonOutput.Write(append(b, '\n'))
 }
 
-// warnf will print warning level messages
-func warnf(format, ingressName string, args ...interface{}) {
-	prefix := fmt.Sprintf("%s: ", ingressName)
-	for _, line := range strings.Split(fmt.Sprintf(format, args...), "\n") {
-		glog.WarningDepth(2, prefix, line)
+func (l *Logger) prefix() string {
+	var sb strings.Builder
+	sb.WriteString(l.name)
+	for _, f := range l.fields {
+		fmt.Fprintf(&sb, " %v=%v", f.key, f.value)
 	}
+	sb.WriteString(": ")
+	return sb.String()
 }
 
-// errorf will print error level messages
-func errorf(format, ingressName string, args ...interface{}) {
-	prefix := fmt.Sprintf("%s: ", ingressName)
-	for _, line := range strings.Split(fmt.Sprintf(format, args...), "\n") {
-		glog.ErrorDepth(2, prefix, line)
+func enabled(module string, level Level) bool {
+	levelsMutex.RLock()
+	moduleLevel, ok := moduleLevels[module]
+	levelsMutex.RUnlock()
+	if ok {
+		return level >= moduleLevel
+	}
+	if level == LevelDebug {
+		return bool(glog.V(2))
 	}
+	return true
 }
 
-// fatalf will print error level messages
-func fatalf(format, ingressName string, args ...interface{}) {
-	prefix := fmt.Sprintf("%s: ", ingressName)
-	glog.FatalDepth(2, fmt.Sprintf(prefix+format, args...))
+// callerModule returns the name of the package directory of the function skip frames up the stack.
+func callerModule(skip int) string {
+	_, file, _, ok := runtime.Caller(skip)
+	if !ok {
+		return "unknown"
+	}
+	return filepath.Base(filepath.Dir(file))
 }
 
-// Exitf will print error level messages and exit
-func exitf(format, ingressName string, args ...interface{}) {
-	prefix := fmt.Sprintf("%s: ", ingressName)
-	glog.ExitDepth(2, fmt.Sprintf(prefix+format, args...))
+// requestIDer is implemented by AWS request failures.
+type requestIDer interface {
+	RequestID() string
+}
+
+// requestIDs extracts the AWS request IDs of errors in args.
+func requestIDs(args []interface{}) []string {
+	var ids []string
+	for _, arg := range args {
+		if r, ok := arg.(requestIDer); ok && r.RequestID() != "" {
+			ids = append(ids, r.RequestID())
+		}
+	}
+	return ids
 }
 
 // Prettify uses awsutil.Prettify to print structs, but also removes '\n' for better logging.
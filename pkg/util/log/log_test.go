@@ -1 +1,110 @@
 package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigure(t *testing.T) {
+	defer func() { _ = Configure(FormatText, nil) }()
+
+	for _, tc := range []struct {
+		Name          string
+		Format        string
+		Levels        map[string]string
+		ExpectedError error
+	}{
+		{
+			Name:   "json with module levels",
+			Format: FormatJSON,
+			Levels: map[string]string{"tg": "warn", "aws": "DEBUG"},
+		},
+		{
+			Name:          "unknown format",
+			Format:        "xml",
+			ExpectedError: errors.New("unknown log format xml, must be text or json"),
+		},
+		{
+			Name:          "unknown level",
+			Format:        FormatText,
+			Levels:        map[string]string{"tg": "verbose"},
+			ExpectedError: errors.New("invalid log level for module tg due to unknown log level verbose, must be one of debug, info, warn, error"),
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := Configure(tc.Format, tc.Levels)
+			assert.Equal(t, tc.ExpectedError, err)
+		})
+	}
+}
+
+func TestSetLevels(t *testing.T) {
+	defer func() { _ = Configure(FormatText, nil) }()
+
+	assert.NoError(t, SetLevels(map[string]string{"log": "error"}))
+	assert.False(t, enabled("log", LevelWarn))
+	assert.True(t, enabled("log", LevelError))
+
+	assert.EqualError(t, SetLevels(map[string]string{"log": "verbose"}),
+		"invalid log level for module log due to unknown log level verbose, must be one of debug, info, warn, error")
+	assert.False(t, enabled("log", LevelWarn), "levels are kept on error")
+
+	assert.NoError(t, SetLevels(nil))
+	assert.True(t, enabled("log", LevelWarn))
+}
+
+func TestLogger_JSON(t *testing.T) {
+	defer func() {
+		_ = Configure(FormatText, nil)
+		jsonOutput = os.Stderr
+	}()
+	buf := &bytes.Buffer{}
+	jsonOutput = buf
+	assert.NoError(t, Configure(FormatJSON, map[string]string{"log": "warn"}))
+
+	logger := New("ns/ingress").WithField("albARN", "arn")
+	logger.Infof("suppressed by module level")
+	logger.Errorf("failed due to %v", awserr.NewRequestFailure(awserr.New("Throttling", "Rate exceeded", nil), 400, "req-1"))
+
+	var entry map[string]interface{}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	delete(entry, "ts")
+	assert.Equal(t, map[string]interface{}{
+		"level":      "error",
+		"module":     "log",
+		"ingress":    "ns/ingress",
+		"albARN":     "arn",
+		"msg":        "failed due to Throttling: Rate exceeded\n\tstatus code: 400, request id: req-1",
+		"requestIDs": []interface{}{"req-1"},
+	}, entry)
+}
+
+// debugFromHelper logs like a helper attributing its messages to its caller, as util.DeepEqual does.
+func debugFromHelper(logger *Logger) {
+	logger.DebugLevelf(3, "logged by a helper")
+}
+
+func TestLogger_DebugLevelf(t *testing.T) {
+	defer func() {
+		_ = Configure(FormatText, nil)
+		jsonOutput = os.Stderr
+	}()
+	buf := &bytes.Buffer{}
+	jsonOutput = buf
+	assert.NoError(t, Configure(FormatJSON, map[string]string{"log": "debug"}))
+
+	// the level of DebugLevelf is a glog depth rather than a verbosity, the module of the message is still the one of the caller.
+	debugFromHelper(New("ns/ingress"))
+
+	var entry map[string]interface{}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "debug", entry["level"])
+	assert.Equal(t, "log", entry["module"])
+	assert.Equal(t, "logged by a helper", entry["msg"])
+}
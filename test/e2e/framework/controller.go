@@ -0,0 +1,85 @@
+package framework
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/controller/config"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/ingress/metric"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/test/e2e/framework/utils"
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// controllerDrainTimeout bounds the wait for in-flight reconciles when stopping a LocalController.
+const controllerDrainTimeout = 30 * time.Second
+
+// LocalController runs the reconcile loops of the controller in the test process, so scenarios exercise the
+// lb, ls, tg and sg controllers without building and deploying an image.
+type LocalController struct {
+	stop    chan struct{}
+	done    chan struct{}
+	drainer controller.Drainer
+}
+
+// StartLocalController starts the controller against the cluster of restCfg and cloud.
+// args are controller flags, --cluster-name is set to clusterName unless specified.
+func StartLocalController(restCfg *rest.Config, cloud aws.CloudAPI, clusterName string, args []string) (*LocalController, error) {
+	cfg := config.NewConfiguration()
+	fs := pflag.NewFlagSet("controller", pflag.ContinueOnError)
+	cfg.BindFlags(fs)
+	if err := fs.Parse(append([]string{"--cluster-name=" + clusterName, "--preflight-checks=false"}, args...)); err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	mgr, err := manager.New(restCfg, manager.Options{MetricsBindAddress: "0"})
+	if err != nil {
+		return nil, err
+	}
+	drainer, err := controller.Initialize(&cfg, mgr, metric.DummyCollector{}, cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &LocalController{stop: make(chan struct{}), done: make(chan struct{}), drainer: drainer}
+	go func() {
+		defer close(c.done)
+		if err := mgr.Start(c.stop); err != nil {
+			utils.Failf("local controller failed: %v", err)
+		}
+	}()
+	return c, nil
+}
+
+// Stop stops the controller once its in-flight reconciles finished.
+func (c *LocalController) Stop() {
+	close(c.stop)
+	<-c.done
+	if err := c.drainer.Drain(controllerDrainTimeout); err != nil {
+		utils.Logf("local controller didn't drain: %v", err)
+	}
+}
+
+var (
+	localControllerOnce sync.Once
+	localController     *LocalController
+	localControllerErr  error
+)
+
+// startSharedLocalController starts a single LocalController for all the frameworks of the suite, it's stopped by RunCleanupActions.
+func startSharedLocalController(restCfg *rest.Config, cloud aws.CloudAPI, options *Options) (*LocalController, error) {
+	localControllerOnce.Do(func() {
+		localController, localControllerErr = StartLocalController(restCfg, cloud, options.ClusterName, strings.Fields(options.ControllerArgs))
+		if localControllerErr == nil {
+			AddCleanupAction(localController.Stop)
+		}
+	})
+	return localController, localControllerErr
+}
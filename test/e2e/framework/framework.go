@@ -2,6 +2,7 @@ package framework
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
@@ -27,6 +28,8 @@ type Framework struct {
 
 	Options *Options
 
+	restCfg *rest.Config
+
 	// To make sure that this framework cleans up after itself, no matter what,
 	// we install a Cleanup action before each test and clear it after.  If we
 	// should abort, the AfterSuite hook should run all Cleanup actions.
@@ -50,17 +53,18 @@ func (f *Framework) BeforeEach() {
 	// https://github.com/onsi/ginkgo/issues/222
 	if f.ClientSet == nil {
 		var err error
-		restCfg, err := f.buildRestConfig()
+		f.restCfg, err = f.buildRestConfig()
 		gomega.Expect(err).NotTo(gomega.HaveOccurred())
-		f.ClientSet, err = clientset.NewForConfig(restCfg)
+		f.ClientSet, err = clientset.NewForConfig(f.restCfg)
 		gomega.Expect(err).NotTo(gomega.HaveOccurred())
 	}
 	if f.Cloud == nil {
-		cc := cache.NewConfig(0 * time.Millisecond)
-		reg := prometheus.NewRegistry()
-		mc, _ := metric.NewCollector(reg, "alb")
 		var err error
-		f.Cloud, err = aws.New(aws.CloudConfig{Region: f.Options.AWSRegion, VpcID: f.Options.AWSVPCID}, f.Options.ClusterName, mc, false, cc)
+		f.Cloud, err = f.buildCloud()
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	}
+	if f.Options.MockCloud || f.Options.LocalController {
+		_, err := startSharedLocalController(f.restCfg, f.Cloud, f.Options)
 		gomega.Expect(err).NotTo(gomega.HaveOccurred())
 	}
 
@@ -83,6 +87,25 @@ func (f *Framework) cleanupAction() func() {
 	}
 }
 
+var (
+	mockCloudOnce sync.Once
+	mockCloud     *MockCloud
+)
+
+// buildCloud returns the cloud of the tests, the mock cloud is shared by all frameworks as it's the cloud of the shared LocalController.
+func (f *Framework) buildCloud() (aws.CloudAPI, error) {
+	if f.Options.MockCloud {
+		mockCloudOnce.Do(func() {
+			mockCloud = NewMockCloud(f.Options.AWSVPCID, f.Options.ClusterName)
+		})
+		return mockCloud, nil
+	}
+	cc := cache.NewConfig(0 * time.Millisecond)
+	reg := prometheus.NewRegistry()
+	mc, _ := metric.NewCollector(reg, "alb")
+	return aws.New(aws.CloudConfig{Region: f.Options.AWSRegion, VpcID: f.Options.AWSVPCID, Endpoint: f.Options.AWSEndpoint}, f.Options.ClusterName, mc, false, cc)
+}
+
 func (f *Framework) buildRestConfig() (*rest.Config, error) {
 	restCfg, err := clientcmd.BuildConfigFromFlags("", f.Options.KubeConfig)
 	if err != nil {
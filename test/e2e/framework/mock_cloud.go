@@ -0,0 +1,70 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	albtesting "github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/testing"
+)
+
+// defaultMockVPCID is the VPC of the mock cloud when --aws-vpc-id is unspecified.
+const defaultMockVPCID = "vpc-e2e"
+
+// MockCloud is an in-memory cloud for clusters without AWS networking, such as kind.
+// It pretends every IP of the VPC is served by an ENI, similar to pods networked by the Amazon VPC CNI plugin,
+// so ip targets and their securityGroups can be reconciled.
+type MockCloud struct {
+	*albtesting.Cloud
+}
+
+// NewMockCloud creates a MockCloud with public and internal subnets tagged for clusterName in two availability zones.
+func NewMockCloud(vpcID string, clusterName string) *MockCloud {
+	if len(vpcID) == 0 {
+		vpcID = defaultMockVPCID
+	}
+	cloud := albtesting.NewCloud(vpcID, clusterName)
+	for i, subnet := range []struct {
+		az      string
+		roleTag string
+	}{
+		{"us-west-2a", aws.TagNameSubnetPublicELB},
+		{"us-west-2b", aws.TagNameSubnetPublicELB},
+		{"us-west-2a", aws.TagNameSubnetInternalELB},
+		{"us-west-2b", aws.TagNameSubnetInternalELB},
+	} {
+		cloud.AddSubnet(&ec2.Subnet{
+			SubnetId:         aws.String(fmt.Sprintf("subnet-e2e-%d", i)),
+			AvailabilityZone: aws.String(subnet.az),
+			CidrBlock:        aws.String(fmt.Sprintf("10.0.%d.0/24", i)),
+			Tags: []*ec2.Tag{
+				{Key: aws.String(aws.TagNameCluster + "/" + clusterName), Value: aws.String("shared")},
+				{Key: aws.String(subnet.roleTag), Value: aws.String("1")},
+			},
+		})
+	}
+	return &MockCloud{Cloud: cloud}
+}
+
+// DescribeNetworkInterfaces creates the ENIs of the private IPs filtered on before describing them.
+func (c *MockCloud) DescribeNetworkInterfaces(ctx context.Context, input *ec2.DescribeNetworkInterfacesInput) ([]*ec2.NetworkInterface, error) {
+	for _, filter := range input.Filters {
+		if aws.StringValue(filter.Name) != "addresses.private-ip-address" {
+			continue
+		}
+		for _, ip := range aws.StringValueSlice(filter.Values) {
+			eniID := "eni-" + strings.NewReplacer(".", "-", ":", "-").Replace(ip)
+			if enis, err := c.Cloud.DescribeNetworkInterfaces(ctx, &ec2.DescribeNetworkInterfacesInput{NetworkInterfaceIds: aws.StringSlice([]string{eniID})}); err != nil || len(enis) != 0 {
+				continue
+			}
+			c.AddNetworkInterface(&ec2.NetworkInterface{
+				NetworkInterfaceId: aws.String(eniID),
+				PrivateIpAddress:   aws.String(ip),
+				PrivateIpAddresses: []*ec2.NetworkInterfacePrivateIpAddress{{PrivateIpAddress: aws.String(ip), Primary: aws.Bool(true)}},
+			})
+		}
+	}
+	return c.Cloud.DescribeNetworkInterfaces(ctx, input)
+}
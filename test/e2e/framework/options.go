@@ -18,6 +18,15 @@ type Options struct {
 	ClusterName string
 	AWSRegion   string
 	AWSVPCID    string
+
+	// AWSEndpoint is a LocalStack compatible endpoint serving the AWS APIs, instead of AWS.
+	AWSEndpoint string
+	// MockCloud uses an in-memory cloud instead of AWS, it implies LocalController.
+	MockCloud bool
+	// LocalController runs the controller in the test process against the cluster, instead of relying on a deployed controller.
+	LocalController bool
+	// ControllerArgs are flags for the controller run by LocalController, e.g. --feature-gates=waf=false.
+	ControllerArgs string
 }
 
 func ValidateGlobalOptions() {
@@ -31,6 +40,10 @@ func (options *Options) BindFlags() {
 	flag.StringVar(&options.ClusterName, "cluster-name", "", `Kubernetes cluster name (required)`)
 	flag.StringVar(&options.AWSRegion, "aws-region", "", `AWS Region for the kubernetes cluster`)
 	flag.StringVar(&options.AWSVPCID, "aws-vpc-id", "", `AWS VPC ID for the kubernetes cluster`)
+	flag.StringVar(&options.AWSEndpoint, "aws-endpoint", "", `URL of a LocalStack compatible endpoint serving the AWS APIs, e.g. http://localhost:4566`)
+	flag.BoolVar(&options.MockCloud, "mock-cloud", false, `Run the controller in the test process against an in-memory cloud, e.g. for kind clusters`)
+	flag.BoolVar(&options.LocalController, "local-controller", false, `Run the controller in the test process instead of relying on a deployed controller`)
+	flag.StringVar(&options.ControllerArgs, "controller-args", "", `Space separated flags of the controller run in the test process`)
 }
 
 func (options *Options) Validate() error {
@@ -40,6 +53,12 @@ func (options *Options) Validate() error {
 	if len(options.ClusterName) == 0 {
 		return errors.Errorf("%s must be set!", "cluster-name")
 	}
+	if options.MockCloud {
+		if len(options.AWSEndpoint) != 0 {
+			return errors.Errorf("%s and %s are mutually exclusive!", "mock-cloud", "aws-endpoint")
+		}
+		return nil
+	}
 	if len(options.AWSRegion) == 0 {
 		return errors.Errorf("%s must be set!", "aws-region")
 	}
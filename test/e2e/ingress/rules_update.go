@@ -0,0 +1,56 @@
+package ingress
+
+import (
+	"context"
+	"time"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/test/e2e/framework"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/test/e2e/ingress/shared"
+	"github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	extensionsv1 "k8s.io/api/extensions/v1beta1"
+)
+
+var _ = ginkgo.Describe("Ingress with changing paths", func() {
+	f := framework.New()
+
+	var (
+		ctx context.Context
+		ns  *corev1.Namespace
+	)
+
+	ginkgo.BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		ns, err = f.ResourceManager.CreateNamespaceUnique(context.TODO(), "ingress")
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	ginkgo.It("[mod-ip] should reconcile listener rules with the ingress paths", func() {
+		stackName := "changing-paths"
+		path1 := shared.EchoPath{Path: "/path1", ServicePort: 80}
+		path2 := shared.EchoPath{Path: "/path2", ServicePort: 8443}
+		stack := shared.NewEchoStack(stackName, "ip", path1, path2)
+		stack.Ingress.Spec.Rules[0].HTTP.Paths = shared.EchoIngressPaths(stackName, path1)
+		awsRes := stack.Deploy(ctx, f, ns)
+		gomega.Expect(awsRes.ALBs).To(gomega.HaveLen(1))
+		lbArn := awsRes.ALBs[0]
+
+		rulesCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+		defer cancel()
+		shared.ExpectListenerRulePathsEventually(rulesCtx, f.Cloud, lbArn, "/path1")
+
+		stack.UpdateIngress(ctx, f, ns, func(ing *extensionsv1.Ingress) {
+			ing.Spec.Rules[0].HTTP.Paths = shared.EchoIngressPaths(stackName, path1, path2)
+		})
+		shared.ExpectListenerRulePathsEventually(rulesCtx, f.Cloud, lbArn, "/path1", "/path2")
+
+		stack.UpdateIngress(ctx, f, ns, func(ing *extensionsv1.Ingress) {
+			ing.Spec.Rules[0].HTTP.Paths = shared.EchoIngressPaths(stackName, path2)
+		})
+		shared.ExpectListenerRulePathsEventually(rulesCtx, f.Cloud, lbArn, "/path2")
+
+		stack.Delete(ctx, f, ns)
+	})
+})
@@ -0,0 +1,191 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/test/e2e/framework"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/test/e2e/framework/utils"
+	"github.com/onsi/ginkgo"
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	extensionsv1 "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	echoImage    = "gcr.io/google_containers/echoserver:1.4"
+	echoPort     = 8080
+	echoReplicas = 3
+)
+
+// EchoPath routes Path of the ingress to ServicePort of the echo service.
+type EchoPath struct {
+	Path        string
+	ServicePort int32
+}
+
+// EchoStack is an echoserver deployment exposed by a service and an ingress, the building block of ingress scenarios.
+// Modify the objects before Deploy to cover other annotations or backends.
+type EchoStack struct {
+	Deployment *appsv1.Deployment
+	Service    *corev1.Service
+	Ingress    *extensionsv1.Ingress
+}
+
+// NewEchoStack builds an internet-facing EchoStack named name, with targets of targetType and the service ports of paths.
+func NewEchoStack(name string, targetType string, paths ...EchoPath) *EchoStack {
+	labels := map[string]string{
+		"app.kubernetes.io/name": name,
+	}
+	replicas := int32(echoReplicas)
+	dp := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:  "echoserver",
+						Image: echoImage,
+						Ports: []corev1.ContainerPort{{ContainerPort: echoPort}},
+					}},
+				},
+			},
+		},
+	}
+
+	svcType := corev1.ServiceTypeNodePort
+	if targetType == "ip" {
+		svcType = corev1.ServiceTypeClusterIP
+	}
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: corev1.ServiceSpec{
+			Type:     svcType,
+			Selector: labels,
+		},
+	}
+	seenPorts := map[int32]bool{}
+	for _, path := range paths {
+		if seenPorts[path.ServicePort] {
+			continue
+		}
+		seenPorts[path.ServicePort] = true
+		svc.Spec.Ports = append(svc.Spec.Ports, corev1.ServicePort{
+			Name:       fmt.Sprintf("port%d", len(svc.Spec.Ports)+1),
+			Port:       path.ServicePort,
+			TargetPort: intstr.FromInt(echoPort),
+			Protocol:   corev1.ProtocolTCP,
+		})
+	}
+
+	ing := &extensionsv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Annotations: map[string]string{
+				"kubernetes.io/ingress.class":                            "alb",
+				"alb.ingress.kubernetes.io/scheme":                       "internet-facing",
+				"alb.ingress.kubernetes.io/healthcheck-interval-seconds": "5",
+				"alb.ingress.kubernetes.io/healthcheck-timeout-seconds":  "2",
+				"alb.ingress.kubernetes.io/target-type":                  targetType,
+			},
+		},
+		Spec: extensionsv1.IngressSpec{
+			Rules: []extensionsv1.IngressRule{{
+				IngressRuleValue: extensionsv1.IngressRuleValue{
+					HTTP: &extensionsv1.HTTPIngressRuleValue{Paths: EchoIngressPaths(name, paths...)},
+				},
+			}},
+		},
+	}
+	return &EchoStack{
+		Deployment: dp,
+		Service:    svc,
+		Ingress:    ing,
+	}
+}
+
+// EchoIngressPaths returns the ingress paths routing paths to the service serviceName.
+func EchoIngressPaths(serviceName string, paths ...EchoPath) []extensionsv1.HTTPIngressPath {
+	var result []extensionsv1.HTTPIngressPath
+	for _, path := range paths {
+		result = append(result, extensionsv1.HTTPIngressPath{
+			Path: path.Path,
+			Backend: extensionsv1.IngressBackend{
+				ServiceName: serviceName,
+				ServicePort: intstr.FromInt(int(path.ServicePort)),
+			},
+		})
+	}
+	return result
+}
+
+// Deploy creates the stack in ns and waits until the ingress has an ALB, returning the AWS resources of the ingress.
+func (s *EchoStack) Deploy(ctx context.Context, f *framework.Framework, ns *corev1.Namespace) AWSResources {
+	ginkgo.By("create deployment")
+	dp, err := f.ClientSet.AppsV1().Deployments(ns.Name).Create(s.Deployment)
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+	ginkgo.By("create service")
+	svc, err := f.ClientSet.CoreV1().Services(ns.Name).Create(s.Service)
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+	ginkgo.By("create ingress")
+	ing, err := f.ClientSet.ExtensionsV1beta1().Ingresses(ns.Name).Create(s.Ingress)
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+	ginkgo.By("wait deployment")
+	dp, err = f.ResourceManager.WaitDeploymentReady(ctx, dp)
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+	ginkgo.By("wait service")
+	_, err = f.ResourceManager.WaitServiceHasEndpointsNum(ctx, svc, int(*dp.Spec.Replicas))
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+	ginkgo.By("wait ingress")
+	ing, err = f.ResourceManager.WaitIngressReady(ctx, ing)
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	utils.Logf("ingress DNS created: %v", ing.Status.LoadBalancer.Ingress[0].Hostname)
+	s.Ingress = ing
+
+	awsRes, err := GetAWSResourcesByIngress(f.Cloud, f.Options.ClusterName, ns.Name, ing.Name)
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	utils.Logf("ingress AWS Resources created: %v", awsRes)
+	return awsRes
+}
+
+// UpdateIngress applies mutate to the latest version of the ingress of the stack.
+func (s *EchoStack) UpdateIngress(ctx context.Context, f *framework.Framework, ns *corev1.Namespace, mutate func(ing *extensionsv1.Ingress)) {
+	ginkgo.By("update ingress")
+	ing, err := f.ClientSet.ExtensionsV1beta1().Ingresses(ns.Name).Get(s.Ingress.Name, metav1.GetOptions{})
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	mutate(ing)
+	ing, err = f.ClientSet.ExtensionsV1beta1().Ingresses(ns.Name).Update(ing)
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	s.Ingress = ing
+}
+
+// Delete deletes the stack from ns and waits until the AWS resources of the ingress are deleted.
+func (s *EchoStack) Delete(ctx context.Context, f *framework.Framework, ns *corev1.Namespace) {
+	ginkgo.By("delete ingress")
+	err := f.ClientSet.ExtensionsV1beta1().Ingresses(ns.Name).Delete(s.Ingress.Name, &metav1.DeleteOptions{})
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+	ginkgo.By("delete service")
+	err = f.ClientSet.CoreV1().Services(ns.Name).Delete(s.Service.Name, &metav1.DeleteOptions{})
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+	ginkgo.By("delete deployment")
+	err = f.ClientSet.AppsV1().Deployments(ns.Name).Delete(s.Deployment.Name, &metav1.DeleteOptions{})
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	ExpectAWSResourcedByIngressEventuallyDeleted(ctx, f.Cloud, f.Options.ClusterName, ns.Name, s.Ingress.Name)
+	cancel()
+}
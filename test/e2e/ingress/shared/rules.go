@@ -0,0 +1,56 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/internal/aws"
+	"github.com/kubernetes-sigs/aws-alb-ingress-controller/test/e2e/framework/utils"
+	"github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// GetListenerRulePaths returns the path patterns of the rules of all listeners of the LoadBalancer lbArn, sorted.
+func GetListenerRulePaths(ctx context.Context, cloud aws.CloudAPI, lbArn string) ([]string, error) {
+	listeners, err := cloud.ListListenersByLoadBalancer(ctx, lbArn)
+	if err != nil {
+		return nil, err
+	}
+	paths := sets.NewString()
+	for _, listener := range listeners {
+		rules, err := cloud.GetRules(ctx, aws.StringValue(listener.ListenerArn))
+		if err != nil {
+			return nil, err
+		}
+		for _, rule := range rules {
+			for _, condition := range rule.Conditions {
+				if aws.StringValue(condition.Field) != "path-pattern" {
+					continue
+				}
+				paths.Insert(aws.StringValueSlice(condition.Values)...)
+				if condition.PathPatternConfig != nil {
+					paths.Insert(aws.StringValueSlice(condition.PathPatternConfig.Values)...)
+				}
+			}
+		}
+	}
+	return paths.List(), nil
+}
+
+// ExpectListenerRulePathsEventually checks the listener rules of the LoadBalancer lbArn eventually route exactly paths.
+func ExpectListenerRulePathsEventually(ctx context.Context, cloud aws.CloudAPI, lbArn string, paths ...string) {
+	expected := sets.NewString(paths...)
+	err := wait.PollImmediateUntil(utils.PollIntervalShort, func() (bool, error) {
+		actual, err := GetListenerRulePaths(ctx, cloud, lbArn)
+		if err != nil {
+			return false, err
+		}
+		if !expected.Equal(sets.NewString(actual...)) {
+			utils.Logf("listener rules of %s route %v, expecting %v", lbArn, actual, expected.List())
+			return false, nil
+		}
+		return true, nil
+	}, ctx.Done())
+	gomega.Expect(err).NotTo(gomega.HaveOccurred(), fmt.Sprintf("listener rules of %s should route %v", lbArn, expected.List()))
+}